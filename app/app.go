@@ -3,7 +3,13 @@ package app
 import (
 	"context"
 	"fmt"
+	"strings"
+	gosync "sync"
+	"time"
 
+	"github.com/FleekHQ/space-daemon/core/events"
+	"github.com/FleekHQ/space-daemon/core/profile"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/space/fuse/installer"
 
 	"github.com/FleekHQ/space-daemon/core/search/bleve"
@@ -32,18 +38,28 @@ import (
 	"golang.org/x/sync/errgroup"
 
 	"github.com/FleekHQ/space-daemon/config"
+	"github.com/FleekHQ/space-daemon/core/ignore"
 	"github.com/FleekHQ/space-daemon/core/store"
 	w "github.com/FleekHQ/space-daemon/core/watcher"
+
 	"github.com/golang-collections/collections/stack"
 )
 
 // Shutdown logic follows this example https://gist.github.com/akhenakh/38dbfea70dc36964e23acc19777f3869
 type App struct {
 	eg         *errgroup.Group
+	ctx        context.Context
 	components *stack.Stack
 	cfg        config.Config
 	env        env.SpaceEnv
 	IsRunning  bool
+
+	// components scoped to the active profile, restarted when switching profiles
+	profiles          *profile.Manager
+	profileMu         gosync.Mutex
+	profileEg         *errgroup.Group
+	profileComponents *stack.Stack
+	srv               server
 }
 
 type componentMap struct {
@@ -51,12 +67,23 @@ type componentMap struct {
 	component core.Component
 }
 
+// gRPC server methods used to hand it the services of the active profile
+type server interface {
+	core.AsyncComponent
+	Start(ctx context.Context) error
+	SetService(sv space.Service, fc *fuse.Controller, kc keychain.Keychain, st store.Store)
+	SendFileEvent(event events.FileEvent)
+	SendTextileEvent(event events.TextileEvent)
+	SendNotificationEvent(notif *domain.Notification)
+}
+
 func New(cfg config.Config, env env.SpaceEnv) *App {
 	return &App{
-		components: stack.New(),
-		cfg:        cfg,
-		env:        env,
-		IsRunning:  false,
+		components:        stack.New(),
+		profileComponents: stack.New(),
+		cfg:               cfg,
+		env:               env,
+		IsRunning:         false,
 	}
 }
 
@@ -66,32 +93,18 @@ func New(cfg config.Config, env env.SpaceEnv) *App {
 // added to the apps list of tracked components using the `Run()` function, but if the component has a blocking
 // start/run function it should be tracked with the `RunAsync()` function and call the blocking function in the
 // input function block.
+// Components holding user data are started by startProfile so they can be restarted when switching profiles.
 func (a *App) Start() error {
-	var ctx context.Context
-	a.eg, ctx = errgroup.WithContext(context.Background())
+	a.eg, a.ctx = errgroup.WithContext(context.Background())
+	ctx := a.ctx
 
 	log.SetLogLevel(a.cfg.GetString(config.LogLevel, "debug"))
 
-	// init appStore
-	appStore := store.New(
-		store.WithPath(a.cfg.GetString(config.SpaceStorePath, "")),
-	)
-	if err := appStore.Open(); err != nil {
-		return err
-	}
-	a.Run("Store", appStore)
-
-	// Init keychain
-	kc := keychain.New(keychain.WithPath(a.cfg.GetString(config.SpaceStorePath, "")), keychain.WithStore(appStore))
-
-	// Init Vault
-	v := vault.New(a.cfg.GetString(config.SpaceVaultAPIURL, ""), a.cfg.GetString(config.SpaceVaultSaltSecret, ""))
-
-	watcher, err := w.New()
+	profiles, err := profile.New(a.cfg.GetString(config.SpaceStorePath, store.DefaultRootDir))
 	if err != nil {
 		return err
 	}
-	a.Run("FolderWatcher", watcher)
+	a.profiles = profiles
 
 	// setup local ipfs node if Ipfsnode is set
 	if a.cfg.GetBool(config.Ipfsnode, true) {
@@ -117,17 +130,97 @@ func (a *App) Start() error {
 		return err
 	}
 
-	hubAuth := hub.New(appStore, kc, a.cfg)
+	if err := a.startProfile(profiles.Active()); err != nil {
+		return err
+	}
+
+	log.Info("Daemon ready")
+	a.IsRunning = true
+
+	return nil
+}
+
+// startProfile initializes the components holding the data of the given profile.
+// The gRPC server is created with the first profile and handed the new services on later switches.
+func (a *App) startProfile(name string) error {
+	log.Info("Starting profile", "profile:"+name)
+
+	var ctx context.Context
+	a.profileEg, ctx = errgroup.WithContext(a.ctx)
+	cfg := a.profiles.Config(a.cfg, name)
+	storePath := cfg.GetString(config.SpaceStorePath, "")
+
+	// the store keys are kept in the keyring, the keychain below cannot be used since it needs the store itself
+	storeKeys := keychain.New(
+		keychain.WithPath(a.cfg.GetString(config.SpaceStorePath, "")),
+		keychain.WithNamespace(a.profiles.KeychainNamespace(name)),
+	)
+	encryptStore := cfg.GetBool(config.SpaceStoreEncryption, false)
+	if encryptStore && cfg.GetBool(config.SpaceRotateStoreKey, false) {
+		if err := storeKeys.RotateStoreEncryptionKey(); err != nil {
+			return err
+		}
+	}
+
+	// init appStore
+	appStore := store.New(
+		store.WithPath(storePath),
+		store.WithProfile(name),
+		store.WithEncryption(storeKeys, encryptStore),
+	)
+	if err := appStore.Open(); err != nil {
+		return err
+	}
+	a.runProfile("Store", appStore)
+
+	// Init keychain
+	// the root path is kept for all profiles so app tokens are shared by them
+	kcOpts := []keychain.Option{
+		keychain.WithPath(a.cfg.GetString(config.SpaceStorePath, "")),
+		keychain.WithStore(appStore),
+		keychain.WithNamespace(a.profiles.KeychainNamespace(name)),
+	}
+	if signerCmd := strings.Fields(cfg.GetString(config.SpaceSignerCommand, "")); len(signerCmd) > 0 {
+		signer := keychain.NewExternalSigner(signerCmd[0], signerCmd[1:]...)
+		a.runProfile("ExternalSigner", signer)
+		kcOpts = append(kcOpts, keychain.WithSigner(signer))
+	}
+	kc := keychain.New(kcOpts...)
+
+	// Init Vault
+	v, err := vault.NewFromConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	debounce := w.DefaultDebounceDelay
+	if val := a.cfg.GetString(config.SpaceWatcherDebounce, ""); val != "" {
+		if debounce, err = time.ParseDuration(val); err != nil {
+			return fmt.Errorf("invalid watcher debounce %s: %s", val, err.Error())
+		}
+	}
+
+	watcher, err := w.NewFolderWatcher(
+		w.WithIgnorePatterns(ignore.SplitPatterns(a.cfg.GetString(config.SpaceIgnorePatterns, ""))...),
+		w.WithBackend(a.cfg.GetString(config.SpaceWatcherBackend, "")),
+		w.WithDebounce(debounce),
+	)
+	if err != nil {
+		return err
+	}
+	a.runProfile("FolderWatcher", watcher)
+
+	hubAuth := hub.New(appStore, kc, cfg)
 
 	// setup files search engine
-	searchEngine := bleve.NewSearchEngine(bleve.WithDBPath(a.cfg.GetString(config.SpaceStorePath, "")))
-	a.Run("FilesSearchEngine", searchEngine)
+	searchEngine := bleve.NewSearchEngine(bleve.WithDBPath(storePath))
+	a.runProfile("FilesSearchEngine", searchEngine)
 
 	// setup textile client
-	uc := textile.CreateUserClient(a.cfg.GetString(config.TextileHubTarget, ""))
+	uc := textile.CreateUserClient(cfg.GetString(config.TextileHubTarget, ""))
 	textileClient := textile.NewClient(appStore, kc, hubAuth, uc, nil, searchEngine)
-	err = a.RunAsync("TextileClient", textileClient, func() error {
-		return textileClient.Start(ctx, a.cfg)
+	err = a.runProfileAsync("TextileClient", textileClient, func() error {
+		return textileClient.Start(ctx, cfg)
 	})
 	if err != nil {
 		return err
@@ -141,7 +234,7 @@ func (a *App) Start() error {
 		appStore,
 		textileClient,
 		bucketSync,
-		a.cfg,
+		cfg,
 		kc,
 		v,
 		hubAuth,
@@ -158,7 +251,7 @@ func (a *App) Start() error {
 		fsds.WithSharedWithMeDataSources(sv),
 	))
 	fuseInstaller := installer.NewFuseInstaller()
-	fuseController := fuse.NewController(ctx, a.cfg, appStore, sfs, fuseInstaller)
+	fuseController := fuse.NewController(ctx, cfg, appStore, sfs, fuseInstaller)
 	if fuseController.ShouldMount() {
 		log.Info("Mounting FUSE Drive")
 		if err := fuseController.Mount(); err != nil {
@@ -167,64 +260,154 @@ func (a *App) Start() error {
 			log.Info("Mounting FUSE Drive successful")
 		}
 	}
-	a.Run("FuseController", fuseController)
-
-	// setup gRPC Server
-	srv := grpc.New(
-		sv,
-		fuseController,
-		kc,
-		grpc.WithPort(a.cfg.GetInt(config.SpaceServerPort, 0)),
-		grpc.WithProxyPort(a.cfg.GetInt(config.SpaceProxyServerPort, 0)),
-		grpc.WithRestProxyPort(a.cfg.GetInt(config.SpaceRestProxyServerPort, 0)),
-	)
-
-	textileClient.AttachMailboxNotifier(srv)
-	textileClient.AttachSynchronizerNotifier(srv)
+	a.runProfile("FuseController", fuseController)
+
+	if a.srv == nil {
+		// setup gRPC Server
+		srv := grpc.New(
+			sv,
+			fuseController,
+			kc,
+			appStore,
+			grpc.WithPort(a.cfg.GetInt(config.SpaceServerPort, 0)),
+			grpc.WithProxyPort(a.cfg.GetInt(config.SpaceProxyServerPort, 0)),
+			grpc.WithRestProxyPort(a.cfg.GetInt(config.SpaceRestProxyServerPort, 0)),
+			grpc.WithProfileManager(a),
+		)
+		a.srv = srv
+
+		textileClient.AttachMailboxNotifier(srv)
+		textileClient.AttachSynchronizerNotifier(srv)
+
+		// start the gRPC server
+		err = a.RunAsync("gRPCServer", srv, func() error {
+			return srv.Start(a.ctx)
+		})
+		if err != nil {
+			return err
+		}
+	} else {
+		a.srv.SetService(sv, fuseController, kc, appStore)
+		textileClient.AttachMailboxNotifier(a.srv)
+		textileClient.AttachSynchronizerNotifier(a.srv)
+	}
 
-	// start the gRPC server
-	err = a.RunAsync("gRPCServer", srv, func() error {
-		return srv.Start(ctx)
+	err = a.runProfileAsync("BucketSync", bucketSync, func() error {
+		bucketSync.RegisterNotifier(a.srv)
+		return bucketSync.Start(ctx)
 	})
 	if err != nil {
 		return err
 	}
 
-	err = a.RunAsync("BucketSync", bucketSync, func() error {
-		bucketSync.RegisterNotifier(srv)
-		return bucketSync.Start(ctx)
-	})
-	if err != nil {
+	return nil
+}
+
+// stopProfile shuts down the components of the active profile
+func (a *App) stopProfile() error {
+	shutdownComponents(a.profileComponents)
+
+	if a.profileEg == nil {
+		return nil
+	}
+
+	return a.profileEg.Wait()
+}
+
+// ListProfiles returns the profile names and the active one
+func (a *App) ListProfiles() ([]string, string) {
+	return a.profiles.List(), a.profiles.Active()
+}
+
+// CreateProfile adds a new empty profile
+func (a *App) CreateProfile(name string) error {
+	return a.profiles.Create(name)
+}
+
+// SwitchProfile restarts the profile components with the given profile.
+// If the new profile fails to start, the previous one is restored.
+func (a *App) SwitchProfile(ctx context.Context, name string) error {
+	a.profileMu.Lock()
+	defer a.profileMu.Unlock()
+
+	prev := a.profiles.Active()
+	if name == prev {
+		return nil
+	}
+
+	if err := a.profiles.SetActive(name); err != nil {
 		return err
 	}
 
-	log.Info("Daemon ready")
-	a.IsRunning = true
+	if err := a.stopProfile(); err != nil {
+		log.Error(fmt.Sprintf("error stopping profile %s", prev), err)
+	}
 
-	return nil
+	err := a.startProfile(name)
+	if err == nil {
+		return nil
+	}
+
+	log.Error(fmt.Sprintf("error starting profile %s, restoring profile %s", name, prev), err)
+	if stopErr := a.stopProfile(); stopErr != nil {
+		log.Error(fmt.Sprintf("error stopping profile %s", name), stopErr)
+	}
+
+	if setErr := a.profiles.SetActive(prev); setErr != nil {
+		return errors.Wrap(setErr, err.Error())
+	}
+
+	if startErr := a.startProfile(prev); startErr != nil {
+		return errors.Wrap(startErr, err.Error())
+	}
+
+	return err
 }
 
 // Run registers this component to be cleaned up on Shutdown
 func (a *App) Run(name string, component core.Component) {
+	run(a.components, name, component)
+}
+
+// RunAsync performs the same function as Run() but also accepts an function to be run
+// async to initialize the component.
+func (a *App) RunAsync(name string, component core.AsyncComponent, fn func() error) error {
+	return runAsync(a.eg, a.components, name, component, fn)
+}
+
+// runProfile registers a component to be cleaned up when the profile is stopped
+func (a *App) runProfile(name string, component core.Component) {
+	run(a.profileComponents, name, component)
+}
+
+func (a *App) runProfileAsync(name string, component core.AsyncComponent, fn func() error) error {
+	return runAsync(a.profileEg, a.profileComponents, name, component, fn)
+}
+
+func run(components *stack.Stack, name string, component core.Component) {
 	log.Debug("Starting Component", "name:"+name)
-	a.components.Push(&componentMap{
+	components.Push(&componentMap{
 		name:      name,
 		component: component,
 	})
 }
 
-// RunAsync performs the same function as Run() but also accepts an function to be run
-// async to initialize the component.
-func (a *App) RunAsync(name string, component core.AsyncComponent, fn func() error) error {
+func runAsync(
+	eg *errgroup.Group,
+	components *stack.Stack,
+	name string,
+	component core.AsyncComponent,
+	fn func() error,
+) error {
 	log.Debug("Starting Async Component", "name:"+name)
-	if a.eg == nil {
+	if eg == nil {
 		log.Warn("App.RunAsync() should be called after App.Start()")
 		return nil
 	}
 
 	errc := make(chan error)
 
-	a.eg.Go(func() error {
+	eg.Go(func() error {
 		err := fn()
 		if err != nil {
 			errc <- err
@@ -237,7 +420,7 @@ func (a *App) RunAsync(name string, component core.AsyncComponent, fn func() err
 	case err := <-errc:
 		return err
 	case <-component.WaitForReady():
-		a.components.Push(&componentMap{
+		components.Push(&componentMap{
 			name:      name,
 			component: component,
 		})
@@ -246,6 +429,18 @@ func (a *App) RunAsync(name string, component core.AsyncComponent, fn func() err
 	return nil
 }
 
+func shutdownComponents(components *stack.Stack) {
+	for components.Len() > 0 {
+		m, ok := components.Pop().(*componentMap)
+		if ok {
+			log.Debug("Shutting down Component", fmt.Sprintf("name:%s", m.name))
+			if err := m.component.Shutdown(); err != nil {
+				log.Error(fmt.Sprintf("error shutting down %s", m.name), err)
+			}
+		}
+	}
+}
+
 // Shutdown would perform a graceful shutdown of all components added through the
 // Run() or RunAsync() functions
 func (a *App) Shutdown() error {
@@ -254,15 +449,13 @@ func (a *App) Shutdown() error {
 		return errors.New("app is not running")
 	}
 
-	for a.components.Len() > 0 {
-		m, ok := a.components.Pop().(*componentMap)
-		if ok {
-			log.Debug("Shutting down Component", fmt.Sprintf("name:%s", m.name))
-			if err := m.component.Shutdown(); err != nil {
-				log.Error(fmt.Sprintf("error shutting down %s", m.name), err)
-			}
-		}
+	a.profileMu.Lock()
+	defer a.profileMu.Unlock()
+
+	if err := a.stopProfile(); err != nil {
+		log.Error("error stopping profile", err)
 	}
+	shutdownComponents(a.components)
 
 	err := a.eg.Wait()
 	log.Info("Shutdown complete")
@@ -30,6 +30,12 @@ var (
 	enableTracing        = flag.Bool("trace", false, "run tracing on daemon rpc")
 	devMode              = flag.Bool("dev", false, "run daemon in dev mode to use .env file")
 	ipfsnode             = flag.Bool("ipfsnode", true, "run IPFS embedded into the daemon (defaults to true)")
+	signer               = flag.String("signer", "", "command of an external process used to sign with the identity key")
+	storeEncryption      = flag.Bool("storeEncryption", false, "encrypt the values of the local store at rest with a key kept in the keyring, keys such as file paths stay in plaintext")
+	rotateStoreKey       = flag.Bool("rotateStoreKey", false, "re-encrypt the local store with a new key on startup")
+	ignorePatterns       = flag.String("ignorePatterns", "", "comma separated gitignore-style patterns of files never synced or uploaded")
+	watcherBackend       = flag.String("watcherBackend", "", "how watched folders are checked for changes: native or poll (defaults to native on Linux)")
+	watcherDebounce      = flag.String("watcherDebounce", "", "time a changed file must be quiet before it is uploaded, like 500ms (defaults to 1s, 0 uploads right away)")
 	ipfsaddr             string
 	ipfsnodeaddr         string
 	ipfsnodepath         string
@@ -37,6 +43,8 @@ var (
 	spacestoragesiteurl  string
 	vaultapi             string
 	vaultsaltsecret      string
+	vaultbackend         string
+	vaultpath            string
 	spacehubauth         string
 	textilehub           string
 	textilehubma         string
@@ -65,6 +73,14 @@ func main() {
 		SpaceStorageSiteUrl:  spacestoragesiteurl,
 		VaultAPIURL:          vaultapi,
 		VaultSaltSecret:      vaultsaltsecret,
+		VaultBackend:         vaultbackend,
+		VaultPath:            vaultpath,
+		SignerCommand:        *signer,
+		StoreEncryption:      *storeEncryption,
+		RotateStoreKey:       *rotateStoreKey,
+		IgnorePatterns:       *ignorePatterns,
+		WatcherBackend:       *watcherBackend,
+		WatcherDebounce:      *watcherDebounce,
 		ServicesHubAuthURL:   spacehubauth,
 		DevMode:              *devMode == true,
 		TextileHubTarget:     textilehub,
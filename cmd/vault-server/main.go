@@ -0,0 +1,184 @@
+// Reference vault server implementing the same protocol as the hosted vault API.
+// It keeps vaults in a local folder, so it can be self-hosted or used to test the daemon locally:
+//
+//	go run ./cmd/vault-server -addr :8090 -dir /tmp/vaults -jwt-secret <secret>
+//
+// and run the daemon with VAULT_API_URL=http://localhost:8090.
+// The secret is required since without it anyone reaching the server could overwrite any vault.
+// Pass -insecure instead to skip token verification when testing locally.
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/vault"
+	"github.com/FleekHQ/space-daemon/log"
+)
+
+var (
+	addr      = flag.String("addr", ":8090", "address to listen on")
+	dir       = flag.String("dir", "vaults", "folder in which to store the vaults")
+	jwtSecret = flag.String("jwt-secret", "", "secret used to verify api tokens")
+	insecure  = flag.Bool("insecure", false, "do not verify api tokens, only for local testing")
+)
+
+type storeVaultRequest struct {
+	Vault string `json:"vault"`
+	Vsk   string `json:"vsk"`
+	Type  string `json:"type"`
+}
+
+type retrieveVaultRequest struct {
+	Vsk  string `json:"vsk"`
+	Type string `json:"type"`
+}
+
+type retrieveVaultResponse struct {
+	EncryptedVault string `json:"encryptedVault"`
+}
+
+var errInvalidToken = errors.New("Unauthorized Error: Authorization token is invalid.")
+var errExpiredToken = errors.New("Unauthorized Error: Authorization token is expired.")
+
+type server struct {
+	backend *vault.FilesystemBackend
+	secret  []byte
+}
+
+var errMissingSecret = errors.New("-jwt-secret is required, use -insecure to run without verifying api tokens")
+
+func main() {
+	flag.Parse()
+
+	if *jwtSecret == "" && !*insecure {
+		log.Fatal(errMissingSecret)
+	}
+
+	if *insecure {
+		log.Warn("Vault server running without verifying api tokens, anyone can overwrite any vault")
+	}
+
+	s := &server{
+		backend: vault.NewFilesystemBackend(*dir),
+		secret:  []byte(*jwtSecret),
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/vaults", s.storeVault)
+	mux.HandleFunc("/vaults/", s.retrieveVault)
+
+	log.Info("Vault server listening on " + *addr)
+	if err := http.ListenAndServe(*addr, mux); err != nil {
+		log.Error("Vault server stopped", err)
+	}
+}
+
+// POST /vaults, the vault uuid is taken from the api token
+func (s *server) storeVault(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	uuid, err := s.uuidFromToken(r.Header.Get("Authorization"))
+	if err != nil {
+		writeError(w, http.StatusUnauthorized, err)
+		return
+	}
+
+	var req storeVaultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	err = s.backend.Put(uuid, &vault.StoredVault{Vault: req.Vault, Vsk: req.Vsk}, req.Type, "")
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, struct{}{})
+}
+
+// POST /vaults/{uuid}
+func (s *server) retrieveVault(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("method not allowed"))
+		return
+	}
+
+	uuid := strings.TrimPrefix(r.URL.Path, "/vaults/")
+
+	var req retrieveVaultRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+
+	encryptedVault, err := s.backend.Get(uuid, req.Vsk, req.Type)
+	if err != nil {
+		// do not leak whether the vault exists
+		writeError(w, http.StatusUnauthorized, vault.ErrIncorrectVaultCreds)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, &retrieveVaultResponse{EncryptedVault: encryptedVault})
+}
+
+// api tokens are HS256 JWTs with the vault uuid in the "uuid" claim.
+// They must expire, so a captured token can't be used to overwrite the vault later on.
+func (s *server) uuidFromToken(token string) (string, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return "", errInvalidToken
+	}
+
+	// the secret is only empty when running with -insecure
+	if len(s.secret) > 0 {
+		mac := hmac.New(sha256.New, s.secret)
+		mac.Write([]byte(parts[0] + "." + parts[1]))
+		signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+		if err != nil || !hmac.Equal(signature, mac.Sum(nil)) {
+			return "", errInvalidToken
+		}
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errInvalidToken
+	}
+
+	var claims struct {
+		Uuid string `json:"uuid"`
+		Exp  int64  `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Uuid == "" || claims.Exp == 0 {
+		return "", errInvalidToken
+	}
+
+	if time.Now().Unix() >= claims.Exp {
+		return "", errExpiredToken
+	}
+
+	return claims.Uuid, nil
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, &domain.APIError{Message: err.Error()})
+}
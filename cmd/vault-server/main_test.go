@@ -0,0 +1,55 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func signToken(t *testing.T, secret []byte, claims map[string]interface{}) string {
+	header := base64.RawURLEncoding.EncodeToString([]byte(`{"alg":"HS256","typ":"JWT"}`))
+	payload, err := json.Marshal(claims)
+	assert.Nil(t, err)
+
+	unsigned := header + "." + base64.RawURLEncoding.EncodeToString(payload)
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(unsigned))
+
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestUuidFromToken_AcceptsValidToken(t *testing.T) {
+	s := &server{secret: []byte("secret")}
+	token := signToken(t, s.secret, map[string]interface{}{
+		"uuid": "vault-uuid",
+		"exp":  time.Now().Add(time.Hour).Unix(),
+	})
+
+	uuid, err := s.uuidFromToken(token)
+	assert.Nil(t, err)
+	assert.Equal(t, "vault-uuid", uuid)
+}
+
+func TestUuidFromToken_RejectsExpiredToken(t *testing.T) {
+	s := &server{secret: []byte("secret")}
+	token := signToken(t, s.secret, map[string]interface{}{
+		"uuid": "vault-uuid",
+		"exp":  time.Now().Add(-time.Minute).Unix(),
+	})
+
+	_, err := s.uuidFromToken(token)
+	assert.Equal(t, errExpiredToken, err)
+}
+
+func TestUuidFromToken_RejectsTokenWithoutExpiry(t *testing.T) {
+	s := &server{secret: []byte("secret")}
+	token := signToken(t, s.secret, map[string]interface{}{"uuid": "vault-uuid"})
+
+	_, err := s.uuidFromToken(token)
+	assert.Equal(t, errInvalidToken, err)
+}
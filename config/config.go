@@ -23,6 +23,14 @@ const (
 	SpaceServicesAPIURL      = "space/servicesApiUrl"
 	SpaceVaultAPIURL         = "space/vaultApiUrl"
 	SpaceVaultSaltSecret     = "space/vaultSaltSecret"
+	SpaceVaultBackend        = "space/vaultBackend"
+	SpaceVaultPath           = "space/vaultPath"
+	SpaceSignerCommand       = "space/signerCommand"
+	SpaceStoreEncryption     = "space/storeEncryption"
+	SpaceRotateStoreKey      = "space/rotateStoreKey"
+	SpaceIgnorePatterns      = "space/ignorePatterns"
+	SpaceWatcherBackend      = "space/watcherBackend"
+	SpaceWatcherDebounce     = "space/watcherDebounce"
 	SpaceServicesHubAuthURL  = "space/servicesHubAuthUrl"
 	Ipfsaddr                 = "space/ipfsAddr"
 	Ipfsnode                 = "space/ipfsNode"
@@ -52,6 +60,14 @@ type Flags struct {
 	SpaceStorageSiteUrl    string
 	VaultAPIURL            string
 	VaultSaltSecret        string
+	VaultBackend           string
+	VaultPath              string
+	SignerCommand          string
+	StoreEncryption        bool
+	RotateStoreKey         bool
+	IgnorePatterns         string
+	WatcherBackend         string
+	WatcherDebounce        string
 	ServicesHubAuthURL     string
 	TextileHubTarget       string
 	TextileHubMa           string
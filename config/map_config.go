@@ -36,6 +36,12 @@ func NewMap(flags *Flags) Config {
 		configStr[SpaceServicesAPIURL] = os.Getenv(env.ServicesAPIURL)
 		configStr[SpaceVaultAPIURL] = os.Getenv(env.VaultAPIURL)
 		configStr[SpaceVaultSaltSecret] = os.Getenv(env.VaultSaltSecret)
+		configStr[SpaceVaultBackend] = os.Getenv(env.VaultBackend)
+		configStr[SpaceVaultPath] = os.Getenv(env.VaultPath)
+		configStr[SpaceSignerCommand] = os.Getenv(env.SignerCommand)
+		configStr[SpaceIgnorePatterns] = os.Getenv(env.IgnorePatterns)
+		configStr[SpaceWatcherBackend] = os.Getenv(env.WatcherBackend)
+		configStr[SpaceWatcherDebounce] = os.Getenv(env.WatcherDebounce)
 		configStr[SpaceServicesHubAuthURL] = os.Getenv(env.ServicesHubAuthURL)
 		configStr[SpaceStorageSiteUrl] = os.Getenv(env.SpaceStorageSiteUrl)
 		configStr[TextileHubTarget] = os.Getenv(env.TextileHubTarget)
@@ -48,6 +54,8 @@ func NewMap(flags *Flags) Config {
 		if os.Getenv(env.IpfsNode) != "false" {
 			configBool[Ipfsnode] = true
 		}
+		configBool[SpaceStoreEncryption] = os.Getenv(env.StoreEncryption) == "true"
+		configBool[SpaceRotateStoreKey] = os.Getenv(env.RotateStoreKey) == "true"
 	} else {
 		configStr[Ipfsaddr] = flags.Ipfsaddr
 		configStr[Ipfsnodeaddr] = flags.Ipfsnodeaddr
@@ -55,6 +63,12 @@ func NewMap(flags *Flags) Config {
 		configStr[SpaceServicesAPIURL] = flags.ServicesAPIURL
 		configStr[SpaceVaultAPIURL] = flags.VaultAPIURL
 		configStr[SpaceVaultSaltSecret] = flags.VaultSaltSecret
+		configStr[SpaceVaultBackend] = flags.VaultBackend
+		configStr[SpaceVaultPath] = flags.VaultPath
+		configStr[SpaceSignerCommand] = flags.SignerCommand
+		configStr[SpaceIgnorePatterns] = flags.IgnorePatterns
+		configStr[SpaceWatcherBackend] = flags.WatcherBackend
+		configStr[SpaceWatcherDebounce] = flags.WatcherDebounce
 		configStr[SpaceServicesHubAuthURL] = flags.ServicesHubAuthURL
 		if flags.SpaceStorageSiteUrl != "" {
 			configStr[SpaceStorageSiteUrl] = flags.SpaceStorageSiteUrl
@@ -66,6 +80,8 @@ func NewMap(flags *Flags) Config {
 		configStr[TextileUserKey] = flags.TextileUserKey
 		configStr[TextileUserSecret] = flags.TextileUserSecret
 		configBool[Ipfsnode] = flags.Ipfsnode
+		configBool[SpaceStoreEncryption] = flags.StoreEncryption
+		configBool[SpaceRotateStoreKey] = flags.RotateStoreKey
 		if flags.SpaceStorePath != "" {
 			configStr[SpaceStorePath] = flags.SpaceStorePath
 		}
@@ -1,23 +1,83 @@
 package backup
 
 import (
+	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"io"
 	"io/ioutil"
+
+	"github.com/FleekHQ/space-daemon/core/permissions"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Backup file format versions.
+// Version 0 files only contain the private key obfuscated with a static key.
+// Version 1 files start with a header holding the KDF parameters used to derive
+// the encryption key from the user passphrase.
+const (
+	VersionLegacy = 0
+	Version1      = 1
+)
+
+const (
+	kdfScrypt = "scrypt"
+
+	defaultScryptN = 1 << 15
+	defaultScryptR = 8
+	defaultScryptP = 1
+	keyLength      = 32
+	saltLength     = 32
+
+	// Bounds for the parameters read from backup headers, which are not trusted before decrypting.
+	// scrypt uses 128 * N * R bytes of memory.
+	maxScryptMemory = 256 << 20
+	maxScryptP      = 16
+)
+
+// prefix of versioned backup files, followed by the version byte and the header length
+var magic = []byte("SPACEBAK")
+
+var (
+	ErrPassphraseRequired = errors.New("a passphrase is required to create a keys backup")
+	ErrInvalidPassphrase  = errors.New("invalid passphrase or corrupted keys backup")
+	ErrUnsupportedVersion = errors.New("unsupported keys backup version")
+	ErrInvalidKDFParams   = errors.New("invalid key derivation parameters in keys backup")
 )
 
 type Backup struct {
 	PrivateKey string `json:"privateKey"`
+	// Optional fields, only present in version 1 backups when requested
+	Mnemonic  string                  `json:"mnemonic,omitempty"`
+	AppTokens []*permissions.AppToken `json:"appTokens,omitempty"`
+	// Managed thread keys by name, in their string encoding
+	ThreadKeys map[string]string `json:"threadKeys,omitempty"`
 }
 
-// Note: Using static key since the goal of this is to obfuscate the file, not to encrypt it
+// KDFParams are stored in the backup header so they can be tuned without breaking older files
+type KDFParams struct {
+	Name   string `json:"name"`
+	Salt   []byte `json:"salt"`
+	N      int    `json:"n"`
+	R      int    `json:"r"`
+	P      int    `json:"p"`
+	KeyLen int    `json:"keyLen"`
+}
+
+type header struct {
+	Version int       `json:"version"`
+	KDF     KDFParams `json:"kdf"`
+}
+
+// Note: Using static key since the goal of this is to obfuscate the file, not to encrypt it.
+// Only used to read legacy (version 0) backups.
 var key = []byte{0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC, 0xBC}
 
-func obfuscate(data []byte) ([]byte, error) {
+func seal(key, data, additionalData []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key[:])
 	if err != nil {
 		return nil, err
@@ -34,10 +94,10 @@ func obfuscate(data []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return gcm.Seal(nonce, nonce, data, nil), nil
+	return gcm.Seal(nonce, nonce, data, additionalData), nil
 }
 
-func deobfuscate(ciphertext []byte) ([]byte, error) {
+func open(key, ciphertext, additionalData []byte) ([]byte, error) {
 	block, err := aes.NewCipher(key[:])
 	if err != nil {
 		return nil, err
@@ -55,34 +115,123 @@ func deobfuscate(ciphertext []byte) ([]byte, error) {
 	return gcm.Open(nil,
 		ciphertext[:gcm.NonceSize()],
 		ciphertext[gcm.NonceSize():],
-		nil,
+		additionalData,
 	)
 }
 
-// Creates a backup file in the given path
-func MarshalBackup(path string, b *Backup) error {
+func deobfuscate(ciphertext []byte) ([]byte, error) {
+	return open(key, ciphertext, nil)
+}
+
+func newKDFParams() (KDFParams, error) {
+	salt := make([]byte, saltLength)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return KDFParams{}, err
+	}
+
+	return KDFParams{
+		Name:   kdfScrypt,
+		Salt:   salt,
+		N:      defaultScryptN,
+		R:      defaultScryptR,
+		P:      defaultScryptP,
+		KeyLen: keyLength,
+	}, nil
+}
+
+// Checks the parameters are within the bounds this daemon could have written, so a crafted
+// header cannot make the key derivation use all the available memory
+func (params KDFParams) validate() error {
+	if params.Name != kdfScrypt {
+		return ErrUnsupportedVersion
+	}
+
+	validN := params.N > 1 && params.N&(params.N-1) == 0
+	if !validN || params.R < 1 || params.P < 1 || params.P > maxScryptP {
+		return ErrInvalidKDFParams
+	}
+
+	if params.N > maxScryptMemory/128/params.R {
+		return ErrInvalidKDFParams
+	}
+
+	if params.KeyLen != keyLength || len(params.Salt) == 0 {
+		return ErrInvalidKDFParams
+	}
+
+	return nil
+}
+
+func deriveKey(passphrase string, params KDFParams) ([]byte, error) {
+	switch params.Name {
+	case kdfScrypt:
+		return scrypt.Key([]byte(passphrase), params.Salt, params.N, params.R, params.P, params.KeyLen)
+	default:
+		return nil, ErrUnsupportedVersion
+	}
+}
+
+// Creates a backup file in the given path, encrypted with a key derived from the passphrase
+func MarshalBackup(path string, b *Backup, passphrase string) error {
+	if passphrase == "" {
+		return ErrPassphraseRequired
+	}
+
 	jsonData, err := json.Marshal(b)
 	if err != nil {
 		return err
 	}
 
-	obfuscatedBackup, err := obfuscate(jsonData)
+	params, err := newKDFParams()
+	if err != nil {
+		return err
+	}
+
+	h, err := json.Marshal(&header{
+		Version: Version1,
+		KDF:     params,
+	})
+	if err != nil {
+		return err
+	}
+
+	k, err := deriveKey(passphrase, params)
 	if err != nil {
 		return err
 	}
 
-	err = ioutil.WriteFile(path, obfuscatedBackup, 0644)
-	return err
+	// header is authenticated so KDF parameters cannot be tampered with
+	encryptedBackup, err := seal(k, jsonData, h)
+	if err != nil {
+		return err
+	}
+
+	var buf bytes.Buffer
+	buf.Write(magic)
+	buf.WriteByte(Version1)
+	if err := binary.Write(&buf, binary.BigEndian, uint32(len(h))); err != nil {
+		return err
+	}
+	buf.Write(h)
+	buf.Write(encryptedBackup)
+
+	return ioutil.WriteFile(path, buf.Bytes(), 0600)
 }
 
-// Reads a file in the given path and returns a Backup object
-func UnmarshalBackup(path string) (*Backup, error) {
-	obfuscatedBackup, err := ioutil.ReadFile(path)
+// Reads a file in the given path and returns a Backup object.
+// Legacy (version 0) backups are not encrypted with a passphrase so it is ignored for them.
+func UnmarshalBackup(path string, passphrase string) (*Backup, error) {
+	data, err := ioutil.ReadFile(path)
 	if err != nil {
 		return nil, err
 	}
 
-	jsonData, err := deobfuscate(obfuscatedBackup)
+	var jsonData []byte
+	if bytes.HasPrefix(data, magic) {
+		jsonData, err = decryptVersioned(data[len(magic):], passphrase)
+	} else {
+		jsonData, err = deobfuscate(data)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -95,3 +244,46 @@ func UnmarshalBackup(path string) (*Backup, error) {
 
 	return &result, nil
 }
+
+func decryptVersioned(data []byte, passphrase string) ([]byte, error) {
+	// version byte + header length
+	if len(data) < 5 {
+		return nil, ErrInvalidPassphrase
+	}
+
+	if data[0] != Version1 {
+		return nil, ErrUnsupportedVersion
+	}
+
+	headerLen := binary.BigEndian.Uint32(data[1:5])
+	data = data[5:]
+	if uint32(len(data)) < headerLen {
+		return nil, ErrInvalidPassphrase
+	}
+
+	rawHeader := data[:headerLen]
+	var h header
+	if err := json.Unmarshal(rawHeader, &h); err != nil {
+		return nil, err
+	}
+
+	if h.Version != Version1 {
+		return nil, ErrUnsupportedVersion
+	}
+
+	if err := h.KDF.validate(); err != nil {
+		return nil, err
+	}
+
+	k, err := deriveKey(passphrase, h.KDF)
+	if err != nil {
+		return nil, err
+	}
+
+	jsonData, err := open(k, data[headerLen:], rawHeader)
+	if err != nil {
+		return nil, ErrInvalidPassphrase
+	}
+
+	return jsonData, nil
+}
@@ -0,0 +1,106 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func tempBackupPath(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir("", "space-backup-test")
+	require.NoError(t, err)
+
+	return filepath.Join(dir, "backup"), func() {
+		os.RemoveAll(dir)
+	}
+}
+
+func TestBackup_MarshalAndUnmarshal(t *testing.T) {
+	path, cleanup := tempBackupPath(t)
+	defer cleanup()
+
+	b := &Backup{
+		PrivateKey: "abcdef",
+		Mnemonic:   "clog chalk blame black uncover frame before decide tuition maple crowd uncle",
+		ThreadKeys: map[string]string{"bucketKey_personal": "somekey"},
+	}
+
+	err := MarshalBackup(path, b, "strawberry123")
+	require.NoError(t, err)
+
+	result, err := UnmarshalBackup(path, "strawberry123")
+	require.NoError(t, err)
+	assert.Equal(t, b, result)
+}
+
+func TestBackup_Marshal_ShouldFail_WithoutPassphrase(t *testing.T) {
+	path, cleanup := tempBackupPath(t)
+	defer cleanup()
+
+	err := MarshalBackup(path, &Backup{PrivateKey: "abcdef"}, "")
+	assert.Equal(t, ErrPassphraseRequired, err)
+}
+
+func TestBackup_Unmarshal_ShouldFail_WithWrongPassphrase(t *testing.T) {
+	path, cleanup := tempBackupPath(t)
+	defer cleanup()
+
+	err := MarshalBackup(path, &Backup{PrivateKey: "abcdef"}, "strawberry123")
+	require.NoError(t, err)
+
+	_, err = UnmarshalBackup(path, "blueberry123")
+	assert.Equal(t, ErrInvalidPassphrase, err)
+}
+
+func TestBackup_Unmarshal_ReadsLegacyBackups(t *testing.T) {
+	path, cleanup := tempBackupPath(t)
+	defer cleanup()
+
+	jsonData, err := json.Marshal(&Backup{PrivateKey: "abcdef"})
+	require.NoError(t, err)
+
+	// legacy backups were obfuscated with the static key
+	legacy, err := seal(key, jsonData, nil)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, legacy, 0644))
+
+	result, err := UnmarshalBackup(path, "")
+	require.NoError(t, err)
+	assert.Equal(t, "abcdef", result.PrivateKey)
+}
+
+func TestBackup_Unmarshal_ShouldFail_WithOversizedKDFParams(t *testing.T) {
+	path, cleanup := tempBackupPath(t)
+	defer cleanup()
+
+	h, err := json.Marshal(&header{
+		Version: Version1,
+		KDF: KDFParams{
+			Name:   kdfScrypt,
+			Salt:   []byte("salt"),
+			N:      1 << 30,
+			R:      8,
+			P:      1,
+			KeyLen: keyLength,
+		},
+	})
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	buf.Write(magic)
+	buf.WriteByte(Version1)
+	require.NoError(t, binary.Write(&buf, binary.BigEndian, uint32(len(h))))
+	buf.Write(h)
+	buf.Write([]byte("ciphertext"))
+	require.NoError(t, ioutil.WriteFile(path, buf.Bytes(), 0600))
+
+	_, err = UnmarshalBackup(path, "strawberry123")
+	assert.Equal(t, ErrInvalidKDFParams, err)
+}
@@ -16,6 +16,14 @@ const (
 	ServicesAPIURL       = "SERVICES_API_URL"
 	VaultAPIURL          = "VAULT_API_URL"
 	VaultSaltSecret      = "VAULT_SALT_SECRET"
+	VaultBackend         = "VAULT_BACKEND"
+	VaultPath            = "VAULT_PATH"
+	SignerCommand        = "SIGNER_COMMAND"
+	StoreEncryption      = "STORE_ENCRYPTION"
+	RotateStoreKey       = "ROTATE_STORE_KEY"
+	IgnorePatterns       = "IGNORE_PATTERNS"
+	WatcherBackend       = "WATCHER_BACKEND"
+	WatcherDebounce      = "WATCHER_DEBOUNCE"
 	ServicesHubAuthURL   = "SERVICES_HUB_AUTH_URL"
 	SpaceStorageSiteUrl  = "SPACE_STORAGE_SITE_URL"
 	TextileHubTarget     = "TXL_HUB_TARGET"
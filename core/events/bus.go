@@ -0,0 +1,170 @@
+package events
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	DefaultSubscriberBufferSize = 100
+	MaxSubscriberBufferSize     = 10000
+)
+
+var ErrSlowSubscriber = errors.New("subscription closed because the subscriber did not keep up with the events")
+
+// SlowSubscriberPolicy decides what happens when the buffer of a subscriber is full
+type SlowSubscriberPolicy int
+
+const (
+	// Events are dropped for the subscriber until it catches up
+	DropEvents SlowSubscriberPolicy = iota
+	// The subscription is closed
+	Disconnect
+)
+
+// Filter returns true for the events a subscriber wants
+type Filter func(event interface{}) bool
+
+type SubscribeOptions struct {
+	// Events matching the filter are sent, all of them if nil
+	Filter     Filter
+	Policy     SlowSubscriberPolicy
+	BufferSize int
+}
+
+// Bus sends events to every subscriber, each one with its own buffer so a slow subscriber does not block the others
+type Bus struct {
+	mu   sync.RWMutex
+	subs map[*Subscription]struct{}
+}
+
+func NewBus() *Bus {
+	return &Bus{
+		subs: map[*Subscription]struct{}{},
+	}
+}
+
+type Subscription struct {
+	ch      chan interface{}
+	filter  Filter
+	policy  SlowSubscriberPolicy
+	dropped uint64
+
+	mu     sync.Mutex
+	closed bool
+	err    error
+}
+
+// Subscribe adds a subscriber, it has to be removed with Unsubscribe once done
+func (b *Bus) Subscribe(opts SubscribeOptions) *Subscription {
+	size := opts.BufferSize
+	if size <= 0 {
+		size = DefaultSubscriberBufferSize
+	}
+	if size > MaxSubscriberBufferSize {
+		size = MaxSubscriberBufferSize
+	}
+
+	sub := &Subscription{
+		ch:     make(chan interface{}, size),
+		filter: opts.Filter,
+		policy: opts.Policy,
+	}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	return sub
+}
+
+func (b *Bus) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	delete(b.subs, sub)
+	b.mu.Unlock()
+
+	sub.close(nil)
+}
+
+// Publish sends the event to the subscribers without blocking
+func (b *Bus) Publish(event interface{}) {
+	slow := []*Subscription{}
+
+	b.mu.RLock()
+	for sub := range b.subs {
+		if sub.filter != nil && !sub.filter(event) {
+			continue
+		}
+
+		if !sub.send(event) && sub.policy == Disconnect {
+			slow = append(slow, sub)
+		}
+	}
+	b.mu.RUnlock()
+
+	for _, sub := range slow {
+		b.mu.Lock()
+		delete(b.subs, sub)
+		b.mu.Unlock()
+
+		sub.close(ErrSlowSubscriber)
+	}
+}
+
+// SubscriberCount returns the number of active subscriptions
+func (b *Bus) SubscriberCount() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	return len(b.subs)
+}
+
+// Events returns the channel events are received from. It is closed when the subscription ends.
+func (s *Subscription) Events() <-chan interface{} {
+	return s.ch
+}
+
+// Err returns why the subscription was closed by the bus, nil if it was unsubscribed
+func (s *Subscription) Err() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.err
+}
+
+// Dropped returns the number of events dropped because the buffer was full
+func (s *Subscription) Dropped() uint64 {
+	return atomic.LoadUint64(&s.dropped)
+}
+
+// returns false if the buffer was full
+func (s *Subscription) send(event interface{}) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return true
+	}
+
+	select {
+	case s.ch <- event:
+		return true
+	default:
+		atomic.AddUint64(&s.dropped, 1)
+		return false
+	}
+}
+
+func (s *Subscription) close(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.closed {
+		return
+	}
+
+	s.closed = true
+	s.err = err
+	close(s.ch)
+}
@@ -0,0 +1,72 @@
+package events_test
+
+import (
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/events"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBus_SendsToEverySubscriber(t *testing.T) {
+	bus := events.NewBus()
+	first := bus.Subscribe(events.SubscribeOptions{})
+	second := bus.Subscribe(events.SubscribeOptions{})
+
+	bus.Publish("event")
+
+	assert.Equal(t, "event", <-first.Events())
+	assert.Equal(t, "event", <-second.Events())
+
+	bus.Unsubscribe(first)
+	_, ok := <-first.Events()
+	assert.False(t, ok)
+	assert.Nil(t, first.Err())
+	assert.Equal(t, 1, bus.SubscriberCount())
+}
+
+func TestBus_Filters(t *testing.T) {
+	bus := events.NewBus()
+	sub := bus.Subscribe(events.SubscribeOptions{
+		Filter: func(e interface{}) bool {
+			return e.(string) != "skipped"
+		},
+	})
+
+	bus.Publish("skipped")
+	bus.Publish("sent")
+
+	assert.Equal(t, "sent", <-sub.Events())
+	assert.Len(t, sub.Events(), 0)
+}
+
+func TestBus_DropsEventsForSlowSubscriber(t *testing.T) {
+	bus := events.NewBus()
+	slow := bus.Subscribe(events.SubscribeOptions{BufferSize: 1, Policy: events.DropEvents})
+	fast := bus.Subscribe(events.SubscribeOptions{BufferSize: 3})
+
+	bus.Publish(1)
+	bus.Publish(2)
+	bus.Publish(3)
+
+	assert.Equal(t, uint64(2), slow.Dropped())
+	assert.Equal(t, 1, <-slow.Events())
+	assert.Len(t, fast.Events(), 3)
+	assert.Equal(t, 2, bus.SubscriberCount())
+}
+
+func TestBus_DisconnectsSlowSubscriber(t *testing.T) {
+	bus := events.NewBus()
+	slow := bus.Subscribe(events.SubscribeOptions{BufferSize: 1, Policy: events.Disconnect})
+
+	bus.Publish(1)
+	bus.Publish(2)
+
+	assert.Equal(t, 1, <-slow.Events())
+	_, ok := <-slow.Events()
+	assert.False(t, ok)
+	assert.Equal(t, events.ErrSlowSubscriber, slow.Err())
+	assert.Equal(t, 0, bus.SubscriberCount())
+
+	// unsubscribing after the bus closed the subscription is safe
+	bus.Unsubscribe(slow)
+}
@@ -16,6 +16,9 @@ const (
 	FileRestored  FileEventType = "FileRestored"
 	FileRestoring FileEventType = "FileRestoring"
 
+	// An opened file was changed locally after its bucket version changed
+	FileConflict FileEventType = "FileConflict"
+
 	FolderAdded   FileEventType = "FolderAdded"
 	FolderDeleted FileEventType = "FolderDeleted"
 	// NOTE: not sure if this needs to be specific to rename or copy
@@ -0,0 +1,157 @@
+package events
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/FleekHQ/space-daemon/core/store"
+	"github.com/dgraph-io/badger"
+)
+
+const (
+	DefaultLogSize = 1000
+
+	logEntryKeyPrefix = "eventLog#"
+	logHeadKeyPrefix  = "eventLogHead#"
+)
+
+// LogEntry is an event kept in the log with its sequence number
+type LogEntry struct {
+	Seq  uint64
+	Data []byte
+}
+
+// Log numbers events in the order they are sent and keeps the last ones in the store,
+// so subscribers that reconnect can get the events they missed.
+// Sequence numbers keep increasing across restarts.
+type Log struct {
+	st   store.Store
+	name string
+	size int
+
+	mu   sync.Mutex
+	last uint64
+	// sequence numbers of the entries in the store, oldest first
+	seqs []uint64
+}
+
+func NewLog(st store.Store, name string, size int) (*Log, error) {
+	if size <= 0 {
+		size = DefaultLogSize
+	}
+
+	l := &Log{
+		st:   st,
+		name: name,
+		size: size,
+		seqs: []uint64{},
+	}
+
+	head, err := st.Get([]byte(logHeadKeyPrefix + name))
+	if err != nil && err != badger.ErrKeyNotFound {
+		return nil, err
+	}
+	if head != nil {
+		if l.last, err = strconv.ParseUint(string(head), 10, 64); err != nil {
+			return nil, err
+		}
+	}
+
+	keys, err := st.KeysWithPrefix(l.entryKeyPrefix())
+	if err != nil {
+		return nil, err
+	}
+
+	// keys are sorted since sequence numbers are zero padded
+	for _, key := range keys {
+		seq, err := strconv.ParseUint(strings.TrimPrefix(key, l.entryKeyPrefix()), 10, 64)
+		if err != nil {
+			continue
+		}
+		l.seqs = append(l.seqs, seq)
+	}
+
+	return l, nil
+}
+
+// Append stores the event and returns its sequence number, the oldest entries are removed past the log size
+func (l *Log) Append(data []byte) (uint64, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	seq := l.last + 1
+	if err := l.st.Set(l.entryKey(seq), data); err != nil {
+		return 0, err
+	}
+
+	if err := l.st.SetString(logHeadKeyPrefix+l.name, strconv.FormatUint(seq, 10)); err != nil {
+		return 0, err
+	}
+
+	l.last = seq
+	l.seqs = append(l.seqs, seq)
+
+	for len(l.seqs) > l.size {
+		if err := l.st.Remove(l.entryKey(l.seqs[0])); err != nil {
+			return seq, err
+		}
+		l.seqs = l.seqs[1:]
+	}
+
+	return seq, nil
+}
+
+// Since returns the entries after seq that are still in the log, oldest first.
+// truncated is true when some of the events after seq are no longer in the log,
+// or when seq is after the last event, e.g. because it comes from a log that was reset.
+func (l *Log) Since(seq uint64) (entries []LogEntry, truncated bool, err error) {
+	l.mu.Lock()
+	truncated = seq > l.last
+	if seq < l.last {
+		// seqs has every entry kept, so a gap after seq means events were removed
+		truncated = len(l.seqs) == 0 || l.seqs[0] > seq+1
+	}
+
+	seqs := []uint64{}
+	for _, s := range l.seqs {
+		if s > seq {
+			seqs = append(seqs, s)
+		}
+	}
+	l.mu.Unlock()
+
+	entries = make([]LogEntry, 0, len(seqs))
+	for _, s := range seqs {
+		data, err := l.st.Get(l.entryKey(s))
+		if err == badger.ErrKeyNotFound {
+			// removed while reading
+			truncated = true
+			continue
+		}
+		if err != nil {
+			return nil, false, err
+		}
+
+		entries = append(entries, LogEntry{Seq: s, Data: data})
+	}
+
+	return entries, truncated, nil
+}
+
+// LastSeq returns the sequence number of the last event appended
+func (l *Log) LastSeq() uint64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.last
+}
+
+func (l *Log) entryKeyPrefix() string {
+	return logEntryKeyPrefix + l.name + "#"
+}
+
+func (l *Log) entryKey(seq uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", l.entryKeyPrefix(), seq))
+}
@@ -0,0 +1,131 @@
+package events_test
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/events"
+	"github.com/FleekHQ/space-daemon/core/store"
+	"github.com/stretchr/testify/assert"
+)
+
+func openTestStore(t *testing.T, dir string) store.Store {
+	st := store.New(store.WithPath(dir))
+	assert.Nil(t, st.Open())
+
+	return st
+}
+
+func initTestStoreDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "space-events")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	return dir
+}
+
+func TestLog_ReplaysEventsSinceSeq(t *testing.T) {
+	st := openTestStore(t, initTestStoreDir(t))
+	defer st.Close()
+
+	l, err := events.NewLog(st, "file", 10)
+	assert.Nil(t, err)
+
+	for _, data := range []string{"a", "b", "c"} {
+		_, err := l.Append([]byte(data))
+		assert.Nil(t, err)
+	}
+
+	entries, truncated, err := l.Since(1)
+	assert.Nil(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, []events.LogEntry{
+		{Seq: 2, Data: []byte("b")},
+		{Seq: 3, Data: []byte("c")},
+	}, entries)
+
+	entries, truncated, err = l.Since(3)
+	assert.Nil(t, err)
+	assert.False(t, truncated)
+	assert.Len(t, entries, 0)
+}
+
+func TestLog_ReportsUnknownSeqAsTruncated(t *testing.T) {
+	st := openTestStore(t, initTestStoreDir(t))
+	defer st.Close()
+
+	l, err := events.NewLog(st, "file", 10)
+	assert.Nil(t, err)
+
+	_, err = l.Append([]byte("a"))
+	assert.Nil(t, err)
+
+	// a seq after the last event comes from a log that was reset
+	entries, truncated, err := l.Since(5)
+	assert.Nil(t, err)
+	assert.True(t, truncated)
+	assert.Len(t, entries, 0)
+}
+
+func TestLog_RemovesOldestEntries(t *testing.T) {
+	st := openTestStore(t, initTestStoreDir(t))
+	defer st.Close()
+
+	l, err := events.NewLog(st, "file", 2)
+	assert.Nil(t, err)
+
+	for _, data := range []string{"a", "b", "c"} {
+		_, err := l.Append([]byte(data))
+		assert.Nil(t, err)
+	}
+
+	// the first entry was removed so the client is told events were lost
+	entries, truncated, err := l.Since(0)
+	assert.Nil(t, err)
+	assert.True(t, truncated)
+	assert.Len(t, entries, 2)
+	assert.Equal(t, uint64(2), entries[0].Seq)
+
+	_, truncated, err = l.Since(1)
+	assert.Nil(t, err)
+	assert.False(t, truncated)
+}
+
+func TestLog_KeepsSequenceAcrossRestarts(t *testing.T) {
+	dir := initTestStoreDir(t)
+	st := openTestStore(t, dir)
+
+	l, err := events.NewLog(st, "file", 10)
+	assert.Nil(t, err)
+	_, err = l.Append([]byte("a"))
+	assert.Nil(t, err)
+
+	// logs with other names are separate
+	other, err := events.NewLog(st, "notification", 10)
+	assert.Nil(t, err)
+	_, err = other.Append([]byte("other"))
+	assert.Nil(t, err)
+	assert.Nil(t, st.Close())
+
+	st = openTestStore(t, dir)
+	defer st.Close()
+
+	l, err = events.NewLog(st, "file", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), l.LastSeq())
+
+	seq, err := l.Append([]byte("b"))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), seq)
+
+	entries, truncated, err := l.Since(0)
+	assert.Nil(t, err)
+	assert.False(t, truncated)
+	assert.Equal(t, []events.LogEntry{
+		{Seq: 1, Data: []byte("a")},
+		{Seq: 2, Data: []byte("b")},
+	}, entries)
+}
@@ -18,6 +18,8 @@ type sharedFileEntry struct {
 	entry  *DirEntry
 	dbId   string
 	bucket string
+	// path of the item in the bucket it was shared from
+	remotePath string
 }
 
 // Provides content for the 'Shared With Me' content managed by the space user
@@ -50,48 +52,54 @@ func (f *sharedWithMeDataSource) Get(ctx context.Context, path string) (*DirEntr
 		return entry.entry, nil
 	}
 
-	itemsInParent, _, err := f.service.GetSharedWithMeFiles(ctx, "", f.maxDirLimit)
-	if err != nil {
+	// listing the parent folder populates the cache with the item
+	if _, err := f.GetChildren(ctx, filepath.Dir(path)); err != nil {
 		if !isNotExistError(err) {
 			return nil, EntryNotFound
 		}
 		return nil, err
 	}
 
-	f.cacheResults(itemsInParent)
-
-	// find item matching path
-	for _, entry := range itemsInParent {
-		if entry.Path == path {
-			return NewDirEntry(entry.DirEntry), nil
-		}
+	entry, exists = f.cache[path]
+	if exists {
+		return entry.entry, nil
 	}
 
 	return nil, EntryNotFound
 }
 
-// GetChildren should only be called on the parent folder and will always return
+// GetChildren returns the shared items when called on the base directory
+// and the content of a shared folder otherwise
 func (f *sharedWithMeDataSource) GetChildren(ctx context.Context, path string) ([]*DirEntry, error) {
 	log.Debug("SharedWithMeDS GetChildren", fmt.Sprintf("path:%s", path))
-	if !isBaseDirectory(path) && path != "" {
-		// just return empty directory since shared with me currently only supports files
-		return []*DirEntry{}, nil
-	}
+	var entries []*domain.SharedDirEntry
+	var err error
 
-	entries, _, err := f.service.GetSharedWithMeFiles(ctx, "", f.maxDirLimit)
+	if isBaseDirectory(path) || path == "" {
+		path = "/"
+		entries, _, err = f.service.GetSharedWithMeFiles(ctx, "", f.maxDirLimit)
+	} else {
+		parent, exists := f.cache[path]
+		if !exists {
+			// make sure the parent folder was listed before
+			if _, err := f.Get(ctx, path); err != nil {
+				return nil, err
+			}
+			parent = f.cache[path]
+		}
+
+		if !parent.entry.IsDir() {
+			return nil, syscall.ENOTDIR
+		}
+
+		entries, err = f.service.GetSharedWithMeDirectory(ctx, parent.dbId, parent.bucket, parent.remotePath)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	// this ensure it always refreshes the cache whenever operating system calls list directory
-	f.cacheResults(entries)
-
-	dirEntries := make([]*DirEntry, len(entries))
-	for i, entry := range entries {
-		dirEntries[i] = NewDirEntry(entry.DirEntry)
-	}
-
-	return dirEntries, nil
+	return f.cacheResults(path, entries), nil
 }
 
 func (f *sharedWithMeDataSource) Open(ctx context.Context, path string) (FileReadWriterCloser, error) {
@@ -101,7 +109,7 @@ func (f *sharedWithMeDataSource) Open(ctx context.Context, path string) (FileRea
 		return nil, EntryNotFound
 	}
 
-	openFileInfo, err := f.service.OpenFile(ctx, path, entry.bucket, entry.dbId)
+	openFileInfo, err := f.service.OpenFile(ctx, entry.remotePath, entry.bucket, entry.dbId)
 	if err != nil {
 		return nil, err
 	}
@@ -125,12 +133,26 @@ func (f *sharedWithMeDataSource) DeleteEntry(ctx context.Context, path string) e
 	return syscall.ENOTSUP
 }
 
-func (f *sharedWithMeDataSource) cacheResults(items []*domain.SharedDirEntry) {
-	for _, item := range items {
-		f.cache[item.Path] = &sharedFileEntry{
-			entry:  NewDirEntryWithMode(item.DirEntry, StandardFileAccessMode),
-			dbId:   item.DbID,
-			bucket: item.Bucket,
+// caches items listed in the parentPath folder and returns them with their path inside this data source
+func (f *sharedWithMeDataSource) cacheResults(parentPath string, items []*domain.SharedDirEntry) []*DirEntry {
+	dirEntries := make([]*DirEntry, len(items))
+	for i, item := range items {
+		entry := item.DirEntry
+		entry.Path = filepath.Join(parentPath, item.Name)
+
+		mode := StandardFileAccessMode
+		if item.IsDir {
+			mode = RestrictedDirAccessMode
+		}
+
+		dirEntries[i] = NewDirEntryWithMode(entry, mode)
+		f.cache[entry.Path] = &sharedFileEntry{
+			entry:      dirEntries[i],
+			dbId:       item.DbID,
+			bucket:     item.Bucket,
+			remotePath: item.Path,
 		}
 	}
+
+	return dirEntries
 }
@@ -0,0 +1,209 @@
+package ignore
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Name of the file listing the patterns ignored in a folder, with the gitignore syntax
+const FileName = ".spaceignore"
+
+type rule struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// Matcher tells which paths under a root folder are ignored, following gitignore rules:
+// the last matching pattern wins, "!" re-includes a path, a trailing "/" only matches folders,
+// patterns with a "/" are relative to the root and others match at any depth.
+type Matcher struct {
+	root  string
+	rules []rule
+}
+
+// New compiles the patterns for paths under root
+func New(root string, patterns []string) *Matcher {
+	m := &Matcher{
+		root:  root,
+		rules: []rule{},
+	}
+
+	for _, p := range patterns {
+		if r, ok := compile(p); ok {
+			m.rules = append(m.rules, r)
+		}
+	}
+
+	return m
+}
+
+// ForRoot returns the matcher of the folder with the global patterns first
+// and the patterns of the .spaceignore file of the folder after, so they can override the global ones.
+func ForRoot(root string, globalPatterns []string) (*Matcher, error) {
+	filePatterns, err := ReadFile(filepath.Join(root, FileName))
+	if err != nil {
+		return nil, err
+	}
+
+	return New(root, append(append([]string{}, globalPatterns...), filePatterns...)), nil
+}
+
+// ReadFile returns the patterns of an ignore file, none if it does not exist
+func ReadFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return []string{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	patterns := []string{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		patterns = append(patterns, scanner.Text())
+	}
+
+	return patterns, scanner.Err()
+}
+
+// SplitPatterns parses a comma separated list of patterns, as set in the config
+func SplitPatterns(list string) []string {
+	patterns := []string{}
+	for _, p := range strings.Split(list, ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			patterns = append(patterns, p)
+		}
+	}
+
+	return patterns
+}
+
+// Root returns the folder the patterns are relative to
+func (m *Matcher) Root() string {
+	return m.root
+}
+
+// Match returns true if the path is ignored, either itself or because one of its parent folders is.
+// Paths outside of the root are never ignored.
+func (m *Matcher) Match(path string, isDir bool) bool {
+	if len(m.rules) == 0 {
+		return false
+	}
+
+	rel, err := filepath.Rel(m.root, path)
+	if err != nil || rel == "." || strings.HasPrefix(rel, "..") {
+		return false
+	}
+
+	parts := strings.Split(filepath.ToSlash(rel), "/")
+	for i := range parts {
+		last := i == len(parts)-1
+		if m.matchRel(strings.Join(parts[:i+1], "/"), !last || isDir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Matcher) matchRel(rel string, isDir bool) bool {
+	ignored := false
+	for _, r := range m.rules {
+		if r.dirOnly && !isDir {
+			continue
+		}
+
+		if r.re.MatchString(rel) {
+			ignored = !r.negate
+		}
+	}
+
+	return ignored
+}
+
+func compile(pattern string) (rule, bool) {
+	p := strings.TrimRight(pattern, " \t\r")
+	if p == "" || strings.HasPrefix(p, "#") {
+		return rule{}, false
+	}
+
+	r := rule{}
+	if strings.HasPrefix(p, "!") {
+		r.negate = true
+		p = p[1:]
+	} else if strings.HasPrefix(p, `\!`) || strings.HasPrefix(p, `\#`) {
+		p = p[1:]
+	}
+
+	if strings.HasSuffix(p, "/") {
+		r.dirOnly = true
+		p = strings.TrimRight(p, "/")
+	}
+
+	if p == "" {
+		return rule{}, false
+	}
+
+	// a pattern with a slash is relative to the root, otherwise it matches at any depth
+	anchored := strings.Contains(p, "/")
+	p = strings.TrimPrefix(p, "/")
+
+	expr := globToRegexp(p)
+	if !anchored {
+		expr = "(.*/)?" + expr
+	}
+
+	re, err := regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return rule{}, false
+	}
+	r.re = re
+
+	return r, true
+}
+
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch {
+		case strings.HasPrefix(glob[i:], "**/"):
+			sb.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(glob[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		case c == '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end + 1
+		case c == '\\' && i+1 < len(glob):
+			i++
+			sb.WriteString(regexp.QuoteMeta(string(glob[i])))
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,69 @@
+package ignore_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/ignore"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatcher_Patterns(t *testing.T) {
+	root := filepath.Join("home", "user", "docs")
+	m := ignore.New(root, []string{
+		"# editor files",
+		"*.tmp",
+		"*.sw[po]",
+		"node_modules/",
+		"/build",
+		"logs/**/*.log",
+		"!keep.tmp",
+	})
+
+	tests := []struct {
+		path    string
+		isDir   bool
+		ignored bool
+	}{
+		{"notes.tmp", false, true},
+		{"sub/notes.tmp", false, true},
+		{"keep.tmp", false, false},
+		{".notes.txt.swp", false, true},
+		{"notes.txt", false, false},
+		{"node_modules", true, true},
+		{"node_modules", false, false},
+		{"app/node_modules/lib/index.js", false, true},
+		{"build", true, true},
+		{"build/out.bin", false, true},
+		{"src/build", true, false},
+		{"logs/app.log", false, true},
+		{"logs/2020/10/app.log", false, true},
+		{"app.log", false, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.ignored, m.Match(filepath.Join(root, filepath.FromSlash(tt.path)), tt.isDir), tt.path)
+	}
+
+	// outside of the root
+	assert.False(t, m.Match(filepath.Join("home", "user", "notes.tmp"), false))
+}
+
+func TestForRoot_FileOverridesGlobalPatterns(t *testing.T) {
+	root, err := ioutil.TempDir("", "space-ignore")
+	assert.Nil(t, err)
+	defer os.RemoveAll(root)
+
+	err = ioutil.WriteFile(filepath.Join(root, ignore.FileName), []byte("*.bak\n!important.tmp\n"), 0644)
+	assert.Nil(t, err)
+
+	m, err := ignore.ForRoot(root, ignore.SplitPatterns("*.tmp, ,node_modules/"))
+	assert.Nil(t, err)
+
+	assert.True(t, m.Match(filepath.Join(root, "old.bak"), false))
+	assert.True(t, m.Match(filepath.Join(root, "scratch.tmp"), false))
+	assert.False(t, m.Match(filepath.Join(root, "important.tmp"), false))
+	assert.True(t, m.Match(filepath.Join(root, "node_modules"), true))
+}
@@ -0,0 +1,318 @@
+package keychain
+
+import (
+	"bufio"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/log"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	signerMethodPublicKey = "publicKey"
+	signerMethodSign      = "sign"
+	signerMethodDecrypt   = "decrypt"
+	signerMethodDeriveKey = "deriveKey"
+
+	// signing may wait for the user, e.g. to type a PIN or touch a smartcard
+	defaultSignerTimeout = 2 * time.Minute
+)
+
+var (
+	ErrInvalidSignature  = errors.New("external signer returned an invalid signature")
+	ErrInvalidDerivedKey = errors.New("external signer returned an invalid derived key")
+)
+
+// ExternalSigner signs through a separate process, so smartcards or agents can hold the identity key.
+// The process is started on first use and talks JSON over its stdin and stdout, one message per line:
+//
+//	-> {"id":1,"method":"publicKey"}
+//	<- {"id":1,"publicKey":"<base64 raw ed25519 public key>"}
+//	-> {"id":2,"method":"sign","data":"<base64 payload>"}
+//	<- {"id":2,"signature":"<base64 ed25519 signature>"}
+//	-> {"id":3,"method":"decrypt","data":"<base64 ciphertext>"}
+//	<- {"id":3,"plaintext":"<base64 plaintext>"}
+//	-> {"id":4,"method":"deriveKey","data":"<base64 salt>","size":32}
+//	<- {"id":4,"key":"<base64 derived key>"}
+//
+// Ciphertexts are encrypted for the public key as threads does for libp2p keys (go-threads crypto/asymmetric). Derived keys are PBKDF2-SHA512 of the raw private key (seed followed by public key)
+// with the salt, 256 iterations and the requested size.
+// Failures are returned as {"id":2,"error":"<message>"}.
+type ExternalSigner struct {
+	name    string
+	args    []string
+	timeout time.Duration
+
+	mu     sync.Mutex
+	cmd    *exec.Cmd
+	stdin  io.WriteCloser
+	lines  chan signerLine
+	nextID int
+	pub    crypto.PubKey
+}
+
+type signerRequest struct {
+	ID     int    `json:"id"`
+	Method string `json:"method"`
+	Data   string `json:"data,omitempty"`
+	Size   int    `json:"size,omitempty"`
+}
+
+type signerResponse struct {
+	ID        int    `json:"id"`
+	PublicKey string `json:"publicKey,omitempty"`
+	Signature string `json:"signature,omitempty"`
+	Plaintext string `json:"plaintext,omitempty"`
+	Key       string `json:"key,omitempty"`
+	Error     string `json:"error,omitempty"`
+}
+
+type signerLine struct {
+	data []byte
+	err  error
+}
+
+func NewExternalSigner(name string, args ...string) *ExternalSigner {
+	return &ExternalSigner{
+		name:    name,
+		args:    args,
+		timeout: defaultSignerTimeout,
+	}
+}
+
+// PublicKey returns the public key of the signer, it is only requested once
+func (s *ExternalSigner) PublicKey(ctx context.Context) (crypto.PubKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.publicKey(ctx)
+}
+
+// Sign asks the signer process to sign the data and verifies the returned signature
+func (s *ExternalSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pub, err := s.publicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.call(ctx, &signerRequest{
+		Method: signerMethodSign,
+		Data:   base64.StdEncoding.EncodeToString(data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(res.Signature)
+	if err != nil {
+		return nil, ErrInvalidSignature
+	}
+
+	if ok, err := pub.Verify(data, sig); err != nil || !ok {
+		return nil, ErrInvalidSignature
+	}
+
+	return sig, nil
+}
+
+// Decrypt asks the signer process to open data encrypted for its public key
+func (s *ExternalSigner) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.call(ctx, &signerRequest{
+		Method: signerMethodDecrypt,
+		Data:   base64.StdEncoding.EncodeToString(data),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	plaintext, err := base64.StdEncoding.DecodeString(res.Plaintext)
+	if err != nil {
+		return nil, errors.Wrap(err, "external signer returned an invalid plaintext")
+	}
+
+	return plaintext, nil
+}
+
+// DeriveKey asks the signer process to derive a key from the private key and the salt
+func (s *ExternalSigner) DeriveKey(ctx context.Context, salt []byte, size int) ([]byte, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res, err := s.call(ctx, &signerRequest{
+		Method: signerMethodDeriveKey,
+		Data:   base64.StdEncoding.EncodeToString(salt),
+		Size:   size,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := base64.StdEncoding.DecodeString(res.Key)
+	if err != nil || len(key) != size {
+		return nil, ErrInvalidDerivedKey
+	}
+
+	return key, nil
+}
+
+// Shutdown stops the signer process
+func (s *ExternalSigner) Shutdown() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.stop()
+	return nil
+}
+
+func (s *ExternalSigner) publicKey(ctx context.Context) (crypto.PubKey, error) {
+	if s.pub != nil {
+		return s.pub, nil
+	}
+
+	res, err := s.call(ctx, &signerRequest{Method: signerMethodPublicKey})
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(res.PublicKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "external signer returned an invalid public key")
+	}
+
+	pub, err := crypto.UnmarshalEd25519PublicKey(raw)
+	if err != nil {
+		return nil, errors.Wrap(err, "external signer returned an invalid public key")
+	}
+
+	s.pub = pub
+	return pub, nil
+}
+
+func (s *ExternalSigner) call(ctx context.Context, request *signerRequest) (*signerResponse, error) {
+	if err := s.start(); err != nil {
+		return nil, err
+	}
+
+	s.nextID++
+	request.ID = s.nextID
+	req, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := s.stdin.Write(append(req, '\n')); err != nil {
+		s.stop()
+		return nil, errors.Wrap(err, "failed to write to external signer")
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	var line signerLine
+	select {
+	case line = <-s.lines:
+	case <-ctx.Done():
+		// the process state is unknown, it is restarted on the next call
+		s.stop()
+		return nil, errors.Wrap(ctx.Err(), "external signer did not respond")
+	}
+
+	if line.err != nil {
+		s.stop()
+		return nil, errors.Wrap(line.err, "failed to read from external signer")
+	}
+
+	res := &signerResponse{}
+	if err := json.Unmarshal(line.data, res); err != nil {
+		s.stop()
+		return nil, errors.Wrap(err, "invalid external signer response")
+	}
+
+	if res.ID != s.nextID {
+		s.stop()
+		return nil, fmt.Errorf("external signer response id %d does not match request id %d", res.ID, s.nextID)
+	}
+
+	if res.Error != "" {
+		return nil, fmt.Errorf("external signer: %s", res.Error)
+	}
+
+	return res, nil
+}
+
+func (s *ExternalSigner) start() error {
+	if s.cmd != nil {
+		return nil
+	}
+
+	cmd := exec.Command(s.name, s.args...)
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	if err := cmd.Start(); err != nil {
+		return errors.Wrap(err, "failed to start external signer")
+	}
+
+	lines := make(chan signerLine)
+	go func() {
+		reader := bufio.NewReader(stdout)
+		for {
+			data, err := reader.ReadBytes('\n')
+			lines <- signerLine{data: data, err: err}
+			if err != nil {
+				close(lines)
+				return
+			}
+		}
+	}()
+
+	s.cmd = cmd
+	s.stdin = stdin
+	s.lines = lines
+
+	return nil
+}
+
+func (s *ExternalSigner) stop() {
+	if s.cmd == nil {
+		return
+	}
+
+	s.stdin.Close()
+	if err := s.cmd.Process.Kill(); err != nil {
+		log.Debug("external signer already exited")
+	}
+
+	// drain the reader so it can exit
+	lines := s.lines
+	go func() {
+		for range lines {
+		}
+	}()
+
+	s.cmd.Wait()
+	s.cmd = nil
+	s.stdin = nil
+	s.lines = nil
+}
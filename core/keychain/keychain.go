@@ -1,15 +1,13 @@
 package keychain
 
 import (
+	"context"
 	"crypto/ed25519"
-	"crypto/sha512"
 	"encoding/hex"
 	"os"
 	"path"
 	"strings"
 
-	"golang.org/x/crypto/pbkdf2"
-
 	"errors"
 
 	"github.com/99designs/keyring"
@@ -17,6 +15,7 @@ import (
 	"github.com/FleekHQ/space-daemon/core/permissions"
 	"github.com/FleekHQ/space-daemon/core/store"
 	"github.com/FleekHQ/space-daemon/log"
+	"github.com/dgraph-io/badger"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/textileio/go-threads/core/thread"
 	sym "github.com/textileio/go-threads/crypto/symmetric"
@@ -32,10 +31,12 @@ var (
 )
 
 type keychain struct {
-	fileDir string
-	st      store.Store
-	ring    ri.Keyring
-	privKey *crypto.PrivKey
+	fileDir   string
+	namespace string
+	st        store.Store
+	ring      ri.Keyring
+	signer    Signer
+	privKey   *crypto.PrivKey
 }
 
 type Keychain interface {
@@ -44,18 +45,28 @@ type Keychain interface {
 	GetStoredKeyPairInLibP2PFormat() (crypto.PrivKey, crypto.PubKey, error)
 	GetStoredPublicKey() (crypto.PubKey, error)
 	GetStoredMnemonic() (string, error)
+	GetStoredMnemonicLanguage() (string, error)
 	GetManagedThreadKey(threadKeyName string) (thread.Key, error)
 	GenerateKeyPairWithForce() (pub []byte, priv []byte, err error)
 	Sign([]byte) ([]byte, error)
+	GetSigner() Signer
+	UsesExternalSigner() bool
+	DeriveKey(salt []byte, size int) ([]byte, error)
+	GetLogKey() (crypto.PrivKey, error)
 	ImportExistingKeyPair(priv crypto.PrivKey, mnemonic string) error
 	DeleteKeypair() error
 	StoreAppToken(tok *permissions.AppToken) error
 	GetAppToken(key string) (*permissions.AppToken, error)
+	GetStoreEncryptionKeys() ([][]byte, error)
+	RotateStoreEncryptionKey() error
+	DropPreviousStoreEncryptionKeys() error
 }
 
 type keychainOptions struct {
-	fileDir string
-	store   store.Store
+	fileDir   string
+	namespace string
+	store     store.Store
+	signer    Signer
 
 	// Don't use kc.ring directly, use getKeyRing() instead
 	ring ri.Keyring
@@ -82,6 +93,23 @@ func WithStore(st store.Store) Option {
 	}
 }
 
+// Keeps the key pair of a profile apart from the other profiles sharing the same keyring.
+// App tokens are not namespaced as they authorize the client app, not the user.
+func WithNamespace(namespace string) Option {
+	return func(o *keychainOptions) {
+		o.namespace = namespace
+	}
+}
+
+// Signs with an external signer instead of the key pair stored in the keyring
+func WithSigner(signer Signer) Option {
+	return func(o *keychainOptions) {
+		if signer != nil {
+			o.signer = signer
+		}
+	}
+}
+
 // Used to inject a mock keyring in tests or in case you want to use a custom keyring implementation
 func WithKeyring(ring ri.Keyring) Option {
 	return func(o *keychainOptions) {
@@ -104,11 +132,18 @@ func New(opts ...Option) *keychain {
 		o.store = defaultStore
 	}
 
-	return &keychain{
-		fileDir: o.fileDir,
-		st:      o.store,
-		ring:    o.ring,
+	kc := &keychain{
+		fileDir:   o.fileDir,
+		namespace: o.namespace,
+		st:        o.store,
+		ring:      o.ring,
 	}
+
+	if o.signer != nil {
+		kc.signer = &verifiedSigner{kc: kc, signer: o.signer}
+	}
+
+	return kc
 }
 
 // Generates a public/private key pair using ed25519 algorithm.
@@ -125,10 +160,15 @@ func (kc *keychain) GenerateKeyPair() ([]byte, []byte, error) {
 }
 
 // Returns the stored key pair using the same signature than libp2p's GenerateEd25519Key function
+// The private key cannot be read when it is kept by an external signer.
 func (kc *keychain) GetStoredKeyPairInLibP2PFormat() (crypto.PrivKey, crypto.PubKey, error) {
 	var priv []byte
 	var err error
 
+	if kc.signer != nil {
+		return nil, nil, ErrSignerCannotExport
+	}
+
 	_, err = kc.GetStoredPublicKey()
 	if err != nil {
 		return nil, nil, err
@@ -167,16 +207,22 @@ func (kc *keychain) GenerateKeyPairWithForce() ([]byte, []byte, error) {
 // Returns an error if there's no public key set.
 // Unlike GetStoredKeyPairInLibP2PFormat, this method does not access the keychain
 func (kc *keychain) GetStoredPublicKey() (crypto.PubKey, error) {
-	ring, err := kc.getKeyRing()
-	if err != nil {
-		return nil, err
-	}
-	_, err = ring.GetMetadata(PrivateKeyStoreKey)
-	if err == keyring.ErrKeyNotFound {
-		return nil, ErrKeyPairNotFound
+	// the private key is not in the keyring when an external signer keeps it
+	if kc.signer == nil {
+		ring, err := kc.getKeyRing()
+		if err != nil {
+			return nil, err
+		}
+		_, err = ring.GetMetadata(kc.privateKeyItemKey())
+		if err == keyring.ErrKeyNotFound {
+			return nil, ErrKeyPairNotFound
+		}
 	}
 
 	pubInBytes, err := kc.st.Get([]byte(PublicKeyStoreKey))
+	if err == badger.ErrKeyNotFound {
+		return nil, ErrKeyPairNotFound
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +265,13 @@ func (kc *keychain) ImportExistingKeyPair(priv crypto.PrivKey, mnemonic string)
 		return err
 	}
 
+	// the language is not known when the key comes from a backup, so it is taken from the words
+	if mnemonic != "" {
+		if err := kc.st.Set([]byte(MnemonicLanguageStoreKey), []byte(detectMnemonicLanguage(mnemonic))); err != nil {
+			return err
+		}
+	}
+
 	kc.privKey = &priv
 
 	return nil
@@ -232,7 +285,7 @@ func (kc *keychain) DeleteKeypair() error {
 
 	// Note: currently ignoring error on keychain removal because it's failing randomly.
 	// Use GenerateKeyPair with override option instead.
-	err = ring.Remove(PrivateKeyStoreKey)
+	err = ring.Remove(kc.privateKeyItemKey())
 
 	err = kc.st.Remove([]byte(PublicKeyStoreKey))
 	if err != nil {
@@ -281,9 +334,23 @@ func (kc *keychain) generateAndStoreKeyPair(seed []byte, mnemonic string) ([]byt
 	return pub, priv, nil
 }
 
-// Signs a message using the stored private key.
+// Signs a message using the configured signer, which defaults to the stored private key.
 // Returns an error if the private key cannot be found.
 func (kc *keychain) Sign(message []byte) ([]byte, error) {
+	return kc.GetSigner().Sign(context.Background(), message)
+}
+
+// Returns the signer of the identity key. Unless an external signer is set,
+// it signs with the key pair stored in the keyring.
+func (kc *keychain) GetSigner() Signer {
+	if kc.signer != nil {
+		return kc.signer
+	}
+
+	return &keyringSigner{kc: kc}
+}
+
+func (kc *keychain) signWithStoredKey(message []byte) ([]byte, error) {
 	if priv, _, err := kc.retrieveKeyPair(); err != nil {
 		return nil, err
 	} else {
@@ -292,6 +359,15 @@ func (kc *keychain) Sign(message []byte) ([]byte, error) {
 	}
 }
 
+// key of the keyring item holding the private key, the default namespace keeps the original key
+func (kc *keychain) privateKeyItemKey() string {
+	if kc.namespace == "" {
+		return PrivateKeyStoreKey
+	}
+
+	return kc.namespace + "_" + PrivateKeyStoreKey
+}
+
 func (kc *keychain) getKeyRing() (ri.Keyring, error) {
 	if kc.ring != nil {
 		return kc.ring, nil
@@ -341,7 +417,7 @@ func (kc *keychain) storeKeyPair(privKey []byte, pubKey []byte, mnemonic string)
 	// Priv key is stored as 0x1234...890___some mnemonic
 	// The idea behind storing them together is that we avoid asking for keychain access twice
 	if err := ring.Set(keyring.Item{
-		Key:   PrivateKeyStoreKey,
+		Key:   kc.privateKeyItemKey(),
 		Data:  []byte(privWithMnemonic),
 		Label: "Space App",
 	}); err != nil {
@@ -362,7 +438,7 @@ func (kc *keychain) retrieveKeyPair() (privKey []byte, mnemonic string, err erro
 		return nil, "", err
 	}
 
-	privKeyItem, err := ring.Get(PrivateKeyStoreKey)
+	privKeyItem, err := ring.Get(kc.privateKeyItemKey())
 	if err != nil {
 		return nil, "", err
 	}
@@ -387,29 +463,43 @@ func (kc *keychain) GetManagedThreadKey(threadKeyName string) (thread.Key, error
 		return thread.Key{}, err
 	}
 
-	size := 32
-
-	priv, _, err := kc.GetStoredKeyPairInLibP2PFormat()
+	// thread keys are made of a service and a read key
+	num, err := kc.DeriveKey([]byte("threadKey"+threadKeyName), sym.KeyBytes*2)
 	if err != nil {
 		return thread.Key{}, err
 	}
 
-	privBytes, err := priv.Raw()
+	managedKey, err := thread.KeyFromBytes(num)
 	if err != nil {
 		return thread.Key{}, err
 	}
 
-	num := pbkdf2.Key(privBytes, []byte("threadKey"+threadKeyName), 256, size, sha512.New)
-	if err != nil {
-		return thread.Key{}, err
-	}
+	return managedKey, nil
+}
+
+// Derives a secret of the given size from the identity key through the signer,
+// so it also works when the private key is kept by an external signer
+func (kc *keychain) DeriveKey(salt []byte, size int) ([]byte, error) {
+	return kc.GetSigner().DeriveKey(context.Background(), salt, size)
+}
 
-	truncated := num[:sym.KeyBytes*2]
+// Returns the key that signs the thread logs of the user.
+// It is the identity key, unless an external signer keeps it, in which case a key derived from it is used.
+func (kc *keychain) GetLogKey() (crypto.PrivKey, error) {
+	if kc.signer == nil {
+		priv, _, err := kc.GetStoredKeyPairInLibP2PFormat()
+		return priv, err
+	}
 
-	managedKey, err := thread.KeyFromBytes(truncated)
+	seed, err := kc.DeriveKey([]byte("logKey"), ed25519.SeedSize)
 	if err != nil {
-		return thread.Key{}, err
+		return nil, err
 	}
 
-	return managedKey, nil
+	return crypto.UnmarshalEd25519PrivateKey(ed25519.NewKeyFromSeed(seed))
+}
+
+// Returns true if the identity key is kept by an external signer instead of the keyring
+func (kc *keychain) UsesExternalSigner() bool {
+	return kc.signer != nil
 }
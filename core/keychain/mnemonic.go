@@ -1,23 +1,67 @@
 package keychain
 
 import (
+	"crypto/ed25519"
 	"crypto/sha512"
 	"errors"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/dgraph-io/badger"
+	"github.com/libp2p/go-libp2p-core/crypto"
 
 	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
 	"golang.org/x/crypto/pbkdf2"
 )
 
+const DefaultMnemonicLanguage = "english"
+
+// MnemonicLanguageStoreKey is the store key of the wordlist language of the stored mnemonic
+const MnemonicLanguageStoreKey = "mnemonicLanguage"
+
+var (
+	ErrUnsupportedMnemonicLanguage = errors.New("unsupported mnemonic language")
+	ErrInvalidMnemonicLength       = errors.New("mnemonic must have 12, 15, 18, 21 or 24 words")
+	ErrInvalidMnemonicChecksum     = errors.New("mnemonic checksum is incorrect")
+)
+
+var mnemonicWordLists = map[string][]string{
+	"english":             wordlists.English,
+	"chinese_simplified":  wordlists.ChineseSimplified,
+	"chinese_traditional": wordlists.ChineseTraditional,
+	"french":              wordlists.French,
+	"italian":             wordlists.Italian,
+	"japanese":            wordlists.Japanese,
+	"korean":              wordlists.Korean,
+	"spanish":             wordlists.Spanish,
+}
+
+// bip39 keeps the wordlist in a package variable, so switching it has to be serialized
+var wordListMutex sync.Mutex
+
+// UnknownMnemonicWordsError is returned when a mnemonic has words that are not in the wordlist, usually typos
+type UnknownMnemonicWordsError struct {
+	Words []string
+}
+
+func (e *UnknownMnemonicWordsError) Error() string {
+	return "mnemonic has unknown words: " + strings.Join(e.Words, ", ")
+}
+
 type generateKeyFromMnemonicOpts struct {
 	override bool
 	mnemonic string
 	password string
+	language string
 }
 
 var defaultMnemonicOpts = generateKeyFromMnemonicOpts{
 	override: false,
 	mnemonic: "",
 	password: "",
+	language: DefaultMnemonicLanguage,
 }
 
 type GenerateKeyFromMnemonicOpts func(o *generateKeyFromMnemonicOpts)
@@ -38,6 +82,15 @@ func WithPassword(password string) GenerateKeyFromMnemonicOpts {
 	}
 }
 
+// WithLanguage sets the wordlist used to generate or read the mnemonic
+func WithLanguage(language string) GenerateKeyFromMnemonicOpts {
+	return func(o *generateKeyFromMnemonicOpts) {
+		if language != "" {
+			o.language = language
+		}
+	}
+}
+
 func WithOverride() GenerateKeyFromMnemonicOpts {
 	return func(o *generateKeyFromMnemonicOpts) {
 		o.override = true
@@ -58,34 +111,152 @@ func (kc *keychain) GenerateKeyFromMnemonic(opts ...GenerateKeyFromMnemonicOpts)
 		return "", newErr
 	}
 
-	mnemonic := o.mnemonic
+	mnemonic, compressedSeed, err := mnemonicSeed(o.mnemonic, o.password, o.language)
+	if err != nil {
+		return "", err
+	}
 
-	if mnemonic == "" {
-		entropy, err := bip39.NewEntropy(128)
-		if err != nil {
-			return "", err
-		}
+	_, _, err = kc.generateAndStoreKeyPair(compressedSeed, mnemonic)
+	if err != nil {
+		return "", err
+	}
 
-		mnemonic, err = bip39.NewMnemonic(entropy)
-		if err != nil {
-			return "", err
-		}
+	if err := kc.st.Set([]byte(MnemonicLanguageStoreKey), []byte(o.language)); err != nil {
+		return "", err
 	}
 
-	seed, err := bip39.NewSeedWithErrorChecking(mnemonic, o.password)
+	return mnemonic, nil
+}
+
+// Returns the wordlist language of the stored mnemonic, english if it was not stored
+func (kc *keychain) GetStoredMnemonicLanguage() (string, error) {
+	language, err := kc.st.Get([]byte(MnemonicLanguageStoreKey))
+	if err == badger.ErrKeyNotFound || len(language) == 0 {
+		return DefaultMnemonicLanguage, nil
+	}
 	if err != nil {
 		return "", err
 	}
 
-	// The seed returned by bip39 is fixed to size = 64 bytes.
-	// However the seed in ed25519 needs to have size 32.
-	// So to fix this we derive a key again based on the previous one, but with the correct size.
-	compressedSeed := pbkdf2.Key(seed, []byte("iter2"+o.password), 512, 32, sha512.New)
+	return string(language), nil
+}
 
-	_, _, err = kc.generateAndStoreKeyPair(compressedSeed, mnemonic)
+// returns the language of the first wordlist the mnemonic is valid in, english if none
+func detectMnemonicLanguage(mnemonic string) string {
+	if ValidateMnemonic(mnemonic, DefaultMnemonicLanguage) == nil {
+		return DefaultMnemonicLanguage
+	}
+
+	languages := make([]string, 0, len(mnemonicWordLists))
+	for language := range mnemonicWordLists {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	for _, language := range languages {
+		if ValidateMnemonic(mnemonic, language) == nil {
+			return language
+		}
+	}
+
+	return DefaultMnemonicLanguage
+}
+
+// NewKeyFromMnemonic derives a key pair from a mnemonic without storing it.
+// If mnemonic is a blank string, a random one is generated.
+func NewKeyFromMnemonic(mnemonic, password string) (crypto.PrivKey, string, error) {
+	mnemonic, seed, err := mnemonicSeed(mnemonic, password, DefaultMnemonicLanguage)
 	if err != nil {
-		return "", err
+		return nil, "", err
 	}
 
-	return mnemonic, nil
+	priv, err := crypto.UnmarshalEd25519PrivateKey(ed25519.NewKeyFromSeed(seed))
+	if err != nil {
+		return nil, "", err
+	}
+
+	return priv, mnemonic, nil
+}
+
+// ValidateMnemonic checks the words and the checksum of a mnemonic without deriving a key from it.
+// Unknown words are returned in an UnknownMnemonicWordsError so they can be pointed out to the user.
+func ValidateMnemonic(mnemonic, language string) error {
+	return withWordList(language, func() error {
+		return validateMnemonic(mnemonic)
+	})
+}
+
+// expects the wordlist to be set already
+func validateMnemonic(mnemonic string) error {
+	words := strings.Fields(mnemonic)
+	if len(words)%3 != 0 || len(words) < 12 || len(words) > 24 {
+		return ErrInvalidMnemonicLength
+	}
+
+	unknown := []string{}
+	for _, word := range words {
+		if _, ok := bip39.GetWordIndex(word); !ok {
+			unknown = append(unknown, word)
+		}
+	}
+
+	if len(unknown) > 0 {
+		return &UnknownMnemonicWordsError{Words: unknown}
+	}
+
+	if _, err := bip39.MnemonicToByteArray(mnemonic); err != nil {
+		return ErrInvalidMnemonicChecksum
+	}
+
+	return nil
+}
+
+// runs fn with the wordlist of the given language set in bip39, english if blank
+func withWordList(language string, fn func() error) error {
+	if language == "" {
+		language = DefaultMnemonicLanguage
+	}
+
+	list, ok := mnemonicWordLists[language]
+	if !ok {
+		return ErrUnsupportedMnemonicLanguage
+	}
+
+	wordListMutex.Lock()
+	defer wordListMutex.Unlock()
+
+	bip39.SetWordList(list)
+	defer bip39.SetWordList(wordlists.English)
+
+	return fn()
+}
+
+// returns the mnemonic, generating a random one if blank, and the ed25519 seed derived from it
+func mnemonicSeed(mnemonic, password, language string) (string, []byte, error) {
+	var seed []byte
+	err := withWordList(language, func() error {
+		if mnemonic == "" {
+			entropy, err := bip39.NewEntropy(128)
+			if err != nil {
+				return err
+			}
+
+			mnemonic, err = bip39.NewMnemonic(entropy)
+			if err != nil {
+				return err
+			}
+		}
+
+		var err error
+		seed, err = bip39.NewSeedWithErrorChecking(mnemonic, password)
+		return err
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	// The seed returned by bip39 is fixed to size = 64 bytes.
+	// However the seed in ed25519 needs to have size 32.
+	// So to fix this we derive a key again based on the previous one, but with the correct size.
+	return mnemonic, pbkdf2.Key(seed, []byte("iter2"+password), 512, 32, sha512.New), nil
 }
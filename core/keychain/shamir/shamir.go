@@ -0,0 +1,152 @@
+// Package shamir implements Shamir's secret sharing over GF(2^8).
+// Each share holds one byte per secret byte followed by the share x coordinate.
+package shamir
+
+import (
+	"crypto/rand"
+	"errors"
+)
+
+const maxShares = 255
+
+var (
+	ErrInvalidThreshold = errors.New("threshold must be between 2 and the number of shares")
+	ErrTooManyShares    = errors.New("cannot create more than 255 shares")
+	ErrEmptySecret      = errors.New("cannot split an empty secret")
+	ErrNotEnoughShares  = errors.New("at least two shares are required")
+	ErrInvalidShares    = errors.New("shares are malformed or do not belong to the same secret")
+)
+
+// Split divides secret into n shares so that any k of them can rebuild it
+func Split(secret []byte, n, k int) ([][]byte, error) {
+	if len(secret) == 0 {
+		return nil, ErrEmptySecret
+	}
+	if n > maxShares {
+		return nil, ErrTooManyShares
+	}
+	if k < 2 || k > n {
+		return nil, ErrInvalidThreshold
+	}
+
+	shares := make([][]byte, n)
+	for i := range shares {
+		shares[i] = make([]byte, len(secret)+1)
+		// x coordinates 1..n, 0 would reveal the secret
+		shares[i][len(secret)] = byte(i + 1)
+	}
+
+	coefficients := make([]byte, k)
+	for idx, b := range secret {
+		// random polynomial of degree k-1 with the secret byte as intercept
+		coefficients[0] = b
+		if _, err := rand.Read(coefficients[1:]); err != nil {
+			return nil, err
+		}
+
+		for i := range shares {
+			shares[i][idx] = evaluate(coefficients, byte(i+1))
+		}
+	}
+
+	return shares, nil
+}
+
+// Combine rebuilds the secret from at least the threshold number of shares.
+// Using fewer shares than the threshold returns a wrong secret, not an error.
+func Combine(shares [][]byte) ([]byte, error) {
+	if len(shares) < 2 {
+		return nil, ErrNotEnoughShares
+	}
+
+	size := len(shares[0])
+	if size < 2 {
+		return nil, ErrInvalidShares
+	}
+
+	xs := make([]byte, len(shares))
+	seen := make(map[byte]bool)
+	for i, share := range shares {
+		if len(share) != size {
+			return nil, ErrInvalidShares
+		}
+
+		x := share[size-1]
+		if x == 0 || seen[x] {
+			return nil, ErrInvalidShares
+		}
+		seen[x] = true
+		xs[i] = x
+	}
+
+	secret := make([]byte, size-1)
+	ys := make([]byte, len(shares))
+	for idx := range secret {
+		for i, share := range shares {
+			ys[i] = share[idx]
+		}
+		secret[idx] = interpolateAtZero(xs, ys)
+	}
+
+	return secret, nil
+}
+
+// evaluates the polynomial at x using Horner's method
+func evaluate(coefficients []byte, x byte) byte {
+	result := coefficients[len(coefficients)-1]
+	for i := len(coefficients) - 2; i >= 0; i-- {
+		result = add(mul(result, x), coefficients[i])
+	}
+	return result
+}
+
+// lagrange interpolation of the points evaluated at x = 0
+func interpolateAtZero(xs, ys []byte) byte {
+	var result byte
+	for i := range xs {
+		basis := byte(1)
+		for j := range xs {
+			if i == j {
+				continue
+			}
+			// x_j / (x_j - x_i), subtraction is xor in GF(2^8)
+			basis = mul(basis, div(xs[j], add(xs[j], xs[i])))
+		}
+		result = add(result, mul(ys[i], basis))
+	}
+	return result
+}
+
+func add(a, b byte) byte {
+	return a ^ b
+}
+
+// multiplication in GF(2^8) with the AES polynomial x^8 + x^4 + x^3 + x + 1
+func mul(a, b byte) byte {
+	var result byte
+	for b > 0 {
+		if b&1 == 1 {
+			result ^= a
+		}
+		carry := a & 0x80
+		a <<= 1
+		if carry != 0 {
+			a ^= 0x1b
+		}
+		b >>= 1
+	}
+	return result
+}
+
+// a^254 is the multiplicative inverse of a in GF(2^8)
+func inverse(a byte) byte {
+	result := byte(1)
+	for i := 0; i < 254; i++ {
+		result = mul(result, a)
+	}
+	return result
+}
+
+func div(a, b byte) byte {
+	return mul(a, inverse(b))
+}
@@ -0,0 +1,50 @@
+package shamir
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitAndCombine(t *testing.T) {
+	secret := []byte("some very secret private key bytes")
+
+	shares, err := Split(secret, 5, 3)
+	require.NoError(t, err)
+	assert.Len(t, shares, 5)
+
+	// any subset of k shares should rebuild the secret
+	subsets := [][][]byte{
+		{shares[0], shares[1], shares[2]},
+		{shares[4], shares[2], shares[0]},
+		{shares[1], shares[3], shares[4]},
+		shares,
+	}
+	for _, subset := range subsets {
+		result, err := Combine(subset)
+		require.NoError(t, err)
+		assert.Equal(t, secret, result)
+	}
+
+	// less than k shares should not
+	result, err := Combine(shares[:2])
+	require.NoError(t, err)
+	assert.NotEqual(t, secret, result)
+}
+
+func TestSplit_ShouldFail_WithInvalidThreshold(t *testing.T) {
+	_, err := Split([]byte("secret"), 3, 4)
+	assert.Equal(t, ErrInvalidThreshold, err)
+
+	_, err = Split([]byte("secret"), 3, 1)
+	assert.Equal(t, ErrInvalidThreshold, err)
+}
+
+func TestCombine_ShouldFail_WithDuplicatedShares(t *testing.T) {
+	shares, err := Split([]byte("secret"), 3, 2)
+	require.NoError(t, err)
+
+	_, err = Combine([][]byte{shares[0], shares[0]})
+	assert.Equal(t, ErrInvalidShares, err)
+}
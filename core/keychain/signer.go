@@ -0,0 +1,189 @@
+package keychain
+
+import (
+	"context"
+	"crypto/sha512"
+	"errors"
+	"sync"
+
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/textileio/go-threads/core/thread"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var (
+	ErrSignerCannotExport = errors.New("the identity signer cannot export its private key")
+	ErrSignerKeyMismatch  = errors.New("the identity signer public key does not match the stored public key")
+)
+
+// Signer performs the operations that need the identity private key.
+// Implementations may keep the private key outside of the daemon, e.g. in a smartcard or an agent.
+type Signer interface {
+	PublicKey(ctx context.Context) (crypto.PubKey, error)
+	Sign(ctx context.Context, data []byte) ([]byte, error)
+	// Decrypt opens data encrypted for the identity public key, such as mailbox messages
+	Decrypt(ctx context.Context, data []byte) ([]byte, error)
+	// DeriveKey derives a secret of the given size from the private key and the salt, see deriveKeyFromPrivateKey
+	DeriveKey(ctx context.Context, salt []byte, size int) ([]byte, error)
+}
+
+// Keys derived from the identity key, such as thread ids and thread keys, are
+// PBKDF2-SHA512 of the raw ed25519 private key (seed followed by public key) with 256 iterations
+func deriveKeyFromPrivateKey(priv []byte, salt []byte, size int) []byte {
+	return pbkdf2.Key(priv, salt, 256, size, sha512.New)
+}
+
+// signs with the key pair stored in the keyring
+type keyringSigner struct {
+	kc *keychain
+}
+
+func (s *keyringSigner) PublicKey(ctx context.Context) (crypto.PubKey, error) {
+	return s.kc.GetStoredPublicKey()
+}
+
+func (s *keyringSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	return s.kc.signWithStoredKey(data)
+}
+
+func (s *keyringSigner) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	priv, _, err := s.kc.GetStoredKeyPairInLibP2PFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	return thread.NewLibp2pIdentity(priv).Decrypt(ctx, data)
+}
+
+func (s *keyringSigner) DeriveKey(ctx context.Context, salt []byte, size int) ([]byte, error) {
+	priv, _, err := s.kc.GetStoredKeyPairInLibP2PFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	privBytes, err := priv.Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	return deriveKeyFromPrivateKey(privBytes, salt, size), nil
+}
+
+// checks the public key of an external signer against the stored identity before using it,
+// so a signer holding another key is not used for this identity
+type verifiedSigner struct {
+	kc       *keychain
+	signer   Signer
+	mu       sync.Mutex
+	verified bool
+}
+
+func (s *verifiedSigner) verify(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.verified {
+		return nil
+	}
+
+	pub, err := s.signer.PublicKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	stored, err := s.kc.GetStoredPublicKey()
+	switch {
+	case err == ErrKeyPairNotFound:
+		// the first time the signer is used it sets the identity
+		raw, err := pub.Raw()
+		if err != nil {
+			return err
+		}
+
+		if err := s.kc.st.Set([]byte(PublicKeyStoreKey), raw); err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	case !stored.Equals(pub):
+		return ErrSignerKeyMismatch
+	}
+
+	s.verified = true
+	return nil
+}
+
+func (s *verifiedSigner) PublicKey(ctx context.Context) (crypto.PubKey, error) {
+	if err := s.verify(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.signer.PublicKey(ctx)
+}
+
+func (s *verifiedSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	if err := s.verify(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.signer.Sign(ctx, data)
+}
+
+func (s *verifiedSigner) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	if err := s.verify(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.signer.Decrypt(ctx, data)
+}
+
+func (s *verifiedSigner) DeriveKey(ctx context.Context, salt []byte, size int) ([]byte, error) {
+	if err := s.verify(ctx); err != nil {
+		return nil, err
+	}
+
+	return s.signer.DeriveKey(ctx, salt, size)
+}
+
+// NewSignerIdentity returns a thread identity that signs and decrypts through the given signer.
+// The identity cannot be marshalled since the private key is not available.
+func NewSignerIdentity(ctx context.Context, s Signer) (thread.Identity, error) {
+	pub, err := s.PublicKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &signerIdentity{
+		signer: s,
+		pub:    thread.NewLibp2pPubKey(pub),
+	}, nil
+}
+
+type signerIdentity struct {
+	signer Signer
+	pub    thread.PubKey
+}
+
+func (i *signerIdentity) MarshalBinary() ([]byte, error) {
+	return nil, ErrSignerCannotExport
+}
+
+func (i *signerIdentity) UnmarshalBinary([]byte) error {
+	return ErrSignerCannotExport
+}
+
+func (i *signerIdentity) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	return i.signer.Sign(ctx, data)
+}
+
+func (i *signerIdentity) GetPublic() thread.PubKey {
+	return i.pub
+}
+
+func (i *signerIdentity) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	return i.signer.Decrypt(ctx, data)
+}
+
+func (i *signerIdentity) Equals(other thread.Identity) bool {
+	return i.pub.Equals(other.GetPublic())
+}
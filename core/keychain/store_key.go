@@ -0,0 +1,122 @@
+package keychain
+
+import (
+	"crypto/rand"
+	"encoding/json"
+
+	"github.com/99designs/keyring"
+)
+
+const StoreEncryptionKeyStoreKey = "storeKey"
+
+const storeEncryptionKeySize = 32
+
+// GetStoreEncryptionKeys returns the keys the local store is encrypted with, creating the first one if needed.
+// The key is random instead of derived from the identity key, so the store can be opened before a key pair exists.
+func (kc *keychain) GetStoreEncryptionKeys() ([][]byte, error) {
+	keys, err := kc.retrieveStoreKeys()
+	if err != nil && err != keyring.ErrKeyNotFound {
+		return nil, err
+	}
+
+	if len(keys) > 0 {
+		return keys, nil
+	}
+
+	key, err := newStoreEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+
+	keys = [][]byte{key}
+	if err := kc.storeStoreKeys(keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+// RotateStoreEncryptionKey adds a new key used to encrypt the store.
+// The previous keys are kept until the store is re-encrypted on the next open.
+func (kc *keychain) RotateStoreEncryptionKey() error {
+	keys, err := kc.retrieveStoreKeys()
+	if err != nil && err != keyring.ErrKeyNotFound {
+		return err
+	}
+
+	key, err := newStoreEncryptionKey()
+	if err != nil {
+		return err
+	}
+
+	return kc.storeStoreKeys(append([][]byte{key}, keys...))
+}
+
+// DropPreviousStoreEncryptionKeys removes every key but the current one
+func (kc *keychain) DropPreviousStoreEncryptionKeys() error {
+	keys, err := kc.retrieveStoreKeys()
+	if err != nil {
+		return err
+	}
+
+	if len(keys) <= 1 {
+		return nil
+	}
+
+	return kc.storeStoreKeys(keys[:1])
+}
+
+func newStoreEncryptionKey() ([]byte, error) {
+	key := make([]byte, storeEncryptionKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// key of the keyring item holding the store keys, namespaced like the private key since each profile has its own store
+func (kc *keychain) storeKeyItemKey() string {
+	if kc.namespace == "" {
+		return StoreEncryptionKeyStoreKey
+	}
+
+	return kc.namespace + "_" + StoreEncryptionKeyStoreKey
+}
+
+func (kc *keychain) retrieveStoreKeys() ([][]byte, error) {
+	ring, err := kc.getKeyRing()
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := ring.Get(kc.storeKeyItemKey())
+	if err != nil {
+		return nil, err
+	}
+
+	keys := [][]byte{}
+	if err := json.Unmarshal(item.Data, &keys); err != nil {
+		return nil, err
+	}
+
+	return keys, nil
+}
+
+func (kc *keychain) storeStoreKeys(keys [][]byte) error {
+	ring, err := kc.getKeyRing()
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(keys)
+	if err != nil {
+		return err
+	}
+
+	return ring.Set(keyring.Item{
+		Key:   kc.storeKeyItemKey(),
+		Data:  data,
+		Label: "Space App - Store Key",
+	})
+}
@@ -0,0 +1,196 @@
+package keychain_test
+
+import (
+	"bufio"
+	"context"
+	"crypto/ed25519"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/keychain"
+	"github.com/FleekHQ/space-daemon/mocks"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/textileio/go-threads/core/thread"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+var signerSeed = []byte("0123456789abcdef0123456789abcdef")
+
+// TestExternalSignerProcess is not a real test, it acts as the signer process when spawned by the tests below
+func TestExternalSignerProcess(t *testing.T) {
+	mode := os.Getenv("SPACE_TEST_SIGNER")
+	if mode == "" {
+		return
+	}
+	defer os.Exit(0)
+
+	priv := ed25519.NewKeyFromSeed(signerSeed)
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		req := map[string]interface{}{}
+		json.Unmarshal(scanner.Bytes(), &req)
+		res := map[string]interface{}{"id": req["id"]}
+
+		switch {
+		case mode == "refuse":
+			res["error"] = "refused"
+		case req["method"] == "publicKey":
+			res["publicKey"] = base64.StdEncoding.EncodeToString(priv.Public().(ed25519.PublicKey))
+		case req["method"] == "sign" && mode == "bad-signature":
+			res["signature"] = base64.StdEncoding.EncodeToString(make([]byte, ed25519.SignatureSize))
+		case req["method"] == "sign":
+			data, _ := base64.StdEncoding.DecodeString(req["data"].(string))
+			res["signature"] = base64.StdEncoding.EncodeToString(ed25519.Sign(priv, data))
+		case req["method"] == "decrypt":
+			data, _ := base64.StdEncoding.DecodeString(req["data"].(string))
+			libp2pPriv, _ := crypto.UnmarshalEd25519PrivateKey(priv)
+			plaintext, err := thread.NewLibp2pIdentity(libp2pPriv).Decrypt(context.Background(), data)
+			if err != nil {
+				res["error"] = err.Error()
+			}
+			res["plaintext"] = base64.StdEncoding.EncodeToString(plaintext)
+		case req["method"] == "deriveKey":
+			salt, _ := base64.StdEncoding.DecodeString(req["data"].(string))
+			key := pbkdf2.Key(priv, salt, 256, int(req["size"].(float64)), sha512.New)
+			res["key"] = base64.StdEncoding.EncodeToString(key)
+		}
+
+		out, _ := json.Marshal(res)
+		fmt.Println(string(out))
+	}
+}
+
+func newTestSigner(t *testing.T, mode string) *keychain.ExternalSigner {
+	os.Setenv("SPACE_TEST_SIGNER", mode)
+	t.Cleanup(func() {
+		os.Unsetenv("SPACE_TEST_SIGNER")
+	})
+
+	signer := keychain.NewExternalSigner(os.Args[0], "-test.run=TestExternalSignerProcess")
+	t.Cleanup(func() {
+		signer.Shutdown()
+	})
+
+	return signer
+}
+
+func TestExternalSigner_Sign(t *testing.T) {
+	signer := newTestSigner(t, "ok")
+	ctx := context.Background()
+
+	pub, err := signer.PublicKey(ctx)
+	assert.Nil(t, err)
+
+	raw, _ := pub.Raw()
+	assert.Equal(t, []byte(ed25519.NewKeyFromSeed(signerSeed).Public().(ed25519.PublicKey)), raw)
+
+	sig, err := signer.Sign(ctx, []byte("challenge"))
+	assert.Nil(t, err)
+
+	ok, err := pub.Verify([]byte("challenge"), sig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+
+	identity, err := keychain.NewSignerIdentity(ctx, signer)
+	assert.Nil(t, err)
+	idSig, err := identity.Sign(ctx, []byte("token"))
+	assert.Nil(t, err)
+	ok, err = identity.GetPublic().Verify([]byte("token"), idSig)
+	assert.Nil(t, err)
+	assert.True(t, ok)
+}
+
+func TestExternalSigner_RejectsInvalidSignatures(t *testing.T) {
+	signer := newTestSigner(t, "bad-signature")
+
+	_, err := signer.Sign(context.Background(), []byte("challenge"))
+	assert.Equal(t, keychain.ErrInvalidSignature, err)
+}
+
+func TestExternalSigner_ReturnsSignerErrors(t *testing.T) {
+	signer := newTestSigner(t, "refuse")
+
+	_, err := signer.Sign(context.Background(), []byte("challenge"))
+	assert.EqualError(t, err, "external signer: refused")
+}
+
+func TestExternalSigner_DecryptAndDeriveKey(t *testing.T) {
+	signer := newTestSigner(t, "ok")
+	ctx := context.Background()
+
+	identity, err := keychain.NewSignerIdentity(ctx, signer)
+	assert.Nil(t, err)
+
+	ciphertext, err := identity.GetPublic().Encrypt([]byte("message"))
+	assert.Nil(t, err)
+
+	plaintext, err := identity.Decrypt(ctx, ciphertext)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("message"), plaintext)
+
+	key, err := signer.DeriveKey(ctx, []byte("salt"), 64)
+	assert.Nil(t, err)
+	assert.Equal(t, pbkdf2.Key(ed25519.NewKeyFromSeed(signerSeed), []byte("salt"), 256, 64, sha512.New), key)
+}
+
+// keychain using the test signer, with the stored public key returned by the mocked store
+func newSignerKeychain(t *testing.T, storedPub []byte) (keychain.Keychain, *mocks.Store) {
+	st := new(mocks.Store)
+	st.On("IsOpen").Return(true)
+	st.On("Get", []byte(keychain.PublicKeyStoreKey)).Return(storedPub, nil)
+	st.On("Set", mock.Anything, mock.Anything).Return(nil)
+
+	return keychain.New(keychain.WithStore(st), keychain.WithSigner(newTestSigner(t, "ok"))), st
+}
+
+func TestKeychain_SignsWithConfiguredSigner(t *testing.T) {
+	kc, st := newSignerKeychain(t, nil)
+
+	sig, err := kc.Sign([]byte("message"))
+	assert.Nil(t, err)
+
+	priv := ed25519.NewKeyFromSeed(signerSeed)
+	pub := priv.Public().(ed25519.PublicKey)
+	assert.True(t, ed25519.Verify(pub, []byte("message"), sig))
+
+	// the signer sets the identity when there is none
+	st.AssertCalled(t, "Set", []byte(keychain.PublicKeyStoreKey), []byte(pub))
+}
+
+func TestKeychain_RejectsSignerOfAnotherIdentity(t *testing.T) {
+	otherPub := ed25519.NewKeyFromSeed([]byte("fedcba9876543210fedcba9876543210")).Public().(ed25519.PublicKey)
+	kc, _ := newSignerKeychain(t, otherPub)
+
+	_, err := kc.Sign([]byte("message"))
+	assert.Equal(t, keychain.ErrSignerKeyMismatch, err)
+
+	_, err = kc.GetManagedThreadKey("bucketKey_personal")
+	assert.Equal(t, keychain.ErrSignerKeyMismatch, err)
+}
+
+func TestKeychain_DoesNotExportKeyKeptBySigner(t *testing.T) {
+	pub := ed25519.NewKeyFromSeed(signerSeed).Public().(ed25519.PublicKey)
+	kc, _ := newSignerKeychain(t, pub)
+
+	_, _, err := kc.GetStoredKeyPairInLibP2PFormat()
+	assert.Equal(t, keychain.ErrSignerCannotExport, err)
+
+	// thread keys are derived by the signer the same way as from a key in the keyring
+	managedKey, err := kc.GetManagedThreadKey("bucketKey_personal")
+	assert.Nil(t, err)
+
+	num := pbkdf2.Key(ed25519.NewKeyFromSeed(signerSeed), []byte("threadKeybucketKey_personal"), 256, 64, sha512.New)
+	expected, err := thread.KeyFromBytes(num)
+	assert.Nil(t, err)
+	assert.Equal(t, expected.String(), managedKey.String())
+
+	logKey, err := kc.GetLogKey()
+	assert.Nil(t, err)
+	assert.NotNil(t, logKey)
+}
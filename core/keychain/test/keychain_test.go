@@ -309,3 +309,83 @@ func TestKeychain_AppToken_Get(t *testing.T) {
 
 	assert.Equal(t, tok, tok2)
 }
+
+func TestKeychain_RestoreMnemonicKeyWithPassphrase(t *testing.T) {
+	kc := initTestKeychain(t)
+
+	mockStore.On("Set", mock.Anything, mock.Anything).Return(nil)
+	mockStore.On("Get", []byte(keychain.PublicKeyStoreKey)).Return(nil, nil)
+	mockKeyRing.On("Set", mock.Anything).Return(nil)
+	mockKeyRing.On("GetMetadata", mock.Anything).Return(keyring.Metadata{}, nil)
+
+	mnemonic := "clog chalk blame black uncover frame before decide tuition maple crowd uncle"
+	pubWithoutPassphrase, _ := hex.DecodeString("bbfa792cbf0453dde84947e5733c734b1bc11592190517d579ab589ae8107907")
+	pubWithPassphrase, _ := hex.DecodeString("bfa37b935ea56262cf31c0f509a44f4a391f4f1b919398e1bd75cc2b81e16880")
+
+	_, err := kc.GenerateKeyFromMnemonic(keychain.WithMnemonic(mnemonic), keychain.WithPassword("25th word"))
+	assert.Nil(t, err)
+	mockStore.AssertCalled(t, "Set", []byte(keychain.PublicKeyStoreKey), pubWithPassphrase)
+	mockStore.AssertNotCalled(t, "Set", []byte(keychain.PublicKeyStoreKey), pubWithoutPassphrase)
+}
+
+func TestKeychain_StoresMnemonicLanguage(t *testing.T) {
+	kc := initTestKeychain(t)
+
+	mockStore.On("Set", mock.Anything, mock.Anything).Return(nil)
+	mockStore.On("Get", []byte(keychain.PublicKeyStoreKey)).Return(nil, nil)
+	mockKeyRing.On("Set", mock.Anything).Return(nil)
+	mockKeyRing.On("GetMetadata", mock.Anything).Return(keyring.Metadata{}, nil)
+
+	_, err := kc.GenerateKeyFromMnemonic(keychain.WithLanguage("spanish"))
+	assert.Nil(t, err)
+	mockStore.AssertCalled(t, "Set", []byte(keychain.MnemonicLanguageStoreKey), []byte("spanish"))
+
+	mockStore.On("Get", []byte(keychain.MnemonicLanguageStoreKey)).Return([]byte("spanish"), nil)
+	language, err := kc.GetStoredMnemonicLanguage()
+	assert.Nil(t, err)
+	assert.Equal(t, "spanish", language)
+}
+
+func TestKeychain_ImportedMnemonicLanguageIsDetected(t *testing.T) {
+	kc := initTestKeychain(t)
+
+	mockStore.On("Set", mock.Anything, mock.Anything).Return(nil)
+	mockKeyRing.On("Set", mock.Anything).Return(nil)
+
+	priv, mnemonic, err := keychain.NewKeyFromMnemonic("", "")
+	assert.Nil(t, err)
+
+	err = kc.ImportExistingKeyPair(priv, mnemonic)
+	assert.Nil(t, err)
+	mockStore.AssertCalled(t, "Set", []byte(keychain.MnemonicLanguageStoreKey), []byte(keychain.DefaultMnemonicLanguage))
+}
+
+func TestKeychain_GenerateMnemonicKeyWithLanguage(t *testing.T) {
+	kc := initTestKeychain(t)
+
+	mockStore.On("Set", mock.Anything, mock.Anything).Return(nil)
+	mockStore.On("Get", []byte(keychain.PublicKeyStoreKey)).Return(nil, nil)
+	mockKeyRing.On("Set", mock.Anything).Return(nil)
+	mockKeyRing.On("GetMetadata", mock.Anything).Return(keyring.Metadata{}, nil)
+
+	val, err := kc.GenerateKeyFromMnemonic(keychain.WithLanguage("spanish"))
+	assert.Nil(t, err)
+	assert.Nil(t, keychain.ValidateMnemonic(val, "spanish"))
+	assert.NotNil(t, keychain.ValidateMnemonic(val, "english"))
+
+	_, err = kc.GenerateKeyFromMnemonic(keychain.WithLanguage("klingon"), keychain.WithOverride())
+	assert.Equal(t, keychain.ErrUnsupportedMnemonicLanguage, err)
+}
+
+func TestKeychain_ValidateMnemonic(t *testing.T) {
+	assert.Nil(t, keychain.ValidateMnemonic("clog chalk blame black uncover frame before decide tuition maple crowd uncle", ""))
+
+	err := keychain.ValidateMnemonic("clog chalk blame black uncover frame before decide tuition maple crowd", "")
+	assert.Equal(t, keychain.ErrInvalidMnemonicLength, err)
+
+	err = keychain.ValidateMnemonic("clog chalk blame blakc uncover frame before decide tution maple crowd uncle", "")
+	assert.Equal(t, &keychain.UnknownMnemonicWordsError{Words: []string{"blakc", "tution"}}, err)
+
+	err = keychain.ValidateMnemonic("chalk clog blame black uncover frame before decide tuition maple crowd uncle", "")
+	assert.Equal(t, keychain.ErrInvalidMnemonicChecksum, err)
+}
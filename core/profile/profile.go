@@ -0,0 +1,211 @@
+package profile
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+
+	"github.com/FleekHQ/space-daemon/config"
+	"github.com/FleekHQ/space-daemon/core/util"
+)
+
+// DefaultProfile uses the store root directly so existing installs keep their data
+const DefaultProfile = "default"
+
+const registryFileName = "profiles.json"
+const profilesDirName = "profiles"
+
+var (
+	ErrProfileNotFound    = errors.New("profile not found")
+	ErrProfileExists      = errors.New("profile already exists")
+	ErrInvalidProfileName = errors.New("profile names can only contain letters, numbers, dashes and underscores")
+)
+
+var validName = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,64}$`)
+
+type registry struct {
+	Active   string   `json:"active"`
+	Profiles []string `json:"profiles"`
+}
+
+// Manager keeps track of the profiles in a store root and which one is active.
+// Each profile has its own directory for the store, keychain, mailbox and search index.
+type Manager struct {
+	rootDir string
+	mu      sync.RWMutex
+	reg     registry
+}
+
+func New(rootDir string) (*Manager, error) {
+	rootDir, err := util.ResolvePath(rootDir)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &Manager{
+		rootDir: rootDir,
+		reg: registry{
+			Active:   DefaultProfile,
+			Profiles: []string{DefaultProfile},
+		},
+	}
+
+	data, err := ioutil.ReadFile(m.registryPath())
+	if os.IsNotExist(err) {
+		return m, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(data, &m.reg); err != nil {
+		return nil, err
+	}
+
+	if !m.exists(m.reg.Active) {
+		m.reg.Active = DefaultProfile
+	}
+
+	return m, nil
+}
+
+// List returns the names of all the profiles
+func (m *Manager) List() []string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	names := make([]string, len(m.reg.Profiles))
+	copy(names, m.reg.Profiles)
+
+	return names
+}
+
+// Active returns the name of the profile in use
+func (m *Manager) Active() string {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.reg.Active
+}
+
+// Create adds a new empty profile. Its key pair is generated or restored after switching to it.
+func (m *Manager) Create(name string) error {
+	if !validName.MatchString(name) {
+		return ErrInvalidProfileName
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.exists(name) {
+		return ErrProfileExists
+	}
+
+	m.reg.Profiles = append(m.reg.Profiles, name)
+	if err := m.save(); err != nil {
+		m.reg.Profiles = m.reg.Profiles[:len(m.reg.Profiles)-1]
+		return err
+	}
+
+	return nil
+}
+
+// SetActive persists the profile to use the next time the profile components are started
+func (m *Manager) SetActive(name string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.exists(name) {
+		return ErrProfileNotFound
+	}
+
+	prev := m.reg.Active
+	m.reg.Active = name
+	if err := m.save(); err != nil {
+		m.reg.Active = prev
+		return err
+	}
+
+	return nil
+}
+
+// StorePath returns the directory holding the data of the given profile
+func (m *Manager) StorePath(name string) string {
+	if name == DefaultProfile {
+		return m.rootDir
+	}
+
+	return filepath.Join(m.rootDir, profilesDirName, name)
+}
+
+// KeychainNamespace returns the namespace of the profile key pair in the OS keyring
+func (m *Manager) KeychainNamespace(name string) string {
+	if name == DefaultProfile {
+		return ""
+	}
+
+	return profilesDirName + "_" + name
+}
+
+// Config returns cfg with the store path pointing to the given profile directory.
+// The default profile keeps the given config so its paths don't change.
+func (m *Manager) Config(cfg config.Config, name string) config.Config {
+	if name == DefaultProfile {
+		return cfg
+	}
+
+	return &profileConfig{
+		Config:    cfg,
+		storePath: m.StorePath(name),
+	}
+}
+
+func (m *Manager) exists(name string) bool {
+	for _, p := range m.reg.Profiles {
+		if p == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (m *Manager) registryPath() string {
+	return filepath.Join(m.rootDir, registryFileName)
+}
+
+func (m *Manager) save() error {
+	path := m.registryPath()
+	data, err := json.Marshal(&m.reg)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	if err := ioutil.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+type profileConfig struct {
+	config.Config
+	storePath string
+}
+
+func (c *profileConfig) GetString(key string, defaultValue interface{}) string {
+	if key == config.SpaceStorePath {
+		return c.storePath
+	}
+
+	return c.Config.GetString(key, defaultValue)
+}
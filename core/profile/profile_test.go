@@ -0,0 +1,80 @@
+package profile
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type testConfig struct{}
+
+func (testConfig) GetString(key string, defaultValue interface{}) string {
+	return "root-" + key
+}
+
+func (testConfig) GetInt(key string, defaultValue interface{}) int {
+	return 0
+}
+
+func (testConfig) GetBool(key string, defaultValue interface{}) bool {
+	return false
+}
+
+func setup(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "space-profiles-*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	return dir
+}
+
+func TestNewDefaultsToDefaultProfile(t *testing.T) {
+	m, err := New(setup(t))
+	assert.Nil(t, err)
+
+	assert.Equal(t, DefaultProfile, m.Active())
+	assert.Equal(t, []string{DefaultProfile}, m.List())
+}
+
+func TestCreateAndSwitchProfilePersists(t *testing.T) {
+	dir := setup(t)
+	m, err := New(dir)
+	assert.Nil(t, err)
+
+	assert.Nil(t, m.Create("work"))
+	assert.Equal(t, ErrProfileExists, m.Create("work"))
+	assert.Equal(t, ErrInvalidProfileName, m.Create("../work"))
+	assert.Equal(t, ErrProfileNotFound, m.SetActive("personal"))
+	assert.Nil(t, m.SetActive("work"))
+
+	reloaded, err := New(dir)
+	assert.Nil(t, err)
+	assert.Equal(t, "work", reloaded.Active())
+	assert.Equal(t, []string{DefaultProfile, "work"}, reloaded.List())
+}
+
+func TestProfilePaths(t *testing.T) {
+	dir := setup(t)
+	m, err := New(dir)
+	assert.Nil(t, err)
+
+	assert.Equal(t, dir, m.StorePath(DefaultProfile))
+	assert.Equal(t, filepath.Join(dir, "profiles", "work"), m.StorePath("work"))
+	assert.Equal(t, "", m.KeychainNamespace(DefaultProfile))
+	assert.NotEqual(t, "", m.KeychainNamespace("work"))
+
+	cfg := testConfig{}
+	assert.Equal(t, cfg, m.Config(cfg, DefaultProfile))
+
+	workCfg := m.Config(cfg, "work")
+	assert.Equal(t, m.StorePath("work"), workCfg.GetString(config.SpaceStorePath, ""))
+	assert.Equal(t, "root-"+config.TextileHubTarget, workCfg.GetString(config.TextileHubTarget, ""))
+}
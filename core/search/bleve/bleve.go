@@ -19,6 +19,9 @@ import (
 
 const DbFileName = "filesIndex.bleve"
 
+// number of records read at a time when looking for the records of a bucket
+const bucketScanPageSize = 500
+
 type bleveSearchOption struct {
 	dbPath string
 }
@@ -174,20 +177,85 @@ func (b *bleveFilesSearchEngine) QueryFileData(
 
 	records := make([]*search.IndexRecord, len(searchResults.Hits))
 	for i, hit := range searchResults.Hits {
-		records[i] = &search.IndexRecord{
-			Id:            hit.Fields["Id"].(string),
-			ItemName:      hit.Fields["ItemName"].(string),
-			ItemExtension: hit.Fields["ItemExtension"].(string),
-			ItemPath:      hit.Fields["ItemPath"].(string),
-			ItemType:      hit.Fields["ItemType"].(string),
-			BucketSlug:    hit.Fields["BucketSlug"].(string),
-			DbId:          hit.Fields["DbId"].(string),
-		}
+		records[i] = recordFromFields(hit.Fields)
 	}
 
 	return records, nil
 }
 
+func (b *bleveFilesSearchEngine) DeleteBucketData(ctx context.Context, bucketSlug string) error {
+	records, err := b.bucketRecords(bucketSlug)
+	if err != nil {
+		return err
+	}
+
+	batch := b.idx.NewBatch()
+	for _, record := range records {
+		batch.Delete(record.Id)
+	}
+
+	return b.idx.Batch(batch)
+}
+
+// The records are indexed again, since their id depends on the bucket slug
+func (b *bleveFilesSearchEngine) RenameBucketData(ctx context.Context, bucketSlug, newBucketSlug string) error {
+	records, err := b.bucketRecords(bucketSlug)
+	if err != nil {
+		return err
+	}
+
+	batch := b.idx.NewBatch()
+	for _, record := range records {
+		batch.Delete(record.Id)
+
+		record.BucketSlug = newBucketSlug
+		record.Id = generateIndexId(record.ItemName, record.ItemPath, record.BucketSlug, record.DbId)
+		if err := batch.Index(record.Id, *record); err != nil {
+			return err
+		}
+	}
+
+	return b.idx.Batch(batch)
+}
+
+// Returns the records of the local bucket, found by scanning the index since the bucket slug is not indexed
+func (b *bleveFilesSearchEngine) bucketRecords(bucketSlug string) ([]*search.IndexRecord, error) {
+	records := []*search.IndexRecord{}
+	for from := 0; ; from += bucketScanPageSize {
+		searchRequest := bleve.NewSearchRequestOptions(bleve.NewMatchAllQuery(), bucketScanPageSize, from, false)
+		searchRequest.Fields = []string{"*"}
+
+		searchResults, err := b.idx.Search(searchRequest)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, hit := range searchResults.Hits {
+			record := recordFromFields(hit.Fields)
+			// records of shared content have the db id of the remote bucket
+			if record.BucketSlug == bucketSlug && record.DbId == "" {
+				records = append(records, record)
+			}
+		}
+
+		if len(searchResults.Hits) < bucketScanPageSize {
+			return records, nil
+		}
+	}
+}
+
+func recordFromFields(fields map[string]interface{}) *search.IndexRecord {
+	return &search.IndexRecord{
+		Id:            fields["Id"].(string),
+		ItemName:      fields["ItemName"].(string),
+		ItemExtension: fields["ItemExtension"].(string),
+		ItemPath:      fields["ItemPath"].(string),
+		ItemType:      fields["ItemType"].(string),
+		BucketSlug:    fields["BucketSlug"].(string),
+		DbId:          fields["DbId"].(string),
+	}
+}
+
 func (b *bleveFilesSearchEngine) Shutdown() error {
 	if b.idx == nil {
 		return nil
@@ -130,6 +130,49 @@ func TestFilesSearchEngine_Delete_And_Query(t *testing.T) {
 	assert.Equal(t, "second-content.txt", queryResult[0].ItemName, "search query result incorrect")
 }
 
+func TestFilesSearchEngine_Rename_And_Delete_Bucket(t *testing.T) {
+	engine, ctx := setupEngine(t)
+	insertRecord(t, ctx, engine, &search.InsertIndexRecord{
+		ItemName:      "report.pdf",
+		ItemExtension: "pdf",
+		ItemPath:      "/",
+		ItemType:      "FILE",
+		BucketSlug:    "work",
+		DbId:          "",
+	})
+	insertRecord(t, ctx, engine, &search.InsertIndexRecord{
+		ItemName:      "shared-report.pdf",
+		ItemExtension: "pdf",
+		ItemPath:      "/",
+		ItemType:      "FILE",
+		BucketSlug:    "work",
+		DbId:          "remote-db",
+	})
+
+	err := engine.RenameBucketData(ctx, "work", "office")
+	assert.NilError(t, err, "renaming bucket data failed")
+
+	queryResult, err := engine.QueryFileData(ctx, "report", 20)
+	assert.NilError(t, err, "failed to query file data")
+	assert.Equal(t, 2, len(queryResult), "query result not expected length")
+
+	slugs := map[string]string{}
+	for _, record := range queryResult {
+		slugs[record.ItemName] = record.BucketSlug
+	}
+	// shared content is not part of the local bucket
+	assert.Equal(t, "office", slugs["report.pdf"], "record not moved to the new bucket")
+	assert.Equal(t, "work", slugs["shared-report.pdf"], "shared record should not be moved")
+
+	err = engine.DeleteBucketData(ctx, "office")
+	assert.NilError(t, err, "deleting bucket data failed")
+
+	queryResult, err = engine.QueryFileData(ctx, "report", 20)
+	assert.NilError(t, err, "failed to query file data")
+	assert.Equal(t, 1, len(queryResult), "expected only single result")
+	assert.Equal(t, "shared-report.pdf", queryResult[0].ItemName, "search query result incorrect")
+}
+
 func TestPrefixFileSearchWorks(t *testing.T) {
 	engine, ctx := setupEngine(t)
 
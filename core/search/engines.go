@@ -10,5 +10,9 @@ type FilesSearchEngine interface {
 	Start() error
 	InsertFileData(ctx context.Context, data *InsertIndexRecord) (*IndexRecord, error)
 	DeleteFileData(ctx context.Context, data *DeleteIndexRecord) error
+	// DeleteBucketData removes the records of the local bucket
+	DeleteBucketData(ctx context.Context, bucketSlug string) error
+	// RenameBucketData moves the records of the local bucket to its new slug
+	RenameBucketData(ctx context.Context, bucketSlug, newBucketSlug string) error
 	QueryFileData(ctx context.Context, query string, limit int) ([]*IndexRecord, error)
 }
@@ -100,6 +100,20 @@ func (s *sqliteFilesSearchEngine) DeleteFileData(ctx context.Context, data *sear
 	return result.Error
 }
 
+func (s *sqliteFilesSearchEngine) DeleteBucketData(ctx context.Context, bucketSlug string) error {
+	result := s.db.Where("bucket_slug = ? AND db_id = ?", bucketSlug, "").Delete(&SearchIndexRecord{})
+
+	return result.Error
+}
+
+func (s *sqliteFilesSearchEngine) RenameBucketData(ctx context.Context, bucketSlug, newBucketSlug string) error {
+	result := s.db.Model(&SearchIndexRecord{}).
+		Where("bucket_slug = ? AND db_id = ?", bucketSlug, "").
+		Update("bucket_slug", newBucketSlug)
+
+	return result.Error
+}
+
 func (s *sqliteFilesSearchEngine) QueryFileData(ctx context.Context, query string, limit int) ([]*search.IndexRecord, error) {
 	var records []*SearchIndexRecord
 	result := s.db.Where(
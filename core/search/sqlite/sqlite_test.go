@@ -110,6 +110,33 @@ func TestSqliteFilesSearchEngine_Delete_And_Query(t *testing.T) {
 	assert.Equal(t, "second-content.txt", queryResult[0].ItemName, "search query result incorrect")
 }
 
+func TestSqliteFilesSearchEngine_Rename_And_Delete_Bucket(t *testing.T) {
+	engine, ctx := setupEngine(t)
+	insertRecord(t, ctx, engine, &search.InsertIndexRecord{
+		ItemName:      "report.pdf",
+		ItemExtension: "pdf",
+		ItemPath:      "/",
+		ItemType:      "FILE",
+		BucketSlug:    "work",
+		DbId:          "",
+	})
+
+	err := engine.RenameBucketData(ctx, "work", "office")
+	assert.NilError(t, err, "renaming bucket data failed")
+
+	queryResult, err := engine.QueryFileData(ctx, "report", 20)
+	assert.NilError(t, err, "failed to query file data")
+	assert.Equal(t, 1, len(queryResult), "not enough results returned from query")
+	assert.Equal(t, "office", queryResult[0].BucketSlug, "record not moved to the new bucket")
+
+	err = engine.DeleteBucketData(ctx, "office")
+	assert.NilError(t, err, "deleting bucket data failed")
+
+	queryResult, err = engine.QueryFileData(ctx, "report", 20)
+	assert.NilError(t, err, "failed to query file data")
+	assert.Equal(t, 0, len(queryResult), "bucket records should be deleted")
+}
+
 func insertRecord(
 	t *testing.T,
 	ctx context.Context,
@@ -1,6 +1,9 @@
 package domain
 
-import "fmt"
+import (
+	"fmt"
+	"time"
+)
 
 type AppConfig struct {
 	Port                 int
@@ -57,8 +60,9 @@ type AddItemsResponse struct {
 }
 
 type Member struct {
-	Address   string `json:"address"`
-	PublicKey string `json:"publicKey"`
+	Address   string    `json:"address"`
+	PublicKey string    `json:"publicKey"`
+	Role      ShareRole `json:"role"`
 }
 
 type AddWatchFile struct {
@@ -68,7 +72,38 @@ type AddWatchFile struct {
 	BucketKey  string `json:"bucket_key"`
 	BucketSlug string `json:"bucket_slug"`
 	IsRemote   bool   `json:"isRemote"`
-	Cid        string `json:"cid"`
+	// cid of the bucket version the local file is based on, updated after each upload
+	Cid string `json:"cid"`
+	// sha256 of the local content when it was last synced, writes that do not change it are not uploaded
+	ContentHash []byte `json:"content_hash"`
+}
+
+// FileConflict is an opened file changed locally after its bucket version changed
+type FileConflict struct {
+	LocalPath  string `json:"local_path"`
+	BucketSlug string `json:"bucket_slug"`
+	BucketPath string `json:"bucket_path"`
+	DbId       string `json:"dbId"`
+	// version the local file is based on
+	BaseCid string `json:"base_cid"`
+	// version in the bucket when the conflict was detected
+	RemoteCid  string `json:"remote_cid"`
+	DetectedAt int64  `json:"detected_at"`
+}
+
+type FileConflictResolution int
+
+const (
+	KeepLocal FileConflictResolution = iota
+	KeepRemote
+	KeepBoth
+)
+
+// SyncFolder is a local folder kept in sync with a path of a bucket
+type SyncFolder struct {
+	LocalPath  string `json:"local_path"`
+	BucketSlug string `json:"bucket_slug"`
+	BucketPath string `json:"bucket_path"`
 }
 
 type Identity struct {
@@ -86,6 +121,38 @@ type FileSharingInfo struct {
 	SharedFileCid     string
 	SharedFileKey     string
 	SpaceDownloadLink string
+	LinkID            string
+}
+
+// PublicLinkOptions restricts how long a public link can be used.
+// Zero values mean no restriction.
+type PublicLinkOptions struct {
+	// Unix timestamp in seconds after which the link is revoked. The daemon of the link owner
+	// unpins the file once it notices the expiry, the public gateway serves it until then.
+	ExpiresAt int64
+	// Downloads after which the link is revoked. Only downloads opened through a Space daemon with
+	// the full link are counted, downloads straight from the public gateway are not.
+	MaxDownloads int64
+	// When set, a random key is generated to encrypt the file and embedded in the
+	// link fragment so it can be opened without a separate password
+	EmbedKey bool
+}
+
+type PublicLink struct {
+	ID            string
+	Bucket        string
+	DbID          string
+	Paths         []string
+	FileName      string
+	FileCid       string
+	Link          string
+	CreatedAt     int64
+	ExpiresAt     int64
+	MaxDownloads  int64
+	DownloadCount int64
+	Revoked       bool
+	// false once the link expired or reached its max downloads, even before it is revoked
+	Active bool
 }
 
 type NotificationTypes int
@@ -96,6 +163,9 @@ const (
 	USAGEALERT
 	INVITATION_REPLY
 	REVOKED_INVITATION
+	KEY_SHARE
+	KEY_ROTATION
+	PUBLIC_LINK_DOWNLOAD
 )
 
 type FullPath struct {
@@ -108,9 +178,10 @@ type FullPath struct {
 type InvitationStatus int
 
 const (
-	PENDING  InvitationStatus = 0
-	ACCEPTED InvitationStatus = 1
-	REJECTED InvitationStatus = 2
+	PENDING   InvitationStatus = 0
+	ACCEPTED  InvitationStatus = 1
+	REJECTED  InvitationStatus = 2
+	CANCELLED InvitationStatus = 3
 )
 
 type Invitation struct {
@@ -120,6 +191,25 @@ type Invitation struct {
 	Status           InvitationStatus `json:"status"`
 	ItemPaths        []FullPath       `json:"itemPaths"`
 	Keys             [][]byte         `json:"keys"`
+	// unix timestamp (seconds) after which a pending invitation is treated as rejected, 0 means never
+	ExpiresAt int64 `json:"expiresAt"`
+}
+
+// IsExpired returns true if the invitation has an expiry date that already passed
+func (i Invitation) IsExpired() bool {
+	return i.ExpiresAt != 0 && i.ExpiresAt <= time.Now().Unix()
+}
+
+// Represents an invitation sent by the user and its current status
+type SentInvitation struct {
+	InvitationID     string
+	InviteePublicKey string
+	ItemPaths        []FullPath
+	Status           InvitationStatus
+	CreatedAt        int64
+	ExpiresAt        int64
+	// pending invitation past its expiry date, the invitee access is revoked on the next expiry check
+	Expired bool
 }
 
 type InvitationReply struct {
@@ -134,6 +224,68 @@ type RevokedInvitation struct {
 	Keys             [][]byte   `json:"keys"`
 }
 
+// MnemonicOptions are used when generating or restoring a key pair from a mnemonic
+type MnemonicOptions struct {
+	// BIP39 passphrase, also known as the 25th word. It is not stored, so it is needed again to restore
+	Passphrase string
+	// Wordlist language of the mnemonic, english if blank
+	Language string
+}
+
+// MnemonicValidation is the result of checking a mnemonic before restoring from it
+type MnemonicValidation struct {
+	Valid bool
+	// Words that are not in the wordlist, usually typos
+	UnknownWords []string
+	Reason       string
+}
+
+// KeyShare is one of the shares the identity key was split into for social recovery
+type KeyShare struct {
+	Index int
+	// Encoded share, small enough to be shown as a QR code
+	Payload string
+	// Set when the share was exported to a file
+	FilePath string
+	// Set when the share was sent to a contact through the mailbox
+	RecipientPublicKey string
+}
+
+// Represents a key share another user sent to be kept for them
+type KeyShareMessage struct {
+	OwnerPublicKey string `json:"ownerPublicKey"`
+	Payload        string `json:"payload"`
+}
+
+// Sent to the owner of a public link when the file is opened through it, so the owner can count the download
+type PublicLinkDownload struct {
+	FileCid string `json:"fileCid"`
+}
+
+// Sent from the new key to contacts once the user rotated the identity key.
+// Both keys sign the notice so contacts can trust the new key came from the old one.
+type KeyRotationNotice struct {
+	OldPublicKey    string `json:"oldPublicKey"`
+	NewPublicKey    string `json:"newPublicKey"`
+	RotatedAt       int64  `json:"rotatedAt"`
+	OldKeySignature []byte `json:"oldKeySignature"`
+	NewKeySignature []byte `json:"newKeySignature"`
+}
+
+// SigningPayload returns the bytes signed by both keys
+func (n KeyRotationNotice) SigningPayload() []byte {
+	return []byte(fmt.Sprintf("space-key-rotation:%s:%s:%d", n.OldPublicKey, n.NewPublicKey, n.RotatedAt))
+}
+
+// Result of an identity key rotation
+type KeyRotation struct {
+	// mnemonic of the new key
+	Mnemonic string
+	// true unless the passphrase backup was stored again with the new key. Local backup files
+	// and key shares made before the rotation always hold the revoked key and have to be made again.
+	BackupRequired bool
+}
+
 type UsageAlert struct {
 	Used    int64  `json:"used"`
 	Limit   int64  `json:"limit"`
@@ -157,6 +309,8 @@ type Notification struct {
 	UsageAlertValue        UsageAlert        `json:"usageAlertValue"`
 	InvitationAcceptValue  InvitationReply   `json:"invitationAcceptValue"`
 	RevokedInvitationValue RevokedInvitation `json:"revokedInvitationValue"`
+	KeyShareValue          KeyShareMessage   `json:"keyShareValue"`
+	KeyRotationValue       KeyRotationNotice `json:"keyRotationValue"`
 	RelatedObject          interface{}       `json:"relatedObject"`
 }
 
@@ -189,6 +343,14 @@ type SearchFileEntry struct {
 	DbID   string
 }
 
+// LocalKeysBackupOptions selects what is included in a local keys backup besides the private key
+type LocalKeysBackupOptions struct {
+	IncludeMnemonic  bool
+	IncludeAppTokens bool
+	// Thread keys are derived from the private key, they are checked against it when restoring the backup
+	IncludeThreadKeys bool
+}
+
 type KeyBackupType int
 
 const (
@@ -213,10 +375,94 @@ func (b KeyBackupType) String() string {
 	}
 }
 
-// SharedFilesRoleAction represents action to be performed on the role
-type SharedFilesRoleAction int
+// ShareRole represents the access a member has over shared files.
+// Roles map onto the hub bucket roles: viewer and commenter are granted read access,
+// editor write access and admin can also see members and reshare.
+// The hub has no comment permission, so the daemon of the sharer keeps the role of each member
+// to tell commenters apart from viewers when listing members.
+// The values are stored and ordered from the least to the most permissive role.
+type ShareRole int
+
+const (
+	NoneShareRole      ShareRole = 0
+	ViewerShareRole    ShareRole = 1
+	CommenterShareRole ShareRole = 2
+	EditorShareRole    ShareRole = 3
+	AdminShareRole     ShareRole = 4
+)
+
+func (r ShareRole) String() string {
+	switch r {
+	case NoneShareRole:
+		return "none"
+	case ViewerShareRole:
+		return "viewer"
+	case CommenterShareRole:
+		return "commenter"
+	case EditorShareRole:
+		return "editor"
+	case AdminShareRole:
+		return "admin"
+	default:
+		return fmt.Sprintf("%d", int(r))
+	}
+}
+
+// RestoreOptions selects what a bucket restore downloads from the backup
+type RestoreOptions struct {
+	// path prefixes restored, all the files when empty
+	Paths []string
+	// only reports what the restore would download
+	DryRun bool
+	// lists the files with their metadata and downloads their content when they are opened
+	MetadataOnly bool
+}
+
+// RestorePlan lists the files a restore downloads
+type RestorePlan struct {
+	Files      []RestoreFile
+	TotalBytes int64
+}
+
+type RestoreFile struct {
+	Path        string
+	SizeInBytes int64
+}
+
+// LazyRestoredFile is the metadata of a file restored without its content, which is downloaded when it is opened
+type LazyRestoredFile struct {
+	Cid         string `json:"cid"`
+	SizeInBytes int64  `json:"size"`
+	UpdatedAt   int64  `json:"updated_at"`
+}
+
+// BackupPolicy selects what of a bucket is backed up to the hub
+type BackupPolicy string
 
 const (
-	DeleteRoleAction SharedFilesRoleAction = iota
-	ReadWriteRoleAction
+	BackupOff BackupPolicy = "off"
+	BackupOn  BackupPolicy = "on"
+	// only the bucket thread is replicated, the content of the files is not uploaded
+	BackupMetadataOnly BackupPolicy = "metadata_only"
 )
+
+func (p BackupPolicy) IsValid() bool {
+	return p == BackupOff || p == BackupOn || p == BackupMetadataOnly
+}
+
+// BucketSettings are the per bucket settings of the user
+type BucketSettings struct {
+	BackupPolicy BackupPolicy
+	// local storage quota in bytes, 0 for no quota
+	QuotaBytes int64
+	// percentage of the quota at which a usage alert is sent, 100 if 0
+	QuotaAlertPercent int
+	// number of versions of each file to keep, 0 keeps all of them.
+	// Buckets only keep the latest version of files for now, so it is stored for clients only.
+	VersionRetention int
+	// role of members files are shared with when no role is given
+	DefaultShareRole ShareRole
+	// files backed up to the hub are encrypted with the bucket key, set when the bucket is created.
+	// It can not be updated: buckets have no unencrypted mode, and a new key would need every backed up file re-uploaded.
+	Encrypted bool
+}
@@ -35,6 +35,13 @@ type Space struct {
 
 type Syncer interface {
 	AddFileWatch(addFileInfo domain.AddWatchFile) error
+	AddSyncFolder(folder domain.SyncFolder) error
+	RemoveSyncFolder(localPath string) error
+	ListSyncFolders() ([]domain.SyncFolder, error)
+	RenameBucket(bucketSlug, newBucketSlug string) error
+	RemoveBucket(bucketSlug string) error
+	ListFileConflicts() ([]domain.FileConflict, error)
+	ResolveFileConflict(ctx context.Context, localPath string, resolution domain.FileConflictResolution) error
 	GetOpenFilePath(bucketSlug, bucketPath, dbID, cid string) (string, bool)
 }
 
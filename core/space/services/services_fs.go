@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
 	"errors"
 	"fmt"
 	"io"
@@ -15,6 +16,8 @@ import (
 	"sync"
 	"time"
 
+	"github.com/FleekHQ/space-daemon/config"
+	"github.com/FleekHQ/space-daemon/core/ignore"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/textile"
 	"github.com/FleekHQ/space-daemon/core/textile/utils"
@@ -53,6 +56,39 @@ func (s *Space) ListBuckets(ctx context.Context) ([]textile.Bucket, error) {
 	return buckets, nil
 }
 
+// Deletes the bucket and its backup. Files synced from the bucket are kept on disk.
+func (s *Space) DeleteBucket(ctx context.Context, slug string) error {
+	err := s.waitForTextileInit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := s.tc.DeleteBucket(ctx, slug); err != nil {
+		return err
+	}
+
+	return s.sync.RemoveBucket(slug)
+}
+
+// Renames the bucket, it keeps its thread and keys
+func (s *Space) RenameBucket(ctx context.Context, slug, newSlug string) (textile.Bucket, error) {
+	err := s.waitForTextileInit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := s.tc.RenameBucket(ctx, slug, newSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.sync.RenameBucket(slug, newSlug); err != nil {
+		return nil, err
+	}
+
+	return b, nil
+}
+
 func (s *Space) ShareBucket(ctx context.Context, slug string) (*domain.ThreadInfo, error) {
 	err := s.waitForTextileHub(ctx)
 	if err != nil {
@@ -111,15 +147,31 @@ func (s *Space) ToggleBucketBackup(ctx context.Context, bucketSlug string, bucke
 	return nil
 }
 
-func (s *Space) BucketBackupRestore(ctx context.Context, bucketSlug string) error {
+func (s *Space) GetBucketSettings(ctx context.Context, bucketSlug string) (domain.BucketSettings, error) {
+	if err := s.waitForTextileInit(ctx); err != nil {
+		return domain.BucketSettings{}, err
+	}
+
+	return s.tc.GetBucketSettings(ctx, bucketSlug)
+}
+
+// UpdateBucketSettings replaces the settings of the bucket, the encryption of the bucket can not be changed
+func (s *Space) UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (domain.BucketSettings, error) {
 	if err := s.waitForTextileHub(ctx); err != nil {
-		return err
+		return domain.BucketSettings{}, err
 	}
-	if err := s.tc.BucketBackupRestore(ctx, bucketSlug); err != nil {
-		return err
+
+	return s.tc.UpdateBucketSettings(ctx, bucketSlug, settings)
+}
+
+// BucketBackupRestore restores the bucket from its backup in the background.
+// The plan of the files that would be downloaded is only returned for dry runs.
+func (s *Space) BucketBackupRestore(ctx context.Context, bucketSlug string, opts domain.RestoreOptions) (domain.RestorePlan, error) {
+	if err := s.waitForTextileHub(ctx); err != nil {
+		return domain.RestorePlan{}, err
 	}
 
-	return nil
+	return s.tc.BucketBackupRestore(ctx, bucketSlug, opts)
 }
 
 func (s *Space) getBucketForRemoteFile(ctx context.Context, bucketName, dbID, path string) (textile.Bucket, error) {
@@ -227,16 +279,27 @@ func (s *Space) listDirAtPath(
 			locallyAvailable = true
 		}
 
+		size := item.Size
+		updatedAt := item.Metadata.UpdatedAt
+		if !item.IsDir {
+			if lazy, restored := s.tc.GetLazyRestoredFile(b.Slug(), relPath); restored {
+				// restored with metadata only, the content is downloaded when it is opened
+				size = lazy.SizeInBytes
+				updatedAt = lazy.UpdatedAt
+				locallyAvailable = false
+			}
+		}
+
 		entry := domain.FileInfo{
 			DirEntry: domain.DirEntry{
 				Path:          relPath,
 				IsDir:         item.IsDir,
 				Name:          item.Name,
-				SizeInBytes:   strconv.FormatInt(item.Size, 10),
+				SizeInBytes:   strconv.FormatInt(size, 10),
 				FileExtension: strings.Replace(filepath.Ext(item.Name), ".", "", -1),
 				// FIXME: real created at needed
-				Created: time.Unix(0, item.Metadata.UpdatedAt).Format(time.RFC3339),
-				Updated: time.Unix(0, item.Metadata.UpdatedAt).Format(time.RFC3339),
+				Created: time.Unix(0, updatedAt).Format(time.RFC3339),
+				Updated: time.Unix(0, updatedAt).Format(time.RFC3339),
 				Members: members,
 			},
 			IpfsHash:          item.Cid,
@@ -314,6 +377,12 @@ func (s *Space) OpenFile(ctx context.Context, path, bucketName, dbID string) (do
 	if err != nil {
 		return domain.OpenFileInfo{}, err
 	}
+	if !isRemote {
+		// content of files restored with metadata only is downloaded on open
+		if err := s.tc.RestoreLazyFile(ctx, b.Slug(), path); err != nil {
+			return domain.OpenFileInfo{}, err
+		}
+	}
 	listdir, err := b.ListDirectory(ctx, path)
 	if err != nil {
 		return domain.OpenFileInfo{}, err
@@ -341,6 +410,20 @@ func (s *Space) OpenFile(ctx context.Context, path, bucketName, dbID string) (do
 	}, nil
 }
 
+// ListFileConflicts returns the opened files that can not be synced until their conflict is resolved
+func (s *Space) ListFileConflicts(ctx context.Context) ([]domain.FileConflict, error) {
+	return s.sync.ListFileConflicts()
+}
+
+func (s *Space) ResolveFileConflict(ctx context.Context, localPath string, resolution domain.FileConflictResolution) error {
+	err := s.waitForTextileInit(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.sync.ResolveFileConflict(ctx, localPath, resolution)
+}
+
 // TruncateData removes all data from local machine
 func (s *Space) TruncateData(ctx context.Context) error {
 	// not doing anything with store because it's
@@ -365,8 +448,9 @@ func (s *Space) openFileOnFs(ctx context.Context, path string, b textile.Bucket,
 	}
 	defer tmpFile.Close()
 
-	// look for path in textile
-	err = b.GetFile(ctx, path, tmpFile)
+	// look for path in textile, hashing the content so writes that do not change it are not uploaded
+	h := sha256.New()
+	err = b.GetFile(ctx, path, io.MultiWriter(tmpFile, h))
 	if err != nil {
 		log.Error(fmt.Sprintf("error retrieving file from bucket %s in path %s", b.Key(), path), err)
 		return "", err
@@ -374,13 +458,14 @@ func (s *Space) openFileOnFs(ctx context.Context, path string, b textile.Bucket,
 
 	// register temp file in watcher
 	addWatchFile := domain.AddWatchFile{
-		DbId:       dbID,
-		LocalPath:  tmpFile.Name(),
-		BucketPath: path,
-		BucketKey:  b.Key(),
-		BucketSlug: b.Slug(),
-		IsRemote:   isRemote,
-		Cid:        cid,
+		DbId:        dbID,
+		LocalPath:   tmpFile.Name(),
+		BucketPath:  path,
+		BucketKey:   b.Key(),
+		BucketSlug:  b.Slug(),
+		IsRemote:    isRemote,
+		Cid:         cid,
+		ContentHash: h.Sum(nil),
 	}
 
 	err = s.sync.AddFileWatch(addWatchFile)
@@ -419,6 +504,57 @@ func (s *Space) CreateFolder(ctx context.Context, path string, bucketName string
 	return nil
 }
 
+// AddSyncFolder syncs the local folder with the bucket path, the local folder is created if needed
+func (s *Space) AddSyncFolder(ctx context.Context, localPath, bucketName, bucketPath string) error {
+	err := s.waitForTextileInit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if localPath == "" {
+		return errors.New("local path is required")
+	}
+
+	absPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+
+	if PathExists(absPath) && !IsPathDir(absPath) {
+		return fmt.Errorf("%s is not a folder", absPath)
+	}
+
+	b, err := s.getBucketWithFallback(ctx, bucketName)
+	if err != nil {
+		return err
+	}
+
+	return s.sync.AddSyncFolder(domain.SyncFolder{
+		LocalPath:  absPath,
+		BucketSlug: b.Slug(),
+		BucketPath: bucketPath,
+	})
+}
+
+// RemoveSyncFolder stops syncing the local folder, its files are kept both locally and in the bucket
+func (s *Space) RemoveSyncFolder(ctx context.Context, localPath string) error {
+	if localPath == "" {
+		return errors.New("local path is required")
+	}
+
+	absPath, err := filepath.Abs(localPath)
+	if err != nil {
+		return err
+	}
+
+	return s.sync.RemoveSyncFolder(absPath)
+}
+
+// ListSyncFolders returns the local folders synced with a bucket path
+func (s *Space) ListSyncFolders(ctx context.Context) ([]domain.SyncFolder, error) {
+	return s.sync.ListSyncFolders()
+}
+
 func (s *Space) createFolder(ctx context.Context, path string, b textile.Bucket) (string, error) {
 	// NOTE: may need to change signature of createFolder if we need to return this info
 	_, root, err := b.CreateDirectory(ctx, path)
@@ -450,12 +586,12 @@ func (s *Space) AddItems(ctx context.Context, sourcePaths []string, targetPath s
 	}
 	results := make(chan domain.AddItemResult)
 
-	totalsRes, err := getTotals(RemoveDuplicates(sourcePaths))
+	totalsRes, err := s.getTotals(RemoveDuplicates(sourcePaths), nil)
 	if err != nil {
 		return nil, domain.AddItemsResponse{}, err
 	}
 	go func() {
-		s.addItems(ctx, RemoveDuplicates(sourcePaths), targetPath, b, results)
+		s.addItems(ctx, RemoveDuplicates(sourcePaths), targetPath, b, results, nil)
 		close(results)
 	}()
 
@@ -492,8 +628,8 @@ func (s *Space) AddItemWithReader(
 	}, nil
 }
 
-// get totals for addItems operation
-func getTotals(sourcePaths []string) (domain.AddItemsResponse, error) {
+// get totals for addItems operation, rules are the ignore rules of the folder being added or nil for the source paths
+func (s *Space) getTotals(sourcePaths []string, rules *ignore.Matcher) (domain.AddItemsResponse, error) {
 	var wg sync.WaitGroup
 	wg.Add(len(sourcePaths))
 	filesRes := make(chan domain.AddItemsResponse)
@@ -509,6 +645,10 @@ func getTotals(sourcePaths []string) (domain.AddItemsResponse, error) {
 				}
 				// get recursive
 				var folderSubPaths []string
+				folderRules := rules
+				if folderRules == nil {
+					folderRules = s.folderIgnoreRules(pathInFs)
+				}
 				files, err := ioutil.ReadDir(pathInFs)
 				if err != nil {
 					log.Error(fmt.Sprintf("error reading folder path %s ", pathInFs), err)
@@ -519,11 +659,11 @@ func getTotals(sourcePaths []string) (domain.AddItemsResponse, error) {
 				}
 				for _, file := range files {
 					subPath := pathInFs + "/" + file.Name()
-					if subPath != pathInFs {
+					if subPath != pathInFs && !folderRules.Match(subPath, file.IsDir()) {
 						folderSubPaths = append(folderSubPaths, subPath)
 					}
 				}
-				folderSubPathsRes, err := getTotals(folderSubPaths)
+				folderSubPathsRes, err := s.getTotals(folderSubPaths, folderRules)
 				if err != nil {
 					filesRes <- domain.AddItemsResponse{
 						Error: err,
@@ -584,11 +724,18 @@ func getTotals(sourcePaths []string) (domain.AddItemsResponse, error) {
 	return totalResult, nil
 }
 
-func (s *Space) addItems(ctx context.Context, sourcePaths []string, targetPath string, b textile.Bucket, results chan<- domain.AddItemResult) error {
+func (s *Space) addItems(
+	ctx context.Context,
+	sourcePaths []string,
+	targetPath string,
+	b textile.Bucket,
+	results chan<- domain.AddItemResult,
+	rules *ignore.Matcher,
+) error {
 	// NOTE: sequential upload of files and folders
 	for _, sourcePath := range sourcePaths {
 		if IsPathDir(sourcePath) {
-			s.handleAddItemFolder(ctx, sourcePath, targetPath, b, results)
+			s.handleAddItemFolder(ctx, sourcePath, targetPath, b, results, rules)
 		} else {
 			// add files
 			r, err := s.addFile(ctx, sourcePath, targetPath, b)
@@ -611,7 +758,14 @@ func (s *Space) addItems(ctx context.Context, sourcePaths []string, targetPath s
 	return nil
 }
 
-func (s *Space) handleAddItemFolder(ctx context.Context, sourcePath string, targetPath string, b textile.Bucket, results chan<- domain.AddItemResult) {
+func (s *Space) handleAddItemFolder(
+	ctx context.Context,
+	sourcePath string,
+	targetPath string,
+	b textile.Bucket,
+	results chan<- domain.AddItemResult,
+	rules *ignore.Matcher,
+) {
 	// create folder
 	_, folderName := filepath.Split(sourcePath)
 	targetBucketFolder := targetPath + "/" + folderName
@@ -628,7 +782,11 @@ func (s *Space) handleAddItemFolder(ctx context.Context, sourcePath string, targ
 		SourcePath: sourcePath,
 		BucketPath: folderBucketPath,
 	}
-	err = s.addFolderRec(sourcePath, targetBucketFolder, ctx, b, results)
+	if rules == nil {
+		rules = s.folderIgnoreRules(sourcePath)
+	}
+
+	err = s.addFolderRec(sourcePath, targetBucketFolder, ctx, b, results, rules)
 	if err != nil {
 		results <- domain.AddItemResult{
 			SourcePath: sourcePath,
@@ -638,7 +796,14 @@ func (s *Space) handleAddItemFolder(ctx context.Context, sourcePath string, targ
 	}
 }
 
-func (s *Space) addFolderRec(sourcePath string, targetPath string, ctx context.Context, b textile.Bucket, results chan<- domain.AddItemResult) error {
+func (s *Space) addFolderRec(
+	sourcePath string,
+	targetPath string,
+	ctx context.Context,
+	b textile.Bucket,
+	results chan<- domain.AddItemResult,
+	rules *ignore.Matcher,
+) error {
 	var folderSubPaths []string
 
 	// NOTE: only reading each folder one level deep since this function is recursive
@@ -651,13 +816,27 @@ func (s *Space) addFolderRec(sourcePath string, targetPath string, ctx context.C
 	}
 
 	for _, file := range files {
-		if file.Name() != sourcePath {
-			folderSubPaths = append(folderSubPaths, sourcePath+"/"+file.Name())
+		subPath := sourcePath + "/" + file.Name()
+		if file.Name() != sourcePath && !rules.Match(subPath, file.IsDir()) {
+			folderSubPaths = append(folderSubPaths, subPath)
 		}
 	}
 
 	// recursive call to addItems
-	return s.addItems(ctx, folderSubPaths, targetPath, b, results)
+	return s.addItems(ctx, folderSubPaths, targetPath, b, results, rules)
+}
+
+// Ignore rules of a folder added with AddItems: the global patterns from the config and its .spaceignore file
+func (s *Space) folderIgnoreRules(folderPath string) *ignore.Matcher {
+	patterns := ignore.SplitPatterns(s.cfg.GetString(config.SpaceIgnorePatterns, ""))
+
+	rules, err := ignore.ForRoot(folderPath, patterns)
+	if err != nil {
+		log.Error(fmt.Sprintf("error reading ignore file of folder %s", folderPath), err)
+		return ignore.New(folderPath, patterns)
+	}
+
+	return rules
 }
 
 // Working with a file
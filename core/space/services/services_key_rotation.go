@@ -0,0 +1,228 @@
+package services
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/core/keychain"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
+	"github.com/FleekHQ/space-daemon/log"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/pkg/errors"
+)
+
+var errRotateExternalSignerKey = errors.New("the identity key is kept by an external signer, rotate it there and update the signer config instead")
+
+// RotateIdentityKey replaces the identity key with a new one, e.g. after the current one leaked.
+// Before switching keys, the new key is made admin of every bucket and of its replica on the hub,
+// the keys of the bucket threads are stored in the bucket metadata, since they are derived from the current key,
+// and the records of the metathread are exported, since the new key has its own metathread.
+// Any failure up to there leaves the current key in place.
+// Once the new key is in use, the records are imported in its metathread, the old key loses access
+// to every bucket, and every contact is sent a notice signed by both keys, so their clients move
+// what they shared with the old key to the new one. If importing or revoking fails, the old key is restored.
+// Keys kept by an external signer can't be rotated by the daemon, since the new key would be kept in the keyring
+// while the configured signer still holds the old key, so the daemon could not start again.
+// Backups made before the rotation hold the revoked key. When the passphrase of the vault backup is given,
+// the vault is stored again with the new key once the rotation succeeded, and a wrong passphrase fails
+// before anything changes. Otherwise, or if storing the vault fails, the result tells a new backup is required.
+// Returns the mnemonic of the new key so it can be backed up.
+func (s *Space) RotateIdentityKey(
+	ctx context.Context,
+	uuid string,
+	pass string,
+	backupType domain.KeyBackupType,
+) (domain.KeyRotation, error) {
+	rotation := domain.KeyRotation{BackupRequired: true}
+
+	if s.keychain.UsesExternalSigner() {
+		return rotation, errRotateExternalSignerKey
+	}
+
+	if err := s.waitForTextileHub(ctx); err != nil {
+		return rotation, err
+	}
+
+	if pass != "" {
+		if _, err := s.vault.Retrieve(uuid, pass, backupType); err != nil {
+			return rotation, errors.Wrap(err, "failed to open the vault backup to store the new key")
+		}
+	}
+
+	oldSigner := s.keychain.GetSigner()
+	oldPub, err := oldSigner.PublicKey(ctx)
+	if err != nil {
+		return rotation, err
+	}
+
+	old := previousIdentity{}
+	if old.priv, _, err = s.keychain.GetStoredKeyPairInLibP2PFormat(); err != nil {
+		return rotation, err
+	}
+
+	// keys restored from a backup may not have a mnemonic
+	old.mnemonic, _ = s.keychain.GetStoredMnemonic()
+
+	newPriv, mnemonic, err := keychain.NewKeyFromMnemonic("", "")
+	if err != nil {
+		return rotation, err
+	}
+
+	m := s.tc.GetModel()
+
+	buckets, err := m.ListBuckets(ctx)
+	if err != nil {
+		return rotation, err
+	}
+
+	err = s.tc.ManageBucketsAccess(ctx, buckets, newPriv.GetPublic(), domain.AdminShareRole)
+	if err != nil {
+		return rotation, errors.Wrap(err, "failed to grant access to the new key")
+	}
+
+	// the bucket thread keys are derived from the old key, so they are kept to join the threads with the new one
+	if err := s.tc.StoreBucketThreadKeys(ctx); err != nil {
+		return rotation, errors.Wrap(err, "failed to store the bucket thread keys")
+	}
+
+	notice, err := newKeyRotationNotice(ctx, oldSigner, oldPub, newPriv)
+	if err != nil {
+		return rotation, err
+	}
+
+	// the records are kept in the metathread of the old key, so they are read before switching
+	contacts, err := m.ListSharedPublicKeys(ctx)
+	if err != nil {
+		return rotation, errors.Wrap(err, "failed to list contacts for the key rotation notice")
+	}
+
+	records, err := m.ExportCollections(ctx)
+	if err != nil {
+		return rotation, errors.Wrap(err, "failed to export the records of the metathread")
+	}
+
+	if err := s.keychain.ImportExistingKeyPair(newPriv, mnemonic); err != nil {
+		return rotation, err
+	}
+
+	if err := s.tc.ResetIdentity(ctx); err != nil {
+		log.Error("Failed to initialize the new identity, restoring the previous key", err)
+		return rotation, s.restoreIdentityKey(ctx, old, err)
+	}
+
+	if err := s.tc.GetModel().ImportCollections(ctx, records); err != nil {
+		log.Error("Failed to import the records in the new metathread, restoring the previous key", err)
+		return rotation, s.restoreIdentityKey(ctx, old, err)
+	}
+
+	// the old key is the one that could have leaked, so it loses access to every bucket
+	err = s.tc.ManageBucketsAccess(ctx, buckets, oldPub, domain.NoneShareRole)
+	if err != nil {
+		log.Error("Failed to revoke the access of the previous key, restoring it", err)
+		if grantErr := s.tc.ManageBucketsAccess(ctx, buckets, oldPub, domain.AdminShareRole); grantErr != nil {
+			return rotation, errors.Wrap(grantErr, err.Error())
+		}
+
+		return rotation, s.restoreIdentityKey(ctx, old, err)
+	}
+
+	s.sendKeyRotationNotices(ctx, notice, contacts)
+
+	rotation.Mnemonic = mnemonic
+	if pass != "" {
+		if err := s.BackupKeysByPassphrase(ctx, uuid, pass, backupType); err != nil {
+			log.Error("Failed to store the new key in the vault backup", err)
+		} else {
+			rotation.BackupRequired = false
+		}
+	}
+
+	return rotation, nil
+}
+
+// Identity key in use before a rotation, to switch back to it if the rotation fails
+type previousIdentity struct {
+	priv     crypto.PrivKey
+	mnemonic string
+}
+
+// Switches back to the previous key after a failed rotation and returns the cause of the failure
+func (s *Space) restoreIdentityKey(ctx context.Context, old previousIdentity, cause error) error {
+	if err := s.keychain.ImportExistingKeyPair(old.priv, old.mnemonic); err != nil {
+		return errors.Wrap(err, cause.Error())
+	}
+
+	if err := s.tc.ResetIdentity(ctx); err != nil {
+		return errors.Wrap(err, cause.Error())
+	}
+
+	return cause
+}
+
+func newKeyRotationNotice(
+	ctx context.Context,
+	oldSigner keychain.Signer,
+	oldPubKey crypto.PubKey,
+	newPriv crypto.PrivKey,
+) (domain.KeyRotationNotice, error) {
+	notice := domain.KeyRotationNotice{
+		RotatedAt: time.Now().Unix(),
+	}
+
+	oldPub, err := oldPubKey.Raw()
+	if err != nil {
+		return notice, err
+	}
+
+	newPub, err := newPriv.GetPublic().Raw()
+	if err != nil {
+		return notice, err
+	}
+
+	notice.OldPublicKey = hex.EncodeToString(oldPub)
+	notice.NewPublicKey = hex.EncodeToString(newPub)
+
+	if notice.OldKeySignature, err = oldSigner.Sign(ctx, notice.SigningPayload()); err != nil {
+		return notice, err
+	}
+
+	if notice.NewKeySignature, err = newPriv.Sign(notice.SigningPayload()); err != nil {
+		return notice, err
+	}
+
+	return notice, nil
+}
+
+// Sends the notice from the new key to every contact the user shared files with.
+// Failures are only logged so one unreachable contact does not block the rotation.
+func (s *Space) sendKeyRotationNotices(ctx context.Context, notice domain.KeyRotationNotice, contacts []*model.SharedPublicKeySchema) {
+	body, err := json.Marshal(&notice)
+	if err != nil {
+		log.Error("Failed to encode the key rotation notice", err)
+		return
+	}
+
+	message, err := json.Marshal(&domain.MessageBody{
+		Type: domain.KEY_ROTATION,
+		Body: body,
+	})
+	if err != nil {
+		log.Error("Failed to encode the key rotation notice", err)
+		return
+	}
+
+	for _, contact := range contacts {
+		pk, err := decodePublicKey(nil, contact.PublicKey)
+		if err != nil {
+			log.Error("Invalid contact public key "+contact.PublicKey, err)
+			continue
+		}
+
+		if _, err := s.tc.SendMessage(ctx, pk, message); err != nil {
+			log.Error("Failed to send key rotation notice to "+contact.PublicKey, err)
+		}
+	}
+}
@@ -6,17 +6,20 @@ import (
 	"errors"
 
 	"github.com/FleekHQ/space-daemon/core/keychain"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 )
 
 // Generates a key pair and returns a mnemonic for recovering that key later on
-func (s *Space) GenerateKeyPair(ctx context.Context, useForce bool) (string, error) {
-	var mnemonic string
-	var err error
+func (s *Space) GenerateKeyPair(ctx context.Context, useForce bool, opts domain.MnemonicOptions) (string, error) {
+	kcOpts := []keychain.GenerateKeyFromMnemonicOpts{
+		keychain.WithPassword(opts.Passphrase),
+		keychain.WithLanguage(opts.Language),
+	}
 	if useForce {
-		mnemonic, err = s.keychain.GenerateKeyFromMnemonic(keychain.WithOverride())
-	} else {
-		mnemonic, err = s.keychain.GenerateKeyFromMnemonic()
+		kcOpts = append(kcOpts, keychain.WithOverride())
 	}
+
+	mnemonic, err := s.keychain.GenerateKeyFromMnemonic(kcOpts...)
 	if err != nil {
 		return "", err
 	}
@@ -24,8 +27,13 @@ func (s *Space) GenerateKeyPair(ctx context.Context, useForce bool) (string, err
 	return mnemonic, nil
 }
 
-func (s *Space) RestoreKeyPairFromMnemonic(ctx context.Context, mnemonic string) error {
-	_, err := s.keychain.GenerateKeyFromMnemonic(keychain.WithMnemonic(mnemonic), keychain.WithOverride())
+func (s *Space) RestoreKeyPairFromMnemonic(ctx context.Context, mnemonic string, opts domain.MnemonicOptions) error {
+	_, err := s.keychain.GenerateKeyFromMnemonic(
+		keychain.WithMnemonic(mnemonic),
+		keychain.WithPassword(opts.Passphrase),
+		keychain.WithLanguage(opts.Language),
+		keychain.WithOverride(),
+	)
 	if err != nil {
 		return err
 	}
@@ -38,6 +46,30 @@ func (s *Space) RestoreKeyPairFromMnemonic(ctx context.Context, mnemonic string)
 	return nil
 }
 
+// ValidateMnemonic checks the words and checksum of a mnemonic, so typos can be flagged before restoring.
+// The passphrase cannot be validated since any passphrase derives a valid key.
+func (s *Space) ValidateMnemonic(ctx context.Context, mnemonic string, language string) (domain.MnemonicValidation, error) {
+	err := keychain.ValidateMnemonic(mnemonic, language)
+	if err == nil {
+		return domain.MnemonicValidation{Valid: true}, nil
+	}
+
+	if err == keychain.ErrUnsupportedMnemonicLanguage {
+		return domain.MnemonicValidation{}, err
+	}
+
+	validation := domain.MnemonicValidation{
+		Valid:        false,
+		UnknownWords: []string{},
+		Reason:       err.Error(),
+	}
+	if unknownErr, ok := err.(*keychain.UnknownMnemonicWordsError); ok {
+		validation.UnknownWords = unknownErr.Words
+	}
+
+	return validation, nil
+}
+
 func (s *Space) GetPublicKey(ctx context.Context) (string, error) {
 	pub, err := s.keychain.GetStoredPublicKey()
 	if err != nil {
@@ -63,17 +95,23 @@ func (s *Space) GetHubAuthToken(ctx context.Context) (string, error) {
 	return tokens.HubToken, nil
 }
 
-func (s *Space) GetMnemonic(ctx context.Context) (string, error) {
+// GetMnemonic returns the stored mnemonic and its wordlist language
+func (s *Space) GetMnemonic(ctx context.Context) (string, string, error) {
 	mnemonic, err := s.keychain.GetStoredMnemonic()
 	if err != nil {
-		return "", err
+		return "", "", err
 	}
 
 	if mnemonic == "" {
-		return "", errors.New("No mnemonic seed stored in the keychain")
+		return "", "", errors.New("No mnemonic seed stored in the keychain")
 	}
 
-	return mnemonic, nil
+	language, err := s.keychain.GetStoredMnemonicLanguage()
+	if err != nil {
+		return "", "", err
+	}
+
+	return mnemonic, language, nil
 }
 
 func (s *Space) DeleteKeypair(ctx context.Context) error {
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/FleekHQ/space-daemon/core/keychain/shamir"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/pkg/errors"
+)
+
+// prefix of encoded key shares, so they can be told apart from share file paths
+const keySharePrefix = "space-share:"
+
+const keyShareVersion = 1
+
+var (
+	errTooManyShareRecipients = errors.New("there are more recipients than shares")
+	errInvalidKeyShare        = errors.New("invalid key share")
+	errMismatchingKeyShares   = errors.New("key shares belong to different keys")
+	errNotEnoughKeyShares     = errors.New("not enough key shares to recover the key")
+)
+
+// encoded content of a share, the public key is used to verify the recovered key
+type keyShareEnvelope struct {
+	Version   int    `json:"v"`
+	PublicKey string `json:"pk"`
+	Threshold int    `json:"k"`
+	Share     []byte `json:"s"`
+}
+
+func encodeKeyShare(e keyShareEnvelope) (string, error) {
+	b, err := json.Marshal(e)
+	if err != nil {
+		return "", err
+	}
+
+	return keySharePrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func decodeKeyShare(payload string) (keyShareEnvelope, error) {
+	e := keyShareEnvelope{}
+	if !strings.HasPrefix(payload, keySharePrefix) {
+		return e, errInvalidKeyShare
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(strings.TrimPrefix(payload, keySharePrefix))
+	if err != nil {
+		return e, errInvalidKeyShare
+	}
+
+	if err := json.Unmarshal(b, &e); err != nil {
+		return e, errInvalidKeyShare
+	}
+
+	if e.Version != keyShareVersion {
+		return e, errInvalidKeyShare
+	}
+
+	return e, nil
+}
+
+// SplitKeyIntoShares splits the identity private key into n shares, k of which are needed to recover it.
+// Shares are returned encoded so they can be shown as QR codes. If exportDir is set, each share is also written to a file.
+// The first shares are sent through the mailbox to the recipients, one each, so they can keep them for the user.
+func (s *Space) SplitKeyIntoShares(
+	ctx context.Context,
+	n, k int,
+	exportDir string,
+	recipients []crypto.PubKey,
+) ([]domain.KeyShare, error) {
+	if len(recipients) > n {
+		return nil, errTooManyShareRecipients
+	}
+
+	if err := ensureShareExportDir(exportDir); err != nil {
+		return nil, err
+	}
+
+	priv, pub, err := s.keychain.GetStoredKeyPairInLibP2PFormat()
+	if err != nil {
+		return nil, err
+	}
+
+	privBytes, err := priv.Raw()
+	if err != nil {
+		return nil, err
+	}
+
+	pubBytes, err := pub.Raw()
+	if err != nil {
+		return nil, err
+	}
+	ownerPublicKey := hex.EncodeToString(pubBytes)
+
+	rawShares, err := shamir.Split(privBytes, n, k)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recipients) > 0 {
+		if err := s.waitForTextileHub(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	shares := make([]domain.KeyShare, 0, n)
+	for i, rawShare := range rawShares {
+		payload, err := encodeKeyShare(keyShareEnvelope{
+			Version:   keyShareVersion,
+			PublicKey: ownerPublicKey,
+			Threshold: k,
+			Share:     rawShare,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		share := domain.KeyShare{
+			Index:   i + 1,
+			Payload: payload,
+		}
+
+		if exportDir != "" {
+			share.FilePath = filepath.Join(exportDir, fmt.Sprintf("space-key-share-%d.txt", i+1))
+			if err := ioutil.WriteFile(share.FilePath, []byte(payload), 0600); err != nil {
+				return nil, errors.Wrap(err, "failed to export key share")
+			}
+		}
+
+		if i < len(recipients) {
+			share.RecipientPublicKey, err = s.sendKeyShare(ctx, recipients[i], ownerPublicKey, payload)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to send key share")
+			}
+		}
+
+		shares = append(shares, share)
+	}
+
+	return shares, nil
+}
+
+func (s *Space) sendKeyShare(ctx context.Context, recipient crypto.PubKey, ownerPublicKey, payload string) (string, error) {
+	recipientRaw, err := recipient.Raw()
+	if err != nil {
+		return "", err
+	}
+
+	body, err := json.Marshal(&domain.KeyShareMessage{
+		OwnerPublicKey: ownerPublicKey,
+		Payload:        payload,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	message, err := json.Marshal(&domain.MessageBody{
+		Type: domain.KEY_SHARE,
+		Body: body,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := s.tc.SendMessage(ctx, recipient, message); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(recipientRaw), nil
+}
+
+// RecoverFromShares rebuilds the identity key from key shares and restores the state.
+// Each share is given either by its encoded Payload or by the FilePath of an exported share file.
+// Warning: This will delete any local state before restoring the key
+func (s *Space) RecoverFromShares(ctx context.Context, shares []domain.KeyShare) error {
+	rawShares := make([][]byte, 0, len(shares))
+	var ownerPublicKey string
+	var threshold int
+
+	for _, share := range shares {
+		payload := share.Payload
+		if share.FilePath != "" {
+			content, err := ioutil.ReadFile(share.FilePath)
+			if err != nil {
+				return errInvalidKeyShare
+			}
+			payload = string(content)
+		}
+		payload = strings.TrimSpace(payload)
+
+		e, err := decodeKeyShare(payload)
+		if err != nil {
+			return err
+		}
+
+		if ownerPublicKey == "" {
+			ownerPublicKey = e.PublicKey
+			threshold = e.Threshold
+		} else if ownerPublicKey != e.PublicKey {
+			return errMismatchingKeyShares
+		}
+
+		rawShares = append(rawShares, e.Share)
+	}
+
+	if len(rawShares) < threshold || len(rawShares) < 2 {
+		return errNotEnoughKeyShares
+	}
+
+	privBytes, err := shamir.Combine(rawShares)
+	if err != nil {
+		return err
+	}
+
+	priv, err := crypto.UnmarshalEd25519PrivateKey(privBytes)
+	if err != nil {
+		return errInvalidKeyShare
+	}
+
+	// make sure the shares were not tampered with before replacing the current key
+	expectedPub, err := hex.DecodeString(ownerPublicKey)
+	if err != nil {
+		return errInvalidKeyShare
+	}
+
+	pubBytes, err := priv.GetPublic().Raw()
+	if err != nil {
+		return err
+	}
+
+	if !bytes.Equal(expectedPub, pubBytes) {
+		return errInvalidKeyShare
+	}
+
+	if err := s.keychain.ImportExistingKeyPair(priv, ""); err != nil {
+		return err
+	}
+
+	if err := s.tc.RestoreDB(ctx); err != nil {
+		s.keychain.DeleteKeypair()
+		return err
+	}
+
+	return nil
+}
+
+// checks the export directory exists before generating any share
+func ensureShareExportDir(dir string) error {
+	if dir == "" {
+		return nil
+	}
+
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+
+	if !info.IsDir() {
+		return errors.New("key shares export path is not a directory")
+	}
+
+	return nil
+}
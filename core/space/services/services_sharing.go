@@ -11,6 +11,7 @@ import (
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/opentracing/opentracing-go"
@@ -23,6 +24,7 @@ import (
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	t "github.com/FleekHQ/space-daemon/core/textile"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
 	"github.com/ipfs/go-cid"
 	"github.com/textileio/dcrypto"
 )
@@ -33,6 +35,7 @@ func (s *Space) GenerateFileSharingLink(
 	path string,
 	bucketName string,
 	dbID string,
+	opts domain.PublicLinkOptions,
 ) (domain.FileSharingInfo, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "Space.GenerateFileSharingLink")
 	defer span.Finish()
@@ -45,12 +48,29 @@ func (s *Space) GenerateFileSharingLink(
 	if dbID != "" {
 		bucket, err = s.getBucketForRemoteFile(ctx, bucketName, dbID, path)
 	} else {
+		if err := s.checkBucketShareable(ctx, bucketName); err != nil {
+			return EmptyFileSharingInfo, err
+		}
 		bucket, err = s.getBucketWithFallback(ctx, bucketName)
 	}
 	if err != nil {
 		return domain.FileSharingInfo{}, err
 	}
 
+	if dbID == "" {
+		// content of files restored with metadata only is downloaded before it is shared
+		if err := s.tc.RestoreLazyFile(ctx, bucket.Slug(), path); err != nil {
+			return EmptyFileSharingInfo, err
+		}
+	}
+
+	if opts.EmbedKey {
+		encryptionPassword, err = generatePublicLinkKey()
+		if err != nil {
+			return EmptyFileSharingInfo, errors.Wrap(err, "failed to generate link key")
+		}
+	}
+
 	encryptedFile, err := s.encryptBucketFile(ctx, encryptionPassword, path, bucket)
 	if err != nil {
 		return EmptyFileSharingInfo, errors.Wrap(err, "file encryption failed")
@@ -68,6 +88,9 @@ func (s *Space) GenerateFileSharingLink(
 		encryptedFile,
 		fileName,
 		bucketName,
+		dbID,
+		[]string{path},
+		opts,
 	)
 }
 
@@ -121,17 +144,28 @@ func (s *Space) uploadSharedFileToIpfs(
 	sharedContent io.Reader,
 	fileName string,
 	bucketName string,
+	dbID string,
+	paths []string,
+	opts domain.PublicLinkOptions,
 ) (domain.FileSharingInfo, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "Space.uploadSharedFileToIpfs")
 	defer span.Finish()
 
+	if opts.ExpiresAt != 0 && opts.ExpiresAt <= time.Now().Unix() {
+		return EmptyFileSharingInfo, errors.New("public link expiry should be in the future")
+	}
+	if opts.MaxDownloads < 0 {
+		return EmptyFileSharingInfo, errors.New("public link max downloads cannot be negative")
+	}
+
 	b, err := s.tc.GetPublicShareBucket(ctx)
 	if err != nil {
 		return EmptyFileSharingInfo, errors.Wrap(err, "failed to get public files bucket")
 	}
 
 	timestamp := time.Now().UnixNano()
-	uploadResult, _, err := b.UploadFile(ctx, fmt.Sprintf("%s-%d", fileName, timestamp), sharedContent)
+	publicPath := fmt.Sprintf("%s-%d", fileName, timestamp)
+	uploadResult, _, err := b.UploadFile(ctx, publicPath, sharedContent)
 	if err != nil {
 		return EmptyFileSharingInfo, errors.Wrap(err, "publishing shared file failed")
 	}
@@ -141,16 +175,65 @@ func (s *Space) uploadSharedFileToIpfs(
 	urlQuery := url.Values{}
 	urlQuery.Add("fname", fileName)
 	urlQuery.Add("hash", encryptedFileHash)
+	if opts.MaxDownloads != 0 {
+		owner, err := s.keychain.GetStoredPublicKey()
+		if err != nil {
+			return EmptyFileSharingInfo, err
+		}
+
+		ownerRaw, err := owner.Raw()
+		if err != nil {
+			return EmptyFileSharingInfo, err
+		}
+		urlQuery.Add(publicLinkOwnerParam, hex.EncodeToString(ownerRaw))
+	}
+
+	downloadLink := fmt.Sprintf(
+		"%s/files/share?%s",
+		s.cfg.GetString(config.SpaceStorageSiteUrl, "https://app.space.storage"),
+		urlQuery.Encode(),
+	)
+
+	var expiresAt int64
+	if opts.ExpiresAt != 0 {
+		expiresAt = time.Unix(opts.ExpiresAt, 0).UnixNano()
+	}
+
+	link, err := s.tc.GetModel().CreatePublicLink(ctx, &model.PublicLinkSchema{
+		Bucket:       bucketName,
+		DbID:         dbID,
+		Paths:        paths,
+		FileName:     fileName,
+		FileCid:      encryptedFileHash,
+		PublicPath:   publicPath,
+		Link:         downloadLink,
+		ExpiresAt:    expiresAt,
+		MaxDownloads: opts.MaxDownloads,
+		CreatedAt:    timestamp,
+	})
+	if err != nil {
+		// an untracked copy could never be revoked or expired
+		if _, delErr := b.DeleteDirOrFile(ctx, publicPath); delErr != nil {
+			log.Error("failed to unpin untracked public file "+publicPath, delErr)
+		}
+
+		return EmptyFileSharingInfo, errors.Wrap(err, "failed to track public link")
+	}
+
+	// the fragment is never sent to the server, so the key only travels with the link.
+	// It is added after storing the link so the key is not replicated in the metathread.
+	if opts.EmbedKey {
+		fragment := url.Values{}
+		fragment.Add(publicLinkKeyParam, password)
+		downloadLink = downloadLink + "#" + fragment.Encode()
+	}
 
 	return domain.FileSharingInfo{
-		Bucket:        bucketName,
-		SharedFileCid: encryptedFileHash,
-		SharedFileKey: password,
-		SpaceDownloadLink: fmt.Sprintf(
-			"%s/files/share?%s",
-			s.cfg.GetString(config.SpaceStorageSiteUrl, "https://app.space.storage"),
-			urlQuery.Encode(),
-		),
+		Bucket:            bucketName,
+		SharedFileCid:     encryptedFileHash,
+		SharedFileKey:     password,
+		SpaceDownloadLink: downloadLink,
+		LinkID:            link.ID.String(),
 	}, nil
 }
 
@@ -160,6 +243,7 @@ func (s *Space) GenerateFilesSharingLink(
 	encryptionPassword string,
 	paths []string,
 	bucketName, dbID string,
+	opts domain.PublicLinkOptions,
 ) (domain.FileSharingInfo, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "Space.GenerateFilesSharingLink")
 	defer span.Finish()
@@ -168,7 +252,7 @@ func (s *Space) GenerateFilesSharingLink(
 		return EmptyFileSharingInfo, errors.New("no file passed to share link")
 	}
 	if len(paths) == 1 {
-		return s.GenerateFileSharingLink(ctx, encryptionPassword, paths[0], bucketName, dbID)
+		return s.GenerateFileSharingLink(ctx, encryptionPassword, paths[0], bucketName, dbID, opts)
 	}
 
 	var bucket t.Bucket
@@ -178,12 +262,22 @@ func (s *Space) GenerateFilesSharingLink(
 		// Safe to use the first path to get the bucket as all shared files should be under the same dbID
 		bucket, err = s.getBucketForRemoteFile(ctx, bucketName, dbID, paths[0])
 	} else {
+		if err := s.checkBucketShareable(ctx, bucketName); err != nil {
+			return EmptyFileSharingInfo, err
+		}
 		bucket, err = s.getBucketWithFallback(ctx, bucketName)
 	}
 	if err != nil {
 		return domain.FileSharingInfo{}, err
 	}
 
+	if opts.EmbedKey {
+		encryptionPassword, err = generatePublicLinkKey()
+		if err != nil {
+			return EmptyFileSharingInfo, errors.Wrap(err, "failed to generate link key")
+		}
+	}
+
 	// create zip file output
 	filename := generateFilesSharingZip()
 	// tempFile is written from textile before encryption
@@ -205,6 +299,13 @@ func (s *Space) GenerateFilesSharingLink(
 	zipper := zip.NewWriter(tempFile)
 	// write each file to zip
 	for _, path := range paths {
+		if dbID == "" {
+			// content of files restored with metadata only is downloaded before it is shared
+			if err := s.tc.RestoreLazyFile(ctx, bucket.Slug(), path); err != nil {
+				return EmptyFileSharingInfo, err
+			}
+		}
+
 		_, fileName := filepath.Split(path)
 		writer, err := zipper.Create(fileName)
 		if err != nil {
@@ -247,12 +348,115 @@ func (s *Space) GenerateFilesSharingLink(
 		encryptedFile,
 		filename,
 		bucketName,
+		dbID,
+		paths,
+		opts,
 	)
 }
 
+// ListPublicLinks returns the public links generated by the user along with their status.
+func (s *Space) ListPublicLinks(ctx context.Context, seek string, limit int) ([]*domain.PublicLink, string, error) {
+	err := s.waitForTextileInit(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.tc.ListPublicLinks(ctx, seek, limit)
+}
+
+// RevokePublicLink unpins the encrypted copy of a public link so it can no longer be downloaded.
+func (s *Space) RevokePublicLink(ctx context.Context, linkID string) error {
+	err := s.waitForTextileHub(ctx)
+	if err != nil {
+		return err
+	}
+
+	return s.tc.RevokePublicLink(ctx, linkID)
+}
+
+var errPublicLinkUnavailable = errors.New("public link was revoked, expired or reached its max downloads")
+
+// Returns true if the hash is of a public link generated by this user. Fails if that link can no longer be downloaded.
+// Links of other users are not known here, the gateway serves them until their owner unpins them.
+func (s *Space) checkOwnPublicLink(ctx context.Context, hash string) (bool, error) {
+	link, err := s.tc.GetModel().FindPublicLinkByCid(ctx, hash)
+	if err == model.ErrPublicLinkNotFound {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !link.IsActive() {
+		return true, errPublicLinkUnavailable
+	}
+
+	return true, nil
+}
+
+// Counts a successful download of a public link. Links of this user are counted here, the owner of
+// other links is sent a notice so their daemon counts it. Failures are only logged so they don't fail the download.
+func (s *Space) trackPublicLinkDownload(ctx context.Context, hash string, ownLink bool, owner string) {
+	if ownLink {
+		if err := s.tc.CountPublicLinkDownload(ctx, hash); err != nil {
+			log.Error("Failed to count download of public link "+hash, err)
+		}
+		return
+	}
+
+	// links without max downloads don't carry their owner
+	if owner == "" {
+		return
+	}
+
+	pk, err := decodePublicKey(nil, owner)
+	if err != nil {
+		log.Error("Invalid public link owner "+owner, err)
+		return
+	}
+
+	body, err := json.Marshal(&domain.PublicLinkDownload{FileCid: hash})
+	if err != nil {
+		log.Error("Failed to encode the public link download", err)
+		return
+	}
+
+	message, err := json.Marshal(&domain.MessageBody{
+		Type: domain.PUBLIC_LINK_DOWNLOAD,
+		Body: body,
+	})
+	if err != nil {
+		log.Error("Failed to encode the public link download", err)
+		return
+	}
+
+	if _, err := s.tc.SendMessage(ctx, pk, message); err != nil {
+		log.Error("Failed to send public link download to "+owner, err)
+	}
+}
+
 // OpenSharedFile fetched the ipfs file and decrypts it with the key. Then returns the decrypted
-// files location. NOTE: This only opens public link shared files and not those shared via direct invites.
+// files location. hash can also be the full public link, in which case the file name and the key
+// embedded in the link are used when not provided. A successful download is counted for links with max downloads.
+// NOTE: This only opens public link shared files and not those shared via direct invites.
 func (s *Space) OpenSharedFile(ctx context.Context, hash, password, filename string) (domain.OpenFileInfo, error) {
+	var linkOwner string
+	if strings.Contains(hash, "://") {
+		link, err := parsePublicFileLink(hash)
+		if err != nil {
+			return domain.OpenFileInfo{}, err
+		}
+
+		hash = link.hash
+		linkOwner = link.owner
+		if filename == "" {
+			filename = link.filename
+		}
+		if password == "" {
+			password = link.key
+		}
+	}
+
 	parsedCid, err := cid.Parse(hash)
 	if err != nil {
 		return domain.OpenFileInfo{}, err
@@ -271,6 +475,11 @@ func (s *Space) OpenSharedFile(ctx context.Context, hash, password, filename str
 		}
 	}
 
+	ownLink, err := s.checkOwnPublicLink(ctx, hash)
+	if err != nil {
+		return domain.OpenFileInfo{}, err
+	}
+
 	encryptedFile, err := s.tc.DownloadPublicItem(ctx, parsedCid)
 	if err != nil {
 		return domain.OpenFileInfo{}, err
@@ -294,6 +503,8 @@ func (s *Space) OpenSharedFile(ctx context.Context, hash, password, filename str
 		return domain.OpenFileInfo{}, errors.Wrap(err, "decryption failed")
 	}
 
+	s.trackPublicLinkDownload(ctx, hash, ownLink, linkOwner)
+
 	// Add accessed file to shared with me list
 	_, err = s.tc.AcceptSharedFileLink(ctx, hash, password, filename, strconv.FormatInt(decryptedFileSize, 10))
 	if err != nil {
@@ -305,25 +516,56 @@ func (s *Space) OpenSharedFile(ctx context.Context, hash, password, filename str
 	}, nil
 }
 
-func (s *Space) ShareFilesViaPublicKey(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey) error {
+var errInvalidInvitationExpiry = errors.New("invitation expiry date must be in the future")
+
+// ShareFilesViaPublicKey shares the paths with the given public keys and sends them an invitation.
+// If expiresAt (unix seconds) is set, the invitation is treated as rejected when it is still pending after that date.
+func (s *Space) ShareFilesViaPublicKey(
+	ctx context.Context,
+	paths []domain.FullPath,
+	pubkeys []crypto.PubKey,
+	role domain.ShareRole,
+	expiresAt int64,
+) error {
 	err := s.waitForTextileHub(ctx)
 	if err != nil {
 		return err
 	}
 
+	if role == domain.NoneShareRole {
+		return errInvalidShareRole
+	}
+
+	if expiresAt != 0 && expiresAt <= time.Now().Unix() {
+		return errInvalidInvitationExpiry
+	}
+
+	for _, path := range paths {
+		// files shared with the user are not in their own buckets
+		if path.DbId != "" {
+			continue
+		}
+
+		if err := s.checkBucketShareable(ctx, path.Bucket); err != nil {
+			return err
+		}
+	}
+
 	enhancedPaths, enckeys, err := s.resolveFullPaths(ctx, paths)
 	if err != nil {
 		return err
 	}
 
+	sentFileIds := make([]string, 0, len(enhancedPaths))
 	for i, path := range enhancedPaths {
-		_, err = s.tc.GetModel().CreateSentFileViaInvitation(ctx, path, "", enckeys[i])
+		sentFile, err := s.tc.GetModel().CreateSentFileViaInvitation(ctx, path, "", enckeys[i])
 		if err != nil {
 			return err
 		}
+		sentFileIds = append(sentFileIds, sentFile.ID.String())
 	}
 
-	err = s.tc.ManageShareFilesViaPublicKey(ctx, enhancedPaths, pubkeys, enckeys, domain.ReadWriteRoleAction)
+	err = s.tc.ManageShareFilesViaPublicKey(ctx, enhancedPaths, pubkeys, enckeys, role)
 	if err != nil {
 		return err
 	}
@@ -349,6 +591,7 @@ func (s *Space) ShareFilesViaPublicKey(ctx context.Context, paths []domain.FullP
 			InviteePublicKey: hex.EncodeToString(pkRaw),
 			ItemPaths:        enhancedPaths,
 			Keys:             enckeys,
+			ExpiresAt:        expiresAt,
 		}
 
 		i, err := json.Marshal(d)
@@ -366,14 +609,81 @@ func (s *Space) ShareFilesViaPublicKey(ctx context.Context, paths []domain.FullP
 			return err
 		}
 
-		_, err = s.tc.SendMessage(ctx, pk, j)
+		msg, err := s.tc.SendMessage(ctx, pk, j)
 		if err != nil {
 			return err
 		}
+
+		sentInvitation := &model.SentInvitationSchema{
+			SentFileInvitationSchema: model.SentFileInvitationSchema{
+				InvitationId:     msg.ID,
+				InviteePublicKey: d.InviteePublicKey,
+				Status:           domain.PENDING,
+				Role:             role,
+			},
+			ItemPaths:   enhancedPaths,
+			Keys:        enckeys,
+			SentFileIds: sentFileIds,
+		}
+		if expiresAt != 0 {
+			sentInvitation.ExpiresAt = time.Unix(expiresAt, 0).UnixNano()
+		}
+
+		if _, err = s.tc.GetModel().CreateSentInvitation(ctx, sentInvitation); err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// ListSentInvitations returns the invitations sent by the user along with their status.
+func (s *Space) ListSentInvitations(ctx context.Context, seek string, limit int) ([]*domain.SentInvitation, string, error) {
+	err := s.waitForTextileInit(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return s.tc.ListSentInvitations(ctx, seek, limit)
+}
+
+var errInvitationNotPending = errors.New("only pending invitations can be cancelled")
+
+// CancelInvitation revokes a pending invitation, removing the invitee access to the shared files.
+func (s *Space) CancelInvitation(ctx context.Context, invitationID string) error {
+	err := s.waitForTextileHub(ctx)
+	if err != nil {
+		return err
+	}
+
+	invitation, err := s.tc.GetModel().FindSentInvitation(ctx, invitationID)
+	if err != nil {
+		return errInvitationNotFound
+	}
+
+	if invitation.Status != domain.PENDING {
+		return errInvitationNotPending
+	}
+
+	invitee, err := decodePublicKey(nil, invitation.InviteePublicKey)
+	if err != nil {
+		return err
+	}
+
+	pubkeys := []crypto.PubKey{invitee}
+	err = s.tc.ManageShareFilesViaPublicKey(ctx, invitation.ItemPaths, pubkeys, invitation.Keys, domain.NoneShareRole)
+	if err != nil {
+		return err
+	}
+
+	if err = s.sendPathsRevokedInvitation(ctx, pubkeys, invitation.ItemPaths, invitation.Keys); err != nil {
+		return err
+	}
+
+	invitation.Status = domain.CANCELLED
+	_, err = s.tc.GetModel().UpdateSentInvitation(ctx, invitation)
+	return err
+}
+
 func (s *Space) resolveFullPaths(ctx context.Context, paths []domain.FullPath) ([]domain.FullPath, [][]byte, error) {
 	m := s.tc.GetModel()
 
@@ -416,7 +726,7 @@ func (s *Space) resolveFullPaths(ctx context.Context, paths []domain.FullPath) (
 			ep.BucketKey = bs.RemoteBucketKey
 			enckeys[i] = bs.EncryptionKey
 		} else {
-			r, err := m.FindReceivedFile(ctx, path.DbId, path.Bucket, path.Path)
+			r, err := m.FindReceivedFileForPath(ctx, path.DbId, path.Bucket, path.Path)
 			if err != nil {
 				return nil, nil, err
 			}
@@ -431,6 +741,103 @@ func (s *Space) resolveFullPaths(ctx context.Context, paths []domain.FullPath) (
 	return enhancedPaths, enckeys, nil
 }
 
+// DefaultShareRole returns the least permissive default share role of the buckets of the paths.
+// Files shared with the user have no bucket settings, viewer is used if only those are given.
+func (s *Space) DefaultShareRole(ctx context.Context, paths []domain.FullPath) (domain.ShareRole, error) {
+	err := s.waitForTextileInit(ctx)
+	if err != nil {
+		return domain.NoneShareRole, err
+	}
+
+	role := domain.NoneShareRole
+	for _, path := range paths {
+		if path.DbId != "" {
+			continue
+		}
+
+		bucket := path.Bucket
+		if bucket == "" {
+			bucket = t.GetDefaultBucketSlug()
+		}
+
+		settings, err := s.tc.GetBucketSettings(ctx, bucket)
+		if err != nil {
+			return domain.NoneShareRole, err
+		}
+
+		if role == domain.NoneShareRole || settings.DefaultShareRole < role {
+			role = settings.DefaultShareRole
+		}
+	}
+
+	if role == domain.NoneShareRole {
+		return domain.ViewerShareRole, nil
+	}
+
+	return role, nil
+}
+
+var errInvalidShareRole = errors.New("share role should grant access, use unshare to remove it")
+
+var errMetadataOnlyBucket = errors.New("files of buckets backed up with metadata only cannot be shared")
+
+// checkBucketShareable fails for the buckets backed up with metadata only,
+// their files content is kept out of the hub so it is not shared either
+func (s *Space) checkBucketShareable(ctx context.Context, bucket string) error {
+	if bucket == "" {
+		bucket = t.GetDefaultBucketSlug()
+	}
+
+	settings, err := s.tc.GetBucketSettings(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if settings.BackupPolicy == domain.BackupMetadataOnly {
+		return errMetadataOnlyBucket
+	}
+
+	return nil
+}
+
+// ChangeShareRole updates the role of members that paths were already shared with
+func (s *Space) ChangeShareRole(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey, role domain.ShareRole) error {
+	err := s.waitForTextileHub(ctx)
+	if err != nil {
+		return err
+	}
+
+	if role == domain.NoneShareRole {
+		return errInvalidShareRole
+	}
+
+	enhancedPaths, enckeys, err := s.resolveFullPaths(ctx, paths)
+	if err != nil {
+		return err
+	}
+
+	err = s.tc.ManageShareFilesViaPublicKey(ctx, enhancedPaths, pubkeys, enckeys, role)
+	if err != nil {
+		return err
+	}
+
+	// the hub can't tell commenters apart from viewers, so the role is kept with the sent files
+	for _, pk := range pubkeys {
+		pkRaw, err := pk.Raw()
+		if err != nil {
+			return err
+		}
+
+		for _, path := range enhancedPaths {
+			if err := s.tc.GetModel().UpdateSentFileRole(ctx, path, hex.EncodeToString(pkRaw), role); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
 func (s *Space) UnshareFilesViaPublicKey(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey) error {
 	err := s.waitForTextileHub(ctx)
 	if err != nil {
@@ -442,7 +849,7 @@ func (s *Space) UnshareFilesViaPublicKey(ctx context.Context, paths []domain.Ful
 		return err
 	}
 
-	err = s.tc.ManageShareFilesViaPublicKey(ctx, enhancedPaths, pubkeys, enckeys, domain.DeleteRoleAction)
+	err = s.tc.ManageShareFilesViaPublicKey(ctx, enhancedPaths, pubkeys, enckeys, domain.NoneShareRole)
 	if err != nil {
 		return err
 	}
@@ -504,6 +911,7 @@ func (s *Space) sendPathsRevokedInvitation(
 
 var errInvitationNotFound = errors.New("invitation not found")
 var errFailedToNotifyInviter = errors.New("failed to notify inviter of invitation status")
+var errInvitationExpired = errors.New("invitation has expired")
 
 // HandleSharedFilesInvitation accepts or rejects an invitation based on the invitation id
 func (s *Space) HandleSharedFilesInvitation(ctx context.Context, invitationId string, accept bool) error {
@@ -528,43 +936,47 @@ func (s *Space) HandleSharedFilesInvitation(ctx context.Context, invitationId st
 		return err
 	}
 
+	if accept && invitation.IsExpired() {
+		return errInvitationExpired
+	}
+
 	if accept {
 		invitation, err = s.tc.AcceptSharedFilesInvitation(ctx, invitation)
-		if err != nil {
-			return err
-		}
+	} else {
+		invitation, err = s.tc.RejectSharedFilesInvitation(ctx, invitation)
+	}
+	if err != nil {
+		return err
+	}
 
-		// notify inviter,  it was accepted
-		invitersPk, err := decodePublicKey(err, invitation.InviterPublicKey)
-		if err != nil {
-			log.Error("should not happen, but inviters public key is invalid", err)
-			return errFailedToNotifyInviter
-		}
+	// notify inviter of the reply so it can keep track of its sent invitations
+	return s.notifyInviter(ctx, invitation)
+}
 
-		messageBody, err := json.Marshal(&invitation)
-		if err != nil {
-			log.Error("error encoding invitation response body", err)
-			return errFailedToNotifyInviter
-		}
+func (s *Space) notifyInviter(ctx context.Context, invitation domain.Invitation) error {
+	invitersPk, err := decodePublicKey(nil, invitation.InviterPublicKey)
+	if err != nil {
+		log.Error("should not happen, but inviters public key is invalid", err)
+		return errFailedToNotifyInviter
+	}
 
-		message, err := json.Marshal(&domain.MessageBody{
-			Type: domain.INVITATION_REPLY,
-			Body: messageBody,
-		})
+	messageBody, err := json.Marshal(&invitation)
+	if err != nil {
+		log.Error("error encoding invitation response body", err)
+		return errFailedToNotifyInviter
+	}
 
-		if err != nil {
-			log.Error("error encoding invitation response", err)
-			return errFailedToNotifyInviter
-		}
+	message, err := json.Marshal(&domain.MessageBody{
+		Type: domain.INVITATION_REPLY,
+		Body: messageBody,
+	})
 
-		_, err = s.tc.SendMessage(ctx, invitersPk, message)
-	} else {
-		invitation, err = s.tc.RejectSharedFilesInvitation(ctx, invitation)
-	}
 	if err != nil {
-		return err
+		log.Error("error encoding invitation response", err)
+		return errFailedToNotifyInviter
 	}
 
+	_, err = s.tc.SendMessage(ctx, invitersPk, message)
 	return err
 }
 
@@ -635,6 +1047,16 @@ func (s *Space) GetSharedWithMeFiles(ctx context.Context, seek string, limit int
 	return items, offset, err
 }
 
+// Returns the items inside a folder shared with the user, so shared folders can be browsed as a tree
+func (s *Space) GetSharedWithMeDirectory(ctx context.Context, dbID, bucket, path string) ([]*domain.SharedDirEntry, error) {
+	err := s.waitForTextileHub(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.tc.GetReceivedDirectory(ctx, dbID, bucket, path)
+}
+
 // Returns a list of shared files the user has shared
 func (s *Space) GetSharedByMeFiles(ctx context.Context, seek string, limit int) ([]*domain.SharedDirEntry, string, error) {
 	err := s.waitForTextileInit(ctx)
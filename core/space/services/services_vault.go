@@ -7,15 +7,24 @@ import (
 	"strings"
 
 	"github.com/FleekHQ/space-daemon/core/backup"
+	"github.com/FleekHQ/space-daemon/core/keychain"
+	"github.com/FleekHQ/space-daemon/core/permissions"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/vault"
+	"github.com/FleekHQ/space-daemon/log"
 	"github.com/libp2p/go-libp2p-core/crypto"
 )
 
 const separator = "___"
 
-// Creates an obfuscated local file that contains everything needed to restore the state from this or another device
-func (s *Space) CreateLocalKeysBackup(ctx context.Context, path string) error {
+// Creates a local file encrypted with the passphrase that contains everything needed to restore the state
+// from this or another device. opts selects which secrets are included besides the private key.
+func (s *Space) CreateLocalKeysBackup(
+	ctx context.Context,
+	path string,
+	passphrase string,
+	opts domain.LocalKeysBackupOptions,
+) error {
 	priv, _, err := s.keychain.GetStoredKeyPairInLibP2PFormat()
 	if err != nil {
 		return err
@@ -30,18 +39,47 @@ func (s *Space) CreateLocalKeysBackup(ctx context.Context, path string) error {
 		PrivateKey: hex.EncodeToString(privInBytes),
 	}
 
-	if err := backup.MarshalBackup(path, b); err != nil {
+	if opts.IncludeMnemonic {
+		b.Mnemonic, err = s.keychain.GetStoredMnemonic()
+		if err != nil {
+			return err
+		}
+	}
+
+	if opts.IncludeAppTokens {
+		// only the master token can be looked up, other tokens are derived from it by the apps
+		masterToken, err := s.keychain.GetAppToken(keychain.MasterAppTokenStoreKey)
+		if err != nil {
+			log.Debug("No master app token found to backup", err.Error())
+		} else {
+			b.AppTokens = []*permissions.AppToken{masterToken}
+		}
+	}
+
+	if opts.IncludeThreadKeys {
+		if err := s.waitForTextileInit(ctx); err != nil {
+			return err
+		}
+
+		b.ThreadKeys, err = s.tc.GetManagedThreadKeys(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := backup.MarshalBackup(path, b, passphrase); err != nil {
 		return err
 	}
 
 	return nil
 }
 
-// Restores the state by receiving the path to a local backup
+// Restores the state by receiving the path to a local backup and the passphrase used to create it.
+// Legacy backups created without a passphrase can still be restored.
 // Warning: This will delete any local state before restoring the backup
-func (s *Space) RecoverKeysByLocalBackup(ctx context.Context, path string) error {
+func (s *Space) RecoverKeysByLocalBackup(ctx context.Context, path string, passphrase string) error {
 	// Retrieve the backup
-	b, err := backup.UnmarshalBackup(path)
+	b, err := backup.UnmarshalBackup(path, passphrase)
 	if err != nil {
 		return err
 	}
@@ -56,7 +94,20 @@ func (s *Space) RecoverKeysByLocalBackup(ctx context.Context, path string) error
 	if err != nil {
 		return err
 	}
-	if err := s.keychain.ImportExistingKeyPair(priv, ""); err != nil {
+	if err := s.keychain.ImportExistingKeyPair(priv, b.Mnemonic); err != nil {
+		return err
+	}
+
+	for _, tok := range b.AppTokens {
+		if err := s.keychain.StoreAppToken(tok); err != nil && err != keychain.ErrMasterTokenAlreadyExists {
+			s.keychain.DeleteKeypair()
+			return err
+		}
+	}
+
+	// managed thread keys are derived from the private key, so they are checked instead of imported back
+	if err := s.verifyManagedThreadKeys(b.ThreadKeys); err != nil {
+		s.keychain.DeleteKeypair()
 		return err
 	}
 
@@ -67,9 +118,29 @@ func (s *Space) RecoverKeysByLocalBackup(ctx context.Context, path string) error
 	return nil
 }
 
-// Uses vault service to fetch and decrypt a keypair set
+var errThreadKeysMismatch = errors.New("thread keys in the backup do not match the restored private key")
+
+// Checks the thread keys included in a backup are the ones derived from the restored private key,
+// otherwise the buckets they were used for could not be opened after restoring
+func (s *Space) verifyManagedThreadKeys(threadKeys map[string]string) error {
+	for name, backedUpKey := range threadKeys {
+		managedKey, err := s.keychain.GetManagedThreadKey(name)
+		if err != nil {
+			return err
+		}
+
+		if managedKey.String() != backedUpKey {
+			return errThreadKeysMismatch
+		}
+	}
+
+	return nil
+}
+
+// Uses vault service to fetch and decrypt a keypair set.
+// Vaults stored with an outdated key derivation are migrated to the current one once the keys are restored.
 func (s *Space) RecoverKeysByPassphrase(ctx context.Context, uuid string, pass string, backupType domain.KeyBackupType) error {
-	items, err := s.vault.Retrieve(uuid, pass, backupType)
+	items, version, err := s.vault.RetrieveWithVersion(uuid, pass, backupType)
 	if err != nil {
 		return err
 	}
@@ -98,16 +169,56 @@ func (s *Space) RecoverKeysByPassphrase(ctx context.Context, uuid string, pass s
 		s.keychain.DeleteKeypair()
 		return err
 	}
+
+	if version != vault.CurrentVkVersion {
+		// keys are already restored, so a failed migration is retried on the next recovery
+		if err := s.storeVaultItems(ctx, uuid, pass, backupType, items); err != nil {
+			log.Error("Failed to migrate vault to "+string(vault.CurrentVkVersion), err)
+		}
+	}
+
 	return nil
 }
 
-// Uses the vault service to securely store the current keypair
-func (s *Space) BackupKeysByPassphrase(ctx context.Context, uuid string, pass string, backupType domain.KeyBackupType) error {
+// ChangeBackupPassphrase re-encrypts the vault with a new passphrase.
+// The vault is replaced in a single store, so the old passphrase keeps working if it fails.
+func (s *Space) ChangeBackupPassphrase(
+	ctx context.Context,
+	uuid string,
+	oldPass string,
+	newPass string,
+	backupType domain.KeyBackupType,
+) error {
+	if newPass == "" {
+		return errors.New("new passphrase cannot be empty")
+	}
+
+	items, _, err := s.vault.RetrieveWithVersion(uuid, oldPass, backupType)
+	if err != nil {
+		return err
+	}
+
+	return s.storeVaultItems(ctx, uuid, newPass, backupType, items)
+}
+
+func (s *Space) storeVaultItems(
+	ctx context.Context,
+	uuid string,
+	pass string,
+	backupType domain.KeyBackupType,
+	items []vault.VaultItem,
+) error {
 	tokens, err := s.GetAPISessionTokens(ctx)
 	if err != nil {
 		return err
 	}
 
+	_, err = s.vault.Store(uuid, pass, backupType, tokens.ServicesToken, items)
+	return err
+}
+
+// Uses the vault service to securely store the current keypair
+func (s *Space) BackupKeysByPassphrase(ctx context.Context, uuid string, pass string, backupType domain.KeyBackupType) error {
 	priv, _, err := s.keychain.GetStoredKeyPairInLibP2PFormat()
 	if err != nil {
 		return err
@@ -131,11 +242,7 @@ func (s *Space) BackupKeysByPassphrase(ctx context.Context, uuid string, pass st
 
 	items := []vault.VaultItem{item}
 
-	if _, err := s.vault.Store(uuid, pass, backupType, tokens.ServicesToken, items); err != nil {
-		return err
-	}
-
-	return nil
+	return s.storeVaultItems(ctx, uuid, pass, backupType, items)
 }
 
 // Tests a passphrase without storing anything to check if the passphrase is correct
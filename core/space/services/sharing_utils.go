@@ -1,10 +1,13 @@
 package services
 
 import (
+	"encoding/base64"
 	"encoding/hex"
 	"errors"
+	"net/url"
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile/utils"
 	crypto "github.com/libp2p/go-libp2p-crypto"
 )
 
@@ -37,3 +40,59 @@ func decodePublicKey(err error, pkString string) (crypto.PubKey, error) {
 	}
 	return pk, nil
 }
+
+// key used in the fragment of links that embed their encryption key
+const publicLinkKeyParam = "key"
+
+// query param with the public key of the user that generated a link with max downloads,
+// so the daemons opening the link can report the download to them
+const publicLinkOwnerParam = "owner"
+
+var errInvalidPublicLink = errors.New("invalid public file link")
+
+// generates a random url safe key to encrypt a public link file with
+func generatePublicLinkKey() (string, error) {
+	b, err := utils.RandBytes(32)
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// publicFileLink holds the parts of a link generated by uploadSharedFileToIpfs
+type publicFileLink struct {
+	hash     string
+	filename string
+	// key embedded in the fragment, empty if the link was shared with a separate password
+	key string
+	// hex encoded public key of the link owner, only set on links with max downloads
+	owner string
+}
+
+// parsePublicFileLink extracts the file hash, name, owner and the key embedded in the fragment (if any)
+// from a link generated by uploadSharedFileToIpfs.
+func parsePublicFileLink(link string) (publicFileLink, error) {
+	u, err := url.Parse(link)
+	if err != nil {
+		return publicFileLink{}, errInvalidPublicLink
+	}
+
+	query := u.Query()
+	hash := query.Get("hash")
+	if hash == "" {
+		return publicFileLink{}, errInvalidPublicLink
+	}
+
+	fragment, err := url.ParseQuery(u.Fragment)
+	if err != nil {
+		return publicFileLink{}, errInvalidPublicLink
+	}
+
+	return publicFileLink{
+		hash:     hash,
+		filename: query.Get("fname"),
+		key:      fragment.Get(publicLinkKeyParam),
+		owner:    query.Get(publicLinkOwnerParam),
+	}, nil
+}
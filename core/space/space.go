@@ -26,39 +26,60 @@ type Service interface {
 	GetConfig(ctx context.Context) domain.AppConfig
 	ListDirs(ctx context.Context, path string, bucketName string, listMembers bool) ([]domain.FileInfo, error)
 	ListDir(ctx context.Context, path string, bucketName string, listMembers bool) ([]domain.FileInfo, error)
-	GenerateKeyPair(ctx context.Context, useForce bool) (mnemonic string, err error)
+	GenerateKeyPair(ctx context.Context, useForce bool, opts domain.MnemonicOptions) (mnemonic string, err error)
 	DeleteKeypair(ctx context.Context) error
-	GetMnemonic(ctx context.Context) (mnemonic string, err error)
-	RestoreKeyPairFromMnemonic(ctx context.Context, mnemonic string) error
+	GetMnemonic(ctx context.Context) (mnemonic string, language string, err error)
+	RestoreKeyPairFromMnemonic(ctx context.Context, mnemonic string, opts domain.MnemonicOptions) error
+	ValidateMnemonic(ctx context.Context, mnemonic string, language string) (domain.MnemonicValidation, error)
 	RecoverKeysByPassphrase(ctx context.Context, uuid string, pass string, backupType domain.KeyBackupType) error
 	BackupKeysByPassphrase(ctx context.Context, uuid string, pass string, backupType domain.KeyBackupType) error
+	ChangeBackupPassphrase(ctx context.Context, uuid string, oldPass string, newPass string, backupType domain.KeyBackupType) error
 	TestPassphrase(ctx context.Context, uuid string, pass string) error
 	GetPublicKey(ctx context.Context) (string, error)
 	GetHubAuthToken(ctx context.Context) (string, error)
 	CreateFolder(ctx context.Context, path string, bucketName string) error
+	AddSyncFolder(ctx context.Context, localPath, bucketName, bucketPath string) error
+	RemoveSyncFolder(ctx context.Context, localPath string) error
+	ListSyncFolders(ctx context.Context) ([]domain.SyncFolder, error)
+	ListFileConflicts(ctx context.Context) ([]domain.FileConflict, error)
+	ResolveFileConflict(ctx context.Context, localPath string, resolution domain.FileConflictResolution) error
 	CreateBucket(ctx context.Context, slug string) (textile.Bucket, error)
 	ListBuckets(ctx context.Context) ([]textile.Bucket, error)
+	DeleteBucket(ctx context.Context, slug string) error
+	RenameBucket(ctx context.Context, slug, newSlug string) (textile.Bucket, error)
 	AddItems(ctx context.Context, sourcePaths []string, targetPath string, bucketName string) (<-chan domain.AddItemResult, domain.AddItemsResponse, error)
 	AddItemWithReader(ctx context.Context, reader io.Reader, targetPath, bucketName string) (domain.AddItemResult, error)
 	CreateIdentity(ctx context.Context, username string) (*domain.Identity, error)
 	GetIdentityByUsername(ctx context.Context, username string) (*domain.Identity, error)
-	GenerateFileSharingLink(ctx context.Context, encryptionPassword, path, bucketName, dbID string) (domain.FileSharingInfo, error)
-	GenerateFilesSharingLink(ctx context.Context, encryptionPassword string, paths []string, bucketName, dbID string) (domain.FileSharingInfo, error)
+	GenerateFileSharingLink(ctx context.Context, encryptionPassword, path, bucketName, dbID string, opts domain.PublicLinkOptions) (domain.FileSharingInfo, error)
+	GenerateFilesSharingLink(ctx context.Context, encryptionPassword string, paths []string, bucketName, dbID string, opts domain.PublicLinkOptions) (domain.FileSharingInfo, error)
+	ListPublicLinks(ctx context.Context, seek string, limit int) ([]*domain.PublicLink, string, error)
+	RevokePublicLink(ctx context.Context, linkID string) error
 	OpenSharedFile(ctx context.Context, cid, password, filename string) (domain.OpenFileInfo, error)
 	ShareBucket(ctx context.Context, slug string) (*domain.ThreadInfo, error)
 	JoinBucket(ctx context.Context, slug string, threadinfo *domain.ThreadInfo) (bool, error)
-	CreateLocalKeysBackup(ctx context.Context, pathToKeyBackup string) error
-	RecoverKeysByLocalBackup(ctx context.Context, pathToKeyBackup string) error
+	CreateLocalKeysBackup(ctx context.Context, pathToKeyBackup, passphrase string, opts domain.LocalKeysBackupOptions) error
+	RecoverKeysByLocalBackup(ctx context.Context, pathToKeyBackup, passphrase string) error
+	SplitKeyIntoShares(ctx context.Context, n, k int, exportDir string, recipients []crypto.PubKey) ([]domain.KeyShare, error)
+	RecoverFromShares(ctx context.Context, shares []domain.KeyShare) error
+	RotateIdentityKey(ctx context.Context, uuid string, pass string, backupType domain.KeyBackupType) (domain.KeyRotation, error)
 	GetNotifications(ctx context.Context, seek string, limit int) ([]*domain.Notification, error)
 	ToggleBucketBackup(ctx context.Context, bucketSlug string, bucketBackup bool) error
-	BucketBackupRestore(ctx context.Context, bucketSlug string) error
-	ShareFilesViaPublicKey(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey) error
+	GetBucketSettings(ctx context.Context, bucketSlug string) (domain.BucketSettings, error)
+	UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (domain.BucketSettings, error)
+	BucketBackupRestore(ctx context.Context, bucketSlug string, opts domain.RestoreOptions) (domain.RestorePlan, error)
+	ShareFilesViaPublicKey(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey, role domain.ShareRole, expiresAt int64) error
+	ListSentInvitations(ctx context.Context, seek string, limit int) ([]*domain.SentInvitation, string, error)
+	CancelInvitation(ctx context.Context, invitationID string) error
+	ChangeShareRole(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey, role domain.ShareRole) error
+	DefaultShareRole(ctx context.Context, paths []domain.FullPath) (domain.ShareRole, error)
 	UnshareFilesViaPublicKey(ctx context.Context, paths []domain.FullPath, pks []crypto.PubKey) error
 	HandleSharedFilesInvitation(ctx context.Context, invitationId string, accept bool) error
 	GetAPISessionTokens(ctx context.Context) (*domain.APISessionTokens, error)
 	AddRecentlySharedPublicKeys(ctx context.Context, pubkeys []crypto.PubKey) error
 	RecentlySharedPublicKeys(ctx context.Context) ([]crypto.PubKey, error)
 	GetSharedWithMeFiles(ctx context.Context, seek string, limit int) ([]*domain.SharedDirEntry, string, error)
+	GetSharedWithMeDirectory(ctx context.Context, dbID, bucket, path string) ([]*domain.SharedDirEntry, error)
 	GetSharedByMeFiles(ctx context.Context, seek string, limit int) ([]*domain.SharedDirEntry, string, error)
 	SetNotificationsLastSeenAt(timestamp int64) error
 	GetNotificationsLastSeenAt() (int64, error)
@@ -3,6 +3,7 @@ package space
 import (
 	"context"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -16,8 +17,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/ipfs/go-cid"
+	ipfspath "github.com/ipfs/interface-go-ipfs-core/path"
 	"github.com/textileio/dcrypto"
 
+	"github.com/FleekHQ/space-daemon/config"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 
 	"github.com/libp2p/go-libp2p-core/crypto"
@@ -31,6 +35,7 @@ import (
 	"github.com/FleekHQ/space-daemon/core/vault"
 	"github.com/FleekHQ/space-daemon/mocks"
 	"github.com/stretchr/testify/assert"
+	"github.com/textileio/go-threads/core/thread"
 	buckets_pb "github.com/textileio/textile/v2/api/bucketsd/pb"
 )
 
@@ -172,6 +177,48 @@ func TestService_CreateBucket(t *testing.T) {
 	assert.Equal(t, d2, res.GetData().UpdatedAt)
 }
 
+func TestService_RenameBucket(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	textileClient.On("IsInitialized").Return(true)
+	textileClient.On("RenameBucket", mock.Anything, "slug", "renamed").Return(mockBucket, nil)
+	mockSync.On("RenameBucket", "slug", "renamed").Return(nil)
+
+	res, err := sv.RenameBucket(context.Background(), "slug", "renamed")
+
+	assert.Nil(t, err)
+	assert.Equal(t, mockBucket, res)
+	mockSync.AssertExpectations(t)
+}
+
+func TestService_DeleteBucket(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	textileClient.On("IsInitialized").Return(true)
+	textileClient.On("DeleteBucket", mock.Anything, "slug").Return(nil)
+	mockSync.On("RemoveBucket", "slug").Return(nil)
+
+	err := sv.DeleteBucket(context.Background(), "slug")
+
+	assert.Nil(t, err)
+	mockSync.AssertExpectations(t)
+}
+
+func TestService_DeleteBucket_OnError(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	textileClient.On("IsInitialized").Return(true)
+	textileClient.On("DeleteBucket", mock.Anything, "personal").Return(errors.New("default buckets can not be deleted"))
+
+	err := sv.DeleteBucket(context.Background(), "personal")
+
+	assert.NotNil(t, err)
+	mockSync.AssertNotCalled(t, "RemoveBucket", mock.Anything)
+}
+
 func TestService_ListDirs(t *testing.T) {
 	sv, _, tearDown := initTestService(t)
 	defer tearDown()
@@ -290,6 +337,13 @@ func TestService_ListDirs(t *testing.T) {
 		nil,
 	)
 
+	// restored with metadata only
+	textileClient.On("GetLazyRestoredFile", "meow", "/example.txt").Return(domain.LazyRestoredFile{
+		SizeInBytes: 2048,
+		UpdatedAt:   time.Now().UnixNano(),
+	}, true)
+	textileClient.On("GetLazyRestoredFile", mock.Anything, mock.Anything).Return(domain.LazyRestoredFile{}, false)
+
 	res, err := sv.ListDirs(context.Background(), "", "", true)
 
 	assert.Nil(t, err)
@@ -307,12 +361,16 @@ func TestService_ListDirs(t *testing.T) {
 	assert.Equal(t, "txt", res[1].FileExtension)
 	assert.Equal(t, "bafkreia4q63he72sgzrn64kpa2uu5it7utmqkdby6t3xck6umy77x7p2ae", res[1].IpfsHash)
 	assert.Equal(t, "/somedir/example.txt", res[1].Path)
+	assert.Equal(t, "16", res[1].SizeInBytes)
+	assert.True(t, res[1].LocallyAvailable)
 
 	assert.False(t, res[2].IsDir)
 	assert.Equal(t, "example.txt", res[2].Name)
 	assert.Equal(t, "txt", res[2].FileExtension)
 	assert.Equal(t, "bafkreia4q63he72sgzrn64kpa2uu5it7utmqkdby6t3xck6umy77x7p2ae", res[2].IpfsHash)
 	assert.Equal(t, "/example.txt", res[2].Path)
+	assert.Equal(t, "2048", res[2].SizeInBytes)
+	assert.False(t, res[2].LocallyAvailable)
 
 	// assert mocks
 	cfg.AssertExpectations(t)
@@ -343,6 +401,7 @@ func TestService_OpenFile(t *testing.T) {
 
 	textileClient.On("GetDefaultBucket", mock.Anything).Return(mockBucket, nil)
 	textileClient.On("IsInitialized").Return(true)
+	textileClient.On("RestoreLazyFile", mock.Anything, testKey, testPath).Return(nil)
 	mockBucket.On(
 		"GetFile",
 		mock.Anything,
@@ -460,6 +519,7 @@ func TestService_AddItems_Folder(t *testing.T) {
 
 	textileClient.On("GetDefaultBucket", mock.Anything).Return(mockBucket, nil)
 	textileClient.On("IsInitialized").Return(true)
+	cfg.On("GetString", config.SpaceIgnorePatterns, "").Return("")
 
 	mockBucket.On(
 		"Key",
@@ -730,8 +790,9 @@ func TestService_BackupAndRestore(t *testing.T) {
 	ctx := context.Background()
 
 	path := testDir.fileNames[0]
+	passphrase := "strawberry123"
 
-	err := sv.CreateLocalKeysBackup(ctx, path)
+	err := sv.CreateLocalKeysBackup(ctx, path, passphrase, domain.LocalKeysBackupOptions{})
 
 	backup, _ := ioutil.ReadFile(path)
 
@@ -741,12 +802,118 @@ func TestService_BackupAndRestore(t *testing.T) {
 	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
 	textileClient.On("RestoreDB", mock.Anything).Return(nil)
 
-	err = sv.RecoverKeysByLocalBackup(ctx, path)
+	err = sv.RecoverKeysByLocalBackup(ctx, path, passphrase)
+
+	assert.Nil(t, err)
+	mockKeychain.AssertCalled(t, "ImportExistingKeyPair", mockPrivKey, "")
+}
+
+func TestService_BackupAndRestore_WithMnemonic(t *testing.T) {
+	sv, getTestDir, tearDown := initTestService(t)
+	defer tearDown()
+
+	testDir := getTestDir()
+	ctx := context.Background()
+	path := testDir.fileNames[0]
+	passphrase := "strawberry123"
+	mnemonic := "clog chalk blame black uncover frame before decide tuition maple crowd uncle"
+
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+	mockKeychain.On("GetStoredMnemonic").Return(mnemonic, nil)
+
+	err := sv.CreateLocalKeysBackup(ctx, path, passphrase, domain.LocalKeysBackupOptions{IncludeMnemonic: true})
+	assert.Nil(t, err)
+
+	// wrong passphrase should not import anything
+	err = sv.RecoverKeysByLocalBackup(ctx, path, "wrong-passphrase")
+	assert.NotNil(t, err)
+	mockKeychain.AssertNotCalled(t, "ImportExistingKeyPair", mock.Anything, mock.Anything)
+
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
+	textileClient.On("RestoreDB", mock.Anything).Return(nil)
+
+	err = sv.RecoverKeysByLocalBackup(ctx, path, passphrase)
+
+	assert.Nil(t, err)
+	mockKeychain.AssertCalled(t, "ImportExistingKeyPair", mockPrivKey, mnemonic)
+}
+
+func TestService_BackupAndRestore_ShouldFail_When_ThreadKeysDoNotMatch(t *testing.T) {
+	sv, getTestDir, tearDown := initTestService(t)
+	defer tearDown()
+
+	testDir := getTestDir()
+	ctx := context.Background()
+	path := testDir.fileNames[0]
+	passphrase := "strawberry123"
+
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+	textileClient.On("IsInitialized").Return(true)
+	textileClient.On("GetManagedThreadKeys", mock.Anything).Return(map[string]string{
+		"bucketKey_personal": thread.NewRandomKey().String(),
+	}, nil)
+
+	err := sv.CreateLocalKeysBackup(ctx, path, passphrase, domain.LocalKeysBackupOptions{IncludeThreadKeys: true})
+	assert.Nil(t, err)
+
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
+	mockKeychain.On("GetManagedThreadKey", "bucketKey_personal").Return(thread.NewRandomKey(), nil)
+	mockKeychain.On("DeleteKeypair").Return(nil)
+
+	err = sv.RecoverKeysByLocalBackup(ctx, path, passphrase)
+
+	assert.NotNil(t, err)
+	mockKeychain.AssertCalled(t, "DeleteKeypair")
+	textileClient.AssertNotCalled(t, "RestoreDB", mock.Anything)
+}
+
+func TestService_SplitKeyIntoShares_And_RecoverFromShares(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	ctx := context.Background()
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+
+	shares, err := sv.SplitKeyIntoShares(ctx, 5, 3, "", []crypto.PubKey{})
+	assert.Nil(t, err)
+	assert.Len(t, shares, 5)
+
+	// not enough shares should not touch the keychain
+	err = sv.RecoverFromShares(ctx, []domain.KeyShare{{Payload: shares[0].Payload}, {Payload: shares[3].Payload}})
+	assert.NotNil(t, err)
+	mockKeychain.AssertNotCalled(t, "ImportExistingKeyPair", mock.Anything, mock.Anything)
+
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
+	textileClient.On("RestoreDB", mock.Anything).Return(nil)
+
+	err = sv.RecoverFromShares(ctx, []domain.KeyShare{{Payload: shares[4].Payload}, {Payload: shares[0].Payload}, {Payload: shares[2].Payload}})
 
 	assert.Nil(t, err)
 	mockKeychain.AssertCalled(t, "ImportExistingKeyPair", mockPrivKey, "")
 }
 
+func TestService_RecoverFromShares_ReadsSharesFromFilePaths(t *testing.T) {
+	sv, getDir, tearDown := initTestService(t)
+	defer tearDown()
+
+	ctx := context.Background()
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
+	textileClient.On("RestoreDB", mock.Anything).Return(nil)
+
+	shares, err := sv.SplitKeyIntoShares(ctx, 3, 2, getDir().dir, []crypto.PubKey{})
+	assert.Nil(t, err)
+
+	// a payload is never read as a path
+	err = sv.RecoverFromShares(ctx, []domain.KeyShare{{Payload: shares[0].FilePath}, {Payload: shares[1].FilePath}})
+	assert.NotNil(t, err)
+	mockKeychain.AssertNotCalled(t, "ImportExistingKeyPair", mock.Anything, mock.Anything)
+
+	err = sv.RecoverFromShares(ctx, []domain.KeyShare{{FilePath: shares[0].FilePath}, {Payload: shares[1].Payload}})
+	assert.Nil(t, err)
+	mockKeychain.AssertCalled(t, "ImportExistingKeyPair", mockPrivKey, "")
+}
+
 func TestService_VaultBackup(t *testing.T) {
 	sv, _, tearDown := initTestService(t)
 	defer tearDown()
@@ -794,7 +961,7 @@ func TestService_VaultRestore(t *testing.T) {
 
 	mockItems := []vault.VaultItem{mockItem}
 
-	mockVault.On("Retrieve", uuid, pass, domain.PASSWORD).Return(mockItems, nil)
+	mockVault.On("RetrieveWithVersion", uuid, pass, domain.PASSWORD).Return(mockItems, vault.CurrentVkVersion, nil)
 
 	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
 
@@ -803,6 +970,59 @@ func TestService_VaultRestore(t *testing.T) {
 	err := sv.RecoverKeysByPassphrase(ctx, uuid, pass, domain.PASSWORD)
 	assert.Nil(t, err)
 	mockKeychain.AssertCalled(t, "ImportExistingKeyPair", mockPrivKey, mnemonic)
+	mockVault.AssertNotCalled(t, "Store", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_VaultRestore_MigratesOutdatedVault(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	pass := "strawberry123"
+	uuid := "c907e7ef-7b36-4ab1-8a56-f788d7526a2c"
+	ctx := context.Background()
+	mnemonic := "clog chalk blame black uncover frame before decide tuition maple crowd uncle"
+
+	mockItems := []vault.VaultItem{
+		{
+			ItemType: vault.PrivateKeyWithMnemonic,
+			Value:    mockPrivKeyHex + "___" + mnemonic,
+		},
+	}
+
+	mockVault.On("RetrieveWithVersion", uuid, pass, domain.PASSWORD).Return(mockItems, vault.VkVersion1, nil)
+	mockVault.On("Store", uuid, pass, domain.PASSWORD, mock.Anything, mockItems).Return(nil, nil)
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
+	textileClient.On("RestoreDB", mock.Anything).Return(nil)
+	mockHub.On("GetTokensWithCache", mock.Anything).Return(&hub.AuthTokens{}, nil)
+
+	err := sv.RecoverKeysByPassphrase(ctx, uuid, pass, domain.PASSWORD)
+	assert.Nil(t, err)
+	mockVault.AssertCalled(t, "Store", uuid, pass, domain.PASSWORD, mock.Anything, mockItems)
+}
+
+func TestService_ChangeBackupPassphrase(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	oldPass := "strawberry123"
+	newPass := "blueberry456"
+	uuid := "c907e7ef-7b36-4ab1-8a56-f788d7526a2c"
+	ctx := context.Background()
+
+	mockItems := []vault.VaultItem{
+		{
+			ItemType: vault.PrivateKeyWithMnemonic,
+			Value:    mockPrivKeyHex + "___mnemonic",
+		},
+	}
+
+	mockVault.On("RetrieveWithVersion", uuid, oldPass, domain.PASSWORD).Return(mockItems, vault.VkVersion1, nil)
+	mockVault.On("Store", uuid, newPass, domain.PASSWORD, mock.Anything, mockItems).Return(nil, nil)
+	mockHub.On("GetTokensWithCache", mock.Anything).Return(&hub.AuthTokens{}, nil)
+
+	err := sv.ChangeBackupPassphrase(ctx, uuid, oldPass, newPass, domain.PASSWORD)
+	assert.Nil(t, err)
+	mockVault.AssertCalled(t, "Store", uuid, newPass, domain.PASSWORD, mock.Anything, mockItems)
 }
 
 func TestService_UnshareFilesViaPublicKey_Works(t *testing.T) {
@@ -818,13 +1038,382 @@ func TestService_UnshareFilesViaPublicKey_Works(t *testing.T) {
 		[]domain.FullPath{},
 		[]crypto.PubKey{},
 		[][]byte{},
-		domain.DeleteRoleAction,
+		domain.NoneShareRole,
 	).Return(nil)
 
 	err := sv.UnshareFilesViaPublicKey(ctx, []domain.FullPath{}, []crypto.PubKey{})
 	assert.Nil(t, err)
 }
 
+func TestService_ChangeShareRole_Works(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	textileClient.On(
+		"ManageShareFilesViaPublicKey",
+		ctx,
+		[]domain.FullPath{},
+		[]crypto.PubKey{},
+		[][]byte{},
+		domain.ViewerShareRole,
+	).Return(nil)
+
+	err := sv.ChangeShareRole(ctx, []domain.FullPath{}, []crypto.PubKey{}, domain.ViewerShareRole)
+	assert.Nil(t, err)
+	textileClient.AssertExpectations(t)
+}
+
+func TestService_ChangeShareRole_ShouldStoreRoleWithSentFiles(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	path := domain.FullPath{DbId: "shared-db", Bucket: "shared", Path: "/a.txt"}
+	enhancedPath := domain.FullPath{DbId: "shared-db", Bucket: "shared-remote", Path: "/a.txt", BucketKey: "bucket-key"}
+	pkRaw, err := mockPubKey.Raw()
+	assert.NoError(t, err)
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindReceivedFileForPath", mock.Anything, "shared-db", "shared", "/a.txt").Return(&model.ReceivedFileSchema{
+		ReceivedFileViaInvitationSchema: model.ReceivedFileViaInvitationSchema{
+			Bucket:        "shared-remote",
+			BucketKey:     "bucket-key",
+			EncryptionKey: []byte("key"),
+		},
+	}, nil)
+	textileClient.On(
+		"ManageShareFilesViaPublicKey",
+		ctx,
+		[]domain.FullPath{enhancedPath},
+		[]crypto.PubKey{mockPubKey},
+		[][]byte{[]byte("key")},
+		domain.CommenterShareRole,
+	).Return(nil)
+	mockModel.On("UpdateSentFileRole", ctx, enhancedPath, hex.EncodeToString(pkRaw), domain.CommenterShareRole).Return(nil)
+
+	err = sv.ChangeShareRole(ctx, []domain.FullPath{path}, []crypto.PubKey{mockPubKey}, domain.CommenterShareRole)
+	assert.Nil(t, err)
+	textileClient.AssertExpectations(t)
+	mockModel.AssertExpectations(t)
+}
+
+func TestService_ChangeShareRole_ShouldFail_When_RoleRemovesAccess(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	textileClient.On("IsHealthy").Return(true)
+
+	err := sv.ChangeShareRole(ctx, []domain.FullPath{}, []crypto.PubKey{}, domain.NoneShareRole)
+	assert.Error(t, err)
+	textileClient.AssertNotCalled(t, "ManageShareFilesViaPublicKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_DefaultShareRole_ShouldUseLeastPermissiveBucketRole(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	textileClient.On("IsInitialized").Return(true)
+	textileClient.On("GetBucketSettings", mock.Anything, "personal").Return(domain.BucketSettings{DefaultShareRole: domain.EditorShareRole}, nil)
+	textileClient.On("GetBucketSettings", mock.Anything, "photos").Return(domain.BucketSettings{DefaultShareRole: domain.CommenterShareRole}, nil)
+
+	role, err := sv.DefaultShareRole(ctx, []domain.FullPath{
+		{Path: "/a.txt"},
+		{Bucket: "photos", Path: "/b.jpg"},
+		{DbId: "shared-db", Bucket: "shared", Path: "/c.txt"},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, domain.CommenterShareRole, role)
+
+	// shared with me files have no settings
+	role, err = sv.DefaultShareRole(ctx, []domain.FullPath{{DbId: "shared-db", Bucket: "shared", Path: "/c.txt"}})
+	assert.Nil(t, err)
+	assert.Equal(t, domain.ViewerShareRole, role)
+}
+
+func TestService_RotateIdentityKey_ShouldKeepKey_When_GrantingAccessFails(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	buckets := []*model.BucketSchema{{Slug: "personal"}, {Slug: "photos"}}
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockKeychain.On("GetSigner").Return(&testSigner{priv: mockPrivKey})
+	mockKeychain.On("UsesExternalSigner").Return(false)
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+	mockKeychain.On("GetStoredMnemonic").Return("", nil)
+	mockModel.On("ListBuckets", mock.Anything).Return(buckets, nil)
+	textileClient.On("ManageBucketsAccess", mock.Anything, buckets, mock.Anything, domain.AdminShareRole).Return(errors.New("hub error"))
+
+	_, err := sv.RotateIdentityKey(ctx, "", "", domain.PASSWORD)
+	assert.Error(t, err)
+	mockKeychain.AssertNotCalled(t, "ImportExistingKeyPair", mock.Anything, mock.Anything)
+}
+
+func TestService_RotateIdentityKey_ShouldRestoreKey_When_RevokingOldKeyFails(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	buckets := []*model.BucketSchema{{Slug: "personal"}}
+	records := map[string][]*json.RawMessage{}
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockKeychain.On("GetSigner").Return(&testSigner{priv: mockPrivKey})
+	mockKeychain.On("UsesExternalSigner").Return(false)
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+	mockKeychain.On("GetStoredMnemonic").Return("", nil)
+	mockModel.On("ListBuckets", mock.Anything).Return(buckets, nil)
+	mockModel.On("ListSharedPublicKeys", mock.Anything).Return([]*model.SharedPublicKeySchema{}, nil)
+	textileClient.On("StoreBucketThreadKeys", mock.Anything).Return(nil)
+	mockModel.On("ExportCollections", mock.Anything).Return(records, nil)
+	mockModel.On("ImportCollections", mock.Anything, records).Return(nil)
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
+	textileClient.On("ResetIdentity", mock.Anything).Return(nil)
+	textileClient.On("ManageBucketsAccess", mock.Anything, buckets, mockPubKey, domain.NoneShareRole).Return(errors.New("hub error"))
+	textileClient.On("ManageBucketsAccess", mock.Anything, buckets, mock.Anything, domain.AdminShareRole).Return(nil)
+
+	_, err := sv.RotateIdentityKey(ctx, "", "", domain.PASSWORD)
+	assert.Error(t, err)
+	mockKeychain.AssertCalled(t, "ImportExistingKeyPair", mockPrivKey, "")
+	textileClient.AssertCalled(t, "ManageBucketsAccess", mock.Anything, buckets, mockPubKey, domain.AdminShareRole)
+	textileClient.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_RotateIdentityKey_ShouldFail_When_ExternalSignerKeepsKey(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	mockKeychain.On("UsesExternalSigner").Return(true)
+
+	_, err := sv.RotateIdentityKey(ctx, "", "", domain.PASSWORD)
+	assert.Error(t, err)
+	textileClient.AssertNotCalled(t, "ManageBucketsAccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockKeychain.AssertNotCalled(t, "ImportExistingKeyPair", mock.Anything, mock.Anything)
+}
+
+func TestService_RotateIdentityKey_ShouldStoreNewKeyInVault(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	buckets := []*model.BucketSchema{{Slug: "personal"}}
+	records := map[string][]*json.RawMessage{}
+	uuid := "user-uuid"
+	pass := "passphrase"
+
+	var newPriv crypto.PrivKey
+	storedPriv := func() crypto.PrivKey {
+		if newPriv != nil {
+			return newPriv
+		}
+		return mockPrivKey
+	}
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockVault.On("Retrieve", uuid, pass, domain.PASSWORD).Return([]vault.VaultItem{}, nil)
+	mockKeychain.On("GetSigner").Return(&testSigner{priv: mockPrivKey})
+	mockKeychain.On("UsesExternalSigner").Return(false)
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(storedPriv, nil, nil)
+	mockKeychain.On("GetStoredMnemonic").Return("", nil)
+	mockModel.On("ListBuckets", mock.Anything).Return(buckets, nil)
+	mockModel.On("ListSharedPublicKeys", mock.Anything).Return([]*model.SharedPublicKeySchema{}, nil)
+	textileClient.On("StoreBucketThreadKeys", mock.Anything).Return(nil)
+	mockModel.On("ExportCollections", mock.Anything).Return(records, nil)
+	mockModel.On("ImportCollections", mock.Anything, records).Return(nil)
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		newPriv = args.Get(0).(crypto.PrivKey)
+	})
+	textileClient.On("ResetIdentity", mock.Anything).Return(nil)
+	textileClient.On("ManageBucketsAccess", mock.Anything, buckets, mock.Anything, mock.Anything).Return(nil)
+	mockHub.On("GetTokensWithCache", mock.Anything).Return(&hub.AuthTokens{}, nil)
+	mockVault.On("Store", uuid, pass, domain.PASSWORD, mock.Anything, mock.Anything).Return(nil, nil)
+
+	rotation, err := sv.RotateIdentityKey(ctx, uuid, pass, domain.PASSWORD)
+	assert.Nil(t, err)
+	assert.NotEmpty(t, rotation.Mnemonic)
+	assert.False(t, rotation.BackupRequired)
+
+	newPrivBytes, _ := newPriv.Raw()
+	mockVault.AssertCalled(t, "Store", uuid, pass, domain.PASSWORD, mock.Anything, mock.MatchedBy(func(items []vault.VaultItem) bool {
+		return len(items) == 1 && strings.HasPrefix(items[0].Value, hex.EncodeToString(newPrivBytes))
+	}))
+}
+
+func TestService_RotateIdentityKey_ShouldStoreBucketThreadKeysBeforeSwitchingKeys(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	buckets := []*model.BucketSchema{{Slug: "personal"}}
+	records := map[string][]*json.RawMessage{}
+	keysStored := false
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockKeychain.On("GetSigner").Return(&testSigner{priv: mockPrivKey})
+	mockKeychain.On("UsesExternalSigner").Return(false)
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+	mockKeychain.On("GetStoredMnemonic").Return("", nil)
+	mockModel.On("ListBuckets", mock.Anything).Return(buckets, nil)
+	mockModel.On("ListSharedPublicKeys", mock.Anything).Return([]*model.SharedPublicKeySchema{}, nil)
+	textileClient.On("ManageBucketsAccess", mock.Anything, buckets, mock.Anything, mock.Anything).Return(nil)
+	textileClient.On("StoreBucketThreadKeys", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		keysStored = true
+	})
+	mockModel.On("ExportCollections", mock.Anything).Return(records, nil).Run(func(args mock.Arguments) {
+		// the stored keys are exported with the records to the metathread of the new key
+		assert.True(t, keysStored)
+	})
+	mockModel.On("ImportCollections", mock.Anything, records).Return(nil)
+	mockKeychain.On("ImportExistingKeyPair", mock.Anything, mock.Anything).Return(nil)
+	textileClient.On("ResetIdentity", mock.Anything).Return(nil)
+
+	_, err := sv.RotateIdentityKey(ctx, "", "", domain.PASSWORD)
+	assert.Nil(t, err)
+	textileClient.AssertCalled(t, "StoreBucketThreadKeys", mock.Anything)
+}
+
+func TestService_RotateIdentityKey_ShouldKeepKey_When_StoringBucketThreadKeysFails(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	buckets := []*model.BucketSchema{{Slug: "personal"}}
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockKeychain.On("GetSigner").Return(&testSigner{priv: mockPrivKey})
+	mockKeychain.On("UsesExternalSigner").Return(false)
+	mockKeychain.On("GetStoredKeyPairInLibP2PFormat").Return(mockPrivKey, mockPubKey, nil)
+	mockKeychain.On("GetStoredMnemonic").Return("", nil)
+	mockModel.On("ListBuckets", mock.Anything).Return(buckets, nil)
+	textileClient.On("ManageBucketsAccess", mock.Anything, buckets, mock.Anything, domain.AdminShareRole).Return(nil)
+	textileClient.On("StoreBucketThreadKeys", mock.Anything).Return(errors.New("threads error"))
+
+	_, err := sv.RotateIdentityKey(ctx, "", "", domain.PASSWORD)
+	assert.Error(t, err)
+	mockKeychain.AssertNotCalled(t, "ImportExistingKeyPair", mock.Anything, mock.Anything)
+}
+
+func TestService_RotateIdentityKey_ShouldKeepKey_When_VaultPassphraseIsWrong(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	textileClient.On("IsHealthy").Return(true)
+	mockKeychain.On("UsesExternalSigner").Return(false)
+	mockVault.On("Retrieve", "user-uuid", "wrong", domain.PASSWORD).Return(nil, errors.New("invalid passphrase"))
+
+	rotation, err := sv.RotateIdentityKey(ctx, "user-uuid", "wrong", domain.PASSWORD)
+	assert.Error(t, err)
+	assert.True(t, rotation.BackupRequired)
+	textileClient.AssertNotCalled(t, "ManageBucketsAccess", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	mockKeychain.AssertNotCalled(t, "ImportExistingKeyPair", mock.Anything, mock.Anything)
+}
+
+func TestService_ShareFilesViaPublicKey_ShouldFail_When_BucketIsBackedUpWithMetadataOnly(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetBucketSettings", mock.Anything, "personal").Return(domain.BucketSettings{BackupPolicy: domain.BackupMetadataOnly}, nil)
+
+	err := sv.ShareFilesViaPublicKey(ctx, []domain.FullPath{{Path: "/a.txt"}}, []crypto.PubKey{}, domain.ViewerShareRole, 0)
+	assert.Error(t, err)
+	textileClient.AssertNotCalled(t, "ManageShareFilesViaPublicKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_GenerateFileSharingLink_ShouldFail_When_BucketIsBackedUpWithMetadataOnly(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	textileClient.On("GetBucketSettings", mock.Anything, "photos").Return(domain.BucketSettings{BackupPolicy: domain.BackupMetadataOnly}, nil)
+
+	_, err := sv.GenerateFileSharingLink(ctx, "password", "/a.jpg", "photos", "", domain.PublicLinkOptions{})
+	assert.Error(t, err)
+	textileClient.AssertNotCalled(t, "GetBucket", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_GenerateFileSharingLink_ShouldUnpinFile_When_LinkIsNotTracked(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	fileCid, err := cid.Decode("QmYwAPJzv5CZsnA625s3Xf2nemtYgPpHdWEz79ojWnPbdG")
+	assert.Nil(t, err)
+
+	publicBucket := new(mocks.Bucket)
+	cfg.On("GetString", mock.Anything, mock.Anything).Return("https://app.space.storage")
+	textileClient.On("GetBucketSettings", mock.Anything, "photos").Return(domain.BucketSettings{BackupPolicy: domain.BackupOn}, nil)
+	textileClient.On("GetBucket", mock.Anything, "photos", mock.Anything).Return(mockBucket, nil)
+	mockBucket.On("Slug").Return("photos")
+	textileClient.On("RestoreLazyFile", mock.Anything, "photos", "/a.jpg").Return(nil)
+	mockBucket.On("GetFile", mock.Anything, "/a.jpg", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("content"))
+	})
+	textileClient.On("GetPublicShareBucket", mock.Anything).Return(publicBucket, nil)
+	var uploadedPath string
+	publicBucket.On("UploadFile", mock.Anything, mock.Anything, mock.Anything).Return(ipfspath.IpfsPath(fileCid), nil, nil).Run(func(args mock.Arguments) {
+		uploadedPath = args.String(1)
+	})
+	publicBucket.On("DeleteDirOrFile", mock.Anything, mock.Anything).Return(nil, nil)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("CreatePublicLink", mock.Anything, mock.Anything).Return(nil, errors.New("metathread unavailable"))
+
+	_, err = sv.GenerateFileSharingLink(ctx, "password", "/a.jpg", "photos", "", domain.PublicLinkOptions{})
+
+	assert.Error(t, err)
+	assert.NotEmpty(t, uploadedPath)
+	publicBucket.AssertCalled(t, "DeleteDirOrFile", mock.Anything, uploadedPath)
+}
+
+func TestService_GenerateFileSharingLink_ShouldRestoreLazyFileBeforeReadingIt(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	textileClient.On("GetBucketSettings", mock.Anything, "photos").Return(domain.BucketSettings{BackupPolicy: domain.BackupOn}, nil)
+	textileClient.On("GetBucket", mock.Anything, "photos", mock.Anything).Return(mockBucket, nil)
+	mockBucket.On("Slug").Return("photos")
+	textileClient.On("RestoreLazyFile", mock.Anything, "photos", "/a.jpg").Return(errors.New("mirror unavailable"))
+
+	_, err := sv.GenerateFileSharingLink(ctx, "password", "/a.jpg", "photos", "", domain.PublicLinkOptions{})
+	assert.Error(t, err)
+	textileClient.AssertCalled(t, "RestoreLazyFile", mock.Anything, "photos", "/a.jpg")
+	mockBucket.AssertNotCalled(t, "GetFile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_GenerateFilesSharingLink_ShouldRestoreLazyFilesBeforeReadingThem(t *testing.T) {
+	sv, getDir, tearDown := initTestService(t)
+	defer tearDown()
+	ctx := context.Background()
+
+	mockEnv.On("WorkingFolder").Return(getDir().dir)
+	textileClient.On("GetBucketSettings", mock.Anything, "photos").Return(domain.BucketSettings{BackupPolicy: domain.BackupOn}, nil)
+	textileClient.On("GetBucket", mock.Anything, "photos", mock.Anything).Return(mockBucket, nil)
+	mockBucket.On("Slug").Return("photos")
+	textileClient.On("RestoreLazyFile", mock.Anything, "photos", "/a.jpg").Return(nil)
+	textileClient.On("RestoreLazyFile", mock.Anything, "photos", "/b.jpg").Return(errors.New("mirror unavailable"))
+	mockBucket.On("GetFile", mock.Anything, "/a.jpg", mock.Anything).Return(nil)
+
+	_, err := sv.GenerateFilesSharingLink(ctx, "password", []string{"/a.jpg", "/b.jpg"}, "photos", "", domain.PublicLinkOptions{})
+	assert.Error(t, err)
+	mockBucket.AssertNotCalled(t, "GetFile", mock.Anything, "/b.jpg", mock.Anything)
+}
+
 func TestService_UnshareFilesViaPublicKey_Fails_IFTextileIsNotInitialized(t *testing.T) {
 	sv, _, tearDown := initTestService(t)
 	defer tearDown()
@@ -933,6 +1522,99 @@ func TestService_HandleSharedFilesInvitation_Rejects_Correctly(t *testing.T) {
 	assert.NoError(t, err, "HandleSharedFilesInvitation failed")
 }
 
+func TestService_HandleSharedFilesInvitation_ShouldFail_When_InvitationExpired(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	invitationId := "random-invitation-uuid"
+	expiredInvitation := domain.Invitation{
+		InviterPublicKey: "b7a3c12dc0c8c748ab07525b701122b88bd78f600c76342d27f25e5f92444cde",
+		Status:           domain.PENDING,
+		ExpiresAt:        time.Now().Add(-time.Hour).Unix(),
+	}
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetMailAsNotifications", mock.Anything, invitationId, 1).
+		Return([]*domain.Notification{
+			{
+				NotificationType: domain.INVITATION,
+				InvitationValue:  expiredInvitation,
+			},
+		}, nil)
+
+	// execute
+	err := sv.HandleSharedFilesInvitation(ctx, invitationId, true)
+
+	// validate
+	assert.EqualError(t, err, "invitation has expired")
+	textileClient.AssertNotCalled(t, "AcceptSharedFilesInvitation", mock.Anything, mock.Anything)
+}
+
+func TestService_CancelInvitation_Works(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	invitation := &model.SentInvitationSchema{
+		SentFileInvitationSchema: model.SentFileInvitationSchema{
+			InvitationId:     "random-invitation-uuid",
+			InviteePublicKey: "b7a3c12dc0c8c748ab07525b701122b88bd78f600c76342d27f25e5f92444cde",
+			Status:           domain.PENDING,
+		},
+	}
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindSentInvitation", mock.Anything, invitation.InvitationId).Return(invitation, nil)
+	textileClient.On(
+		"ManageShareFilesViaPublicKey",
+		mock.Anything,
+		invitation.ItemPaths,
+		mock.Anything,
+		invitation.Keys,
+		domain.NoneShareRole,
+	).Return(nil)
+	mockKeychain.On("GetStoredPublicKey").Return(mockPubKey, nil)
+	textileClient.On("SendMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	mockModel.On("UpdateSentInvitation", mock.Anything, mock.Anything).Return(invitation, nil)
+
+	// execute
+	err := sv.CancelInvitation(ctx, invitation.InvitationId)
+
+	// validate
+	assert.NoError(t, err)
+	assert.Equal(t, domain.CANCELLED, invitation.Status)
+	textileClient.AssertExpectations(t)
+}
+
+func TestService_CancelInvitation_ShouldFail_When_InvitationIsNotPending(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	invitation := &model.SentInvitationSchema{
+		SentFileInvitationSchema: model.SentFileInvitationSchema{
+			InvitationId: "random-invitation-uuid",
+			Status:       domain.ACCEPTED,
+		},
+	}
+
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindSentInvitation", mock.Anything, invitation.InvitationId).Return(invitation, nil)
+
+	// execute
+	err := sv.CancelInvitation(ctx, invitation.InvitationId)
+
+	// validate
+	assert.EqualError(t, err, "only pending invitations can be cancelled")
+	textileClient.AssertNotCalled(t, "ManageShareFilesViaPublicKey", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
 func TestService_OpenSharedFile_ShouldFail_When_PasswordCannotBeFetched(t *testing.T) {
 	sv, _, tearDown := initTestService(t)
 	defer tearDown()
@@ -968,6 +1650,9 @@ func TestService_OpenSharedFile_Should_AddOpenedFileToSharedWithMeList(t *testin
 	textileClient.On("IsHealthy").Return(true)
 	textileClient.On("GetPublicReceivedFile", mock.Anything, testHash, true).
 		Return(&domain.SharedDirEntry{}, testPassword, nil)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).
+		Return(nil, model.ErrPublicLinkNotFound)
 	textileClient.On("DownloadPublicItem", mock.Anything, mock.Anything).
 		Return(encryptString(expectedFileContent, testPassword), nil)
 	textileClient.On("AcceptSharedFileLink", mock.Anything, testHash, testPassword, testFilename, fmt.Sprintf("%d", len(expectedFileContent))).
@@ -983,6 +1668,189 @@ func TestService_OpenSharedFile_Should_AddOpenedFileToSharedWithMeList(t *testin
 	assert.Equal(t, expectedFileContent, string(actualFileContent))
 }
 
+func TestService_OpenSharedFile_Should_UseKeyEmbeddedInLink(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	testFilename := "letter.txt"
+	expectedFileContent := "This is a love letter to the dweb. Be great"
+	testHash := "bafkreidhby4wyrc3cr6hfsg54x6nequylzdhn254nep7z3g7adfkyddlcy"
+	testKey := "c3VwZXItc2VjcmV0LWtleQ"
+	testLink := "https://app.space.storage/files/share?fname=" + testFilename + "&hash=" + testHash + "#key=" + testKey
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).
+		Return(nil, model.ErrPublicLinkNotFound)
+	textileClient.On("DownloadPublicItem", mock.Anything, mock.Anything).
+		Return(encryptString(expectedFileContent, testKey), nil)
+	textileClient.On("AcceptSharedFileLink", mock.Anything, testHash, testKey, testFilename, fmt.Sprintf("%d", len(expectedFileContent))).
+		Return(&domain.SharedDirEntry{}, nil)
+
+	// test (no password nor filename, both are taken from the link)
+	result, err := sv.OpenSharedFile(ctx, testLink, "", "")
+
+	// validate
+	assert.NoError(t, err, "OpenSharedFile should not fail")
+	actualFileContent, err := ioutil.ReadFile(result.Location)
+	assert.NoError(t, err, "Failed to read decrypted file")
+	assert.Equal(t, expectedFileContent, string(actualFileContent))
+	textileClient.AssertNotCalled(t, "GetPublicReceivedFile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_OpenSharedFile_ShouldFail_When_PublicLinkIsRevoked(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	testFilename := "letter.txt"
+	testHash := "bafkreidhby4wyrc3cr6hfsg54x6nequylzdhn254nep7z3g7adfkyddlcy"
+	testPassword := "super-secret"
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).
+		Return(&model.PublicLinkSchema{FileCid: testHash, Revoked: true}, nil)
+
+	// test
+	_, err := sv.OpenSharedFile(ctx, testHash, testPassword, testFilename)
+
+	// validate
+	assert.Error(t, err, "OpenSharedFile should fail for revoked links")
+	textileClient.AssertNotCalled(t, "DownloadPublicItem", mock.Anything, mock.Anything)
+}
+
+func TestService_OpenSharedFile_Should_CountDownloadsOfOwnPublicLinks(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	testFilename := "letter.txt"
+	expectedFileContent := "This is a love letter to the dweb. Be great"
+	testHash := "bafkreidhby4wyrc3cr6hfsg54x6nequylzdhn254nep7z3g7adfkyddlcy"
+	testPassword := "super-secret"
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).
+		Return(&model.PublicLinkSchema{FileCid: testHash, MaxDownloads: 2, DownloadCount: 1}, nil)
+	textileClient.On("DownloadPublicItem", mock.Anything, mock.Anything).
+		Return(encryptString(expectedFileContent, testPassword), nil)
+	textileClient.On("CountPublicLinkDownload", mock.Anything, testHash).Return(nil)
+	textileClient.On("AcceptSharedFileLink", mock.Anything, testHash, testPassword, testFilename, fmt.Sprintf("%d", len(expectedFileContent))).
+		Return(&domain.SharedDirEntry{}, nil)
+
+	// test
+	_, err := sv.OpenSharedFile(ctx, testHash, testPassword, testFilename)
+
+	// validate
+	assert.NoError(t, err, "OpenSharedFile should not fail")
+	textileClient.AssertCalled(t, "CountPublicLinkDownload", mock.Anything, testHash)
+	textileClient.AssertNotCalled(t, "SendMessage", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestService_OpenSharedFile_Should_ReportDownloadToLinkOwner(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	testFilename := "letter.txt"
+	expectedFileContent := "This is a love letter to the dweb. Be great"
+	testHash := "bafkreidhby4wyrc3cr6hfsg54x6nequylzdhn254nep7z3g7adfkyddlcy"
+	testKey := "c3VwZXItc2VjcmV0LWtleQ"
+	ownerPub, err := mockPubKey.Raw()
+	assert.NoError(t, err)
+	testLink := "https://app.space.storage/files/share?fname=" + testFilename + "&hash=" + testHash +
+		"&owner=" + hex.EncodeToString(ownerPub) + "#key=" + testKey
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).
+		Return(nil, model.ErrPublicLinkNotFound)
+	textileClient.On("DownloadPublicItem", mock.Anything, mock.Anything).
+		Return(encryptString(expectedFileContent, testKey), nil)
+	textileClient.On("SendMessage", mock.Anything, mock.Anything, mock.Anything).Return(nil, nil)
+	textileClient.On("AcceptSharedFileLink", mock.Anything, testHash, testKey, testFilename, fmt.Sprintf("%d", len(expectedFileContent))).
+		Return(&domain.SharedDirEntry{}, nil)
+
+	// test
+	_, err = sv.OpenSharedFile(ctx, testLink, "", "")
+
+	// validate
+	assert.NoError(t, err, "OpenSharedFile should not fail")
+	textileClient.AssertNotCalled(t, "CountPublicLinkDownload", mock.Anything, mock.Anything)
+	textileClient.AssertCalled(t, "SendMessage", mock.Anything, mock.MatchedBy(func(pk crypto.PubKey) bool {
+		return pk.Equals(mockPubKey)
+	}), mock.MatchedBy(func(body []byte) bool {
+		message := domain.MessageBody{}
+		download := domain.PublicLinkDownload{}
+		return json.Unmarshal(body, &message) == nil && message.Type == domain.PUBLIC_LINK_DOWNLOAD &&
+			json.Unmarshal(message.Body, &download) == nil && download.FileCid == testHash
+	}))
+}
+
+func TestService_OpenSharedFile_ShouldNotCountFailedDownloads(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	testHash := "bafkreidhby4wyrc3cr6hfsg54x6nequylzdhn254nep7z3g7adfkyddlcy"
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).
+		Return(&model.PublicLinkSchema{FileCid: testHash, MaxDownloads: 2, DownloadCount: 1}, nil)
+	textileClient.On("DownloadPublicItem", mock.Anything, mock.Anything).
+		Return(nil, errors.New("gateway unavailable"))
+
+	// test
+	_, err := sv.OpenSharedFile(ctx, testHash, "super-secret", "letter.txt")
+
+	// validate
+	assert.Error(t, err)
+	textileClient.AssertNotCalled(t, "CountPublicLinkDownload", mock.Anything, mock.Anything)
+}
+
+func TestService_OpenSharedFile_ShouldFail_When_PublicLinkReachedMaxDownloads(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	testHash := "bafkreidhby4wyrc3cr6hfsg54x6nequylzdhn254nep7z3g7adfkyddlcy"
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).
+		Return(&model.PublicLinkSchema{FileCid: testHash, MaxDownloads: 2, DownloadCount: 2}, nil)
+
+	// test
+	_, err := sv.OpenSharedFile(ctx, testHash, "super-secret", "letter.txt")
+
+	// validate
+	assert.Error(t, err, "OpenSharedFile should fail for exhausted links")
+	textileClient.AssertNotCalled(t, "DownloadPublicItem", mock.Anything, mock.Anything)
+}
+
+func TestService_OpenSharedFile_ShouldFail_When_PublicLinkLookupFails(t *testing.T) {
+	sv, _, tearDown := initTestService(t)
+	defer tearDown()
+
+	// setup
+	ctx := context.Background()
+	testHash := "bafkreidhby4wyrc3cr6hfsg54x6nequylzdhn254nep7z3g7adfkyddlcy"
+	textileClient.On("IsHealthy").Return(true)
+	textileClient.On("GetModel").Return(mockModel)
+	mockModel.On("FindPublicLinkByCid", mock.Anything, testHash).Return(nil, errors.New("threads unavailable"))
+
+	// test
+	_, err := sv.OpenSharedFile(ctx, testHash, "super-secret", "letter.txt")
+
+	// validate
+	assert.Error(t, err)
+	textileClient.AssertNotCalled(t, "DownloadPublicItem", mock.Anything, mock.Anything)
+}
+
 func encryptString(content, password string) io.ReadCloser {
 	reader, err := dcrypto.NewEncrypterWithPassword(strings.NewReader(content), []byte(password))
 	if err != nil {
@@ -990,3 +1858,26 @@ func encryptString(content, password string) io.ReadCloser {
 	}
 	return ioutil.NopCloser(reader)
 }
+
+// signs with the key as an external signer would, counting the signatures
+type testSigner struct {
+	priv   crypto.PrivKey
+	signed int
+}
+
+func (s *testSigner) PublicKey(ctx context.Context) (crypto.PubKey, error) {
+	return s.priv.GetPublic(), nil
+}
+
+func (s *testSigner) Sign(ctx context.Context, data []byte) ([]byte, error) {
+	s.signed++
+	return s.priv.Sign(data)
+}
+
+func (s *testSigner) Decrypt(ctx context.Context, data []byte) ([]byte, error) {
+	return nil, errors.New("not supported by the test signer")
+}
+
+func (s *testSigner) DeriveKey(ctx context.Context, salt []byte, size int) ([]byte, error) {
+	return nil, errors.New("not supported by the test signer")
+}
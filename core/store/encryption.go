@@ -0,0 +1,291 @@
+package store
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"os"
+
+	"github.com/FleekHQ/space-daemon/log"
+	badger "github.com/dgraph-io/badger"
+)
+
+// Holds the id of the key the values are encrypted with. It is missing when values are stored in plaintext.
+const encryptionMarkerKey = "__store_encryption"
+
+// Prefix of encrypted values, followed by the key id, the nonce and the sealed value
+var envelopeMagic = []byte("SPE1")
+
+const keyIDSize = 4
+
+const (
+	migratingDirSuffix = ".migrating"
+	previousDirSuffix  = ".previous"
+)
+
+var (
+	ErrStoreEncrypted        = errors.New("the store is encrypted but no encryption keys were provided")
+	ErrEncryptionKeyMissing  = errors.New("no encryption key was found for a value of the store")
+	ErrInvalidEncryptedValue = errors.New("a value of the store is not encrypted with the store key")
+)
+
+// EncryptionKeyProvider gives the keys used to encrypt the values of the store at rest.
+// Keys are not derived from the store so they can be kept in a safer place, like the OS keyring.
+type EncryptionKeyProvider interface {
+	// The first key encrypts values. The rest are previous keys, only used to read values written before a rotation.
+	GetStoreEncryptionKeys() ([][]byte, error)
+	// Called once every value is encrypted with the first key
+	DropPreviousStoreEncryptionKeys() error
+}
+
+type storeCipher struct {
+	id   []byte
+	aead cipher.AEAD
+}
+
+func newStoreCipher(key []byte) (*storeCipher, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	sum := sha256.Sum256(key)
+
+	return &storeCipher{
+		id:   sum[:keyIDSize],
+		aead: aead,
+	}, nil
+}
+
+func (c *storeCipher) seal(value []byte) ([]byte, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	header := append(append(append([]byte{}, envelopeMagic...), c.id...), nonce...)
+
+	return c.aead.Seal(header, nonce, value, header), nil
+}
+
+// returns false if the value was not sealed by this cipher
+func (c *storeCipher) open(value []byte) ([]byte, bool) {
+	headerSize := len(envelopeMagic) + keyIDSize + c.aead.NonceSize()
+	if len(value) < headerSize || !bytes.HasPrefix(value, envelopeMagic) {
+		return nil, false
+	}
+
+	if !bytes.Equal(value[len(envelopeMagic):len(envelopeMagic)+keyIDSize], c.id) {
+		return nil, false
+	}
+
+	header := value[:headerSize]
+	nonce := header[len(envelopeMagic)+keyIDSize:]
+	plain, err := c.aead.Open(nil, nonce, value[headerSize:], header)
+	if err != nil {
+		return nil, false
+	}
+
+	return plain, true
+}
+
+// Makes the values on disk match the configured encryption, which covers:
+// encrypting a plaintext store, re-encrypting after a key rotation and decrypting when encryption is turned off.
+func (store *store) setupEncryption(dbPath string) error {
+	marker, err := store.getRaw([]byte(encryptionMarkerKey))
+	if err != nil && err != badger.ErrKeyNotFound {
+		return err
+	}
+
+	if !store.encrypt && marker == nil {
+		return nil
+	}
+
+	if store.keys == nil {
+		return ErrStoreEncrypted
+	}
+
+	keys, err := store.keys.GetStoreEncryptionKeys()
+	if err != nil {
+		return err
+	}
+
+	ciphers := make([]*storeCipher, 0, len(keys))
+	for _, key := range keys {
+		c, err := newStoreCipher(key)
+		if err != nil {
+			return err
+		}
+		ciphers = append(ciphers, c)
+	}
+
+	var target *storeCipher
+	if store.encrypt {
+		if len(ciphers) == 0 {
+			return ErrEncryptionKeyMissing
+		}
+		target = ciphers[0]
+	}
+
+	if target != nil && len(ciphers) == 1 && hex.EncodeToString(target.id) == string(marker) {
+		store.cipher = target
+		return nil
+	}
+
+	log.Info("Migrating store encryption")
+	if err := store.migrateEncryption(dbPath, ciphers, target); err != nil {
+		return err
+	}
+	store.cipher = target
+
+	if target != nil && len(ciphers) > 1 {
+		return store.keys.DropPreviousStoreEncryptionKeys()
+	}
+
+	return nil
+}
+
+// Copies the values into a new db and replaces the current one with it.
+// Rewriting values in place would leave the previous versions in the value log of the db.
+func (store *store) migrateEncryption(dbPath string, ciphers []*storeCipher, target *storeCipher) error {
+	migratingPath := dbPath + migratingDirSuffix
+	if err := os.RemoveAll(migratingPath); err != nil {
+		return err
+	}
+
+	migrated, err := openBadger(migratingPath)
+	if err != nil {
+		return err
+	}
+
+	if err := copyValues(store.db, migrated, ciphers, target); err != nil {
+		migrated.Close()
+		os.RemoveAll(migratingPath)
+		return err
+	}
+
+	if err := migrated.Close(); err != nil {
+		return err
+	}
+
+	if err := store.db.Close(); err != nil {
+		return err
+	}
+	store.isOpen = false
+
+	previousPath := dbPath + previousDirSuffix
+	if err := os.Rename(dbPath, previousPath); err != nil {
+		return err
+	}
+
+	if err := os.Rename(migratingPath, dbPath); err != nil {
+		return err
+	}
+
+	if err := os.RemoveAll(previousPath); err != nil {
+		log.Error("Failed to remove the store before the migration", err)
+	}
+
+	db, err := openBadger(dbPath)
+	if err != nil {
+		return err
+	}
+
+	store.db = db
+	store.isOpen = true
+
+	return nil
+}
+
+// Cleans up after a migration that stopped before replacing the db, which is then migrated again
+func recoverInterruptedMigration(dbPath string) error {
+	previousPath := dbPath + previousDirSuffix
+	if _, err := os.Stat(previousPath); err == nil {
+		if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+			if err := os.Rename(previousPath, dbPath); err != nil {
+				return err
+			}
+		} else if err := os.RemoveAll(previousPath); err != nil {
+			return err
+		}
+	}
+
+	return os.RemoveAll(dbPath + migratingDirSuffix)
+}
+
+// Decrypts every value with the given ciphers and writes it to dst sealed with target, or in plaintext if target is nil.
+// Values not sealed by any of the ciphers are taken as plaintext.
+func copyValues(src, dst *badger.DB, ciphers []*storeCipher, target *storeCipher) error {
+	wb := dst.NewWriteBatch()
+	defer wb.Cancel()
+
+	err := src.View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.DefaultIteratorOptions)
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			item := it.Item()
+			key := item.KeyCopy(nil)
+			if string(key) == encryptionMarkerKey {
+				continue
+			}
+
+			value, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+
+			plain, err := openWithAny(ciphers, value)
+			if err != nil {
+				return err
+			}
+
+			if target != nil {
+				if plain, err = target.seal(plain); err != nil {
+					return err
+				}
+			}
+
+			if err := wb.Set(key, plain); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if target != nil {
+		if err := wb.Set([]byte(encryptionMarkerKey), []byte(hex.EncodeToString(target.id))); err != nil {
+			return err
+		}
+	}
+
+	return wb.Flush()
+}
+
+func openWithAny(ciphers []*storeCipher, value []byte) ([]byte, error) {
+	for _, c := range ciphers {
+		if plain, ok := c.open(value); ok {
+			return plain, nil
+		}
+	}
+
+	// an envelope whose key is not known anymore cannot be read
+	if len(ciphers) > 0 && bytes.HasPrefix(value, envelopeMagic) && len(value) > len(envelopeMagic)+keyIDSize {
+		return nil, ErrEncryptionKeyMissing
+	}
+
+	return value, nil
+}
@@ -0,0 +1,219 @@
+package store
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/log"
+	badger "github.com/dgraph-io/badger"
+)
+
+// Folder inside the store root where backups are written before migrating,
+// in a subfolder named after the profile when the store has one
+const BackupDirName = "backups"
+
+const schemaVersionKey = "__schema_version"
+
+// Number of store backups kept per profile, older ones are removed after a migration
+const maxStoreBackups = 3
+
+const storeBackupPrefix = "store-v"
+
+var ErrStoreVersionTooNew = errors.New("the store was written by a newer version of the daemon")
+
+// Migration upgrades the data of the store to Version.
+// Migrations run in order of version, each at most once, and should leave the store usable if run again.
+type Migration struct {
+	Version int
+	Name    string
+	Up      func(st Store) error
+}
+
+// Migrations are the migrations of the store, add new ones at the end with the next version
+var Migrations = []Migration{
+	{
+		Version: 1,
+		Name:    "record schema version",
+		Up:      func(st Store) error { return nil },
+	},
+}
+
+// Sets the migrations run on open, used in tests
+func WithMigrations(migrations []Migration) Option {
+	return func(o *storeOptions) {
+		o.migrations = migrations
+	}
+}
+
+// SchemaVersion returns the version of the last migration run on the store, 0 if none was run
+func (store *store) SchemaVersion() (int, error) {
+	val, err := store.Get([]byte(schemaVersionKey))
+	if err == badger.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(string(val))
+}
+
+// Runs the pending migrations. A backup is taken first and loaded back if a migration fails.
+func (store *store) runMigrations(rootDir string) error {
+	migrations := append([]Migration{}, store.migrations...)
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	version, err := store.SchemaVersion()
+	if err != nil {
+		return err
+	}
+
+	latest := 0
+	if len(migrations) > 0 {
+		latest = migrations[len(migrations)-1].Version
+	}
+
+	if version > latest {
+		return ErrStoreVersionTooNew
+	}
+
+	if version == latest {
+		return nil
+	}
+
+	backupPath, err := store.backup(rootDir, version)
+	if err != nil {
+		return err
+	}
+
+	for _, m := range migrations {
+		if m.Version <= version {
+			continue
+		}
+
+		log.Info(fmt.Sprintf("Migrating store to version %d: %s", m.Version, m.Name))
+		err := m.Up(store)
+		if err == nil {
+			err = store.SetString(schemaVersionKey, strconv.Itoa(m.Version))
+		}
+
+		if err != nil {
+			if backupPath != "" {
+				if restoreErr := store.restoreBackup(backupPath); restoreErr != nil {
+					log.Error("Failed to restore the store backup "+backupPath, restoreErr)
+				}
+			}
+
+			return fmt.Errorf("store migration %d (%s) failed: %s", m.Version, m.Name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Writes a backup of the store, returns an empty path if the store is empty.
+// The backup is only readable by the user and is sealed with the store key when encryption is on,
+// since the keys of the store are not encrypted.
+func (store *store) backup(rootDir string, version int) (string, error) {
+	keys, err := store.KeysWithPrefix("")
+	if err != nil {
+		return "", err
+	}
+
+	if len(keys) == 0 {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+	if _, err := store.db.Backup(&buf, 0); err != nil {
+		return "", err
+	}
+
+	data := buf.Bytes()
+	if store.cipher != nil {
+		if data, err = store.cipher.seal(data); err != nil {
+			return "", err
+		}
+	}
+
+	backupDir := filepath.Join(rootDir, BackupDirName, store.profile)
+	if err := os.MkdirAll(backupDir, 0700); err != nil {
+		return "", err
+	}
+
+	backupPath := filepath.Join(backupDir, fmt.Sprintf("%s%d-%d.bak", storeBackupPrefix, version, time.Now().Unix()))
+	f, err := os.OpenFile(backupPath, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	if _, err := f.Write(data); err != nil {
+		os.Remove(backupPath)
+		return "", err
+	}
+
+	if err := f.Sync(); err != nil {
+		return "", err
+	}
+
+	log.Info("Backed up store to " + backupPath)
+	pruneBackups(backupDir)
+
+	return backupPath, nil
+}
+
+func (store *store) restoreBackup(backupPath string) error {
+	data, err := ioutil.ReadFile(backupPath)
+	if err != nil {
+		return err
+	}
+
+	if store.cipher != nil {
+		plain, ok := store.cipher.open(data)
+		if !ok {
+			return ErrInvalidEncryptedValue
+		}
+		data = plain
+	}
+
+	if err := store.db.DropAll(); err != nil {
+		return err
+	}
+
+	return store.db.Load(bytes.NewReader(data), 256)
+}
+
+func pruneBackups(backupDir string) {
+	files, err := ioutil.ReadDir(backupDir)
+	if err != nil {
+		return
+	}
+
+	backups := []os.FileInfo{}
+	for _, f := range files {
+		if strings.HasPrefix(f.Name(), storeBackupPrefix) {
+			backups = append(backups, f)
+		}
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].ModTime().After(backups[j].ModTime())
+	})
+
+	for i := maxStoreBackups; i < len(backups); i++ {
+		if err := os.Remove(filepath.Join(backupDir, backups[i].Name())); err != nil {
+			log.Error("Failed to remove old store backup", err)
+		}
+	}
+}
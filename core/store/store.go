@@ -1,9 +1,11 @@
 package store
 
 import (
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
 	"runtime"
 	s "strings"
 
@@ -23,6 +25,14 @@ type store struct {
 	rootDir string
 	db      *badger.DB
 	isOpen  bool
+
+	// values are encrypted at rest when set
+	encrypt bool
+	keys    EncryptionKeyProvider
+	cipher  *storeCipher
+
+	migrations []Migration
+	profile    string
 }
 
 var _ = core.Component(store{})
@@ -40,11 +50,16 @@ type Store interface {
 }
 
 type storeOptions struct {
-	rootDir string
+	rootDir    string
+	encrypt    bool
+	keys       EncryptionKeyProvider
+	migrations []Migration
+	profile    string
 }
 
 var defaultStoreOptions = storeOptions{
-	rootDir: DefaultRootDir,
+	rootDir:    DefaultRootDir,
+	migrations: Migrations,
 }
 
 // Idea taken from here https://medium.com/soon-london/variadic-configuration-functions-in-go-8cef1c97ce99
@@ -60,8 +75,12 @@ func New(opts ...Option) *store {
 	log.Info(fmt.Sprintf("using path %s for store", o.rootDir))
 
 	store := &store{
-		rootDir: o.rootDir,
-		isOpen:  false,
+		rootDir:    o.rootDir,
+		isOpen:     false,
+		encrypt:    o.encrypt,
+		keys:       o.keys,
+		migrations: o.migrations,
+		profile:    o.profile,
 	}
 
 	return store
@@ -78,16 +97,16 @@ func (store *store) Open() error {
 		return err
 	}
 
+	if err := recoverInterruptedMigration(rootDir); err != nil {
+		return err
+	}
+
 	// We create the directory in case it doesn't exist yet
 	if err := os.MkdirAll(rootDir, os.ModePerm); err != nil {
 		return err
 	}
 
-	db, err := badger.Open(
-		badger.DefaultOptions(rootDir).
-			WithEventLogging(false).
-			WithTruncate(runtime.GOOS == "windows"),
-	)
+	db, err := openBadger(rootDir)
 	if err != nil {
 		return err
 	}
@@ -95,9 +114,27 @@ func (store *store) Open() error {
 	store.db = db
 	store.isOpen = true
 
+	if err := store.setupEncryption(rootDir); err != nil {
+		store.Close()
+		return err
+	}
+
+	if err := store.runMigrations(filepath.Dir(rootDir)); err != nil {
+		store.Close()
+		return err
+	}
+
 	return nil
 }
 
+func openBadger(dir string) (*badger.DB, error) {
+	return badger.Open(
+		badger.DefaultOptions(dir).
+			WithEventLogging(false).
+			WithTruncate(runtime.GOOS == "windows"),
+	)
+}
+
 func (store store) IsOpen() bool {
 	return store.isOpen
 }
@@ -113,6 +150,7 @@ func (store *store) Close() error {
 	}
 
 	store.isOpen = false
+	store.cipher = nil
 
 	return nil
 }
@@ -141,6 +179,24 @@ func WithPath(path string) Option {
 	}
 }
 
+// Sets the profile owning the store, its backups are kept and pruned in their own folder
+func WithProfile(name string) Option {
+	return func(o *storeOptions) {
+		o.profile = name
+	}
+}
+
+// Encrypts the values of the store with the keys given by the provider.
+// If encrypt is false but the store was encrypted before, the keys are used to decrypt it back on open.
+// Keys are left in plaintext so they can still be looked up by prefix, which leaves the local paths
+// and bucket names some keys include readable, e.g. the paths of opened, synced and restored files.
+func WithEncryption(keys EncryptionKeyProvider, encrypt bool) Option {
+	return func(o *storeOptions) {
+		o.keys = keys
+		o.encrypt = encrypt
+	}
+}
+
 func (store *store) getDb() (*badger.DB, error) {
 	if store.isOpen == false {
 		return nil, errors.New("Database has not been opened yet")
@@ -151,6 +207,18 @@ func (store *store) getDb() (*badger.DB, error) {
 
 // Stores a key/value pair in the db.
 func (store *store) Set(key []byte, value []byte) error {
+	if store.cipher != nil {
+		sealed, err := store.cipher.seal(value)
+		if err != nil {
+			return err
+		}
+		value = sealed
+	}
+
+	return store.setRaw(key, value)
+}
+
+func (store *store) setRaw(key []byte, value []byte) error {
 	db, err := store.getDb()
 
 	if err != nil {
@@ -196,6 +264,20 @@ func (store *store) SetString(key string, value string) error {
 
 // Given a key, retrieves the stored value. If the key is not found returns ErrKeyNotFound.
 func (store *store) Get(key []byte) ([]byte, error) {
+	value, err := store.getRaw(key)
+	if err != nil || store.cipher == nil {
+		return value, err
+	}
+
+	plain, ok := store.cipher.open(value)
+	if !ok {
+		return nil, ErrInvalidEncryptedValue
+	}
+
+	return plain, nil
+}
+
+func (store *store) getRaw(key []byte) ([]byte, error) {
 	db, err := store.getDb()
 
 	if err != nil {
@@ -248,6 +330,9 @@ func (store store) KeysWithPrefix(prefix string) ([]string, error) {
 		for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
 			item := it.Item()
 			k := item.Key()
+			if isInternalKey(string(k)) {
+				continue
+			}
 			keys = append(keys, string(k))
 		}
 		return nil
@@ -256,6 +341,11 @@ func (store store) KeysWithPrefix(prefix string) ([]string, error) {
 	return keys, nil
 }
 
+// keys used by the store itself, hidden from the callers
+func isInternalKey(key string) bool {
+	return key == encryptionMarkerKey || key == schemaVersionKey
+}
+
 func (store store) Shutdown() error {
 	return store.Close()
 }
@@ -266,5 +356,14 @@ func (store store) DropAll() error {
 		return err
 	}
 
-	return db.DropAll()
+	if err := db.DropAll(); err != nil {
+		return err
+	}
+
+	// new values keep being encrypted, so the marker has to be kept too
+	if store.cipher != nil {
+		return store.setRaw([]byte(encryptionMarkerKey), []byte(hex.EncodeToString(store.cipher.id)))
+	}
+
+	return nil
 }
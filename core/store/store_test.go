@@ -0,0 +1,270 @@
+package store_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/store"
+	"github.com/stretchr/testify/assert"
+)
+
+type testKeys struct {
+	keys [][]byte
+}
+
+func (k *testKeys) GetStoreEncryptionKeys() ([][]byte, error) {
+	return k.keys, nil
+}
+
+func (k *testKeys) DropPreviousStoreEncryptionKeys() error {
+	k.keys = k.keys[:1]
+	return nil
+}
+
+func newTestKey(b byte) []byte {
+	return bytes.Repeat([]byte{b}, 32)
+}
+
+func initTestStore(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "space-store")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	return dir
+}
+
+// returns true if the value is found in plaintext in the db files
+func valueOnDisk(t *testing.T, dir string, value []byte) bool {
+	found := false
+	filepath.Walk(filepath.Join(dir, store.BadgerFileName), func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, _ := ioutil.ReadFile(path)
+		found = found || bytes.Contains(data, value)
+		return nil
+	})
+
+	return found
+}
+
+func reopen(t *testing.T, dir string, opts ...store.Option) store.Store {
+	st := store.New(append([]store.Option{store.WithPath(dir)}, opts...)...)
+	assert.Nil(t, st.Open())
+	t.Cleanup(func() {
+		st.Close()
+	})
+
+	return st
+}
+
+func TestStore_EncryptsExistingStore(t *testing.T) {
+	dir := initTestStore(t)
+	secret := []byte("plaintext-hub-token")
+
+	st := reopen(t, dir)
+	assert.Nil(t, st.Set([]byte("token"), secret))
+	assert.Nil(t, st.Close())
+
+	keys := &testKeys{keys: [][]byte{newTestKey(1)}}
+	st = reopen(t, dir, store.WithEncryption(keys, true))
+
+	val, err := st.Get([]byte("token"))
+	assert.Nil(t, err)
+	assert.Equal(t, secret, val)
+
+	assert.Nil(t, st.Set([]byte("other"), []byte("other-secret-value")))
+	keysWithPrefix, err := st.KeysWithPrefix("")
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"token", "other"}, keysWithPrefix)
+	assert.Nil(t, st.Close())
+
+	// values written before the migration are not left behind either
+	assert.False(t, valueOnDisk(t, dir, secret))
+	assert.False(t, valueOnDisk(t, dir, []byte("other-secret-value")))
+
+	// opening without the keys must not return encrypted values
+	st = store.New(store.WithPath(dir))
+	assert.Equal(t, store.ErrStoreEncrypted, st.Open())
+}
+
+func TestStore_RotatesKey(t *testing.T) {
+	dir := initTestStore(t)
+
+	keys := &testKeys{keys: [][]byte{newTestKey(1)}}
+	st := reopen(t, dir, store.WithEncryption(keys, true))
+	assert.Nil(t, st.Set([]byte("token"), []byte("secret")))
+	assert.Nil(t, st.Close())
+
+	keys.keys = [][]byte{newTestKey(2), newTestKey(1)}
+	st = reopen(t, dir, store.WithEncryption(keys, true))
+	assert.Len(t, keys.keys, 1)
+	assert.Nil(t, st.Close())
+
+	// the old key is not needed anymore
+	st = reopen(t, dir, store.WithEncryption(&testKeys{keys: [][]byte{newTestKey(2)}}, true))
+	val, err := st.Get([]byte("token"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), val)
+}
+
+func TestStore_DecryptsWhenDisabled(t *testing.T) {
+	dir := initTestStore(t)
+
+	keys := &testKeys{keys: [][]byte{newTestKey(1)}}
+	st := reopen(t, dir, store.WithEncryption(keys, true))
+	assert.Nil(t, st.Set([]byte("token"), []byte("secret")))
+	assert.Nil(t, st.Close())
+
+	st = reopen(t, dir, store.WithEncryption(keys, false))
+	assert.Nil(t, st.Close())
+
+	st = reopen(t, dir)
+	val, err := st.Get([]byte("token"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("secret"), val)
+}
+
+func TestStore_FailsWithWrongKey(t *testing.T) {
+	dir := initTestStore(t)
+
+	st := reopen(t, dir, store.WithEncryption(&testKeys{keys: [][]byte{newTestKey(1)}}, true))
+	assert.Nil(t, st.Set([]byte("token"), []byte("secret")))
+	assert.Nil(t, st.Close())
+
+	st = store.New(store.WithPath(dir), store.WithEncryption(&testKeys{keys: [][]byte{newTestKey(2)}}, true))
+	assert.Equal(t, store.ErrEncryptionKeyMissing, st.Open())
+}
+
+func TestStore_RunsMigrationsInOrder(t *testing.T) {
+	dir := initTestStore(t)
+
+	st := reopen(t, dir, store.WithMigrations(store.Migrations))
+	assert.Nil(t, st.Set([]byte("openFiles#a"), []byte("1")))
+	assert.Nil(t, st.Close())
+
+	ran := []int{}
+	migrations := append([]store.Migration{
+		{Version: 3, Name: "third", Up: func(st store.Store) error {
+			ran = append(ran, 3)
+			return nil
+		}},
+		{Version: 2, Name: "second", Up: func(st store.Store) error {
+			ran = append(ran, 2)
+			return st.Set([]byte("openFiles#b"), []byte("2"))
+		}},
+	}, store.Migrations...)
+
+	st2 := store.New(store.WithPath(dir), store.WithMigrations(migrations))
+	assert.Nil(t, st2.Open())
+	assert.Equal(t, []int{2, 3}, ran)
+
+	version, err := st2.SchemaVersion()
+	assert.Nil(t, err)
+	assert.Equal(t, 3, version)
+	assert.Nil(t, st2.Close())
+
+	backups, _ := ioutil.ReadDir(filepath.Join(dir, store.BackupDirName))
+	assert.Len(t, backups, 1)
+
+	// already applied migrations do not run again
+	st2 = store.New(store.WithPath(dir), store.WithMigrations(migrations))
+	assert.Nil(t, st2.Open())
+	assert.Equal(t, []int{2, 3}, ran)
+	assert.Nil(t, st2.Close())
+
+	// a daemon that does not know version 3 must not use the store
+	st = store.New(store.WithPath(dir), store.WithMigrations(migrations[1:]))
+	assert.Equal(t, store.ErrStoreVersionTooNew, st.Open())
+}
+
+func TestStore_RestoresBackupOnFailedMigration(t *testing.T) {
+	dir := initTestStore(t)
+
+	st := reopen(t, dir, store.WithMigrations(store.Migrations))
+	assert.Nil(t, st.Set([]byte("token"), []byte("before")))
+	assert.Nil(t, st.Close())
+
+	migrations := append([]store.Migration{
+		{Version: 2, Name: "broken", Up: func(st store.Store) error {
+			st.Set([]byte("token"), []byte("half migrated"))
+			return errors.New("broken")
+		}},
+	}, store.Migrations...)
+
+	st = store.New(store.WithPath(dir), store.WithMigrations(migrations))
+	assert.NotNil(t, st.Open())
+
+	st = reopen(t, dir, store.WithMigrations(store.Migrations))
+	val, err := st.Get([]byte("token"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("before"), val)
+}
+
+func TestStore_EncryptsBackupWhenStoreIsEncrypted(t *testing.T) {
+	dir := initTestStore(t)
+	keys := &testKeys{keys: [][]byte{newTestKey(1)}}
+
+	st := reopen(t, dir, store.WithMigrations(store.Migrations), store.WithEncryption(keys, true))
+	assert.Nil(t, st.Set([]byte("hubTokenKey"), []byte("before")))
+	assert.Nil(t, st.Close())
+
+	migrations := append([]store.Migration{
+		{Version: 2, Name: "broken", Up: func(st store.Store) error {
+			st.Set([]byte("hubTokenKey"), []byte("half migrated"))
+			return errors.New("broken")
+		}},
+	}, store.Migrations...)
+
+	st = store.New(store.WithPath(dir), store.WithMigrations(migrations), store.WithEncryption(keys, true))
+	assert.NotNil(t, st.Open())
+
+	backups, _ := ioutil.ReadDir(filepath.Join(dir, store.BackupDirName))
+	assert.Len(t, backups, 1)
+	assert.Equal(t, os.FileMode(0600), backups[0].Mode().Perm())
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, store.BackupDirName, backups[0].Name()))
+	assert.Nil(t, err)
+	assert.False(t, bytes.Contains(data, []byte("hubTokenKey")))
+
+	st = reopen(t, dir, store.WithMigrations(store.Migrations), store.WithEncryption(keys, true))
+	val, err := st.Get([]byte("hubTokenKey"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("before"), val)
+}
+
+func TestStore_KeepsBackupsPerProfile(t *testing.T) {
+	dir := initTestStore(t)
+
+	// backups left by another profile sharing the folder
+	otherDir := filepath.Join(dir, store.BackupDirName, "work")
+	assert.Nil(t, os.MkdirAll(otherDir, 0700))
+	for i := 0; i < 5; i++ {
+		assert.Nil(t, ioutil.WriteFile(filepath.Join(otherDir, "store-v1-"+strconv.Itoa(i)+".bak"), []byte{}, 0600))
+	}
+
+	migrations := store.Migrations
+	for v := 2; v <= 6; v++ {
+		st := reopen(t, dir, store.WithProfile("default"), store.WithMigrations(migrations))
+		assert.Nil(t, st.Set([]byte("token"), []byte(strconv.Itoa(v))))
+		assert.Nil(t, st.Close())
+
+		migrations = append([]store.Migration{{Version: v, Name: "noop", Up: func(st store.Store) error { return nil }}}, migrations...)
+	}
+
+	st := reopen(t, dir, store.WithProfile("default"), store.WithMigrations(migrations))
+	assert.Nil(t, st.Close())
+
+	backups, _ := ioutil.ReadDir(filepath.Join(dir, store.BackupDirName, "default"))
+	assert.Len(t, backups, 3)
+
+	others, _ := ioutil.ReadDir(otherDir)
+	assert.Len(t, others, 5)
+}
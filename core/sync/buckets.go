@@ -0,0 +1,113 @@
+package sync
+
+import (
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+)
+
+// RenameBucket moves the opened files, their conflicts and the sync folders of the local bucket to its new slug
+func (bs *bucketSynchronizer) RenameBucket(bucketSlug, newBucketSlug string) error {
+	bs.openFilesLock.Lock()
+	defer bs.openFilesLock.Unlock()
+
+	files, err := bs.getBucketOpenFiles(bucketSlug)
+	if err != nil {
+		return err
+	}
+
+	for _, fi := range files {
+		if err := bs.removeFileInfo(fi); err != nil {
+			return err
+		}
+
+		fi.BucketSlug = newBucketSlug
+		if err := bs.addFileInfoToStore(fi); err != nil {
+			return err
+		}
+
+		if conflict, err := bs.getFileConflict(getFileConflictKey(fi.LocalPath)); err == nil {
+			conflict.BucketSlug = newBucketSlug
+			if err := bs.setFileConflict(conflict); err != nil {
+				return err
+			}
+		}
+	}
+
+	folders, err := bs.getSyncFolders()
+	if err != nil {
+		return err
+	}
+
+	for _, folder := range folders {
+		if folder.BucketSlug != bucketSlug {
+			continue
+		}
+
+		folder.BucketSlug = newBucketSlug
+		if err := bs.setSyncFolder(folder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveBucket stops syncing the opened files and the sync folders of a deleted local bucket.
+// The local copies are kept.
+func (bs *bucketSynchronizer) RemoveBucket(bucketSlug string) error {
+	bs.openFilesLock.Lock()
+	defer bs.openFilesLock.Unlock()
+
+	files, err := bs.getBucketOpenFiles(bucketSlug)
+	if err != nil {
+		return err
+	}
+
+	for _, fi := range files {
+		if err := bs.removeFileInfo(fi); err != nil {
+			return err
+		}
+
+		if err := bs.store.Remove([]byte(getFileConflictKey(fi.LocalPath))); err != nil {
+			return err
+		}
+	}
+
+	folders, err := bs.getSyncFolders()
+	if err != nil {
+		return err
+	}
+
+	for _, folder := range folders {
+		if folder.BucketSlug != bucketSlug {
+			continue
+		}
+
+		if err := bs.removeSyncFolder(folder); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the opened files of the local bucket, files shared with the user are in remote buckets
+func (bs *bucketSynchronizer) getBucketOpenFiles(bucketSlug string) ([]domain.AddWatchFile, error) {
+	keys, err := bs.store.KeysWithPrefix(OpenFilesKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []domain.AddWatchFile{}
+	for _, key := range keys {
+		fi, err := bs.getOpenFileInfo(key)
+		if err != nil {
+			continue
+		}
+
+		if fi.BucketSlug == bucketSlug && !fi.IsRemote {
+			files = append(files, fi)
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,257 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/core/events"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile"
+	"github.com/FleekHQ/space-daemon/core/textile/utils"
+	"github.com/FleekHQ/space-daemon/log"
+	api_buckets_pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+var (
+	ErrFileConflictNotFound = errors.New("no conflict found for the file")
+	ErrOpenFileNotFound     = errors.New("the file is not an opened file")
+)
+
+const FileConflictsKeyPrefix = "fileConflicts#"
+
+// ListFileConflicts returns the opened files that were changed locally after their bucket version changed
+func (bs *bucketSynchronizer) ListFileConflicts() ([]domain.FileConflict, error) {
+	keys, err := bs.store.KeysWithPrefix(FileConflictsKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []domain.FileConflict{}
+	for _, key := range keys {
+		conflict, err := bs.getFileConflict(key)
+		if err != nil {
+			return nil, err
+		}
+
+		conflicts = append(conflicts, conflict)
+	}
+
+	return conflicts, nil
+}
+
+// ResolveFileConflict resolves the conflict of the opened file at localPath:
+// KeepLocal uploads the local version over the bucket one,
+// KeepRemote replaces the local version with the bucket one,
+// KeepBoth uploads the local version next to the bucket one with a conflict suffix and replaces it locally.
+func (bs *bucketSynchronizer) ResolveFileConflict(ctx context.Context, localPath string, resolution domain.FileConflictResolution) error {
+	bs.openFilesLock.Lock()
+	defer bs.openFilesLock.Unlock()
+
+	if _, err := bs.getFileConflict(getFileConflictKey(localPath)); err != nil {
+		return ErrFileConflictNotFound
+	}
+
+	watchInfo, exists := bs.getOpenFileBucketSlugAndPath(localPath)
+	if !exists {
+		return ErrOpenFileNotFound
+	}
+
+	b, err := bs.getOpenFileBucket(ctx, watchInfo)
+	if err != nil {
+		return err
+	}
+
+	// the content hash is recorded too, so the watcher does not upload the downloaded version back
+	var baseCid string
+	var contentHash []byte
+	switch resolution {
+	case domain.KeepLocal:
+		if baseCid, contentHash, err = uploadOpenFile(ctx, b, watchInfo.BucketPath, localPath); err != nil {
+			return err
+		}
+	case domain.KeepRemote:
+		if baseCid, contentHash, err = downloadOpenFile(ctx, b, watchInfo.BucketPath, localPath); err != nil {
+			return err
+		}
+	case domain.KeepBoth:
+		copyPath := conflictCopyPath(watchInfo.BucketPath, time.Now())
+		if _, _, err = uploadOpenFile(ctx, b, copyPath, localPath); err != nil {
+			return err
+		}
+		log.Info(fmt.Sprintf("kept local version of %s in bucket %s as %s", localPath, watchInfo.BucketSlug, copyPath))
+
+		if baseCid, contentHash, err = downloadOpenFile(ctx, b, watchInfo.BucketPath, localPath); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("unknown conflict resolution %d", resolution)
+	}
+
+	if err := bs.updateOpenFileVersion(watchInfo, baseCid, contentHash); err != nil {
+		return err
+	}
+
+	return bs.store.Remove([]byte(getFileConflictKey(localPath)))
+}
+
+// Returns true and notifies a conflict if the bucket version of the opened file is not the one it was opened at
+func (bs *bucketSynchronizer) checkOpenFileConflict(ctx context.Context, b textile.Bucket, watchInfo domain.AddWatchFile) (bool, error) {
+	if watchInfo.Cid == "" {
+		return false, nil
+	}
+
+	item, err := b.ListDirectory(ctx, watchInfo.BucketPath)
+	if isNotFoundError(err) {
+		// removed from the bucket, the upload adds it back
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if item.Item.Cid == watchInfo.Cid {
+		return false, nil
+	}
+
+	if existing, err := bs.getFileConflict(getFileConflictKey(watchInfo.LocalPath)); err == nil && existing.RemoteCid == item.Item.Cid {
+		// already reported
+		return true, nil
+	}
+
+	conflict := domain.FileConflict{
+		LocalPath:  watchInfo.LocalPath,
+		BucketSlug: watchInfo.BucketSlug,
+		BucketPath: watchInfo.BucketPath,
+		DbId:       watchInfo.DbId,
+		BaseCid:    watchInfo.Cid,
+		RemoteCid:  item.Item.Cid,
+		DetectedAt: time.Now().Unix(),
+	}
+
+	if err := bs.setFileConflict(conflict); err != nil {
+		return true, err
+	}
+
+	info := utils.MapDirEntryToFileInfo(api_buckets_pb.ListPathResponse(*item), watchInfo.BucketPath)
+	bs.notifier.SendFileEvent(events.NewFileEvent(info, events.FileConflict, watchInfo.BucketSlug, watchInfo.DbId))
+
+	return true, nil
+}
+
+func (bs *bucketSynchronizer) getOpenFileBucket(ctx context.Context, watchInfo domain.AddWatchFile) (textile.Bucket, error) {
+	if watchInfo.IsRemote {
+		return bs.textileClient.GetBucket(ctx, watchInfo.BucketSlug, &textile.GetBucketForRemoteFileInput{
+			Bucket: watchInfo.BucketSlug,
+			DbID:   watchInfo.DbId,
+			Path:   watchInfo.BucketPath,
+		})
+	}
+
+	return bs.textileClient.GetBucket(ctx, watchInfo.BucketSlug, nil)
+}
+
+// Records the bucket version the opened file is now based on and the hash of the local content synced with it
+func (bs *bucketSynchronizer) updateOpenFileVersion(watchInfo domain.AddWatchFile, cid string, contentHash []byte) error {
+	if err := bs.removeFileInfo(watchInfo); err != nil {
+		return err
+	}
+
+	watchInfo.Cid = cid
+	watchInfo.ContentHash = contentHash
+	return bs.addFileInfoToStore(watchInfo)
+}
+
+// Returns true if the local content is the one last synced with the bucket,
+// e.g. after a conflict was resolved by replacing the file with the bucket version
+func isOpenFileContentSynced(watchInfo domain.AddWatchFile) bool {
+	if len(watchInfo.ContentHash) == 0 {
+		return false
+	}
+
+	local, err := hashLocalFile(watchInfo.LocalPath)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(local, watchInfo.ContentHash)
+}
+
+// Uploads the file and returns the cid of the new version and the hash of the uploaded content
+func uploadOpenFile(ctx context.Context, b textile.Bucket, bucketPath, localPath string) (string, []byte, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return "", nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	result, _, err := b.UploadFile(ctx, bucketPath, io.TeeReader(f, h))
+	if err != nil {
+		return "", nil, err
+	}
+
+	return result.Cid().String(), h.Sum(nil), nil
+}
+
+// Replaces the file with the bucket version and returns its cid and the hash of the downloaded content
+func downloadOpenFile(ctx context.Context, b textile.Bucket, bucketPath, localPath string) (string, []byte, error) {
+	item, err := b.ListDirectory(ctx, bucketPath)
+	if err != nil {
+		return "", nil, err
+	}
+
+	h := sha256.New()
+	err = replaceFile(localPath, func(w io.Writer) error {
+		return b.GetFile(ctx, bucketPath, io.MultiWriter(w, h))
+	})
+	if err != nil {
+		return "", nil, err
+	}
+
+	return item.Item.Cid, h.Sum(nil), nil
+}
+
+// Same check as the bucket FileExists, textile reports missing paths as "no link named <name> under <cid>"
+func isNotFoundError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	match, _ := regexp.MatchString(".*no link named.*under.*", err.Error())
+	return match
+}
+
+func getFileConflictKey(localPath string) string {
+	return FileConflictsKeyPrefix + localPath
+}
+
+func (bs *bucketSynchronizer) setFileConflict(conflict domain.FileConflict) error {
+	out, err := json.Marshal(conflict)
+	if err != nil {
+		return err
+	}
+
+	return bs.store.SetString(getFileConflictKey(conflict.LocalPath), string(out))
+}
+
+func (bs *bucketSynchronizer) getFileConflict(key string) (domain.FileConflict, error) {
+	val, err := bs.store.Get([]byte(key))
+	if err != nil {
+		return domain.FileConflict{}, err
+	}
+
+	var conflict domain.FileConflict
+	if err := json.Unmarshal(val, &conflict); err != nil {
+		return domain.FileConflict{}, err
+	}
+
+	return conflict, nil
+}
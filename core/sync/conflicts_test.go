@@ -0,0 +1,228 @@
+package sync
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/events"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile/bucket"
+	"github.com/FleekHQ/space-daemon/mocks"
+	badger "github.com/dgraph-io/badger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	api_buckets_pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+type testNotifier struct {
+	fileEvents []events.FileEvent
+}
+
+func (n *testNotifier) SendFileEvent(event events.FileEvent) {
+	n.fileEvents = append(n.fileEvents, event)
+}
+
+func (n *testNotifier) SendTextileEvent(event events.TextileEvent) {}
+
+func dirEntriesWithCid(cid string) *bucket.DirEntries {
+	return &bucket.DirEntries{
+		Item: &api_buckets_pb.PathItem{
+			Cid:      cid,
+			Name:     "file.txt",
+			Metadata: &api_buckets_pb.Metadata{UpdatedAt: 1},
+		},
+	}
+}
+
+var openedFile = domain.AddWatchFile{
+	LocalPath:  "/tmp/file.txt",
+	BucketPath: "docs/file.txt",
+	BucketSlug: "personal",
+	Cid:        "base-cid",
+}
+
+func TestCheckOpenFileConflict_NoConflictWhenBucketVersionDidNotChange(t *testing.T) {
+	st := new(mocks.Store)
+	b := new(mocks.Bucket)
+	n := &testNotifier{}
+	bs := &bucketSynchronizer{store: st, notifier: n}
+
+	b.On("ListDirectory", mock.Anything, openedFile.BucketPath).Return(dirEntriesWithCid("base-cid"), nil)
+
+	conflicted, err := bs.checkOpenFileConflict(context.Background(), b, openedFile)
+
+	assert.Nil(t, err)
+	assert.False(t, conflicted)
+	assert.Len(t, n.fileEvents, 0)
+	st.AssertNotCalled(t, "SetString", mock.Anything, mock.Anything)
+}
+
+func TestCheckOpenFileConflict_ReportsConflictWhenBucketVersionChanged(t *testing.T) {
+	st := new(mocks.Store)
+	b := new(mocks.Bucket)
+	n := &testNotifier{}
+	bs := &bucketSynchronizer{store: st, notifier: n}
+
+	b.On("ListDirectory", mock.Anything, openedFile.BucketPath).Return(dirEntriesWithCid("remote-cid"), nil)
+	st.On("Get", []byte(getFileConflictKey(openedFile.LocalPath))).Return(nil, badger.ErrKeyNotFound)
+	st.On("SetString", getFileConflictKey(openedFile.LocalPath), mock.Anything).Return(nil)
+
+	conflicted, err := bs.checkOpenFileConflict(context.Background(), b, openedFile)
+
+	assert.Nil(t, err)
+	assert.True(t, conflicted)
+	assert.Len(t, n.fileEvents, 1)
+	assert.Equal(t, events.FileConflict, n.fileEvents[0].Type)
+	assert.Equal(t, "personal", n.fileEvents[0].Bucket)
+	st.AssertExpectations(t)
+}
+
+func TestCheckOpenFileConflict_DoesNotReportConflictTwice(t *testing.T) {
+	st := new(mocks.Store)
+	b := new(mocks.Bucket)
+	n := &testNotifier{}
+	bs := &bucketSynchronizer{store: st, notifier: n}
+
+	existing, _ := json.Marshal(domain.FileConflict{
+		LocalPath: openedFile.LocalPath,
+		BaseCid:   "base-cid",
+		RemoteCid: "remote-cid",
+	})
+
+	b.On("ListDirectory", mock.Anything, openedFile.BucketPath).Return(dirEntriesWithCid("remote-cid"), nil)
+	st.On("Get", []byte(getFileConflictKey(openedFile.LocalPath))).Return(existing, nil)
+
+	conflicted, err := bs.checkOpenFileConflict(context.Background(), b, openedFile)
+
+	assert.Nil(t, err)
+	assert.True(t, conflicted)
+	assert.Len(t, n.fileEvents, 0)
+	st.AssertNotCalled(t, "SetString", mock.Anything, mock.Anything)
+}
+
+func TestCheckOpenFileConflict_NoConflictWhenRemovedFromBucket(t *testing.T) {
+	st := new(mocks.Store)
+	b := new(mocks.Bucket)
+	n := &testNotifier{}
+	bs := &bucketSynchronizer{store: st, notifier: n}
+
+	notFound := errors.New("rpc error: code = Unknown desc = no link named \"file.txt\" under bafybeievqvkeo2ycggt4lino45pj3olv7yo2e6sybcmyphicejsvq2vimi")
+	b.On("ListDirectory", mock.Anything, openedFile.BucketPath).Return(nil, notFound)
+
+	conflicted, err := bs.checkOpenFileConflict(context.Background(), b, openedFile)
+
+	assert.Nil(t, err)
+	assert.False(t, conflicted)
+	assert.Len(t, n.fileEvents, 0)
+}
+
+func TestCheckOpenFileConflict_ReturnsListingErrors(t *testing.T) {
+	st := new(mocks.Store)
+	b := new(mocks.Bucket)
+	n := &testNotifier{}
+	bs := &bucketSynchronizer{store: st, notifier: n}
+
+	b.On("ListDirectory", mock.Anything, openedFile.BucketPath).Return(nil, errors.New("connection refused"))
+
+	conflicted, err := bs.checkOpenFileConflict(context.Background(), b, openedFile)
+
+	assert.NotNil(t, err)
+	assert.False(t, conflicted)
+	assert.Len(t, n.fileEvents, 0)
+}
+
+func TestDownloadOpenFile_KeepsLocalFileWhenDownloadFails(t *testing.T) {
+	dir, err := ioutil.TempDir("", "space-open-file")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	localPath := filepath.Join(dir, "file.txt")
+	assert.Nil(t, ioutil.WriteFile(localPath, []byte("local"), 0644))
+
+	b := new(mocks.Bucket)
+	b.On("ListDirectory", mock.Anything, openedFile.BucketPath).Return(dirEntriesWithCid("remote-cid"), nil)
+	b.On("GetFile", mock.Anything, openedFile.BucketPath, mock.Anything).Return(errors.New("connection reset")).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("rem"))
+	})
+
+	_, _, err = downloadOpenFile(context.Background(), b, openedFile.BucketPath, localPath)
+	assert.Error(t, err)
+
+	data, err := ioutil.ReadFile(localPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("local"), data)
+	files, _ := ioutil.ReadDir(dir)
+	assert.Len(t, files, 1)
+}
+
+// stores the opened file info of a local file with the content, as last synced with the given hash
+func openedFileAt(t *testing.T, content, contentHash []byte) (domain.AddWatchFile, *mocks.Store) {
+	dir, err := ioutil.TempDir("", "space-open-file")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	watchInfo := openedFile
+	watchInfo.LocalPath = filepath.Join(dir, "file.txt")
+	watchInfo.BucketKey = "bucket-key"
+	watchInfo.ContentHash = contentHash
+	assert.Nil(t, ioutil.WriteFile(watchInfo.LocalPath, content, 0644))
+
+	st := new(mocks.Store)
+	stored, _ := json.Marshal(watchInfo)
+	st.On("Get", []byte(getOpenFileKey(watchInfo.LocalPath))).Return(stored, nil)
+
+	return watchInfo, st
+}
+
+func TestSyncOpenFile_DoesNotUploadWhenConflictCheckFails(t *testing.T) {
+	watchInfo, st := openedFileAt(t, []byte("local"), nil)
+	tc := new(mocks.Client)
+	b := new(mocks.Bucket)
+	h := &watcherHandler{bs: &bucketSynchronizer{store: st, textileClient: tc, notifier: &testNotifier{}}}
+
+	tc.On("GetBucket", mock.Anything, watchInfo.BucketSlug, mock.Anything).Return(b, nil)
+	b.On("ListDirectory", mock.Anything, watchInfo.BucketPath).Return(nil, errors.New("connection refused"))
+
+	h.syncOpenFile(context.Background(), watchInfo.LocalPath)
+
+	b.AssertNotCalled(t, "UploadFile", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestSyncOpenFile_SkipsContentAlreadySynced(t *testing.T) {
+	content := []byte("bucket version")
+	hash := sha256.Sum256(content)
+	watchInfo, st := openedFileAt(t, content, hash[:])
+	tc := new(mocks.Client)
+	h := &watcherHandler{bs: &bucketSynchronizer{store: st, textileClient: tc, notifier: &testNotifier{}}}
+
+	h.syncOpenFile(context.Background(), watchInfo.LocalPath)
+
+	tc.AssertNotCalled(t, "GetBucket", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestDownloadOpenFile_ReturnsHashOfDownloadedContent(t *testing.T) {
+	watchInfo, _ := openedFileAt(t, []byte("local"), nil)
+	b := new(mocks.Bucket)
+	b.On("ListDirectory", mock.Anything, watchInfo.BucketPath).Return(dirEntriesWithCid("remote-cid"), nil)
+	b.On("GetFile", mock.Anything, watchInfo.BucketPath, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("remote"))
+	})
+
+	cid, contentHash, err := downloadOpenFile(context.Background(), b, watchInfo.BucketPath, watchInfo.LocalPath)
+	assert.Nil(t, err)
+	assert.Equal(t, "remote-cid", cid)
+
+	watchInfo.ContentHash = contentHash
+	assert.True(t, isOpenFileContentSynced(watchInfo))
+}
@@ -0,0 +1,834 @@
+package sync
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	gosync "sync"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile"
+	"github.com/FleekHQ/space-daemon/core/textile/bucket"
+	"github.com/FleekHQ/space-daemon/core/textile/utils"
+	"github.com/FleekHQ/space-daemon/log"
+	tc "github.com/textileio/go-threads/api/client"
+)
+
+var (
+	ErrAddSyncFolder      = errors.New("error adding folder to sync")
+	ErrSyncFolderNotFound = errors.New("the folder is not synced")
+)
+
+const (
+	SyncFoldersKeyPrefix = "syncFolders#"
+	syncedFilesKeyPrefix = "syncedFiles#"
+)
+
+// Time to wait after a remote change before pulling it, so the bucket is restored from the mirror first
+const remotePullDelay = 5 * time.Second
+
+// State of a synced file the last time both sides matched, used to tell which side changed since
+type syncedFile struct {
+	RemoteUpdatedAt int64 `json:"remote_updated_at"`
+	ModTime         int64 `json:"mod_time"`
+	Size            int64 `json:"size"`
+}
+
+func (f syncedFile) localChanged(info os.FileInfo) bool {
+	return info.ModTime().UnixNano() != f.ModTime || info.Size() != f.Size
+}
+
+// Keeps the sync folders state shared by the watcher and listener handlers
+type folderSync struct {
+	// only one sync operation runs at a time, so files written by a pull are recorded before the watcher sees them
+	lock gosync.Mutex
+
+	timersLock gosync.Mutex
+	pullTimers map[string]*time.Timer
+}
+
+// Implementation to pull remote changes into the sync folders on textile events
+type folderListenerHandler struct {
+	bs *bucketSynchronizer
+}
+
+// AddSyncFolder keeps the local folder in sync with the bucket path in both directions.
+// Files changed on both sides are kept twice, the local version is renamed with a conflict suffix.
+func (bs *bucketSynchronizer) AddSyncFolder(folder domain.SyncFolder) error {
+	if folder.LocalPath == "" || folder.BucketSlug == "" {
+		return ErrAddSyncFolder
+	}
+
+	folder.LocalPath = filepath.Clean(folder.LocalPath)
+	folder.BucketPath = cleanBucketPath(folder.BucketPath)
+
+	if err := bs.addSyncFolder(folder); err != nil {
+		return err
+	}
+
+	if err := bs.folderWatcher.AddFolder(folder.LocalPath); err != nil {
+		// the folder is not kept so it is not restored on the next start and doesn't block other folders
+		bs.folders.lock.Lock()
+		defer bs.folders.lock.Unlock()
+
+		if removeErr := bs.removeSyncFolder(folder); removeErr != nil {
+			log.Error(fmt.Sprintf("error removing sync folder at %s", folder.LocalPath), removeErr)
+		}
+
+		return err
+	}
+
+	go bs.syncFolderInBackground(folder)
+
+	return nil
+}
+
+// Stores the folder unless it overlaps a synced folder, since both would upload the same files to different bucket paths.
+// The check and the store are done under the folders lock so two folders added at once are checked against each other.
+func (bs *bucketSynchronizer) addSyncFolder(folder domain.SyncFolder) error {
+	bs.folders.lock.Lock()
+	defer bs.folders.lock.Unlock()
+
+	if existing, exists := bs.getSyncFolderForPath(folder.LocalPath); exists {
+		return fmt.Errorf("%s is already synced as part of %s", folder.LocalPath, existing.LocalPath)
+	}
+
+	if nested, exists := bs.getSyncFolderInPath(folder.LocalPath); exists {
+		return fmt.Errorf("%s contains the synced folder %s", folder.LocalPath, nested.LocalPath)
+	}
+
+	if err := os.MkdirAll(folder.LocalPath, os.ModePerm); err != nil {
+		return err
+	}
+
+	return bs.setSyncFolder(folder)
+}
+
+// RemoveSyncFolder stops syncing the folder, its files are kept both locally and in the bucket
+func (bs *bucketSynchronizer) RemoveSyncFolder(localPath string) error {
+	localPath = filepath.Clean(localPath)
+
+	bs.folders.lock.Lock()
+	defer bs.folders.lock.Unlock()
+
+	folders, err := bs.getSyncFolders()
+	if err != nil {
+		return err
+	}
+
+	for _, folder := range folders {
+		if folder.LocalPath == localPath {
+			return bs.removeSyncFolder(folder)
+		}
+	}
+
+	return ErrSyncFolderNotFound
+}
+
+// ListSyncFolders returns the folders synced with a bucket path
+func (bs *bucketSynchronizer) ListSyncFolders() ([]domain.SyncFolder, error) {
+	return bs.getSyncFolders()
+}
+
+// Watches the sync folders again and syncs the changes made while the daemon was stopped
+func (bs *bucketSynchronizer) startSyncFolders() error {
+	bs.textileClient.AttachListenerHandler(&folderListenerHandler{bs: bs})
+
+	folders, err := bs.getSyncFolders()
+	if err != nil {
+		return err
+	}
+
+	for _, folder := range folders {
+		if err := os.MkdirAll(folder.LocalPath, os.ModePerm); err != nil {
+			log.Error(fmt.Sprintf("error creating sync folder at %s", folder.LocalPath), err)
+			continue
+		}
+
+		if err := bs.folderWatcher.AddFolder(folder.LocalPath); err != nil {
+			log.Error(fmt.Sprintf("error watching sync folder at %s", folder.LocalPath), err)
+			continue
+		}
+
+		go bs.syncFolderInBackground(folder)
+	}
+
+	return nil
+}
+
+func (bs *bucketSynchronizer) syncFolderInBackground(folder domain.SyncFolder) {
+	if err := bs.syncFolder(context.Background(), folder); err != nil {
+		log.Error(fmt.Sprintf("error syncing folder %s with bucket %s", folder.LocalPath, folder.BucketSlug), err)
+	}
+}
+
+// Uploads the local changes of the folder and pulls the remote ones
+func (bs *bucketSynchronizer) syncFolder(ctx context.Context, folder domain.SyncFolder) error {
+	bs.folders.lock.Lock()
+	defer bs.folders.lock.Unlock()
+
+	b, err := bs.textileClient.GetBucket(ctx, folder.BucketSlug, nil)
+	if err != nil {
+		return err
+	}
+
+	if err := bs.uploadSyncedDir(ctx, b, folder, folder.LocalPath); err != nil {
+		return err
+	}
+
+	return bs.pullSyncFolder(ctx, b, folder)
+}
+
+// Uploads the directory and everything under it, skipping hidden and ignored items
+func (bs *bucketSynchronizer) uploadSyncedDir(
+	ctx context.Context,
+	b textile.Bucket,
+	folder domain.SyncFolder,
+	dirPath string,
+) error {
+	return filepath.Walk(dirPath, func(localPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if localPath == folder.LocalPath {
+			return nil
+		}
+
+		if strings.HasPrefix(info.Name(), ".") || bs.folderWatcher.IsIgnored(localPath, info.IsDir()) {
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.IsDir() {
+			return bs.createSyncedDir(ctx, b, folder, localPath)
+		}
+
+		return bs.uploadSyncedFile(ctx, b, folder, localPath)
+	})
+}
+
+// Downloads the remote changes of the folder
+func (bs *bucketSynchronizer) pullSyncFolder(ctx context.Context, b textile.Bucket, folder domain.SyncFolder) error {
+	remoteFiles, err := listRemoteFiles(ctx, b, folder.BucketPath)
+	if err != nil {
+		return err
+	}
+
+	pulled := map[string]bool{}
+	for bucketPath, remoteUpdatedAt := range remoteFiles {
+		localPath, ok := localPathFor(folder, bucketPath)
+		if !ok {
+			continue
+		}
+		pulled[localPath] = true
+
+		if err := bs.pullSyncedFile(ctx, b, localPath, bucketPath, remoteUpdatedAt); err != nil {
+			log.Error(fmt.Sprintf("error pulling %s to %s", bucketPath, localPath), err)
+		}
+	}
+
+	// files synced before that are no longer in the bucket were removed remotely
+	keys, err := bs.store.KeysWithPrefix(syncedFilesKeyPrefix + folder.LocalPath + string(filepath.Separator))
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		localPath := strings.TrimPrefix(key, syncedFilesKeyPrefix)
+		if pulled[localPath] {
+			continue
+		}
+
+		state, _ := bs.getSyncedFile(localPath)
+		bs.removeSyncedFile(localPath)
+
+		info, err := os.Stat(localPath)
+		if err != nil {
+			continue
+		}
+
+		if state.localChanged(info) {
+			// keep the local changes over the remote removal
+			if err := bs.uploadSyncedFile(ctx, b, folder, localPath); err != nil {
+				log.Error(fmt.Sprintf("error uploading %s", localPath), err)
+			}
+			continue
+		}
+
+		if err := os.Remove(localPath); err != nil {
+			log.Error(fmt.Sprintf("error removing %s", localPath), err)
+		}
+	}
+
+	return nil
+}
+
+// Downloads the file if it changed remotely since the last sync, only files changed remotely are fetched.
+// Synced files removed locally while the folder was not watched, e.g. with the daemon stopped,
+// are removed from the bucket unless they changed remotely since.
+func (bs *bucketSynchronizer) pullSyncedFile(
+	ctx context.Context,
+	b textile.Bucket,
+	localPath, bucketPath string,
+	remoteUpdatedAt int64,
+) error {
+	state, synced := bs.getSyncedFile(localPath)
+	info, err := os.Stat(localPath)
+
+	switch {
+	case os.IsNotExist(err) && synced && state.RemoteUpdatedAt == remoteUpdatedAt:
+		if _, err := b.DeleteDirOrFile(ctx, bucketPath); err != nil {
+			return err
+		}
+
+		log.Info(fmt.Sprintf("%s was removed locally, removed %s from bucket %s", localPath, bucketPath, b.Slug()))
+		bs.removeSyncedFile(localPath)
+		return nil
+	case os.IsNotExist(err):
+		return bs.downloadSyncedFile(ctx, b, localPath, bucketPath)
+	case err != nil:
+		return err
+	case synced && state.RemoteUpdatedAt == remoteUpdatedAt:
+		// no remote change, local changes are uploaded by the watcher
+		return nil
+	case synced && !state.localChanged(info):
+		return bs.downloadSyncedFile(ctx, b, localPath, bucketPath)
+	default:
+		return bs.keepBoth(ctx, b, localPath, bucketPath)
+	}
+}
+
+// Uploads the file unless it did not change since the last sync
+func (bs *bucketSynchronizer) uploadSyncedFile(
+	ctx context.Context,
+	b textile.Bucket,
+	folder domain.SyncFolder,
+	localPath string,
+) error {
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	bucketPath := bucketPathFor(folder, localPath)
+	state, synced := bs.getSyncedFile(localPath)
+	if synced && !state.localChanged(info) {
+		// e.g. written by a pull
+		return nil
+	}
+
+	remoteExists, _ := b.FileExists(ctx, bucketPath)
+	if remoteExists {
+		remoteUpdatedAt, err := b.UpdatedAt(ctx, bucketPath)
+		if err != nil {
+			return err
+		}
+
+		if !synced || remoteUpdatedAt != state.RemoteUpdatedAt {
+			// changed on both sides
+			return bs.keepBoth(ctx, b, localPath, bucketPath)
+		}
+	}
+
+	f, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	if _, _, err := b.UploadFile(ctx, bucketPath, f); err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("uploaded %s to bucket %s as %s", localPath, b.Slug(), bucketPath))
+
+	return bs.recordSyncedFile(ctx, b, localPath, bucketPath)
+}
+
+func (bs *bucketSynchronizer) createSyncedDir(
+	ctx context.Context,
+	b textile.Bucket,
+	folder domain.SyncFolder,
+	localPath string,
+) error {
+	bucketPath := bucketPathFor(folder, localPath)
+	if exists, _ := b.DirExists(ctx, bucketPath); exists {
+		return nil
+	}
+
+	_, _, err := b.CreateDirectory(ctx, bucketPath)
+	return err
+}
+
+func (bs *bucketSynchronizer) downloadSyncedFile(ctx context.Context, b textile.Bucket, localPath, bucketPath string) error {
+	if err := os.MkdirAll(filepath.Dir(localPath), os.ModePerm); err != nil {
+		return err
+	}
+
+	err := replaceFile(localPath, func(w io.Writer) error {
+		return bs.getBucketFile(ctx, b, bucketPath, w)
+	})
+	if err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("downloaded %s from bucket %s to %s", bucketPath, b.Slug(), localPath))
+
+	return bs.recordSyncedFile(ctx, b, localPath, bucketPath)
+}
+
+// Writes the file into a hidden temp file next to it and moves it over the file once fully written,
+// so a failed download leaves the previous content in place instead of a truncated file.
+// The temp file is hidden so the watcher skips it.
+func replaceFile(localPath string, write func(w io.Writer) error) error {
+	mode := os.FileMode(0644)
+	if info, err := os.Stat(localPath); err == nil {
+		mode = info.Mode().Perm()
+	}
+
+	tmp, err := ioutil.TempFile(filepath.Dir(localPath), "."+filepath.Base(localPath)+".*.download")
+	if err != nil {
+		return err
+	}
+
+	err = write(tmp)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Chmod(tmp.Name(), mode)
+	}
+	if err == nil {
+		err = os.Rename(tmp.Name(), localPath)
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+
+	return nil
+}
+
+// Reads the content of a bucket file. Files restored with metadata only are listed in the bucket
+// with an empty placeholder, their content is downloaded first.
+func (bs *bucketSynchronizer) getBucketFile(ctx context.Context, b textile.Bucket, bucketPath string, w io.Writer) error {
+	if err := bs.textileClient.RestoreLazyFile(ctx, b.Slug(), bucketPath); err != nil {
+		return err
+	}
+
+	return b.GetFile(ctx, bucketPath, w)
+}
+
+// Keeps both versions of a file changed on both sides:
+// the local version is renamed with a conflict suffix and the remote version is downloaded in its place.
+// The renamed copy is uploaded by the watcher as a new file.
+func (bs *bucketSynchronizer) keepBoth(ctx context.Context, b textile.Bucket, localPath, bucketPath string) error {
+	// both versions are hashed as they are read, they can be too large to be held in memory
+	remote := sha256.New()
+	if err := bs.getBucketFile(ctx, b, bucketPath, remote); err != nil {
+		return err
+	}
+
+	local, err := hashLocalFile(localPath)
+	if err != nil {
+		return err
+	}
+
+	if bytes.Equal(local, remote.Sum(nil)) {
+		return bs.recordSyncedFile(ctx, b, localPath, bucketPath)
+	}
+
+	conflictPath := conflictCopyPath(localPath, time.Now())
+	if err := os.Rename(localPath, conflictPath); err != nil {
+		return err
+	}
+
+	log.Info(fmt.Sprintf("conflict syncing %s, the local version was kept as %s", localPath, conflictPath))
+
+	return bs.downloadSyncedFile(ctx, b, localPath, bucketPath)
+}
+
+func hashLocalFile(localPath string) ([]byte, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return nil, err
+	}
+
+	return h.Sum(nil), nil
+}
+
+// Removes the item from the bucket if it is no longer in the folder.
+// Files changed remotely since the last sync are downloaded again instead of removed,
+// the same way local changes are kept over a remote removal.
+func (bs *bucketSynchronizer) removeSyncedItem(ctx context.Context, folder domain.SyncFolder, localPath string) error {
+	if _, err := os.Stat(localPath); err == nil {
+		// replaced, e.g. by a conflict copy
+		return nil
+	}
+
+	b, err := bs.textileClient.GetBucket(ctx, folder.BucketSlug, nil)
+	if err != nil {
+		return err
+	}
+
+	keys, err := bs.store.KeysWithPrefix(syncedFilesKeyPrefix + localPath + string(filepath.Separator))
+	if err != nil {
+		return err
+	}
+
+	syncedPaths := []string{localPath}
+	for _, key := range keys {
+		syncedPaths = append(syncedPaths, strings.TrimPrefix(key, syncedFilesKeyPrefix))
+	}
+
+	unchanged := []string{}
+	changedRemotely := false
+	for _, syncedPath := range syncedPaths {
+		state, synced := bs.getSyncedFile(syncedPath)
+		if !synced {
+			continue
+		}
+
+		bucketPath := bucketPathFor(folder, syncedPath)
+		remoteUpdatedAt, err := b.UpdatedAt(ctx, bucketPath)
+		if err != nil || remoteUpdatedAt == state.RemoteUpdatedAt {
+			// also removed remotely or not changed since the last sync
+			unchanged = append(unchanged, syncedPath)
+			continue
+		}
+
+		changedRemotely = true
+		log.Info(fmt.Sprintf("%s was removed locally but changed remotely, downloading it again", syncedPath))
+		if err := bs.downloadSyncedFile(ctx, b, syncedPath, bucketPath); err != nil {
+			log.Error(fmt.Sprintf("error downloading %s", syncedPath), err)
+		}
+	}
+
+	if !changedRemotely {
+		if _, err := b.DeleteDirOrFile(ctx, bucketPathFor(folder, localPath)); err != nil {
+			return err
+		}
+
+		for _, syncedPath := range syncedPaths {
+			bs.removeSyncedFile(syncedPath)
+		}
+
+		return nil
+	}
+
+	// only the files that did not change remotely are removed from the folder
+	for _, syncedPath := range unchanged {
+		if _, err := b.DeleteDirOrFile(ctx, bucketPathFor(folder, syncedPath)); err != nil {
+			log.Error(fmt.Sprintf("error removing %s from bucket %s", syncedPath, folder.BucketSlug), err)
+			continue
+		}
+
+		bs.removeSyncedFile(syncedPath)
+	}
+
+	return nil
+}
+
+// Pulls the sync folders of the bucket once the remote changes had time to be restored
+func (bs *bucketSynchronizer) schedulePull(bucketSlug string) {
+	bs.folders.timersLock.Lock()
+	defer bs.folders.timersLock.Unlock()
+
+	if timer, ok := bs.folders.pullTimers[bucketSlug]; ok {
+		timer.Reset(remotePullDelay)
+		return
+	}
+
+	bs.folders.pullTimers[bucketSlug] = time.AfterFunc(remotePullDelay, func() {
+		bs.folders.timersLock.Lock()
+		delete(bs.folders.pullTimers, bucketSlug)
+		bs.folders.timersLock.Unlock()
+
+		folders, err := bs.getSyncFolders()
+		if err != nil {
+			log.Error("error getting sync folders", err)
+			return
+		}
+
+		for _, folder := range folders {
+			if folder.BucketSlug == bucketSlug {
+				bs.pullSyncFolderInBackground(folder)
+			}
+		}
+	})
+}
+
+// Pulls the remote changes of the folder, local changes are uploaded by the watcher
+func (bs *bucketSynchronizer) pullSyncFolderInBackground(folder domain.SyncFolder) {
+	ctx := context.Background()
+
+	bs.folders.lock.Lock()
+	defer bs.folders.lock.Unlock()
+
+	b, err := bs.textileClient.GetBucket(ctx, folder.BucketSlug, nil)
+	if err != nil {
+		log.Error(fmt.Sprintf("error: could not find bucket with slug %s", folder.BucketSlug), err)
+		return
+	}
+
+	if err := bs.pullSyncFolder(ctx, b, folder); err != nil {
+		log.Error(fmt.Sprintf("error pulling folder %s from bucket %s", folder.LocalPath, folder.BucketSlug), err)
+	}
+}
+
+func (h *folderListenerHandler) OnCreate(bucketData *bucket.BucketData, listenEvent *tc.ListenEvent) {
+	h.bs.schedulePull(bucketData.Name)
+}
+
+func (h *folderListenerHandler) OnRemove(bucketData *bucket.BucketData, listenEvent *tc.ListenEvent) {
+	h.bs.schedulePull(bucketData.Name)
+}
+
+func (h *folderListenerHandler) OnSave(bucketData *bucket.BucketData, listenEvent *tc.ListenEvent) {
+	h.bs.schedulePull(bucketData.Name)
+}
+
+func getSyncFolderKey(localPath string) string {
+	return SyncFoldersKeyPrefix + localPath
+}
+
+func (bs *bucketSynchronizer) setSyncFolder(folder domain.SyncFolder) error {
+	out, err := json.Marshal(folder)
+	if err != nil {
+		return err
+	}
+
+	return bs.store.SetString(getSyncFolderKey(folder.LocalPath), string(out))
+}
+
+// Stops syncing the folder, its files are kept
+func (bs *bucketSynchronizer) removeSyncFolder(folder domain.SyncFolder) error {
+	if err := bs.store.Remove([]byte(getSyncFolderKey(folder.LocalPath))); err != nil {
+		return err
+	}
+
+	if err := bs.folderWatcher.RemoveFolder(folder.LocalPath); err != nil {
+		log.Error(fmt.Sprintf("error unwatching sync folder at %s", folder.LocalPath), err)
+	}
+
+	keys, err := bs.store.KeysWithPrefix(syncedFilesKeyPrefix + folder.LocalPath + string(filepath.Separator))
+	if err != nil {
+		return err
+	}
+	for _, key := range keys {
+		bs.removeSyncedFile(strings.TrimPrefix(key, syncedFilesKeyPrefix))
+	}
+
+	return nil
+}
+
+func (bs *bucketSynchronizer) getSyncFolders() ([]domain.SyncFolder, error) {
+	keys, err := bs.store.KeysWithPrefix(SyncFoldersKeyPrefix)
+	if err != nil {
+		return nil, err
+	}
+
+	folders := []domain.SyncFolder{}
+	for _, key := range keys {
+		val, err := bs.store.Get([]byte(key))
+		if err != nil {
+			return nil, err
+		}
+
+		var folder domain.SyncFolder
+		if err := json.Unmarshal(val, &folder); err != nil {
+			return nil, err
+		}
+
+		folders = append(folders, folder)
+	}
+
+	return folders, nil
+}
+
+// Returns the sync folder containing the path
+func (bs *bucketSynchronizer) getSyncFolderForPath(localPath string) (domain.SyncFolder, bool) {
+	folders, err := bs.getSyncFolders()
+	if err != nil {
+		return domain.SyncFolder{}, false
+	}
+
+	for _, folder := range folders {
+		if localPath == folder.LocalPath || strings.HasPrefix(localPath, folder.LocalPath+string(filepath.Separator)) {
+			return folder, true
+		}
+	}
+
+	return domain.SyncFolder{}, false
+}
+
+// Returns a sync folder inside the path
+func (bs *bucketSynchronizer) getSyncFolderInPath(localPath string) (domain.SyncFolder, bool) {
+	folders, err := bs.getSyncFolders()
+	if err != nil {
+		return domain.SyncFolder{}, false
+	}
+
+	for _, folder := range folders {
+		if strings.HasPrefix(folder.LocalPath, localPath+string(filepath.Separator)) {
+			return folder, true
+		}
+	}
+
+	return domain.SyncFolder{}, false
+}
+
+func (bs *bucketSynchronizer) getSyncedFile(localPath string) (syncedFile, bool) {
+	val, err := bs.store.Get([]byte(syncedFilesKeyPrefix + localPath))
+	if err != nil {
+		return syncedFile{}, false
+	}
+
+	var state syncedFile
+	if err := json.Unmarshal(val, &state); err != nil {
+		return syncedFile{}, false
+	}
+
+	return state, true
+}
+
+// Records the current state of both sides of the file
+func (bs *bucketSynchronizer) recordSyncedFile(ctx context.Context, b textile.Bucket, localPath, bucketPath string) error {
+	remoteUpdatedAt, err := b.UpdatedAt(ctx, bucketPath)
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(localPath)
+	if err != nil {
+		return err
+	}
+
+	out, err := json.Marshal(syncedFile{
+		RemoteUpdatedAt: remoteUpdatedAt,
+		ModTime:         info.ModTime().UnixNano(),
+		Size:            info.Size(),
+	})
+	if err != nil {
+		return err
+	}
+
+	return bs.store.SetString(syncedFilesKeyPrefix+localPath, string(out))
+}
+
+func (bs *bucketSynchronizer) removeSyncedFile(localPath string) {
+	if err := bs.store.Remove([]byte(syncedFilesKeyPrefix + localPath)); err != nil {
+		log.Error("error removing synced file state of "+localPath, err)
+	}
+}
+
+// Returns the bucket path of a file inside the sync folder
+func bucketPathFor(folder domain.SyncFolder, localPath string) string {
+	rel, err := filepath.Rel(folder.LocalPath, localPath)
+	if err != nil || rel == "." {
+		return folder.BucketPath
+	}
+
+	return cleanBucketPath(path.Join(folder.BucketPath, filepath.ToSlash(rel)))
+}
+
+// Returns the local path of a bucket path inside the sync folder, false if the bucket path is not in the folder
+func localPathFor(folder domain.SyncFolder, bucketPath string) (string, bool) {
+	rel := cleanBucketPath(bucketPath)
+	if folder.BucketPath != "" {
+		if rel != folder.BucketPath && !strings.HasPrefix(rel, folder.BucketPath+"/") {
+			return "", false
+		}
+
+		rel = strings.TrimPrefix(strings.TrimPrefix(rel, folder.BucketPath), "/")
+	}
+
+	return filepath.Join(folder.LocalPath, filepath.FromSlash(rel)), true
+}
+
+// Lists the files under the bucket path with the time they were last updated, a missing path has no files.
+// It takes one call per directory, so the files that changed are found without a call per file.
+func listRemoteFiles(ctx context.Context, b textile.Bucket, bucketPath string) (map[string]int64, error) {
+	files := map[string]int64{}
+	if bucketPath != "" {
+		exists, err := b.DirExists(ctx, bucketPath)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return files, nil
+		}
+	}
+
+	if err := collectRemoteFiles(ctx, b, bucketPath, files); err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+func collectRemoteFiles(ctx context.Context, b textile.Bucket, dirPath string, files map[string]int64) error {
+	dir, err := b.ListDirectory(ctx, dirPath)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range dir.Item.Items {
+		if utils.IsMetaFileName(item.Name) {
+			continue
+		}
+
+		itemPath := item.Name
+		if dirPath != "" {
+			itemPath = dirPath + "/" + item.Name
+		}
+
+		if item.IsDir {
+			if err := collectRemoteFiles(ctx, b, itemPath, files); err != nil {
+				return err
+			}
+			continue
+		}
+
+		var updatedAt int64
+		if item.Metadata != nil {
+			updatedAt = item.Metadata.UpdatedAt
+		}
+		files[itemPath] = updatedAt
+	}
+
+	return nil
+}
+
+// Bucket paths are relative to the root, which is an empty path
+func cleanBucketPath(p string) string {
+	return strings.Trim(path.Clean("/"+p), "/")
+}
+
+// e.g. notes (conflicted copy 2020-10-19 101500).txt
+func conflictCopyPath(localPath string, at time.Time) string {
+	ext := filepath.Ext(localPath)
+	base := strings.TrimSuffix(localPath, ext)
+
+	return fmt.Sprintf("%s (conflicted copy %s)%s", base, at.Format("2006-01-02 150405"), ext)
+}
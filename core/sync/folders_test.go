@@ -0,0 +1,487 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/store"
+	"github.com/FleekHQ/space-daemon/core/textile/bucket"
+	"github.com/FleekHQ/space-daemon/core/watcher"
+	"github.com/FleekHQ/space-daemon/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	api_buckets_pb "github.com/textileio/textile/v2/api/bucketsd/pb"
+)
+
+// synchronizer of a folder synced with the docs path of the personal bucket
+func initFolderSyncTest(t *testing.T) (*bucketSynchronizer, *mocks.Bucket, domain.SyncFolder) {
+	dir, err := ioutil.TempDir("", "space-sync-folder")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	st := store.New(store.WithPath(filepath.Join(dir, "store")))
+	assert.Nil(t, st.Open())
+	t.Cleanup(func() {
+		st.Close()
+	})
+
+	b := new(mocks.Bucket)
+	b.On("Slug").Return("personal")
+
+	textileClient := new(mocks.Client)
+	textileClient.On("GetBucket", mock.Anything, "personal", mock.Anything).Return(b, nil)
+	textileClient.On("RestoreLazyFile", mock.Anything, "personal", mock.Anything).Return(nil)
+
+	folder := domain.SyncFolder{
+		LocalPath:  filepath.Join(dir, "Synced"),
+		BucketSlug: "personal",
+		BucketPath: "docs",
+	}
+	assert.Nil(t, os.MkdirAll(folder.LocalPath, os.ModePerm))
+
+	bs := New(nil, textileClient, st, nil)
+
+	return bs, b, folder
+}
+
+// writes the file and records it as synced when the remote file was last updated at remoteUpdatedAt
+func writeSyncedFile(t *testing.T, bs *bucketSynchronizer, localPath string, remoteUpdatedAt int64) {
+	assert.Nil(t, ioutil.WriteFile(localPath, []byte("synced"), 0644))
+
+	info, err := os.Stat(localPath)
+	assert.Nil(t, err)
+
+	out, err := json.Marshal(syncedFile{
+		RemoteUpdatedAt: remoteUpdatedAt,
+		ModTime:         info.ModTime().UnixNano(),
+		Size:            info.Size(),
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, bs.store.SetString(syncedFilesKeyPrefix+localPath, string(out)))
+}
+
+func remoteFile(name string, updatedAt int64) *api_buckets_pb.PathItem {
+	return &api_buckets_pb.PathItem{
+		Name:     name,
+		Metadata: &api_buckets_pb.Metadata{UpdatedAt: updatedAt},
+	}
+}
+
+func remoteDir(items ...*api_buckets_pb.PathItem) *bucket.DirEntries {
+	return &bucket.DirEntries{
+		Item: &api_buckets_pb.PathItem{IsDir: true, Items: items},
+	}
+}
+
+func TestPullSyncFolder_DownloadsOnlyRemoteChanges(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+	ctx := context.Background()
+
+	unchangedPath := filepath.Join(folder.LocalPath, "unchanged.txt")
+	writeSyncedFile(t, bs, unchangedPath, 1)
+
+	b.On("DirExists", mock.Anything, "docs").Return(true, nil)
+	b.On("ListDirectory", mock.Anything, "docs").Return(remoteDir(
+		remoteFile("unchanged.txt", 1),
+		remoteFile("new.txt", 2),
+	), nil)
+	b.On("GetFile", mock.Anything, "docs/new.txt", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("remote"))
+	})
+	b.On("UpdatedAt", mock.Anything, "docs/new.txt").Return(int64(2), nil)
+
+	err := bs.pullSyncFolder(ctx, b, folder)
+	assert.Nil(t, err)
+
+	data, err := ioutil.ReadFile(filepath.Join(folder.LocalPath, "new.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("remote"), data)
+
+	b.AssertNotCalled(t, "GetFile", mock.Anything, "docs/unchanged.txt", mock.Anything)
+	b.AssertNotCalled(t, "UpdatedAt", mock.Anything, "docs/unchanged.txt")
+
+	state, synced := bs.getSyncedFile(filepath.Join(folder.LocalPath, "new.txt"))
+	assert.True(t, synced)
+	assert.Equal(t, int64(2), state.RemoteUpdatedAt)
+}
+
+func TestPullSyncFolder_RestoresLazyFilesBeforeDownloading(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+	ctx := context.Background()
+
+	// the local bucket lists the file with an empty placeholder until its content is restored
+	restored := false
+	textileClient := bs.textileClient.(*mocks.Client)
+	textileClient.ExpectedCalls = nil
+	textileClient.On("RestoreLazyFile", mock.Anything, "personal", "docs/lazy.txt").Return(nil).Run(func(args mock.Arguments) {
+		restored = true
+	})
+
+	b.On("DirExists", mock.Anything, "docs").Return(true, nil)
+	b.On("ListDirectory", mock.Anything, "docs").Return(remoteDir(remoteFile("lazy.txt", 2)), nil)
+	b.On("GetFile", mock.Anything, "docs/lazy.txt", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		content := "placeholder"
+		if restored {
+			content = "remote"
+		}
+		args.Get(2).(io.Writer).Write([]byte(content))
+	})
+	b.On("UpdatedAt", mock.Anything, "docs/lazy.txt").Return(int64(2), nil)
+
+	err := bs.pullSyncFolder(ctx, b, folder)
+	assert.Nil(t, err)
+
+	data, err := ioutil.ReadFile(filepath.Join(folder.LocalPath, "lazy.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("remote"), data)
+}
+func TestDownloadSyncedFile_KeepsLocalFileWhenDownloadFails(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	localPath := filepath.Join(folder.LocalPath, "notes.txt")
+	assert.Nil(t, ioutil.WriteFile(localPath, []byte("local"), 0644))
+
+	b.On("GetFile", mock.Anything, "docs/notes.txt", mock.Anything).Return(errors.New("connection reset")).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("rem"))
+	})
+
+	err := bs.downloadSyncedFile(context.Background(), b, localPath, "docs/notes.txt")
+	assert.Error(t, err)
+
+	data, err := ioutil.ReadFile(localPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("local"), data)
+
+	// the partial download is removed
+	files, _ := ioutil.ReadDir(folder.LocalPath)
+	assert.Len(t, files, 1)
+	_, synced := bs.getSyncedFile(localPath)
+	assert.False(t, synced)
+}
+
+func TestPullSyncFolder_TreatsMissingBucketPathAsEmpty(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	b.On("DirExists", mock.Anything, "docs").Return(false, nil)
+
+	err := bs.pullSyncFolder(context.Background(), b, folder)
+	assert.Nil(t, err)
+	b.AssertNotCalled(t, "ListDirectory", mock.Anything, mock.Anything)
+}
+
+func TestPullSyncFolder_RemovesFilesRemovedRemotely(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	removedPath := filepath.Join(folder.LocalPath, "removed.txt")
+	writeSyncedFile(t, bs, removedPath, 1)
+
+	b.On("DirExists", mock.Anything, "docs").Return(true, nil)
+	b.On("ListDirectory", mock.Anything, "docs").Return(remoteDir(), nil)
+
+	err := bs.pullSyncFolder(context.Background(), b, folder)
+	assert.Nil(t, err)
+
+	_, err = os.Stat(removedPath)
+	assert.True(t, os.IsNotExist(err))
+}
+
+func TestSyncFolder_RemovesFilesRemovedLocallyWhileStopped(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	removedPath := filepath.Join(folder.LocalPath, "removed.txt")
+	editedPath := filepath.Join(folder.LocalPath, "edited.txt")
+	writeSyncedFile(t, bs, removedPath, 1)
+	writeSyncedFile(t, bs, editedPath, 1)
+	assert.Nil(t, os.Remove(removedPath))
+	assert.Nil(t, os.Remove(editedPath))
+
+	b.On("DirExists", mock.Anything, "docs").Return(true, nil)
+	b.On("ListDirectory", mock.Anything, "docs").Return(remoteDir(
+		remoteFile("removed.txt", 1),
+		remoteFile("edited.txt", 2),
+	), nil)
+	b.On("DeleteDirOrFile", mock.Anything, "docs/removed.txt").Return(nil, nil)
+	b.On("GetFile", mock.Anything, "docs/edited.txt", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("edited remotely"))
+	})
+	b.On("UpdatedAt", mock.Anything, "docs/edited.txt").Return(int64(2), nil)
+
+	err := bs.syncFolder(context.Background(), folder)
+	assert.Nil(t, err)
+
+	// unchanged remotely since the last sync
+	b.AssertCalled(t, "DeleteDirOrFile", mock.Anything, "docs/removed.txt")
+	b.AssertNotCalled(t, "GetFile", mock.Anything, "docs/removed.txt", mock.Anything)
+	_, err = os.Stat(removedPath)
+	assert.True(t, os.IsNotExist(err))
+	_, synced := bs.getSyncedFile(removedPath)
+	assert.False(t, synced)
+
+	// changed remotely since the last sync
+	b.AssertNotCalled(t, "DeleteDirOrFile", mock.Anything, "docs/edited.txt")
+	data, err := ioutil.ReadFile(editedPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("edited remotely"), data)
+}
+
+func TestUploadSyncedFile_UploadsNewLocalFile(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	localPath := filepath.Join(folder.LocalPath, "local.txt")
+	assert.Nil(t, ioutil.WriteFile(localPath, []byte("local"), 0644))
+
+	b.On("FileExists", mock.Anything, "docs/local.txt").Return(false, nil)
+	b.On("UploadFile", mock.Anything, "docs/local.txt", mock.Anything).Return(nil, nil, nil)
+	b.On("UpdatedAt", mock.Anything, "docs/local.txt").Return(int64(3), nil)
+
+	err := bs.uploadSyncedFile(context.Background(), b, folder, localPath)
+	assert.Nil(t, err)
+	b.AssertCalled(t, "UploadFile", mock.Anything, "docs/local.txt", mock.Anything)
+
+	// not uploaded again until it changes
+	err = bs.uploadSyncedFile(context.Background(), b, folder, localPath)
+	assert.Nil(t, err)
+	b.AssertNumberOfCalls(t, "UploadFile", 1)
+}
+
+func TestRemoveSyncedItem_RemovesUnchangedFileFromBucket(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	localPath := filepath.Join(folder.LocalPath, "old.txt")
+	writeSyncedFile(t, bs, localPath, 1)
+	assert.Nil(t, os.Remove(localPath))
+
+	b.On("UpdatedAt", mock.Anything, "docs/old.txt").Return(int64(1), nil)
+	b.On("DeleteDirOrFile", mock.Anything, "docs/old.txt").Return(nil, nil)
+
+	err := bs.removeSyncedItem(context.Background(), folder, localPath)
+	assert.Nil(t, err)
+	b.AssertCalled(t, "DeleteDirOrFile", mock.Anything, "docs/old.txt")
+
+	_, synced := bs.getSyncedFile(localPath)
+	assert.False(t, synced)
+}
+
+func TestRemoveSyncedItem_KeepsFileChangedRemotely(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	localPath := filepath.Join(folder.LocalPath, "edited.txt")
+	writeSyncedFile(t, bs, localPath, 1)
+	assert.Nil(t, os.Remove(localPath))
+
+	b.On("UpdatedAt", mock.Anything, "docs/edited.txt").Return(int64(2), nil)
+	b.On("GetFile", mock.Anything, "docs/edited.txt", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("edited remotely"))
+	})
+
+	err := bs.removeSyncedItem(context.Background(), folder, localPath)
+	assert.Nil(t, err)
+	b.AssertNotCalled(t, "DeleteDirOrFile", mock.Anything, mock.Anything)
+
+	data, err := ioutil.ReadFile(localPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("edited remotely"), data)
+}
+
+func TestSyncFolderHandler_OnRename_UploadsMovedDirectoryBeforeRemovingIt(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+	ctx := context.Background()
+
+	fw, err := watcher.NewFolderWatcher()
+	assert.Nil(t, err)
+	t.Cleanup(fw.Close)
+	bs.folderWatcher = fw
+	assert.Nil(t, bs.setSyncFolder(folder))
+
+	oldDir := filepath.Join(folder.LocalPath, "old")
+	newDir := filepath.Join(folder.LocalPath, "new")
+	assert.Nil(t, os.MkdirAll(oldDir, os.ModePerm))
+	writeSyncedFile(t, bs, filepath.Join(oldDir, "a.txt"), 1)
+	assert.Nil(t, os.Rename(oldDir, newDir))
+
+	uploaded := false
+	b.On("DirExists", mock.Anything, "docs/new").Return(false, nil)
+	b.On("CreateDirectory", mock.Anything, "docs/new").Return(nil, nil, nil)
+	b.On("FileExists", mock.Anything, "docs/new/a.txt").Return(false, nil)
+	b.On("UploadFile", mock.Anything, "docs/new/a.txt", mock.Anything).Return(nil, nil, nil).Run(func(args mock.Arguments) {
+		uploaded = true
+	})
+	b.On("UpdatedAt", mock.Anything, "docs/new/a.txt").Return(int64(2), nil)
+	b.On("UpdatedAt", mock.Anything, "docs/old").Return(int64(0), errors.New("not found"))
+	b.On("UpdatedAt", mock.Anything, "docs/old/a.txt").Return(int64(1), nil)
+	b.On("DeleteDirOrFile", mock.Anything, "docs/old").Return(nil, nil).Run(func(args mock.Arguments) {
+		assert.True(t, uploaded)
+	})
+
+	info, err := os.Stat(newDir)
+	assert.Nil(t, err)
+	h := &syncFolderHandler{bs: bs}
+	h.OnRename(ctx, newDir, info, oldDir)
+
+	b.AssertCalled(t, "UploadFile", mock.Anything, "docs/new/a.txt", mock.Anything)
+	b.AssertCalled(t, "DeleteDirOrFile", mock.Anything, "docs/old")
+
+	_, synced := bs.getSyncedFile(filepath.Join(oldDir, "a.txt"))
+	assert.False(t, synced)
+	state, synced := bs.getSyncedFile(filepath.Join(newDir, "a.txt"))
+	assert.True(t, synced)
+	assert.Equal(t, int64(2), state.RemoteUpdatedAt)
+}
+
+func TestKeepBoth_KeepsOneCopyWhenBothSidesMatch(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	localPath := filepath.Join(folder.LocalPath, "same.txt")
+	assert.Nil(t, ioutil.WriteFile(localPath, []byte("same"), 0644))
+
+	b.On("GetFile", mock.Anything, "docs/same.txt", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("same"))
+	})
+	b.On("UpdatedAt", mock.Anything, "docs/same.txt").Return(int64(2), nil)
+
+	err := bs.keepBoth(context.Background(), b, localPath, "docs/same.txt")
+	assert.Nil(t, err)
+
+	files, _ := ioutil.ReadDir(folder.LocalPath)
+	assert.Len(t, files, 1)
+	_, synced := bs.getSyncedFile(localPath)
+	assert.True(t, synced)
+}
+
+func TestKeepBoth_KeepsLocalCopyWhenSidesDiffer(t *testing.T) {
+	bs, b, folder := initFolderSyncTest(t)
+
+	localPath := filepath.Join(folder.LocalPath, "edited.txt")
+	assert.Nil(t, ioutil.WriteFile(localPath, []byte("local"), 0644))
+
+	b.On("GetFile", mock.Anything, "docs/edited.txt", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		args.Get(2).(io.Writer).Write([]byte("remote"))
+	})
+	b.On("UpdatedAt", mock.Anything, "docs/edited.txt").Return(int64(2), nil)
+
+	err := bs.keepBoth(context.Background(), b, localPath, "docs/edited.txt")
+	assert.Nil(t, err)
+
+	files, _ := ioutil.ReadDir(folder.LocalPath)
+	assert.Len(t, files, 2)
+	data, err := ioutil.ReadFile(localPath)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("remote"), data)
+}
+
+func TestRemoveSyncFolder_StopsSyncingTheFolder(t *testing.T) {
+	bs, _, folder := initFolderSyncTest(t)
+
+	fw, err := watcher.NewFolderWatcher()
+	assert.Nil(t, err)
+	t.Cleanup(fw.Close)
+	bs.folderWatcher = fw
+	assert.Nil(t, bs.setSyncFolder(folder))
+	assert.Nil(t, fw.AddFolder(folder.LocalPath))
+
+	localPath := filepath.Join(folder.LocalPath, "a.txt")
+	writeSyncedFile(t, bs, localPath, 1)
+
+	folders, err := bs.ListSyncFolders()
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.SyncFolder{folder}, folders)
+
+	assert.Nil(t, bs.RemoveSyncFolder(folder.LocalPath+string(filepath.Separator)))
+
+	folders, err = bs.ListSyncFolders()
+	assert.Nil(t, err)
+	assert.Empty(t, folders)
+	_, synced := bs.getSyncedFile(localPath)
+	assert.False(t, synced)
+
+	// the files are kept
+	_, err = os.Stat(localPath)
+	assert.Nil(t, err)
+
+	assert.Equal(t, ErrSyncFolderNotFound, bs.RemoveSyncFolder(folder.LocalPath))
+}
+
+func TestAddSyncFolder_RejectsOverlappingFolders(t *testing.T) {
+	bs, _, folder := initFolderSyncTest(t)
+	assert.Nil(t, bs.setSyncFolder(folder))
+
+	nested := domain.SyncFolder{LocalPath: filepath.Join(folder.LocalPath, "notes"), BucketSlug: "personal", BucketPath: "notes"}
+	assert.Error(t, bs.AddSyncFolder(nested))
+
+	parent := domain.SyncFolder{LocalPath: filepath.Dir(folder.LocalPath), BucketSlug: "personal", BucketPath: "all"}
+	assert.Error(t, bs.AddSyncFolder(parent))
+
+	folders, err := bs.ListSyncFolders()
+	assert.Nil(t, err)
+	assert.Equal(t, []domain.SyncFolder{folder}, folders)
+}
+
+// watcher that can not watch any folder
+type failingFolderWatcher struct {
+	watcher.FolderWatcher
+}
+
+func (fw *failingFolderWatcher) AddFolder(path string) error {
+	return errors.New("too many open files")
+}
+
+func (fw *failingFolderWatcher) RemoveFolder(path string) error {
+	return nil
+}
+
+func TestAddSyncFolder_RemovesFolderWhenWatchingFails(t *testing.T) {
+	bs, _, folder := initFolderSyncTest(t)
+	bs.folderWatcher = &failingFolderWatcher{}
+
+	assert.Error(t, bs.AddSyncFolder(folder))
+
+	folders, err := bs.ListSyncFolders()
+	assert.Nil(t, err)
+	assert.Empty(t, folders)
+
+	// it doesn't block the folders inside it
+	nested := domain.SyncFolder{LocalPath: filepath.Join(folder.LocalPath, "notes"), BucketSlug: "personal", BucketPath: "notes"}
+	assert.Nil(t, bs.addSyncFolder(nested))
+}
+
+func TestSyncFolder_MapsPaths(t *testing.T) {
+	folder := domain.SyncFolder{
+		LocalPath:  filepath.Join("home", "user", "Synced"),
+		BucketSlug: "personal",
+		BucketPath: cleanBucketPath("/docs/"),
+	}
+
+	localPath := filepath.Join(folder.LocalPath, "notes", "todo.txt")
+	assert.Equal(t, "docs/notes/todo.txt", bucketPathFor(folder, localPath))
+	mapped, ok := localPathFor(folder, "docs/notes/todo.txt")
+	assert.True(t, ok)
+	assert.Equal(t, localPath, mapped)
+	assert.Equal(t, "docs", bucketPathFor(folder, folder.LocalPath))
+
+	// paths that only share a prefix with the folder are not in it
+	_, ok = localPathFor(folder, "docs2/todo.txt")
+	assert.False(t, ok)
+
+	root := domain.SyncFolder{LocalPath: folder.LocalPath, BucketPath: cleanBucketPath("")}
+	assert.Equal(t, "todo.txt", bucketPathFor(root, filepath.Join(folder.LocalPath, "todo.txt")))
+	mapped, ok = localPathFor(root, "todo.txt")
+	assert.True(t, ok)
+	assert.Equal(t, filepath.Join(folder.LocalPath, "todo.txt"), mapped)
+}
+
+func TestConflictCopyPath(t *testing.T) {
+	at := time.Date(2020, 10, 19, 10, 15, 0, 0, time.UTC)
+
+	assert.Equal(t, "notes (conflicted copy 2020-10-19 101500).txt", conflictCopyPath("notes.txt", at))
+	assert.Equal(t, "Makefile (conflicted copy 2020-10-19 101500)", conflictCopyPath("Makefile", at))
+}
@@ -7,7 +7,6 @@ import (
 
 	ipfspath "github.com/ipfs/interface-go-ipfs-core/path"
 
-	"github.com/FleekHQ/space-daemon/core/textile"
 	"github.com/FleekHQ/space-daemon/log"
 )
 
@@ -23,6 +22,17 @@ func (h *watcherHandler) OnCreate(ctx context.Context, path string, fileInfo os.
 	var newRoot ipfspath.Path
 	var err error
 
+	if _, inSyncFolder := h.bs.getSyncFolderForPath(path); inSyncFolder {
+		// handled by the sync folder handler
+		return
+	}
+
+	if !fileInfo.IsDir() {
+		// e.g. saved by an editor that replaces the file, checked for conflicts same as a write
+		h.syncOpenFile(ctx, path)
+		return
+	}
+
 	watchInfo, exists := h.bs.getOpenFileBucketSlugAndPath(path)
 	if !exists {
 		msg := fmt.Sprintf("error: could not find path %s", path)
@@ -33,6 +43,11 @@ func (h *watcherHandler) OnCreate(ctx context.Context, path string, fileInfo os.
 	bucketSlug := watchInfo.BucketSlug
 	bucketPath := watchInfo.BucketPath
 
+	if isOpenFileContentSynced(watchInfo) {
+		log.Debug(fmt.Sprintf("file at path %s is unchanged since it was synced", path))
+		return
+	}
+
 	b, err := h.bs.textileClient.GetBucket(ctx, bucketSlug, nil)
 	if err != nil {
 		msg := fmt.Sprintf("error: could not find bucket with slug %s", bucketSlug)
@@ -40,40 +55,19 @@ func (h *watcherHandler) OnCreate(ctx context.Context, path string, fileInfo os.
 		return
 	}
 
-	if fileInfo.IsDir() {
-		existsOnTextile, err := b.DirExists(ctx, path)
-		if err != nil {
-			log.Error("Could not check if folder exists on textile", err)
-			return
-		}
-
-		if existsOnTextile {
-			log.Info("Folder alerady exists on textile")
-			return
-		}
-
-		result, newRoot, err = b.CreateDirectory(ctx, path)
-	} else {
-		existsOnTextile, err := b.FileExists(ctx, path)
-		if err != nil {
-			log.Error("Could not check if file exists on textile", err)
-			return
-		}
-
-		if existsOnTextile {
-			log.Info("File alerady exists on textile")
-			return
-		}
-
-		fileReader, err := os.Open(path)
-		if err != nil {
-			log.Error("Could not open file for upload", err)
-			return
-		}
+	existsOnTextile, err := b.DirExists(ctx, path)
+	if err != nil {
+		log.Error("Could not check if folder exists on textile", err)
+		return
+	}
 
-		result, newRoot, err = b.UploadFile(ctx, bucketPath, fileReader)
+	if existsOnTextile {
+		log.Info("Folder alerady exists on textile")
+		return
 	}
 
+	result, newRoot, err = b.CreateDirectory(ctx, path)
+
 	if err != nil {
 		log.Error("Uploading to textile failed", err, fmt.Sprintf("path:%s", path))
 		return
@@ -97,6 +91,11 @@ func (h *watcherHandler) OnRemove(ctx context.Context, path string, fileInfo os.
 	log.Info("FS Handler: OnRemove", fmt.Sprintf("path:%s", path), fmt.Sprintf("fileName:%s", fileInfo.Name()))
 	// TODO: Also synchronizer lock check here
 
+	if _, inSyncFolder := h.bs.getSyncFolderForPath(path); inSyncFolder {
+		// handled by the sync folder handler
+		return
+	}
+
 	watchInfo, exists := h.bs.getOpenFileBucketSlugAndPath(path)
 	if !exists {
 		msg := fmt.Sprintf("error: could not find path %s", path)
@@ -106,6 +105,11 @@ func (h *watcherHandler) OnRemove(ctx context.Context, path string, fileInfo os.
 	bucketSlug := watchInfo.BucketSlug
 	bucketPath := watchInfo.BucketPath
 
+	if isOpenFileContentSynced(watchInfo) {
+		log.Debug(fmt.Sprintf("file at path %s is unchanged since it was synced", path))
+		return
+	}
+
 	b, err := h.bs.textileClient.GetBucket(ctx, bucketSlug, nil)
 	if err != nil {
 		msg := fmt.Sprintf("error: could not find bucket with slug %s", bucketSlug)
@@ -127,10 +131,25 @@ func (h *watcherHandler) OnRemove(ctx context.Context, path string, fileInfo os.
 	// TODO: Update synchronizer/store (maybe in a defer function)
 }
 
-// OnWrite is invoked when a new file is created or files content is updated
+// OnWrite is invoked when a new file is created or files content is updated.
+// The file is not uploaded if its bucket version changed since it was opened, a conflict is reported instead.
 func (h *watcherHandler) OnWrite(ctx context.Context, path string, fileInfo os.FileInfo) {
 	log.Info("FS Handler: OnWrite", fmt.Sprintf("path:%s", path), fmt.Sprintf("fileName:%s", fileInfo.Name()))
 
+	if _, inSyncFolder := h.bs.getSyncFolderForPath(path); inSyncFolder {
+		// handled by the sync folder handler
+		return
+	}
+
+	h.syncOpenFile(ctx, path)
+}
+
+// Uploads the opened file unless its bucket version changed since it was opened, a conflict is reported instead.
+// Files are not uploaded if the conflict check fails, the next write retries.
+func (h *watcherHandler) syncOpenFile(ctx context.Context, path string) {
+	h.bs.openFilesLock.Lock()
+	defer h.bs.openFilesLock.Unlock()
+
 	watchInfo, exists := h.bs.getOpenFileBucketSlugAndPath(path)
 	if !exists {
 		msg := fmt.Sprintf("error: could not find path %s", path)
@@ -138,38 +157,42 @@ func (h *watcherHandler) OnWrite(ctx context.Context, path string, fileInfo os.F
 		return
 	}
 
-	var b textile.Bucket
-	var err error
 	bucketSlug := watchInfo.BucketSlug
 	bucketPath := watchInfo.BucketPath
 
-	if watchInfo.IsRemote {
-		b, err = h.bs.textileClient.GetBucket(ctx, bucketSlug, &textile.GetBucketForRemoteFileInput{
-			Bucket: bucketSlug,
-			DbID:   watchInfo.DbId,
-			Path:   watchInfo.BucketPath,
-		})
-	} else {
-		b, err = h.bs.textileClient.GetBucket(ctx, bucketSlug, nil)
+	if isOpenFileContentSynced(watchInfo) {
+		log.Debug(fmt.Sprintf("file at path %s is unchanged since it was synced", path))
+		return
 	}
 
+	b, err := h.bs.getOpenFileBucket(ctx, watchInfo)
 	if err != nil {
 		msg := fmt.Sprintf("error: could not find bucket with slug %s", bucketSlug)
 		log.Error(msg, fmt.Errorf(msg))
 		return
 	}
-	fileReader, err := os.Open(path)
+
+	conflicted, err := h.bs.checkOpenFileConflict(ctx, b, watchInfo)
 	if err != nil {
-		log.Error("Could not open file for upload", err)
+		log.Error(fmt.Sprintf("error checking for conflicts on file at path %s", path), err)
+		return
+	}
+	if conflicted {
+		log.Info(fmt.Sprintf("conflict: file at path %s changed in bucket %s since it was opened", path, bucketSlug))
 		return
 	}
 
-	_, _, err = b.UploadFile(ctx, bucketPath, fileReader)
+	cid, contentHash, err := uploadOpenFile(ctx, b, bucketPath, path)
 	if err != nil {
 		msg := fmt.Sprintf("error: could not sync file at path %s to bucket %s as %s", path, bucketSlug, bucketPath)
 		log.Error(msg, fmt.Errorf(msg))
 		return
 	}
+
+	if err := h.bs.updateOpenFileVersion(watchInfo, cid, contentHash); err != nil {
+		log.Error(fmt.Sprintf("error updating open file info of %s", path), err)
+	}
+
 	msg := fmt.Sprintf("success syncing file at path %s to bucket %s as %s", path, bucketSlug, bucketPath)
 	log.Printf(msg)
 
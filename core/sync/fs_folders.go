@@ -0,0 +1,77 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/FleekHQ/space-daemon/log"
+)
+
+func (h *syncFolderHandler) OnCreate(ctx context.Context, path string, fileInfo os.FileInfo) {
+	h.upload(ctx, path, fileInfo)
+}
+
+func (h *syncFolderHandler) OnRemove(ctx context.Context, path string, fileInfo os.FileInfo) {
+	folder, exists := h.bs.getSyncFolderForPath(path)
+	if !exists || path == folder.LocalPath {
+		return
+	}
+
+	log.Info("Sync Folder Handler: OnRemove", fmt.Sprintf("path:%s", path))
+
+	h.bs.folders.lock.Lock()
+	defer h.bs.folders.lock.Unlock()
+
+	if err := h.bs.removeSyncedItem(ctx, folder, path); err != nil {
+		log.Error(fmt.Sprintf("error removing %s from bucket %s", path, folder.BucketSlug), err)
+	}
+}
+
+func (h *syncFolderHandler) OnWrite(ctx context.Context, path string, fileInfo os.FileInfo) {
+	h.upload(ctx, path, fileInfo)
+}
+
+func (h *syncFolderHandler) OnRename(ctx context.Context, path string, fileInfo os.FileInfo, oldPath string) {
+	h.move(ctx, path, fileInfo, oldPath)
+}
+
+func (h *syncFolderHandler) OnMove(ctx context.Context, path string, fileInfo os.FileInfo, oldPath string) {
+	h.move(ctx, path, fileInfo, oldPath)
+}
+
+// A moved directory comes as a single event without events for its children.
+// Its files are uploaded under the new path before the old path is removed,
+// so other devices don't lose them in between.
+func (h *syncFolderHandler) move(ctx context.Context, path string, fileInfo os.FileInfo, oldPath string) {
+	h.upload(ctx, path, fileInfo)
+	h.OnRemove(ctx, oldPath, fileInfo)
+}
+
+func (h *syncFolderHandler) upload(ctx context.Context, path string, fileInfo os.FileInfo) {
+	folder, exists := h.bs.getSyncFolderForPath(path)
+	if !exists || path == folder.LocalPath {
+		return
+	}
+
+	log.Info("Sync Folder Handler: upload", fmt.Sprintf("path:%s", path))
+
+	h.bs.folders.lock.Lock()
+	defer h.bs.folders.lock.Unlock()
+
+	b, err := h.bs.textileClient.GetBucket(ctx, folder.BucketSlug, nil)
+	if err != nil {
+		log.Error(fmt.Sprintf("error: could not find bucket with slug %s", folder.BucketSlug), err)
+		return
+	}
+
+	if fileInfo.IsDir() {
+		err = h.bs.uploadSyncedDir(ctx, b, folder, path)
+	} else {
+		err = h.bs.uploadSyncedFile(ctx, b, folder, path)
+	}
+
+	if err != nil {
+		log.Error(fmt.Sprintf("error syncing %s to bucket %s", path, folder.BucketSlug), err)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	gosync "sync"
+	"time"
 
 	"github.com/FleekHQ/space-daemon/core/events"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
@@ -37,6 +39,13 @@ type BucketSynchronizer interface {
 	Shutdown() error
 	RegisterNotifier(notifier GrpcNotifier)
 	AddFileWatch(addFileInfo domain.AddWatchFile) error
+	AddSyncFolder(folder domain.SyncFolder) error
+	RemoveSyncFolder(localPath string) error
+	ListSyncFolders() ([]domain.SyncFolder, error)
+	RenameBucket(bucketSlug, newBucketSlug string) error
+	RemoveBucket(bucketSlug string) error
+	ListFileConflicts() ([]domain.FileConflict, error)
+	ResolveFileConflict(ctx context.Context, localPath string, resolution domain.FileConflictResolution) error
 	GetOpenFilePath(bucketSlug, bucketPath, dbID, cid string) (string, bool)
 }
 
@@ -50,6 +59,11 @@ type watcherHandler struct {
 	bs     *bucketSynchronizer
 }
 
+// Implementation to handle events from FS in sync folders
+type syncFolderHandler struct {
+	bs *bucketSynchronizer
+}
+
 // Implementation to handle events from textile
 type textileHandler struct {
 	notifier TextileNotifier
@@ -60,9 +74,13 @@ type bucketSynchronizer struct {
 	folderWatcher watcher.FolderWatcher
 	textileClient textile.Client
 	fh            *watcherHandler
+	sh            *syncFolderHandler
 	th            *textileHandler
 	notifier      GrpcNotifier
 	store         store.Store
+	folders       folderSync
+	// guards the open files info while uploading or resolving conflicts
+	openFilesLock gosync.Mutex
 	ready         chan bool
 }
 
@@ -81,6 +99,7 @@ func New(
 		th:            nil,
 		notifier:      notifier,
 		store:         store,
+		folders:       folderSync{pullTimers: map[string]*time.Timer{}},
 		ready:         make(chan bool),
 	}
 }
@@ -97,7 +116,12 @@ func (bs *bucketSynchronizer) Start(ctx context.Context) error {
 		bs:     bs,
 	}
 
+	bs.sh = &syncFolderHandler{
+		bs: bs,
+	}
+
 	bs.folderWatcher.RegisterHandler(bs.fh)
+	bs.folderWatcher.RegisterHandler(bs.sh)
 
 	g, newCtx := errgroup.WithContext(ctx)
 
@@ -125,6 +149,12 @@ func (bs *bucketSynchronizer) Start(ctx context.Context) error {
 		}
 	}
 
+	log.Debug("start watching sync folders ...")
+	if err := bs.startSyncFolders(); err != nil {
+		log.Error("error starting sync folders", err)
+		return err
+	}
+
 	bs.ready <- true
 
 	err = g.Wait()
@@ -31,6 +31,7 @@ type BucketsClient interface {
 	RemovePath(ctx context.Context, key, pth string, opts ...bucketsClient.Option) (path.Resolved, error)
 	ListIpfsPath(ctx context.Context, ipfsPath path.Path) (*bucketsproto.ListIpfsPathResponse, error)
 	PushPathAccessRoles(ctx context.Context, key, path string, roles map[string]buckets.Role) error
+	PullPathAccessRoles(ctx context.Context, key, path string) (map[string]buckets.Role, error)
 }
 
 type EachFunc = func(ctx context.Context, b *Bucket, path string) error
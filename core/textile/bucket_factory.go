@@ -4,14 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/FleekHQ/space-daemon/core/textile/common"
 
 	"github.com/FleekHQ/space-daemon/config"
+	"github.com/FleekHQ/space-daemon/core/keychain"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	ma "github.com/multiformats/go-multiaddr"
 
 	"github.com/FleekHQ/space-daemon/core/textile/bucket"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
 	"github.com/FleekHQ/space-daemon/core/textile/utils"
 	"github.com/FleekHQ/space-daemon/log"
 	"github.com/alecthomas/jsonschema"
@@ -137,7 +140,7 @@ func (tc *textileClient) getOrCreateBucketContext(ctx context.Context, bucketSlu
 	if err != nil {
 		return nil, nil, err
 	}
-	pk, _, err := tc.kc.GetStoredKeyPairInLibP2PFormat()
+	pk, err := tc.kc.GetLogKey()
 	if err != nil {
 		return nil, nil, err
 	}
@@ -191,7 +194,7 @@ func (tc *textileClient) listBuckets(ctx context.Context) ([]Bucket, error) {
 }
 
 func (tc *textileClient) getBucketRootFromReceivedFile(ctx context.Context, file *GetBucketForRemoteFileInput) (*buckets_pb.Root, bucket.GetBucketContextFn, error) {
-	receivedFile, err := tc.GetModel().FindReceivedFile(ctx, file.DbID, file.Bucket, file.Path)
+	receivedFile, err := tc.GetModel().FindReceivedFileForPath(ctx, file.DbID, file.Bucket, file.Path)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -267,6 +270,17 @@ func (tc *textileClient) getBucketRootFromSlug(ctx context.Context, slug string)
 		}
 	}
 
+	// roots cannot be renamed, so renamed buckets keep the root named after the slug they were created with
+	if bs, err := tc.GetModel().FindBucket(ctx, slug); err == nil && bs.RootSlug() != slug {
+		for _, root := range bucketListReply.Roots {
+			if root.Name == bs.RootSlug() {
+				renamed := *root
+				renamed.Name = slug
+				return ctx, &renamed, nil
+			}
+		}
+	}
+
 	return nil, nil, NotFound(slug)
 }
 
@@ -390,7 +404,7 @@ func (tc *textileClient) joinBucketViaAddress(ctx context.Context, address strin
 	}
 
 	// Create bucket in buckets client in case it's not already there
-	tc.bucketsClient.Create(newBucketCtx, bc.WithName(bucketSlug))
+	tc.bucketsClient.Create(newBucketCtx, bc.WithName(newBucket.RootSlug()))
 
 	return nil
 }
@@ -428,27 +442,177 @@ func (tc *textileClient) JoinBucket(ctx context.Context, slug string, ti *domain
 	return true, nil
 }
 
-func (tc *textileClient) ToggleBucketBackup(ctx context.Context, bucketSlug string, bucketBackup bool) (bool, error) {
-	bucketSchema, err := tc.GetModel().BucketBackupToggle(ctx, bucketSlug, bucketBackup)
+var errDefaultBucket = errors.New("the default buckets cannot be deleted or renamed")
+
+var errInvalidBucketSlug = errors.New("the bucket slug cannot contain '#' or '/'")
+
+// validateBucketSlug checks the slug can be used in the store keys and paths of the bucket
+func validateBucketSlug(slug string) error {
+	if slug == "" {
+		return errors.New("the bucket slug cannot be empty")
+	}
+
+	if strings.ContainsAny(slug, "#/") {
+		return errInvalidBucketSlug
+	}
+
+	return nil
+}
+
+func isDefaultBucketSlug(slug string) bool {
+	switch slug {
+	case defaultPersonalBucketSlug, defaultCacheBucketSlug, defaultPersonalMirrorBucketSlug, defaultPublicShareBucketSlug:
+		return true
+	}
+
+	return false
+}
+
+// DeleteBucket removes the bucket with its thread, its replica on the hub and its metadata.
+// The metadata and the pending sync tasks are only removed once the bucket and its thread are deleted,
+// so a failed deletion leaves the bucket usable and can be retried.
+func (tc *textileClient) DeleteBucket(ctx context.Context, bucketSlug string) error {
+	if err := tc.requiresRunning(); err != nil {
+		return err
+	}
+
+	if isDefaultBucketSlug(bucketSlug) {
+		return errDefaultBucket
+	}
+
+	bs, err := tc.GetModel().FindBucket(ctx, bucketSlug)
 	if err != nil {
-		return false, err
+		return err
 	}
 
-	if bucketSchema.Backup {
-		tc.sync.NotifyBucketBackupOn(bucketSlug)
-	} else {
-		tc.sync.NotifyBucketBackupOff(bucketSlug)
+	// stop listening first, so remote events do not restore the bucket while it is removed
+	listening := tc.isListening(bucketSlug)
+	tc.closeListener(bucketSlug)
+
+	if err := tc.removeBucket(ctx, bucketSlug, bs); err != nil {
+		if listening {
+			// the listener outlives the request, it is closed on shutdown
+			tc.addListener(context.Background(), bucketSlug)
+		}
+		return err
 	}
 
-	return bucketSchema.Backup, nil
+	tc.sync.NotifyBucketRemoved(bucketSlug)
+	tc.resetQuotaAlert(bucketSlug)
+
+	return tc.GetModel().DeleteBucket(ctx, bucketSlug)
 }
 
-func (tc *textileClient) BucketBackupRestore(ctx context.Context, bucketSlug string) error {
-	tc.sync.NotifyBucketRestore(bucketSlug)
+// removeBucket removes the replica of the bucket on the hub, then the local bucket and its thread.
+// The replica is unset from the bucket metadata once removed, so retrying a failed deletion skips it.
+func (tc *textileClient) removeBucket(ctx context.Context, bucketSlug string, bs *model.BucketSchema) error {
+	if bs.MirrorBucketSchema != nil && bs.RemoteBucketKey != "" {
+		hubCtx, _, err := tc.getBucketContext(ctx, bs.RemoteDbID, bs.RemoteBucketSlug, true, bs.EncryptionKey)
+		if err != nil {
+			return err
+		}
+
+		if err := tc.hb.Remove(hubCtx, bs.RemoteBucketKey); err != nil {
+			return err
+		}
+
+		if _, err := tc.GetModel().CreateMirrorBucket(ctx, bucketSlug, &model.MirrorBucketSchema{}); err != nil {
+			return err
+		}
+	}
+
+	bucketCtx, root, err := tc.getBucketRootFromSlug(ctx, bucketSlug)
+	if err != nil {
+		return err
+	}
+
+	if err := tc.bucketsClient.Remove(bucketCtx, root.Key); err != nil {
+		return err
+	}
+
+	dbID, err := utils.ParseDbIDFromString(bs.DbID)
+	if err != nil {
+		return err
+	}
+
+	if err := tc.threads.DeleteDB(ctx, *dbID); err != nil {
+		log.Error("Unable to delete the thread of bucket "+bucketSlug, err)
+		return err
+	}
 
 	return nil
 }
 
+// RenameBucket changes the slug of the bucket. It keeps its thread, keys and replica on the hub.
+// Nothing is moved on disk, only the bucket metadata, the pending sync tasks and the listener follow the new slug.
+// The metadata is shared with the other devices of the user, which list the bucket with the new slug
+// once it syncs, but keep listening and syncing with the old slug until their daemon restarts.
+func (tc *textileClient) RenameBucket(ctx context.Context, bucketSlug, newBucketSlug string) (Bucket, error) {
+	if err := tc.requiresRunning(); err != nil {
+		return nil, err
+	}
+
+	if err := validateBucketSlug(newBucketSlug); err != nil {
+		return nil, err
+	}
+
+	if isDefaultBucketSlug(bucketSlug) || isDefaultBucketSlug(newBucketSlug) {
+		return nil, errDefaultBucket
+	}
+
+	if newBucketSlug == bucketSlug {
+		return tc.getBucket(ctx, bucketSlug, nil)
+	}
+
+	listening := tc.isListening(bucketSlug)
+	tc.closeListener(bucketSlug)
+
+	// the listener outlives the request, it is closed on shutdown
+	listenerCtx := context.Background()
+
+	if _, err := tc.GetModel().RenameBucket(ctx, bucketSlug, newBucketSlug); err != nil {
+		if listening {
+			tc.addListener(listenerCtx, bucketSlug)
+		}
+		return nil, err
+	}
+
+	tc.sync.NotifyBucketRenamed(bucketSlug, newBucketSlug)
+	tc.resetQuotaAlert(bucketSlug)
+
+	if listening {
+		tc.addListener(listenerCtx, newBucketSlug)
+	}
+
+	return tc.getBucket(ctx, newBucketSlug, nil)
+}
+
+// BucketBackupRestore restores the bucket from its backup, only the files under opts.Paths if set.
+// With opts.DryRun, nothing is restored and the plan lists the files that would be downloaded.
+func (tc *textileClient) BucketBackupRestore(ctx context.Context, bucketSlug string, opts domain.RestoreOptions) (domain.RestorePlan, error) {
+	if opts.DryRun {
+		return tc.sync.PlanBucketRestore(ctx, bucketSlug, opts.Paths, opts.MetadataOnly)
+	}
+
+	if len(opts.Paths) == 0 && !opts.MetadataOnly {
+		tc.sync.NotifyBucketRestore(bucketSlug)
+	} else {
+		tc.sync.NotifySelectiveBucketRestore(bucketSlug, opts.Paths, opts.MetadataOnly)
+	}
+
+	return domain.RestorePlan{}, nil
+}
+
+// RestoreLazyFile downloads the content of a file restored with metadata only, before it is opened
+func (tc *textileClient) RestoreLazyFile(ctx context.Context, bucketSlug, path string) error {
+	return tc.sync.RestoreLazyFile(ctx, bucketSlug, path)
+}
+
+// GetLazyRestoredFile returns the metadata of a file restored with metadata only, listed locally without its content
+func (tc *textileClient) GetLazyRestoredFile(bucketSlug, path string) (domain.LazyRestoredFile, bool) {
+	return tc.sync.GetLazyRestoredFile(bucketSlug, path)
+}
+
 func (tc *textileClient) IsBucketBackup(ctx context.Context, bucketSlug string) bool {
 	bucketSchema, err := tc.GetModel().FindBucket(ctx, bucketSlug)
 	if err != nil {
@@ -507,7 +671,7 @@ func (tc *textileClient) restoreBuckets(ctx context.Context) error {
 
 	hubmaStr := tc.cfg.GetString(config.TextileHubMa, "")
 
-	pk, _, err := tc.kc.GetStoredKeyPairInLibP2PFormat()
+	pk, err := tc.kc.GetLogKey()
 	if err != nil {
 		return err
 	}
@@ -525,7 +689,7 @@ func (tc *textileClient) restoreBuckets(ctx context.Context) error {
 		if replThreadExists {
 			hubmaWithThreadID := hubmaStr + "/thread/" + dbID.String()
 
-			managedKey, err := tc.kc.GetManagedThreadKey(getBucketThreadManagedKey(b.Slug))
+			managedKey, err := getBucketThreadKey(tc.kc, b)
 			if err != nil {
 				return err
 			}
@@ -552,6 +716,69 @@ func (tc *textileClient) restoreBuckets(ctx context.Context) error {
 	return nil
 }
 
+// GetManagedThreadKeys returns the managed thread keys of the user buckets by key name.
+// The keys are derived from the user private key, but are exported so they can be included in backups.
+// Keys stored in the bucket metadata after a key rotation are restored with the metathread, so they are left out.
+func (tc *textileClient) GetManagedThreadKeys(ctx context.Context) (map[string]string, error) {
+	bucketList, err := tc.GetModel().ListBuckets(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[string]string)
+	for _, b := range bucketList {
+		if b.ThreadKey != "" {
+			continue
+		}
+
+		name := getBucketThreadManagedKey(b.RootSlug())
+		managedKey, err := tc.kc.GetManagedThreadKey(name)
+		if err != nil {
+			return nil, err
+		}
+
+		keys[name] = managedKey.String()
+	}
+
+	return keys, nil
+}
+
+// StoreBucketThreadKeys stores the key of every bucket thread in the bucket metadata.
+// Keys derived from the identity key are stored before rotating it, since the next key derives other ones
+// and the threads could not be joined again when restoring the buckets with it.
+func (tc *textileClient) StoreBucketThreadKeys(ctx context.Context) error {
+	bucketList, err := tc.GetModel().ListBuckets(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, b := range bucketList {
+		if b.ThreadKey != "" {
+			continue
+		}
+
+		managedKey, err := tc.kc.GetManagedThreadKey(getBucketThreadManagedKey(b.RootSlug()))
+		if err != nil {
+			return err
+		}
+
+		if _, err := tc.GetModel().UpdateBucketThreadKey(ctx, b.Slug, managedKey.String()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Returns the key of the bucket thread, stored in the bucket metadata or derived from the identity key
+func getBucketThreadKey(kc keychain.Keychain, b *model.BucketSchema) (thread.Key, error) {
+	if b.ThreadKey != "" {
+		return thread.KeyFromString(b.ThreadKey)
+	}
+
+	return kc.GetManagedThreadKey(getBucketThreadManagedKey(b.RootSlug()))
+}
+
 func getBucketThreadManagedKey(bucketSlug string) string {
 	return "bucketKey_" + bucketSlug
 }
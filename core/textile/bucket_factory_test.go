@@ -0,0 +1,16 @@
+package textile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBucketSlug(t *testing.T) {
+	assert.Nil(t, validateBucketSlug("photos"))
+	assert.Nil(t, validateBucketSlug("my photos-2020"))
+
+	assert.NotNil(t, validateBucketSlug(""))
+	assert.Equal(t, errInvalidBucketSlug, validateBucketSlug("photos#2020"))
+	assert.Equal(t, errInvalidBucketSlug, validateBucketSlug("photos/2020"))
+}
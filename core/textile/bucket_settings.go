@@ -0,0 +1,171 @@
+package textile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/log"
+)
+
+var errInvalidBucketSettings = errors.New("invalid bucket settings")
+
+func (tc *textileClient) GetBucketSettings(ctx context.Context, bucketSlug string) (domain.BucketSettings, error) {
+	bucketSchema, err := tc.GetModel().FindBucket(ctx, bucketSlug)
+	if err != nil {
+		return domain.BucketSettings{}, err
+	}
+
+	return bucketSchema.GetSettings(), nil
+}
+
+// UpdateBucketSettings stores the settings and backs up the bucket again if its backup policy changed
+func (tc *textileClient) UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (domain.BucketSettings, error) {
+	if err := validateBucketSettings(settings); err != nil {
+		return domain.BucketSettings{}, err
+	}
+
+	current, err := tc.GetBucketSettings(ctx, bucketSlug)
+	if err != nil {
+		return domain.BucketSettings{}, err
+	}
+
+	bucketSchema, err := tc.GetModel().UpdateBucketSettings(ctx, bucketSlug, settings)
+	if err != nil {
+		return domain.BucketSettings{}, err
+	}
+
+	if current.BackupPolicy != settings.BackupPolicy {
+		if settings.BackupPolicy == domain.BackupOff {
+			tc.sync.NotifyBucketBackupOff(bucketSlug)
+		} else {
+			tc.sync.NotifyBucketBackupOn(bucketSlug)
+		}
+	}
+
+	if current.QuotaBytes != settings.QuotaBytes || current.QuotaAlertPercent != settings.QuotaAlertPercent {
+		tc.resetQuotaAlert(bucketSlug)
+		go tc.checkBucketQuota(context.Background(), bucketSlug)
+	}
+
+	return bucketSchema.GetSettings(), nil
+}
+
+// ToggleBucketBackup switches the backup policy of the bucket between on and off.
+// The backup is triggered again even if the policy did not change.
+func (tc *textileClient) ToggleBucketBackup(ctx context.Context, bucketSlug string, bucketBackup bool) (bool, error) {
+	settings, err := tc.GetBucketSettings(ctx, bucketSlug)
+	if err != nil {
+		return false, err
+	}
+
+	currentPolicy := settings.BackupPolicy
+	settings.BackupPolicy = domain.BackupOff
+	if bucketBackup {
+		settings.BackupPolicy = domain.BackupOn
+	}
+
+	settings, err = tc.UpdateBucketSettings(ctx, bucketSlug, settings)
+	if err != nil {
+		return false, err
+	}
+
+	// UpdateBucketSettings only notifies policy changes
+	if currentPolicy == settings.BackupPolicy {
+		if settings.BackupPolicy == domain.BackupOn {
+			tc.sync.NotifyBucketBackupOn(bucketSlug)
+		} else {
+			tc.sync.NotifyBucketBackupOff(bucketSlug)
+		}
+	}
+
+	return settings.BackupPolicy == domain.BackupOn, nil
+}
+
+func validateBucketSettings(settings domain.BucketSettings) error {
+	switch {
+	case !settings.BackupPolicy.IsValid():
+		return fmt.Errorf("%s: unknown backup policy %s", errInvalidBucketSettings.Error(), settings.BackupPolicy)
+	case settings.QuotaBytes < 0:
+		return fmt.Errorf("%s: quota should not be negative", errInvalidBucketSettings.Error())
+	case settings.QuotaAlertPercent < 0 || settings.QuotaAlertPercent > 100:
+		return fmt.Errorf("%s: quota alert percent should be between 0 and 100", errInvalidBucketSettings.Error())
+	case settings.VersionRetention < 0:
+		return fmt.Errorf("%s: version retention should not be negative", errInvalidBucketSettings.Error())
+	case settings.DefaultShareRole == domain.NoneShareRole:
+		return fmt.Errorf("%s: default share role should grant access", errInvalidBucketSettings.Error())
+	}
+
+	return nil
+}
+
+// Sends a usage alert once the local bucket reaches the alert level of its quota.
+// The alert is sent again only after the usage went below that level.
+func (tc *textileClient) checkBucketQuota(ctx context.Context, bucketSlug string) {
+	settings, err := tc.GetBucketSettings(ctx, bucketSlug)
+	if err != nil || settings.QuotaBytes == 0 {
+		return
+	}
+
+	b, err := tc.getBucket(ctx, bucketSlug, nil)
+	if err != nil {
+		log.Error("unable to get bucket to check its quota", err)
+		return
+	}
+
+	dir, err := b.ListDirectory(ctx, "")
+	if err != nil {
+		log.Error("unable to get bucket usage to check its quota", err)
+		return
+	}
+
+	used := dir.Item.Size
+	if !quotaAlertReached(used, settings) {
+		tc.resetQuotaAlert(bucketSlug)
+		return
+	}
+
+	tc.quotaAlertsLock.Lock()
+	alerted := tc.quotaAlerts[bucketSlug]
+	tc.quotaAlerts[bucketSlug] = true
+	tc.quotaAlertsLock.Unlock()
+
+	if alerted || tc.mbNotifier == nil {
+		return
+	}
+
+	alert := domain.UsageAlert{
+		Used:    used,
+		Limit:   settings.QuotaBytes,
+		Message: fmt.Sprintf("bucket %s uses %d of its %d bytes quota", bucketSlug, used, settings.QuotaBytes),
+	}
+
+	createdAt := time.Now().Unix()
+	tc.mbNotifier.SendNotificationEvent(&domain.Notification{
+		ID:               fmt.Sprintf("quota_%s_%d", bucketSlug, createdAt),
+		Subject:          "Bucket quota",
+		Body:             alert.Message,
+		NotificationType: domain.USAGEALERT,
+		CreatedAt:        createdAt,
+		UsageAlertValue:  alert,
+		RelatedObject:    alert,
+	})
+}
+
+func quotaAlertReached(used int64, settings domain.BucketSettings) bool {
+	percent := int64(settings.QuotaAlertPercent)
+	if percent == 0 {
+		percent = 100
+	}
+
+	return used*100 >= settings.QuotaBytes*percent
+}
+
+func (tc *textileClient) resetQuotaAlert(bucketSlug string) {
+	tc.quotaAlertsLock.Lock()
+	defer tc.quotaAlertsLock.Unlock()
+
+	delete(tc.quotaAlerts, bucketSlug)
+}
@@ -0,0 +1,40 @@
+package textile
+
+import (
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidateBucketSettings(t *testing.T) {
+	valid := domain.BucketSettings{
+		BackupPolicy:     domain.BackupMetadataOnly,
+		QuotaBytes:       1024,
+		DefaultShareRole: domain.ViewerShareRole,
+	}
+	assert.Nil(t, validateBucketSettings(valid))
+
+	invalid := []func(s *domain.BucketSettings){
+		func(s *domain.BucketSettings) { s.BackupPolicy = "sometimes" },
+		func(s *domain.BucketSettings) { s.QuotaBytes = -1 },
+		func(s *domain.BucketSettings) { s.QuotaAlertPercent = 101 },
+		func(s *domain.BucketSettings) { s.VersionRetention = -1 },
+		func(s *domain.BucketSettings) { s.DefaultShareRole = domain.NoneShareRole },
+	}
+	for _, change := range invalid {
+		settings := valid
+		change(&settings)
+		assert.NotNil(t, validateBucketSettings(settings))
+	}
+}
+
+func TestQuotaAlertReached(t *testing.T) {
+	settings := domain.BucketSettings{QuotaBytes: 1000}
+	assert.False(t, quotaAlertReached(999, settings))
+	assert.True(t, quotaAlertReached(1000, settings))
+
+	settings.QuotaAlertPercent = 80
+	assert.False(t, quotaAlertReached(799, settings))
+	assert.True(t, quotaAlertReached(800, settings))
+}
@@ -73,8 +73,13 @@ type textileClient struct {
 	notifier           bucket.Notifier
 	ipfsClient         iface.CoreAPI
 	dbListeners        map[string]Listener
+	dbListenersLock    *sync.Mutex
+	attachedHandlers   *attachedListenerHandlers
 	shouldForceRestore bool
 	healthcheckMutex   *sync.Mutex
+	quotaAlerts        map[string]bool
+	quotaAlertsLock    *sync.Mutex
+	mailboxActions     chan mailboxAction
 }
 
 // Creates a new Textile Client
@@ -113,8 +118,13 @@ func NewClient(
 		sync:               nil,
 		notifier:           nil,
 		dbListeners:        make(map[string]Listener),
+		dbListenersLock:    &sync.Mutex{},
+		attachedHandlers:   &attachedListenerHandlers{},
 		shouldForceRestore: false,
 		healthcheckMutex:   &sync.Mutex{},
+		quotaAlerts:        make(map[string]bool),
+		quotaAlertsLock:    &sync.Mutex{},
+		mailboxActions:     make(chan mailboxAction, mailboxActionsQueueSize),
 		filesSearchEngine:  search,
 	}
 }
@@ -181,7 +191,10 @@ func (tc *textileClient) initializeSync(ctx context.Context) {
 		tc.addListener,
 	)
 
-	tc.notifier = notifier.New(tc.sync)
+	tc.notifier = notifier.New(tc.sync, func(bucketSlug string) {
+		// the bucket is locked during the upload
+		go tc.checkBucketQuota(context.Background(), bucketSlug)
+	})
 
 	if err := tc.sync.RestoreQueue(); err != nil {
 		log.Warn("Could not restore Textile synchronizer queue. Queue will start fresh.")
@@ -258,6 +271,9 @@ func (tc *textileClient) start(ctx context.Context, cfg config.Config) error {
 
 	tc.healthcheck(ctx)
 
+	go tc.runMaintenance(ctx)
+	go tc.processMailboxActions(ctx)
+
 	tc.Ready <- true
 
 	// Repeating healthcheck
@@ -392,6 +408,11 @@ func (tc *textileClient) initialize(ctx context.Context) error {
 		return err
 	}
 
+	if err := tc.GetModel().Migrate(ctx); err != nil {
+		log.Error("Error migrating the metathread collections", err)
+		return err
+	}
+
 	buckets, err := tc.listBuckets(ctx)
 	if err != nil {
 		return err
@@ -525,7 +546,7 @@ func (tc *textileClient) healthcheck(ctx context.Context) {
 
 	tc.checkHubConnection(ctx)
 
-	if len(tc.dbListeners) == 0 {
+	if !tc.hasListeners() {
 		tc.initializeListeners(ctx)
 	}
 
@@ -560,6 +581,29 @@ func (tc *textileClient) healthcheck(ctx context.Context) {
 	}
 }
 
+const maintenanceInterval = 60 * time.Second
+
+// Runs the periodic cleanups that call the hub, outside of the healthcheck lock
+func (tc *textileClient) runMaintenance(ctx context.Context) {
+	for {
+		select {
+		case <-time.After(maintenanceInterval):
+			if !tc.isRunning {
+				return
+			}
+
+			if tc.isInitialized && tc.isConnectedToHub {
+				tc.revokeInactivePublicLinks(ctx)
+				tc.expireSentInvitations(ctx)
+			}
+		case <-ctx.Done():
+			return
+		case <-tc.shuttingDown:
+			return
+		}
+	}
+}
+
 func (tc *textileClient) RemoveKeys(ctx context.Context) error {
 	if err := tc.hubAuth.ClearCache(); err != nil {
 		return err
@@ -1,6 +1,8 @@
 package textile
 
 import (
+	gosync "sync"
+
 	"github.com/FleekHQ/space-daemon/core/store"
 	"github.com/FleekHQ/space-daemon/core/textile/bucket"
 	"github.com/FleekHQ/space-daemon/core/textile/sync"
@@ -31,6 +33,44 @@ func (h *defaultListenerHandler) OnSave(bucketData *bucket.BucketData, listenEve
 	log.Info("Default Listener Handler: OnSave")
 }
 
+// Forwards the events to the handlers attached to the client
+type attachedListenerHandlers struct {
+	mu       gosync.RWMutex
+	handlers []EventHandler
+}
+
+func (h *attachedListenerHandlers) attach(handler EventHandler) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handlers = append(h.handlers, handler)
+}
+
+func (h *attachedListenerHandlers) list() []EventHandler {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return h.handlers
+}
+
+func (h *attachedListenerHandlers) OnCreate(bucketData *bucket.BucketData, listenEvent *tc.ListenEvent) {
+	for _, handler := range h.list() {
+		handler.OnCreate(bucketData, listenEvent)
+	}
+}
+
+func (h *attachedListenerHandlers) OnRemove(bucketData *bucket.BucketData, listenEvent *tc.ListenEvent) {
+	for _, handler := range h.list() {
+		handler.OnRemove(bucketData, listenEvent)
+	}
+}
+
+func (h *attachedListenerHandlers) OnSave(bucketData *bucket.BucketData, listenEvent *tc.ListenEvent) {
+	for _, handler := range h.list() {
+		handler.OnSave(bucketData, listenEvent)
+	}
+}
+
 type restorerListenerHandler struct {
 	synchronizer sync.Synchronizer
 	st           store.Store
@@ -161,13 +161,11 @@ func (h *hub) getTokensThroughChallenge(ctx context.Context) (*inMessageTokenVal
 	defer conn.Close()
 	log.Debug("Token Challenge: Connected")
 
-	privateKey, _, err := h.kc.GetStoredKeyPairInLibP2PFormat()
-
+	// the challenge is signed through the signer so the key can live outside of the daemon
+	identity, err := keychain.NewSignerIdentity(ctx, h.kc.GetSigner())
 	if err != nil {
 		return nil, err
 	}
-
-	identity := thread.NewLibp2pIdentity(privateKey)
 	pub := identity.GetPublic().String()
 
 	// Request a challenge (a payload we need to sign)
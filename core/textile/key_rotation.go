@@ -0,0 +1,178 @@
+package textile
+
+import (
+	"context"
+	"encoding/hex"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
+	"github.com/FleekHQ/space-daemon/log"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	"github.com/pkg/errors"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/buckets"
+)
+
+var errInvalidKeyRotationNotice = errors.New("invalid key rotation notice")
+
+// Checks the notice was signed by both the old and the new key
+func verifyKeyRotationNotice(notice domain.KeyRotationNotice) (crypto.PubKey, crypto.PubKey, error) {
+	oldPub, err := decodeHexPublicKey(notice.OldPublicKey)
+	if err != nil {
+		return nil, nil, errInvalidKeyRotationNotice
+	}
+
+	newPub, err := decodeHexPublicKey(notice.NewPublicKey)
+	if err != nil {
+		return nil, nil, errInvalidKeyRotationNotice
+	}
+
+	payload := notice.SigningPayload()
+	if ok, err := oldPub.Verify(payload, notice.OldKeySignature); err != nil || !ok {
+		return nil, nil, errInvalidKeyRotationNotice
+	}
+
+	if ok, err := newPub.Verify(payload, notice.NewKeySignature); err != nil || !ok {
+		return nil, nil, errInvalidKeyRotationNotice
+	}
+
+	return oldPub, newPub, nil
+}
+
+func decodeHexPublicKey(pk string) (crypto.PubKey, error) {
+	b, err := hex.DecodeString(pk)
+	if err != nil {
+		return nil, err
+	}
+
+	return crypto.UnmarshalEd25519PublicKey(b)
+}
+
+// Moves what was shared with the old key of a contact to their new key.
+// The old key loses access since it is the one that could have leaked.
+// The notice is sent once the contact switched keys, so it comes from the new key.
+func (tc *textileClient) handleKeyRotationNotice(ctx context.Context, notice domain.KeyRotationNotice, sender thread.PubKey) error {
+	oldPub, newPub, err := verifyKeyRotationNotice(notice)
+	if err != nil {
+		return err
+	}
+
+	if sender == nil || !thread.NewLibp2pPubKey(newPub).Equals(sender) {
+		return errInvalidKeyRotationNotice
+	}
+
+	if _, err := tc.GetModel().ReplaceSharedPublicKey(ctx, notice.OldPublicKey, notice.NewPublicKey); err != nil {
+		return errors.Wrap(err, "failed to replace shared public key")
+	}
+
+	invitations, err := tc.GetModel().ListSentInvitations(ctx, "", 0)
+	if err != nil {
+		return err
+	}
+
+	for _, invitation := range invitations {
+		if invitation.InviteePublicKey != notice.OldPublicKey {
+			continue
+		}
+
+		if invitation.Status == domain.ACCEPTED {
+			if err := tc.ManageShareFilesViaPublicKey(
+				ctx,
+				invitation.ItemPaths,
+				[]crypto.PubKey{newPub},
+				invitation.Keys,
+				invitation.Role,
+			); err != nil {
+				return errors.Wrap(err, "failed to share files with the rotated key")
+			}
+
+			if err := tc.ManageShareFilesViaPublicKey(
+				ctx,
+				invitation.ItemPaths,
+				[]crypto.PubKey{oldPub},
+				invitation.Keys,
+				domain.NoneShareRole,
+			); err != nil {
+				log.Error("failed to revoke access of the rotated key", err)
+			}
+		}
+
+		invitation.InviteePublicKey = notice.NewPublicKey
+		if _, err := tc.GetModel().UpdateSentInvitation(ctx, invitation); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ManageBucketsAccess sets the role of the key on the buckets and on their replicas on the hub.
+// Items of the replicas keep their own roles, so the role is set on each of them too.
+func (tc *textileClient) ManageBucketsAccess(
+	ctx context.Context,
+	bucketSchemas []*model.BucketSchema,
+	pubkey crypto.PubKey,
+	role domain.ShareRole,
+) error {
+	bucketRole, err := shareRoleToBucketRole(role)
+	if err != nil {
+		return err
+	}
+
+	roles := map[string]buckets.Role{thread.NewLibp2pPubKey(pubkey).String(): bucketRole}
+
+	for _, bs := range bucketSchemas {
+		bucketCtx, root, err := tc.getBucketRootFromSlug(ctx, bs.Slug)
+		if err != nil {
+			return err
+		}
+
+		if err := tc.bucketsClient.PushPathAccessRoles(bucketCtx, root.Key, "", roles); err != nil {
+			return errors.Wrap(err, "failed to set the role on bucket "+bs.Slug)
+		}
+
+		if bs.MirrorBucketSchema == nil || bs.RemoteBucketKey == "" {
+			continue
+		}
+
+		mirror := domain.FullPath{
+			DbId:      bs.RemoteDbID,
+			Bucket:    bs.RemoteBucketSlug,
+			BucketKey: bs.RemoteBucketKey,
+			Path:      "",
+		}
+
+		err = tc.ManageShareFilesViaPublicKey(ctx, []domain.FullPath{mirror}, []crypto.PubKey{pubkey}, [][]byte{bs.EncryptionKey}, role)
+		if err != nil {
+			return errors.Wrap(err, "failed to set the role on the replica of bucket "+bs.Slug)
+		}
+	}
+
+	return nil
+}
+
+// ResetIdentity drops the state tied to the previous identity key and initializes
+// the client for the key pair currently stored in the keychain.
+func (tc *textileClient) ResetIdentity(ctx context.Context) error {
+	tc.healthcheckMutex.Lock()
+	defer tc.healthcheckMutex.Unlock()
+
+	if err := tc.hubAuth.ClearCache(); err != nil {
+		return err
+	}
+
+	if err := tc.clearLocalMailbox(); err != nil {
+		return err
+	}
+
+	if err := tc.store.Remove([]byte(mailboxSetupFlagStoreKey)); err != nil {
+		return err
+	}
+
+	tc.closeListeners()
+	tc.mb = nil
+	tc.isInitialized = false
+	tc.isConnectedToHub = false
+
+	return tc.initialize(ctx)
+}
@@ -0,0 +1,114 @@
+package textile
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/keychain"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/textileio/go-threads/core/thread"
+)
+
+// keychain of an identity key that only derives thread keys, the mocks package cannot be used here since it imports textile
+type threadKeysKeychain struct {
+	keychain.Keychain
+	keys map[string]thread.Key
+}
+
+func newThreadKeysKeychain() *threadKeysKeychain {
+	return &threadKeysKeychain{keys: make(map[string]thread.Key)}
+}
+
+func (kc *threadKeysKeychain) GetManagedThreadKey(threadKeyName string) (thread.Key, error) {
+	if _, ok := kc.keys[threadKeyName]; !ok {
+		kc.keys[threadKeyName] = thread.NewRandomKey()
+	}
+
+	return kc.keys[threadKeyName], nil
+}
+
+func newSignedKeyRotationNotice(t *testing.T) (domain.KeyRotationNotice, crypto.PrivKey, crypto.PrivKey) {
+	oldPriv, oldPub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.Nil(t, err)
+	newPriv, newPub, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.Nil(t, err)
+
+	oldRaw, _ := oldPub.Raw()
+	newRaw, _ := newPub.Raw()
+	notice := domain.KeyRotationNotice{
+		OldPublicKey: hex.EncodeToString(oldRaw),
+		NewPublicKey: hex.EncodeToString(newRaw),
+		RotatedAt:    1600000000,
+	}
+
+	notice.OldKeySignature, err = oldPriv.Sign(notice.SigningPayload())
+	assert.Nil(t, err)
+	notice.NewKeySignature, err = newPriv.Sign(notice.SigningPayload())
+	assert.Nil(t, err)
+
+	return notice, oldPriv, newPriv
+}
+
+func TestVerifyKeyRotationNotice(t *testing.T) {
+	notice, oldPriv, newPriv := newSignedKeyRotationNotice(t)
+
+	oldPub, newPub, err := verifyKeyRotationNotice(notice)
+	assert.Nil(t, err)
+	assert.True(t, oldPub.Equals(oldPriv.GetPublic()))
+	assert.True(t, newPub.Equals(newPriv.GetPublic()))
+}
+
+func TestVerifyKeyRotationNotice_RejectsTamperedNotice(t *testing.T) {
+	notice, _, _ := newSignedKeyRotationNotice(t)
+	notice.RotatedAt++
+
+	_, _, err := verifyKeyRotationNotice(notice)
+	assert.Equal(t, errInvalidKeyRotationNotice, err)
+}
+
+func TestVerifyKeyRotationNotice_RequiresBothSignatures(t *testing.T) {
+	notice, _, _ := newSignedKeyRotationNotice(t)
+	other, _, _ := newSignedKeyRotationNotice(t)
+
+	// a notice for a key the sender does not hold
+	notice.NewPublicKey = other.NewPublicKey
+
+	_, _, err := verifyKeyRotationNotice(notice)
+	assert.Equal(t, errInvalidKeyRotationNotice, err)
+}
+
+func TestGetBucketThreadKey_ShouldJoinBucketWithStoredKeyAfterRotation(t *testing.T) {
+	oldKc := newThreadKeysKeychain()
+	bucket := &model.BucketSchema{Slug: "photos"}
+
+	oldKey, err := getBucketThreadKey(oldKc, bucket)
+	assert.Nil(t, err)
+
+	// the key derived from the old identity key is stored before rotating it
+	bucket.ThreadKey = oldKey.String()
+
+	// restoring on another device with the new identity key
+	newKc := newThreadKeysKeychain()
+	restoredKey, err := getBucketThreadKey(newKc, bucket)
+	assert.Nil(t, err)
+	assert.Equal(t, oldKey.String(), restoredKey.String())
+
+	derivedKey, err := newKc.GetManagedThreadKey(getBucketThreadManagedKey(bucket.RootSlug()))
+	assert.Nil(t, err)
+	assert.NotEqual(t, derivedKey.String(), restoredKey.String())
+}
+
+func TestGetBucketThreadKey_ShouldDeriveKeyOfRenamedBucketFromOriginalSlug(t *testing.T) {
+	kc := newThreadKeysKeychain()
+	bucket := &model.BucketSchema{Slug: "pictures", OriginalSlug: "photos"}
+
+	key, err := getBucketThreadKey(kc, bucket)
+	assert.Nil(t, err)
+
+	derivedKey, _ := kc.GetManagedThreadKey(getBucketThreadManagedKey("photos"))
+	assert.Equal(t, derivedKey.String(), key.String())
+}
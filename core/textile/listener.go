@@ -28,27 +28,58 @@ func (tc *textileClient) addListener(ctx context.Context, bucketSlug string) err
 		return err
 	}
 	handler := newRestorerListenerHandler(tc.sync, tc.store, tc.ipfsClient)
-	handlers := []EventHandler{handler}
+	handlers := []EventHandler{handler, tc.attachedHandlers}
 	listener := NewListener(tc, bucketSlug, handlers)
+
+	tc.dbListenersLock.Lock()
 	tc.dbListeners[bucketSlug] = listener
+	tc.dbListenersLock.Unlock()
 
 	go func() {
 		err := listener.Listen(ctx)
 		if err != nil {
-			// Remove element from map as it's not listening anymore
-			delete(tc.dbListeners, bucketSlug)
+			// Remove element from map as it's not listening anymore,
+			// unless it was already replaced by a new listener of the bucket
+			tc.dbListenersLock.Lock()
+			if tc.dbListeners[bucketSlug] == listener {
+				delete(tc.dbListeners, bucketSlug)
+			}
+			tc.dbListenersLock.Unlock()
 		}
 	}()
 
 	return nil
 }
 
+// AttachListenerHandler adds a handler called on the events of every bucket listener, including the ones already listening
+func (tc *textileClient) AttachListenerHandler(handler EventHandler) {
+	tc.attachedHandlers.attach(handler)
+}
+
 func (tc *textileClient) DeleteListeners(ctx context.Context) {
+	tc.dbListenersLock.Lock()
+	defer tc.dbListenersLock.Unlock()
+
 	for k, _ := range tc.dbListeners {
 		delete(tc.dbListeners, k)
 	}
 }
 
+func (tc *textileClient) isListening(bucketSlug string) bool {
+	tc.dbListenersLock.Lock()
+	defer tc.dbListenersLock.Unlock()
+
+	_, exists := tc.dbListeners[bucketSlug]
+	return exists
+}
+
+func (tc *textileClient) hasListeners() bool {
+	tc.dbListenersLock.Lock()
+	defer tc.dbListenersLock.Unlock()
+
+	return len(tc.dbListeners) > 0
+}
+
 func (tc *textileClient) initializeListeners(ctx context.Context) error {
 	if err := tc.requiresHubConnection(); err != nil {
 		return err
@@ -68,12 +99,28 @@ func (tc *textileClient) initializeListeners(ctx context.Context) error {
 	return nil
 }
 
-func (tc *textileClient) closeListeners() {
-	for key, listener := range tc.dbListeners {
+// closeListener removes the listener of the bucket from the map before closing it,
+// so the lock is not held while waiting for the listener to stop
+func (tc *textileClient) closeListener(bucketSlug string) {
+	tc.dbListenersLock.Lock()
+	listener, exists := tc.dbListeners[bucketSlug]
+	delete(tc.dbListeners, bucketSlug)
+	tc.dbListenersLock.Unlock()
+
+	if exists {
 		listener.Close()
-		delete(tc.dbListeners, key)
 	}
+}
 
+func (tc *textileClient) closeListeners() {
+	tc.dbListenersLock.Lock()
+	listeners := tc.dbListeners
+	tc.dbListeners = make(map[string]Listener)
+	tc.dbListenersLock.Unlock()
+
+	for _, listener := range listeners {
+		listener.Close()
+	}
 }
 
 type listener struct {
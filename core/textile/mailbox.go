@@ -9,6 +9,7 @@ import (
 	"path/filepath"
 
 	"github.com/FleekHQ/space-daemon/config"
+	"github.com/FleekHQ/space-daemon/core/keychain"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/textile/model"
 	"github.com/FleekHQ/space-daemon/log"
@@ -99,6 +100,9 @@ func (tc *textileClient) parseMessage(ctx context.Context, msgs []client.Message
 
 			if fsmap[msg.ID] == nil {
 				i.Status = domain.PENDING
+				if i.IsExpired() {
+					i.Status = domain.REJECTED
+				}
 			} else {
 				if fsmap[msg.ID].Accepted {
 					i.Status = domain.ACCEPTED
@@ -119,6 +123,19 @@ func (tc *textileClient) parseMessage(ctx context.Context, msgs []client.Message
 			}
 			n.UsageAlertValue = *u
 			n.RelatedObject = *u
+		case domain.INVITATION_REPLY:
+			reply := domain.Invitation{}
+			if err := json.Unmarshal((*b).Body, &reply); err != nil {
+				return nil, err
+			}
+
+			from := msg.From
+			tc.queueMailboxAction(msg.ID, func(ctx context.Context) error {
+				return tc.updateSentInvitationStatus(ctx, reply.InvitationID, reply.Status, from)
+			})
+
+			n.InvitationAcceptValue = domain.InvitationReply{InvitationID: reply.InvitationID}
+			n.RelatedObject = n.InvitationAcceptValue
 		case domain.REVOKED_INVITATION:
 			invite := domain.RevokedInvitation{}
 			if err := json.Unmarshal((*b).Body, &invite); err != nil {
@@ -136,6 +153,44 @@ func (tc *textileClient) parseMessage(ctx context.Context, msgs []client.Message
 
 			n.RevokedInvitationValue = invite
 			n.RelatedObject = invite
+		case domain.KEY_SHARE:
+			share := domain.KeyShareMessage{}
+			if err := json.Unmarshal((*b).Body, &share); err != nil {
+				return nil, err
+			}
+
+			n.KeyShareValue = share
+			n.RelatedObject = share
+		case domain.KEY_ROTATION:
+			notice := domain.KeyRotationNotice{}
+			if err := json.Unmarshal((*b).Body, &notice); err != nil {
+				return nil, err
+			}
+
+			from := msg.From
+			tc.queueMailboxAction(msg.ID, func(ctx context.Context) error {
+				return tc.handleKeyRotationNotice(ctx, notice, from)
+			})
+
+			n.KeyRotationValue = notice
+			n.RelatedObject = notice
+		case domain.PUBLIC_LINK_DOWNLOAD:
+			download := domain.PublicLinkDownload{}
+			if err := json.Unmarshal((*b).Body, &download); err != nil {
+				return nil, err
+			}
+
+			// the sender can not be verified, anyone with the full link can count a download
+			tc.queueMailboxAction(msg.ID, func(ctx context.Context) error {
+				err := tc.CountPublicLinkDownload(ctx, download.FileCid)
+				if err == model.ErrPublicLinkNotFound {
+					log.Warn("Received download of an unknown public link", "cid:"+download.FileCid)
+					return nil
+				}
+				return err
+			})
+
+			n.RelatedObject = download
 		default:
 		}
 
@@ -145,6 +200,61 @@ func (tc *textileClient) parseMessage(ctx context.Context, msgs []client.Message
 	return ns, nil
 }
 
+const handledMailboxMessageStoreKeyPrefix = "handledMailboxMessage#"
+
+const mailboxActionsQueueSize = 100
+
+// mailboxAction is a side effect of a mailbox message, such as updating the status of an invitation
+type mailboxAction struct {
+	messageID string
+	run       func(ctx context.Context) error
+}
+
+// Queues the action of a message to be run once by the daemon, instead of on every fetch of the message.
+// If the queue is full the action is dropped and queued again the next time the message is fetched.
+func (tc *textileClient) queueMailboxAction(messageID string, run func(ctx context.Context) error) {
+	if tc.isMailboxMessageHandled(messageID) {
+		return
+	}
+
+	select {
+	case tc.mailboxActions <- mailboxAction{messageID: messageID, run: run}:
+	default:
+		log.Warn("Mailbox actions queue is full, skipping message", "messageID:"+messageID)
+	}
+}
+
+// Runs the queued mailbox actions on the daemon context, marking each message as handled when its action succeeds
+func (tc *textileClient) processMailboxActions(ctx context.Context) {
+	for {
+		select {
+		case action := <-tc.mailboxActions:
+			// the same message can be queued again while its first action is running
+			if tc.isMailboxMessageHandled(action.messageID) {
+				continue
+			}
+
+			if err := action.run(ctx); err != nil {
+				log.Error("Failed to handle mailbox message "+action.messageID, err)
+				continue
+			}
+
+			if err := tc.store.SetString(handledMailboxMessageStoreKeyPrefix+action.messageID, "true"); err != nil {
+				log.Error("Failed to mark mailbox message "+action.messageID+" as handled", err)
+			}
+		case <-ctx.Done():
+			return
+		case <-tc.shuttingDown:
+			return
+		}
+	}
+}
+
+func (tc *textileClient) isMailboxMessageHandled(messageID string) bool {
+	_, err := tc.store.Get([]byte(handledMailboxMessageStoreKeyPrefix + messageID))
+	return err == nil
+}
+
 func (tc *textileClient) SendMessage(ctx context.Context, recipient crypto.PubKey, body []byte) (*client.Message, error) {
 	if err := tc.requiresHubConnection(); err != nil {
 		return nil, err
@@ -221,6 +331,11 @@ func (tc *textileClient) listenForMessages(ctx context.Context) error {
 				p, err := tc.parseMessage(ctx, msg)
 				if err != nil {
 					log.Error("Unable to parse incoming message: ", err)
+					continue
+				}
+
+				if len(p) == 0 {
+					continue
 				}
 
 				tc.mbNotifier.SendNotificationEvent(p[0])
@@ -286,8 +401,26 @@ func (tc *textileClient) getMailboxPath() string {
 	return mbpath
 }
 
-func (tc *textileClient) setupOrCreateMailBox(ctx context.Context) (*mail.Mailbox, error) {
-	maillib := mail.NewMail(cmd.NewClients(tc.cfg.GetString(config.TextileHubTarget, ""), true), mail.DefaultConfConfig())
+func (tc *textileClient) setupOrCreateMailBox(ctx context.Context) (Mailbox, error) {
+	clients := cmd.NewClients(tc.cfg.GetString(config.TextileHubTarget, ""), true)
+
+	// the local mailbox keeps the identity private key in its config, so it is not used with an external signer
+	if tc.kc.UsesExternalSigner() {
+		id, err := keychain.NewSignerIdentity(ctx, tc.kc.GetSigner())
+		if err != nil {
+			return nil, err
+		}
+
+		return newSignerMailbox(
+			ctx,
+			clients,
+			id,
+			tc.cfg.GetString(config.TextileUserKey, ""),
+			tc.cfg.GetString(config.TextileUserSecret, ""),
+		)
+	}
+
+	maillib := mail.NewMail(clients, mail.DefaultConfConfig())
 
 	mbpath := tc.getMailboxPath()
 
@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/textile/utils"
 	"github.com/FleekHQ/space-daemon/log"
 	"github.com/pkg/errors"
@@ -19,15 +20,67 @@ type BucketSchema struct {
 	Backup        bool            `json:"backup"`
 	EncryptionKey []byte          `json:"hub_key"`
 	DbID          string
+	// Slug the bucket was created with, set once it is renamed.
+	// It keeps naming the bucket root in its thread and the managed key of the thread.
+	OriginalSlug string `json:"original_slug"`
+	// Key of the bucket thread, set once the identity key it was derived from is rotated.
+	// Unset keys are derived from the identity key.
+	ThreadKey string               `json:"thread_key"`
+	Settings  BucketSettingsSchema `json:"settings"`
 	*MirrorBucketSchema
 }
 
+// BucketSettingsSchema holds the settings of the bucket, zero values are the defaults.
+// Buckets stored before the settings were added only have the Backup flag.
+type BucketSettingsSchema struct {
+	BackupPolicy      string `json:"backup_policy"`
+	QuotaBytes        int64  `json:"quota_bytes"`
+	QuotaAlertPercent int    `json:"quota_alert_percent"`
+	VersionRetention  int    `json:"version_retention"`
+	DefaultShareRole  int    `json:"default_share_role"`
+}
+
+// RootSlug returns the slug the bucket was created with
+func (b *BucketSchema) RootSlug() string {
+	if b.OriginalSlug != "" {
+		return b.OriginalSlug
+	}
+
+	return b.Slug
+}
+
+// GetSettings returns the settings of the bucket with the defaults of unset values
+func (b *BucketSchema) GetSettings() domain.BucketSettings {
+	policy := domain.BackupPolicy(b.Settings.BackupPolicy)
+	if !policy.IsValid() {
+		policy = domain.BackupOff
+		if b.Backup {
+			policy = domain.BackupOn
+		}
+	}
+
+	role := domain.ShareRole(b.Settings.DefaultShareRole)
+	if role == domain.NoneShareRole {
+		role = domain.ViewerShareRole
+	}
+
+	return domain.BucketSettings{
+		BackupPolicy:      policy,
+		QuotaBytes:        b.Settings.QuotaBytes,
+		QuotaAlertPercent: b.Settings.QuotaAlertPercent,
+		VersionRetention:  b.Settings.VersionRetention,
+		DefaultShareRole:  role,
+		Encrypted:         len(b.EncryptionKey) > 0,
+	}
+}
+
 const bucketModelName = "BucketMetadata"
 
 // 32 bytes aes key + 16 bytes salt/IV + 32 bytes HMAC key
 const BucketEncryptionKeyLength = 32 + 16 + 32
 
 var errBucketNotFound = errors.New("Bucket not found")
+var errBucketAlreadyExists = errors.New("Bucket already exists")
 
 func (m *model) CreateBucket(ctx context.Context, bucketSlug, dbID string) (*BucketSchema, error) {
 	log.Debug("Model.CreateBucket: Storing bucket " + bucketSlug)
@@ -101,7 +154,8 @@ func (m *model) UpsertBucket(ctx context.Context, bucketSlug, dbID string) (*Buc
 	return m.CreateBucket(ctx, bucketSlug, dbID)
 }
 
-func (m *model) BucketBackupToggle(ctx context.Context, bucketSlug string, backup bool) (*BucketSchema, error) {
+// UpdateBucketSettings stores the settings of the bucket, Backup is kept set only for the on policy
+func (m *model) UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (*BucketSchema, error) {
 	metaCtx, metaDbID, err := m.initBucketModel(ctx)
 	if err != nil && metaDbID == nil {
 		return nil, err
@@ -112,7 +166,14 @@ func (m *model) BucketBackupToggle(ctx context.Context, bucketSlug string, backu
 		return nil, err
 	}
 
-	bucket.Backup = backup
+	bucket.Backup = settings.BackupPolicy == domain.BackupOn
+	bucket.Settings = BucketSettingsSchema{
+		BackupPolicy:      string(settings.BackupPolicy),
+		QuotaBytes:        settings.QuotaBytes,
+		QuotaAlertPercent: settings.QuotaAlertPercent,
+		VersionRetention:  settings.VersionRetention,
+		DefaultShareRole:  int(settings.DefaultShareRole),
+	}
 
 	instances := client.Instances{bucket}
 
@@ -124,6 +185,115 @@ func (m *model) BucketBackupToggle(ctx context.Context, bucketSlug string, backu
 	return bucket, nil
 }
 
+// UpdateBucketThreadKey stores the key of the bucket thread, so it can be joined after the identity key changes
+func (m *model) UpdateBucketThreadKey(ctx context.Context, bucketSlug, threadKey string) (*BucketSchema, error) {
+	metaCtx, metaDbID, err := m.initBucketModel(ctx)
+	if err != nil && metaDbID == nil {
+		return nil, err
+	}
+
+	bucket, err := m.FindBucket(ctx, bucketSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.ThreadKey = threadKey
+
+	instances := client.Instances{bucket}
+
+	err = m.threads.Save(metaCtx, *metaDbID, bucketModelName, instances)
+	if err != nil {
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+// DeleteBucket removes the bucket metadata, the metadata of its mirror files and its search index records
+func (m *model) DeleteBucket(ctx context.Context, bucketSlug string) error {
+	metaCtx, metaDbID, err := m.initBucketModel(ctx)
+	if err != nil && metaDbID == nil {
+		return err
+	}
+
+	bucket, err := m.FindBucket(ctx, bucketSlug)
+	if err != nil {
+		return err
+	}
+
+	if err := m.deleteMirrorFiles(ctx, bucketSlug); err != nil {
+		return err
+	}
+
+	if err := m.fsearch.DeleteBucketData(ctx, bucketSlug); err != nil {
+		return err
+	}
+
+	return m.threads.Delete(metaCtx, *metaDbID, bucketModelName, []string{bucket.ID.String()})
+}
+
+// RenameBucket changes the slug of the bucket and of the records referencing it.
+// The bucket keeps its thread and keys. The records are renamed first and the bucket last,
+// the records are renamed back if any step fails so they keep following the bucket.
+func (m *model) RenameBucket(ctx context.Context, bucketSlug, newBucketSlug string) (*BucketSchema, error) {
+	metaCtx, metaDbID, err := m.initBucketModel(ctx)
+	if err != nil && metaDbID == nil {
+		return nil, err
+	}
+
+	if _, err := m.FindBucket(ctx, newBucketSlug); err == nil {
+		return nil, errBucketAlreadyExists
+	}
+
+	bucket, err := m.FindBucket(ctx, bucketSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	bucket.OriginalSlug = bucket.RootSlug()
+	bucket.Slug = newBucketSlug
+
+	renames := []bucketRecordsRename{
+		func(from, to string) error { return m.renameMirrorFiles(ctx, from, to) },
+		func(from, to string) error { return m.renamePublicLinks(ctx, from, to) },
+		func(from, to string) error { return m.fsearch.RenameBucketData(ctx, from, to) },
+	}
+	if err := renameBucketRecords(bucketSlug, newBucketSlug, renames); err != nil {
+		return nil, err
+	}
+
+	instances := client.Instances{bucket}
+	if err := m.threads.Save(metaCtx, *metaDbID, bucketModelName, instances); err != nil {
+		rollbackBucketRecordsRenames(bucketSlug, newBucketSlug, renames)
+		return nil, err
+	}
+
+	return bucket, nil
+}
+
+// bucketRecordsRename moves the records of a bucket from a slug to another
+type bucketRecordsRename func(from, to string) error
+
+// Runs the renames in order, renaming back the ones that succeeded if one of them fails
+func renameBucketRecords(bucketSlug, newBucketSlug string, renames []bucketRecordsRename) error {
+	for i, rename := range renames {
+		if err := rename(bucketSlug, newBucketSlug); err != nil {
+			rollbackBucketRecordsRenames(bucketSlug, newBucketSlug, renames[:i])
+			return err
+		}
+	}
+
+	return nil
+}
+
+func rollbackBucketRecordsRenames(bucketSlug, newBucketSlug string, renames []bucketRecordsRename) {
+	for i := len(renames) - 1; i >= 0; i-- {
+		if err := renames[i](newBucketSlug, bucketSlug); err != nil {
+			log.Error("Failed to rename back the records of bucket "+bucketSlug, err)
+		}
+	}
+}
+
 func (m *model) FindBucket(ctx context.Context, bucketSlug string) (*BucketSchema, error) {
 	metaCtx, dbID, err := m.initBucketModel(ctx)
 	if err != nil || dbID == nil {
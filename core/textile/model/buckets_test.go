@@ -0,0 +1,79 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketSchema_GetSettings_ShouldDefaultToBackupFlag(t *testing.T) {
+	schema := BucketSchema{Slug: "personal", Backup: true, EncryptionKey: []byte("key")}
+
+	assert.Equal(t, domain.BucketSettings{
+		BackupPolicy:     domain.BackupOn,
+		DefaultShareRole: domain.ViewerShareRole,
+		Encrypted:        true,
+	}, schema.GetSettings())
+
+	schema.Backup = false
+	assert.Equal(t, domain.BackupOff, schema.GetSettings().BackupPolicy)
+}
+
+func TestBucketSchema_GetSettings_ShouldReturnStoredSettings(t *testing.T) {
+	schema := BucketSchema{
+		Slug: "personal",
+		Settings: BucketSettingsSchema{
+			BackupPolicy:      string(domain.BackupMetadataOnly),
+			QuotaBytes:        1024,
+			QuotaAlertPercent: 80,
+			VersionRetention:  5,
+			DefaultShareRole:  int(domain.EditorShareRole),
+		},
+	}
+
+	assert.Equal(t, domain.BucketSettings{
+		BackupPolicy:      domain.BackupMetadataOnly,
+		QuotaBytes:        1024,
+		QuotaAlertPercent: 80,
+		VersionRetention:  5,
+		DefaultShareRole:  domain.EditorShareRole,
+	}, schema.GetSettings())
+}
+
+func TestRenameBucketRecords_ShouldRenameBackWhenARenameFails(t *testing.T) {
+	slugs := []string{"work", "work", "work"}
+	renames := []bucketRecordsRename{}
+	for i := range slugs {
+		i := i
+		renames = append(renames, func(from, to string) error {
+			if i == 2 {
+				return errors.New("index unavailable")
+			}
+			assert.Equal(t, slugs[i], from)
+			slugs[i] = to
+			return nil
+		})
+	}
+
+	err := renameBucketRecords("work", "office", renames)
+
+	assert.NotNil(t, err)
+	assert.Equal(t, []string{"work", "work", "work"}, slugs)
+}
+
+func TestRenameBucketRecords_ShouldRenameAllRecords(t *testing.T) {
+	slugs := []string{"work", "work"}
+	renames := []bucketRecordsRename{}
+	for i := range slugs {
+		i := i
+		renames = append(renames, func(from, to string) error {
+			slugs[i] = to
+			return nil
+		})
+	}
+
+	assert.Nil(t, renameBucketRecords("work", "office", renames))
+	assert.Equal(t, []string{"office", "office"}, slugs)
+}
@@ -0,0 +1,71 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/db"
+)
+
+// Collections moved to the metathread of a new identity key. The schema version is not moved,
+// the new metathread is migrated when it is created.
+func identityCollectionConfigs() []db.CollectionConfig {
+	return []db.CollectionConfig{
+		GetBucketCollectionConfig(),
+		GetMirrorFileCollectionConfig(),
+		GetReceivedFileCollectionConfig(),
+		GetSentFileCollectionConfig(),
+		GetSentInvitationCollectionConfig(),
+		GetSharedPublicKeyCollectionConfig(),
+		GetPublicLinkCollectionConfig(),
+	}
+}
+
+// ExportCollections returns the instances of the metathread of the current identity key,
+// so they can be imported in the metathread of the key replacing it.
+func (m *model) ExportCollections(ctx context.Context) (map[string][]*json.RawMessage, error) {
+	metaCtx, dbID, err := m.getMetaThreadContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	collections := map[string][]*json.RawMessage{}
+	for _, cc := range identityCollectionConfigs() {
+		instances, err := m.threads.Find(metaCtx, *dbID, cc.Name, &db.Query{}, &json.RawMessage{})
+		if err != nil {
+			return nil, err
+		}
+
+		collections[cc.Name] = instances.([]*json.RawMessage)
+	}
+
+	return collections, nil
+}
+
+// ImportCollections saves the exported instances in the metathread of the current identity key.
+// Instances keep their IDs, so importing them again updates the same instances.
+func (m *model) ImportCollections(ctx context.Context, collections map[string][]*json.RawMessage) error {
+	metaCtx, dbID, err := m.getMetaThreadContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, cc := range identityCollectionConfigs() {
+		exported := collections[cc.Name]
+		if len(exported) == 0 {
+			continue
+		}
+
+		instances := make(client.Instances, 0, len(exported))
+		for _, instance := range exported {
+			instances = append(instances, instance)
+		}
+
+		if err := m.threads.Save(metaCtx, *dbID, cc.Name, instances); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,353 @@
+package model
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/config"
+	"github.com/FleekHQ/space-daemon/core/store"
+	"github.com/FleekHQ/space-daemon/core/util"
+	"github.com/FleekHQ/space-daemon/log"
+	"github.com/dgraph-io/badger"
+	"github.com/textileio/go-threads/api/client"
+	core "github.com/textileio/go-threads/core/db"
+	"github.com/textileio/go-threads/core/thread"
+	sym "github.com/textileio/go-threads/crypto/symmetric"
+	"github.com/textileio/go-threads/db"
+	threadsUtil "github.com/textileio/go-threads/util"
+)
+
+// Key of the schema version in the local store, used before the version was kept in the metathread
+const legacySchemaVersionStoreKeyPrefix = "metathreadSchemaVersion#"
+
+const schemaVersionModel = "SchemaVersion"
+
+// The metathread has a single schema version instance
+const schemaVersionInstanceID = "metathread"
+
+// Salt of the key the collection backups are encrypted with
+const collectionsBackupKeySalt = "metathreadBackupKey"
+
+var ErrModelVersionTooNew = errors.New("the metathread was migrated by a newer version of the daemon")
+
+// SchemaVersionSchema is the version of the last collection migration run on the metathread.
+// It is kept in the metathread so other devices of the user know it was migrated.
+type SchemaVersionSchema struct {
+	ID        core.InstanceID `json:"_id"`
+	Version   int             `json:"version"`
+	UpdatedAt int64           `json:"updated_at"`
+}
+
+// collectionMigration upgrades the collections of the metathread to version.
+// Migrations run in order of version, each at most once per device, and should be safe to run again
+// since the metathread can be restored on another device that already migrated it.
+type collectionMigration struct {
+	version int
+	name    string
+	up      func(ctx context.Context, m *model, dbID thread.ID) error
+}
+
+// Add new migrations at the end with the next version, e.g. to update a collection schema after adding fields
+var collectionMigrations = []collectionMigration{
+	{
+		version: 1,
+		name:    "create collections and update schemas",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			for _, cc := range GetAllCollectionConfigs() {
+				if err := m.threads.NewCollection(ctx, dbID, cc); err != nil {
+					log.Debug("Model.Migrate: collection " + cc.Name + " already exists")
+				}
+
+				if err := m.threads.UpdateCollection(ctx, dbID, cc); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	},
+	{
+		version: 2,
+		name:    "add original slug of renamed buckets",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			return m.threads.UpdateCollection(ctx, dbID, GetBucketCollectionConfig())
+		},
+	},
+	{
+		version: 3,
+		name:    "add bucket settings",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			return m.threads.UpdateCollection(ctx, dbID, GetBucketCollectionConfig())
+		},
+	},
+	{
+		version: 4,
+		name:    "add invitations to sent files",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			return m.threads.UpdateCollection(ctx, dbID, GetSentFileCollectionConfig())
+		},
+	},
+	{
+		version: 5,
+		name:    "add thread keys of buckets",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			return m.threads.UpdateCollection(ctx, dbID, GetBucketCollectionConfig())
+		},
+	},
+	{
+		version: 6,
+		name:    "index sent invitations",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			if err := m.threads.NewCollection(ctx, dbID, GetSentInvitationCollectionConfig()); err != nil {
+				log.Debug("Model.Migrate: collection " + sentInvitationModelName + " already exists")
+			}
+
+			return m.indexSentInvitations(ctx, dbID)
+		},
+	},
+	{
+		version: 7,
+		name:    "remove version retention from bucket settings",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			return m.threads.UpdateCollection(ctx, dbID, GetBucketCollectionConfig())
+		},
+	},
+	{
+		version: 8,
+		name:    "add max downloads of public links",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			if err := m.threads.NewCollection(ctx, dbID, GetPublicLinkCollectionConfig()); err != nil {
+				log.Debug("Model.Migrate: collection " + publicLinkModelName + " already exists")
+			}
+
+			return m.threads.UpdateCollection(ctx, dbID, GetPublicLinkCollectionConfig())
+		},
+	},
+	{
+		version: 9,
+		name:    "add version retention back to bucket settings",
+		up: func(ctx context.Context, m *model, dbID thread.ID) error {
+			return m.threads.UpdateCollection(ctx, dbID, GetBucketCollectionConfig())
+		},
+	},
+}
+
+// Migrate runs the pending collection migrations on the metathread.
+// The collections are backed up first and restored if a migration fails.
+func (m *model) Migrate(ctx context.Context) error {
+	metaCtx, dbID, err := m.getMetaThreadContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	version, err := m.getSchemaVersion(metaCtx, *dbID)
+	if err != nil {
+		return err
+	}
+
+	latest := collectionMigrations[len(collectionMigrations)-1].version
+	if version > latest {
+		return ErrModelVersionTooNew
+	}
+
+	if version == latest {
+		return nil
+	}
+
+	collections, err := m.backupCollections(metaCtx, *dbID, version)
+	if err != nil {
+		return err
+	}
+
+	for _, migration := range collectionMigrations {
+		if migration.version <= version {
+			continue
+		}
+
+		log.Info(fmt.Sprintf("Migrating metathread to version %d: %s", migration.version, migration.name))
+		err := migration.up(metaCtx, m, *dbID)
+		if err == nil {
+			err = m.setSchemaVersion(metaCtx, *dbID, migration.version)
+		}
+
+		if err != nil {
+			// the version instance is restored too, so the migrations run again on the next start
+			if restoreErr := m.restoreCollections(metaCtx, *dbID, collections); restoreErr != nil {
+				log.Error("Failed to restore the metathread collections", restoreErr)
+			}
+
+			return fmt.Errorf("metathread migration %d (%s) failed: %s", migration.version, migration.name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+// Returns the schema version recorded in the metathread, or the one in the local store if the metathread has none
+func (m *model) getSchemaVersion(ctx context.Context, dbID thread.ID) (int, error) {
+	if err := m.threads.NewCollection(ctx, dbID, GetSchemaVersionCollectionConfig()); err != nil {
+		log.Debug("Model.Migrate: collection " + schemaVersionModel + " already exists")
+	}
+
+	exists, err := m.threads.Has(ctx, dbID, schemaVersionModel, []string{schemaVersionInstanceID})
+	if err != nil {
+		return 0, err
+	}
+
+	if exists {
+		schemaVersion := &SchemaVersionSchema{}
+		if err := m.threads.FindByID(ctx, dbID, schemaVersionModel, schemaVersionInstanceID, schemaVersion); err != nil {
+			return 0, err
+		}
+
+		return schemaVersion.Version, nil
+	}
+
+	val, err := m.st.Get([]byte(legacySchemaVersionStoreKeyPrefix + dbID.String()))
+	if err == badger.ErrKeyNotFound {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(string(val))
+}
+
+func (m *model) setSchemaVersion(ctx context.Context, dbID thread.ID, version int) error {
+	instances := client.Instances{&SchemaVersionSchema{
+		ID:        schemaVersionInstanceID,
+		Version:   version,
+		UpdatedAt: time.Now().UnixNano(),
+	}}
+
+	return m.threads.Save(ctx, dbID, schemaVersionModel, instances)
+}
+
+// Exports the instances of every existing collection, so they can be restored if a migration goes wrong.
+// They are also written to an encrypted backup file, since they include file keys.
+func (m *model) backupCollections(ctx context.Context, dbID thread.ID, version int) (map[string][]*json.RawMessage, error) {
+	collections := map[string][]*json.RawMessage{}
+	for _, cc := range GetAllCollectionConfigs() {
+		instances, err := m.threads.Find(ctx, dbID, cc.Name, &db.Query{}, &json.RawMessage{})
+		if err != nil {
+			// the collection does not exist yet
+			continue
+		}
+
+		collections[cc.Name] = instances.([]*json.RawMessage)
+	}
+
+	data, err := json.Marshal(collections)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted, err := m.encryptCollectionsBackup(data)
+	if err != nil {
+		return nil, err
+	}
+
+	rootDir, err := util.ResolvePath(m.cfg.GetString(config.SpaceStorePath, store.DefaultRootDir))
+	if err != nil {
+		return nil, err
+	}
+
+	backupDir := filepath.Join(rootDir, store.BackupDirName)
+	if err := os.MkdirAll(backupDir, 0700); err != nil {
+		return nil, err
+	}
+
+	backupPath := filepath.Join(
+		backupDir,
+		fmt.Sprintf("metathread-%s-v%d-%d.bak", dbID.String(), version, time.Now().Unix()),
+	)
+	if err := ioutil.WriteFile(backupPath, encrypted, 0600); err != nil {
+		return nil, err
+	}
+
+	log.Info("Backed up metathread collections to " + backupPath)
+
+	return collections, nil
+}
+
+// The backup is encrypted with a key derived from the identity key, which is needed to read the metathread anyways
+func (m *model) encryptCollectionsBackup(data []byte) ([]byte, error) {
+	keyBytes, err := m.kc.DeriveKey([]byte(collectionsBackupKeySalt), sym.KeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := sym.FromBytes(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.Encrypt(data)
+}
+
+// Puts back the instances of the collections as they were backed up, removing the ones created since
+func (m *model) restoreCollections(ctx context.Context, dbID thread.ID, collections map[string][]*json.RawMessage) error {
+	for name, backedUp := range collections {
+		backedUpIDs := make(map[string]bool)
+		instances := make(client.Instances, 0, len(backedUp))
+		for _, instance := range backedUp {
+			partial := &struct {
+				ID string `json:"_id"`
+			}{}
+			if err := json.Unmarshal(*instance, partial); err != nil {
+				return err
+			}
+
+			backedUpIDs[partial.ID] = true
+			instances = append(instances, instance)
+		}
+
+		current, err := m.threads.Find(ctx, dbID, name, &db.Query{}, &json.RawMessage{})
+		if err != nil {
+			return err
+		}
+
+		created := []string{}
+		for _, instance := range current.([]*json.RawMessage) {
+			partial := &struct {
+				ID string `json:"_id"`
+			}{}
+			if err := json.Unmarshal(*instance, partial); err != nil {
+				return err
+			}
+
+			if !backedUpIDs[partial.ID] {
+				created = append(created, partial.ID)
+			}
+		}
+
+		if len(created) > 0 {
+			if err := m.threads.Delete(ctx, dbID, name, created); err != nil {
+				return err
+			}
+		}
+
+		if len(instances) > 0 {
+			if err := m.threads.Save(ctx, dbID, name, instances); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func GetSchemaVersionCollectionConfig() db.CollectionConfig {
+	return db.CollectionConfig{
+		Name:   schemaVersionModel,
+		Schema: threadsUtil.SchemaFromInstance(&SchemaVersionSchema{}, false),
+	}
+}
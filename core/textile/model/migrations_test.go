@@ -0,0 +1,37 @@
+package model
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/keychain"
+	"github.com/stretchr/testify/assert"
+	sym "github.com/textileio/go-threads/crypto/symmetric"
+)
+
+// keychain that only derives keys, the mocks package cannot be used here since it imports model
+type derivingKeychain struct {
+	keychain.Keychain
+	key []byte
+}
+
+func (kc *derivingKeychain) DeriveKey(salt []byte, size int) ([]byte, error) {
+	return kc.key[:size], nil
+}
+
+func TestModel_EncryptCollectionsBackup_ShouldNotWriteFileKeysInPlaintext(t *testing.T) {
+	derived := bytes.Repeat([]byte{1}, sym.KeyBytes)
+	m := &model{kc: &derivingKeychain{key: derived}}
+	data := []byte(`{"SentFile":[{"encryptionKey":"c2VjcmV0"}]}`)
+
+	encrypted, err := m.encryptCollectionsBackup(data)
+	assert.Nil(t, err)
+	assert.False(t, bytes.Contains(encrypted, []byte("c2VjcmV0")))
+
+	key, err := sym.FromBytes(derived)
+	assert.Nil(t, err)
+
+	decrypted, err := key.Decrypt(encrypted)
+	assert.Nil(t, err)
+	assert.Equal(t, data, decrypted)
+}
@@ -197,6 +197,65 @@ func (m *model) UpdateMirrorFile(ctx context.Context, mirrorFile *MirrorFileSche
 	return mf, nil
 }
 
+func (m *model) findMirrorFilesByBucketSlug(ctx context.Context, bucketSlug string) ([]*MirrorFileSchema, error) {
+	metaCtx, dbID, err := m.initMirrorFileModel(ctx)
+	if err != nil || dbID == nil {
+		return nil, err
+	}
+
+	rawMirrorFiles, err := m.threads.Find(metaCtx, *dbID, mirrorFileModelName, db.Where("bucket_slug").Eq(bucketSlug), &MirrorFileSchema{})
+	if err != nil {
+		return nil, err
+	}
+
+	if rawMirrorFiles == nil {
+		return []*MirrorFileSchema{}, nil
+	}
+
+	return rawMirrorFiles.([]*MirrorFileSchema), nil
+}
+
+// removes the mirror files of a deleted bucket
+func (m *model) deleteMirrorFiles(ctx context.Context, bucketSlug string) error {
+	metaCtx, metaDbID, err := m.initMirrorFileModel(ctx)
+	if err != nil && metaDbID == nil {
+		return err
+	}
+
+	mirrorFiles, err := m.findMirrorFilesByBucketSlug(ctx, bucketSlug)
+	if err != nil || len(mirrorFiles) == 0 {
+		return err
+	}
+
+	instanceIds := make([]string, len(mirrorFiles))
+	for i, mirrorFile := range mirrorFiles {
+		instanceIds[i] = mirrorFile.ID.String()
+	}
+
+	return m.threads.Delete(metaCtx, *metaDbID, mirrorFileModelName, instanceIds)
+}
+
+// moves the mirror files of a renamed bucket to its new slug
+func (m *model) renameMirrorFiles(ctx context.Context, bucketSlug, newBucketSlug string) error {
+	metaCtx, metaDbID, err := m.initMirrorFileModel(ctx)
+	if err != nil && metaDbID == nil {
+		return err
+	}
+
+	mirrorFiles, err := m.findMirrorFilesByBucketSlug(ctx, bucketSlug)
+	if err != nil || len(mirrorFiles) == 0 {
+		return err
+	}
+
+	instances := client.Instances{}
+	for _, mirrorFile := range mirrorFiles {
+		mirrorFile.BucketSlug = newBucketSlug
+		instances = append(instances, mirrorFile)
+	}
+
+	return m.threads.Save(metaCtx, *metaDbID, mirrorFileModelName, instances)
+}
+
 func (m *model) initMirrorFileModel(ctx context.Context) (context.Context, *thread.ID, error) {
 	metaCtx, dbID, err := m.getMetaThreadContext(ctx)
 	if err != nil {
@@ -205,12 +264,6 @@ func (m *model) initMirrorFileModel(ctx context.Context) (context.Context, *thre
 
 	m.threads.NewCollection(metaCtx, *dbID, GetMirrorFileCollectionConfig())
 
-	// Migrates db by adding new fields between old version of the daemon and a new one
-	m.threads.UpdateCollection(metaCtx, *dbID, db.CollectionConfig{
-		Name:   mirrorFileModelName,
-		Schema: util.SchemaFromInstance(&MirrorFileSchema{}, false),
-	})
-
 	return metaCtx, dbID, nil
 }
 
@@ -2,6 +2,7 @@ package model
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/FleekHQ/space-daemon/core/search"
 
@@ -35,9 +36,12 @@ type model struct {
 type Model interface {
 	CreateBucket(ctx context.Context, bucketSlug, dbID string) (*BucketSchema, error)
 	UpsertBucket(ctx context.Context, bucketSlug, dbID string) (*BucketSchema, error)
-	BucketBackupToggle(ctx context.Context, bucketSlug string, backup bool) (*BucketSchema, error)
+	UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (*BucketSchema, error)
+	UpdateBucketThreadKey(ctx context.Context, bucketSlug, threadKey string) (*BucketSchema, error)
 	FindBucket(ctx context.Context, bucketSlug string) (*BucketSchema, error)
 	ListBuckets(ctx context.Context) ([]*BucketSchema, error)
+	DeleteBucket(ctx context.Context, bucketSlug string) error
+	RenameBucket(ctx context.Context, bucketSlug, newBucketSlug string) (*BucketSchema, error)
 	CreateReceivedFileViaInvitation(
 		ctx context.Context,
 		file domain.FullPath,
@@ -61,10 +65,14 @@ type Model interface {
 		key []byte,
 	) (*SentFileSchema, error)
 	FindReceivedFile(ctx context.Context, remoteDbID, bucket, path string) (*ReceivedFileSchema, error)
+	FindReceivedFileForPath(ctx context.Context, remoteDbID, bucket, path string) (*ReceivedFileSchema, error)
 	FindPublicLinkReceivedFile(ctx context.Context, ipfsHash string) (*ReceivedFileSchema, error)
 	FindSentFile(ctx context.Context, remoteDbID, bucket, path string) (*SentFileSchema, error)
+	FindSentFileForPath(ctx context.Context, remoteDbID, bucket, path string) (*SentFileSchema, error)
+	UpdateSentFileRole(ctx context.Context, file domain.FullPath, inviteePublicKey string, role domain.ShareRole) error
 	CreateSharedPublicKey(ctx context.Context, pubKey string) (*SharedPublicKeySchema, error)
 	ListSharedPublicKeys(ctx context.Context) ([]*SharedPublicKeySchema, error)
+	ReplaceSharedPublicKey(ctx context.Context, oldPubKey, newPubKey string) (*SharedPublicKeySchema, error)
 	CreateMirrorBucket(ctx context.Context, bucketSlug string, mirrorBucket *MirrorBucketSchema) (*BucketSchema, error)
 	FindMirrorFileByPathAndBucketSlug(ctx context.Context, path, bucketSlug string) (*MirrorFileSchema, error)
 	CreateMirrorFile(ctx context.Context, mirrorFile *domain.MirrorFile) (*MirrorFileSchema, error)
@@ -84,6 +92,18 @@ type Model interface {
 	) (*SearchIndexRecord, error)
 	QuerySearchIndex(ctx context.Context, query string) ([]*SearchIndexRecord, error)
 	DeleteSearchIndexRecord(ctx context.Context, name, path, bucketSlug, dbId string) error
+	CreatePublicLink(ctx context.Context, link *PublicLinkSchema) (*PublicLinkSchema, error)
+	FindPublicLink(ctx context.Context, linkID string) (*PublicLinkSchema, error)
+	FindPublicLinkByCid(ctx context.Context, fileCid string) (*PublicLinkSchema, error)
+	ListPublicLinks(ctx context.Context, seek string, limit int) ([]*PublicLinkSchema, error)
+	UpdatePublicLink(ctx context.Context, link *PublicLinkSchema) (*PublicLinkSchema, error)
+	CreateSentInvitation(ctx context.Context, invitation *SentInvitationSchema) (*SentInvitationSchema, error)
+	FindSentInvitation(ctx context.Context, invitationID string) (*SentInvitationSchema, error)
+	ListSentInvitations(ctx context.Context, seek string, limit int) ([]*SentInvitationSchema, error)
+	UpdateSentInvitation(ctx context.Context, invitation *SentInvitationSchema) (*SentInvitationSchema, error)
+	Migrate(ctx context.Context) error
+	ExportCollections(ctx context.Context) (map[string][]*json.RawMessage, error)
+	ImportCollections(ctx context.Context, collections map[string][]*json.RawMessage) error
 }
 
 func New(
@@ -151,6 +171,9 @@ func GetAllCollectionConfigs() []db.CollectionConfig {
 		GetMirrorFileCollectionConfig(),
 		GetReceivedFileCollectionConfig(),
 		GetSentFileCollectionConfig(),
+		GetSentInvitationCollectionConfig(),
 		GetSharedPublicKeyCollectionConfig(),
+		GetPublicLinkCollectionConfig(),
+		GetSchemaVersionCollectionConfig(),
 	}
 }
@@ -0,0 +1,210 @@
+package model
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/log"
+	"github.com/textileio/go-threads/api/client"
+	core "github.com/textileio/go-threads/core/db"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/go-threads/db"
+	"github.com/textileio/go-threads/util"
+)
+
+// PublicLinkSchema represents a public share link generated by the user.
+// The encrypted copy lives in the public share bucket at PublicPath.
+type PublicLinkSchema struct {
+	ID         core.InstanceID `json:"_id"`
+	Bucket     string          `json:"bucket"`
+	DbID       string          `json:"dbId"`
+	Paths      []string        `json:"paths"`
+	FileName   string          `json:"fileName"`
+	FileCid    string          `json:"fileCid"`
+	PublicPath string          `json:"publicPath"`
+	Link       string          `json:"link"`
+	ExpiresAt  int64           `json:"expiresAt"`
+	// only downloads opened through a Space daemon are counted, see textileClient.CountPublicLinkDownload
+	MaxDownloads  int64 `json:"maxDownloads"`
+	DownloadCount int64 `json:"downloadCount"`
+	Revoked       bool  `json:"revoked"`
+	CreatedAt     int64 `json:"created_at"`
+}
+
+// IsExpired returns true if the link has an expiry date that already passed
+func (p PublicLinkSchema) IsExpired() bool {
+	return p.ExpiresAt != 0 && p.ExpiresAt <= time.Now().UnixNano()
+}
+
+// IsExhausted returns true if the link reached its max downloads count
+func (p PublicLinkSchema) IsExhausted() bool {
+	return p.MaxDownloads != 0 && p.DownloadCount >= p.MaxDownloads
+}
+
+// IsActive returns true if the link can still be used to download the file
+func (p PublicLinkSchema) IsActive() bool {
+	return !p.Revoked && !p.IsExpired() && !p.IsExhausted()
+}
+
+const publicLinkModelName = "PublicLink"
+
+var ErrPublicLinkNotFound = errors.New("Public link not found")
+
+// Creates the metadata for a public link generated by the user
+func (m *model) CreatePublicLink(ctx context.Context, link *PublicLinkSchema) (*PublicLinkSchema, error) {
+	log.Debug("Model.CreatePublicLink: Storing public link", "cid:"+link.FileCid)
+	metaCtx, metaDbID, err := m.initPublicLinkModel(ctx)
+	if err != nil && metaDbID == nil {
+		return nil, err
+	}
+
+	newInstance := *link
+	newInstance.ID = ""
+	if newInstance.CreatedAt == 0 {
+		newInstance.CreatedAt = time.Now().UnixNano()
+	}
+	if newInstance.Paths == nil {
+		newInstance.Paths = []string{}
+	}
+
+	instances := client.Instances{&newInstance}
+	res, err := m.threads.Create(metaCtx, *metaDbID, publicLinkModelName, instances)
+	if err != nil {
+		return nil, err
+	}
+	log.Debug("Model.CreatePublicLink: stored public link", "instance_id:"+res[0])
+
+	newInstance.ID = core.InstanceID(res[0])
+	return &newInstance, nil
+}
+
+// Finds a public link by its instance id
+func (m *model) FindPublicLink(ctx context.Context, linkID string) (*PublicLinkSchema, error) {
+	metaCtx, dbID, err := m.initPublicLinkModel(ctx)
+	if err != nil || dbID == nil {
+		return nil, err
+	}
+
+	link := &PublicLinkSchema{}
+	if err := m.threads.FindByID(metaCtx, *dbID, publicLinkModelName, linkID, link); err != nil {
+		log.Debug("Model.FindPublicLink: link not found", "id:"+linkID)
+		return nil, ErrPublicLinkNotFound
+	}
+
+	return link, nil
+}
+
+// Finds a public link by the cid of its encrypted copy
+func (m *model) FindPublicLinkByCid(ctx context.Context, fileCid string) (*PublicLinkSchema, error) {
+	metaCtx, dbID, err := m.initPublicLinkModel(ctx)
+	if err != nil || dbID == nil {
+		return nil, err
+	}
+
+	rawLinks, err := m.threads.Find(metaCtx, *dbID, publicLinkModelName, db.Where("fileCid").Eq(fileCid), &PublicLinkSchema{})
+	if err != nil {
+		return nil, err
+	}
+
+	if rawLinks == nil {
+		return nil, ErrPublicLinkNotFound
+	}
+
+	links := rawLinks.([]*PublicLinkSchema)
+	if len(links) == 0 {
+		return nil, ErrPublicLinkNotFound
+	}
+
+	return links[0], nil
+}
+
+// Lists the public links generated by the user
+// If seek == "", will start looking from the beginning. If it's an existing ID it will start looking from that ID.
+func (m *model) ListPublicLinks(ctx context.Context, seek string, limit int) ([]*PublicLinkSchema, error) {
+	metaCtx, dbID, err := m.initPublicLinkModel(ctx)
+	if err != nil || dbID == nil {
+		return nil, err
+	}
+
+	query := db.OrderByID()
+	if limit > 0 {
+		query = query.LimitTo(limit)
+	}
+
+	if seek != "" {
+		query = query.SeekID(core.InstanceID(seek))
+	}
+
+	rawLinks, err := m.threads.Find(metaCtx, *dbID, publicLinkModelName, query, &PublicLinkSchema{})
+	if err != nil {
+		return nil, err
+	}
+
+	if rawLinks == nil {
+		return []*PublicLinkSchema{}, nil
+	}
+
+	return rawLinks.([]*PublicLinkSchema), nil
+}
+
+// Updates an existing public link
+func (m *model) UpdatePublicLink(ctx context.Context, link *PublicLinkSchema) (*PublicLinkSchema, error) {
+	metaCtx, metaDbID, err := m.initPublicLinkModel(ctx)
+	if err != nil && metaDbID == nil {
+		return nil, err
+	}
+
+	instances := client.Instances{link}
+	if err := m.threads.Save(metaCtx, *metaDbID, publicLinkModelName, instances); err != nil {
+		return nil, err
+	}
+
+	return link, nil
+}
+
+// moves the public links of files of a renamed local bucket to its new slug
+func (m *model) renamePublicLinks(ctx context.Context, bucketSlug, newBucketSlug string) error {
+	metaCtx, dbID, err := m.initPublicLinkModel(ctx)
+	if err != nil || dbID == nil {
+		return err
+	}
+
+	query := db.Where("bucket").Eq(bucketSlug).And("dbId").Eq("")
+	rawLinks, err := m.threads.Find(metaCtx, *dbID, publicLinkModelName, query, &PublicLinkSchema{})
+	if err != nil || rawLinks == nil {
+		return err
+	}
+
+	instances := client.Instances{}
+	for _, link := range rawLinks.([]*PublicLinkSchema) {
+		link.Bucket = newBucketSlug
+		instances = append(instances, link)
+	}
+
+	if len(instances) == 0 {
+		return nil
+	}
+
+	return m.threads.Save(metaCtx, *dbID, publicLinkModelName, instances)
+}
+
+func (m *model) initPublicLinkModel(ctx context.Context) (context.Context, *thread.ID, error) {
+	metaCtx, dbID, err := m.getMetaThreadContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := m.threads.NewCollection(metaCtx, *dbID, GetPublicLinkCollectionConfig()); err != nil {
+		log.Debug("initPublicLinkModel: collection already exists")
+	}
+
+	return metaCtx, dbID, nil
+}
+
+func GetPublicLinkCollectionConfig() db.CollectionConfig {
+	return db.CollectionConfig{
+		Name:   publicLinkModelName,
+		Schema: util.SchemaFromInstance(&PublicLinkSchema{}, false),
+	}
+}
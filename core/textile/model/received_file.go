@@ -3,6 +3,7 @@ package model
 import (
 	"context"
 	"errors"
+	"strings"
 	"time"
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
@@ -189,6 +190,40 @@ func (m *model) FindReceivedFile(ctx context.Context, remoteDbID, bucket, path s
 	return files[0], nil
 }
 
+// Finds the metadata of a file that has been shared to the user or, if the path was not shared
+// directly, the metadata of the closest shared folder containing it
+func (m *model) FindReceivedFileForPath(ctx context.Context, remoteDbID, bucket, path string) (*ReceivedFileSchema, error) {
+	for _, p := range sharedPathAncestors(path) {
+		file, err := m.FindReceivedFile(ctx, remoteDbID, bucket, p)
+		if err != nil && err != errReceivedFileNotFound {
+			return nil, err
+		}
+
+		if file != nil {
+			return file, nil
+		}
+	}
+
+	return nil, errReceivedFileNotFound
+}
+
+// returns path followed by each of its parent folders, closest first.
+// The bucket root is not included since it can't be shared by itself.
+func sharedPathAncestors(path string) []string {
+	res := []string{}
+	for p := strings.TrimSuffix(path, "/"); p != "" && p != "/"; {
+		res = append(res, p)
+
+		i := strings.LastIndex(p, "/")
+		if i < 0 {
+			break
+		}
+		p = p[:i]
+	}
+
+	return res
+}
+
 func (m *model) FindPublicLinkReceivedFile(ctx context.Context, ipfsHash string) (*ReceivedFileSchema, error) {
 	metaCtx, dbID, err := m.initReceivedFileModel(ctx)
 	if err != nil || dbID == nil {
@@ -20,3 +20,13 @@ func TestReceivedFileSchema_IsPublicLinkReceived_ShouldBeFalse_For_InvitationId(
 
 	assert.False(t, schema.IsPublicLinkReceived(), "received file should not be public")
 }
+
+func TestSharedPathAncestors_ShouldReturnPathAndParentFolders(t *testing.T) {
+	assert.Equal(
+		t,
+		[]string{"/docs/sub/file.txt", "/docs/sub", "/docs"},
+		sharedPathAncestors("/docs/sub/file.txt"),
+	)
+	assert.Equal(t, []string{"docs/sub", "docs"}, sharedPathAncestors("docs/sub/"))
+	assert.Equal(t, []string{}, sharedPathAncestors("/"))
+}
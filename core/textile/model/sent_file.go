@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"time"
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
@@ -29,6 +30,7 @@ type SentFileSchema struct {
 	ID        core.InstanceID `json:"_id"`
 	CreatedAt int64           `json:"created_at"`
 	SentFileViaInvitationSchema
+	Invitations []SentFileInvitationSchema `json:"invitations"`
 }
 
 const sentFileModelName = "SentFile"
@@ -84,6 +86,7 @@ func (m *model) createSentFile(ctx context.Context, instance *SentFileSchema) (*
 		ID:                          core.InstanceID(id),
 		SentFileViaInvitationSchema: instance.SentFileViaInvitationSchema,
 		CreatedAt:                   instance.CreatedAt,
+		Invitations:                 instance.Invitations,
 	}, nil
 }
 
@@ -113,6 +116,47 @@ func (m *model) FindSentFile(ctx context.Context, remoteDbID, bucket, path strin
 	return files[0], nil
 }
 
+// Finds the metadata of a file that has been shared by the user or, if the path was not shared
+// directly, the metadata of the closest shared folder containing it
+func (m *model) FindSentFileForPath(ctx context.Context, remoteDbID, bucket, path string) (*SentFileSchema, error) {
+	for _, p := range sharedPathAncestors(path) {
+		file, err := m.FindSentFile(ctx, remoteDbID, bucket, p)
+		if err != nil && err != errSentFileNotFound {
+			return nil, err
+		}
+
+		if file != nil {
+			return file, nil
+		}
+	}
+
+	return nil, errSentFileNotFound
+}
+
+// Updates the role of the invitations a sent file was shared with the invitee in
+func (m *model) UpdateSentFileRole(ctx context.Context, file domain.FullPath, inviteePublicKey string, role domain.ShareRole) error {
+	metaCtx, metaDbID, err := m.initSentFileModel(ctx)
+	if err != nil && metaDbID == nil {
+		return err
+	}
+
+	sentFile, err := m.FindSentFile(ctx, file.DbId, file.Bucket, file.Path)
+	if err == errSentFileNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	for i := range sentFile.Invitations {
+		if sentFile.Invitations[i].InviteePublicKey == inviteePublicKey {
+			sentFile.Invitations[i].Role = role
+		}
+	}
+
+	return m.saveSentFiles(metaCtx, *metaDbID, []*SentFileSchema{sentFile})
+}
+
 // Lists the metadata of files sent by the user
 // If seek == "", will start looking from the beginning. If it's an existing ID it will start looking from that ID.
 func (m *model) ListSentFiles(ctx context.Context, seek string, limit int) ([]*SentFileSchema, error) {
@@ -156,6 +200,332 @@ func (sf *SentFileSchema) ReceivedFileSchema() *ReceivedFileSchema {
 	}
 }
 
+// SentFileInvitationSchema is an invitation the sent file was shared in
+type SentFileInvitationSchema struct {
+	InvitationId     string                  `json:"invitationId"`
+	InviteePublicKey string                  `json:"inviteePublicKey"`
+	Status           domain.InvitationStatus `json:"status"`
+	Role             domain.ShareRole        `json:"role"`
+	ExpiresAt        int64                   `json:"expiresAt"`
+	CreatedAt        int64                   `json:"createdAt"`
+}
+
+// SentInvitationSchema represents an invitation sent by the user, built from the sent files it includes
+type SentInvitationSchema struct {
+	SentFileInvitationSchema
+	ItemPaths   []domain.FullPath
+	Keys        [][]byte
+	SentFileIds []string
+}
+
+// IsExpired returns true if the invitation is still pending after its expiry date
+func (si SentInvitationSchema) IsExpired() bool {
+	return si.Status == domain.PENDING && si.ExpiresAt != 0 && si.ExpiresAt <= time.Now().UnixNano()
+}
+
+var errSentInvitationNotFound = errors.New("Sent invitation not found")
+
+// SentInvitationIndexSchema indexes the sent files an invitation was stored in by the invitation id,
+// since the invitations of the sent files can't be queried
+type SentInvitationIndexSchema struct {
+	ID           core.InstanceID `json:"_id"`
+	InvitationId string          `json:"invitationId"`
+	SentFileIds  []string        `json:"sentFileIds"`
+	CreatedAt    int64           `json:"created_at"`
+}
+
+const sentInvitationModelName = "SentInvitation"
+
+// Stores an invitation sent by the user in each of the sent files it includes
+func (m *model) CreateSentInvitation(ctx context.Context, invitation *SentInvitationSchema) (*SentInvitationSchema, error) {
+	log.Debug("Model.CreateSentInvitation: Storing sent invitation", "invitationId:"+invitation.InvitationId)
+	metaCtx, metaDbID, err := m.initSentFileModel(ctx)
+	if err != nil && metaDbID == nil {
+		return nil, err
+	}
+
+	newInvitation := *invitation
+	if newInvitation.CreatedAt == 0 {
+		newInvitation.CreatedAt = time.Now().UnixNano()
+	}
+
+	files := make([]*SentFileSchema, 0, len(invitation.SentFileIds))
+	for _, id := range invitation.SentFileIds {
+		file := &SentFileSchema{}
+		if err := m.threads.FindByID(metaCtx, *metaDbID, sentFileModelName, id, file); err != nil {
+			return nil, err
+		}
+
+		file.Invitations = append(file.Invitations, newInvitation.SentFileInvitationSchema)
+		files = append(files, file)
+	}
+
+	if err := m.saveSentFiles(metaCtx, *metaDbID, files); err != nil {
+		return nil, err
+	}
+
+	index := &SentInvitationIndexSchema{
+		InvitationId: newInvitation.InvitationId,
+		SentFileIds:  newInvitation.SentFileIds,
+		CreatedAt:    newInvitation.CreatedAt,
+	}
+	if _, err := m.threads.Create(metaCtx, *metaDbID, sentInvitationModelName, client.Instances{index}); err != nil {
+		return nil, err
+	}
+
+	return &newInvitation, nil
+}
+
+// Finds an invitation sent by the user by its mailbox message id
+func (m *model) FindSentInvitation(ctx context.Context, invitationID string) (*SentInvitationSchema, error) {
+	metaCtx, dbID, err := m.initSentFileModel(ctx)
+	if err != nil || dbID == nil {
+		return nil, err
+	}
+
+	index, err := m.findSentInvitationIndex(metaCtx, *dbID, invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := m.getSentInvitations(metaCtx, *dbID, []*SentInvitationIndexSchema{index})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(invitations) == 0 {
+		return nil, errSentInvitationNotFound
+	}
+
+	return invitations[0], nil
+}
+
+// Lists the invitations sent by the user, oldest first
+// If seek == "", will start looking from the beginning. If it's an existing invitation ID it will start looking after it.
+func (m *model) ListSentInvitations(ctx context.Context, seek string, limit int) ([]*SentInvitationSchema, error) {
+	metaCtx, dbID, err := m.initSentFileModel(ctx)
+	if err != nil || dbID == nil {
+		return nil, err
+	}
+
+	query := db.OrderByID()
+	var seekID core.InstanceID
+	if seek != "" {
+		seekIndex, err := m.findSentInvitationIndex(metaCtx, *dbID, seek)
+		if err != nil {
+			return nil, err
+		}
+
+		// seeking includes the instance seeked to, so one more is fetched in its place
+		seekID = seekIndex.ID
+		query = query.SeekID(seekID)
+		if limit > 0 {
+			limit++
+		}
+	}
+
+	if limit > 0 {
+		query = query.LimitTo(limit)
+	}
+
+	rawIndexes, err := m.threads.Find(metaCtx, *dbID, sentInvitationModelName, query, &SentInvitationIndexSchema{})
+	if err != nil {
+		return nil, err
+	}
+
+	if rawIndexes == nil {
+		return []*SentInvitationSchema{}, nil
+	}
+
+	indexes := rawIndexes.([]*SentInvitationIndexSchema)
+	if seekID != "" && len(indexes) > 0 && indexes[0].ID == seekID {
+		indexes = indexes[1:]
+	}
+
+	return m.getSentInvitations(metaCtx, *dbID, indexes)
+}
+
+func (m *model) findSentInvitationIndex(ctx context.Context, dbID thread.ID, invitationID string) (*SentInvitationIndexSchema, error) {
+	rawIndexes, err := m.threads.Find(ctx, dbID, sentInvitationModelName, db.Where("invitationId").Eq(invitationID), &SentInvitationIndexSchema{})
+	if err != nil {
+		return nil, err
+	}
+
+	if rawIndexes == nil {
+		return nil, errSentInvitationNotFound
+	}
+
+	indexes := rawIndexes.([]*SentInvitationIndexSchema)
+	if len(indexes) == 0 {
+		return nil, errSentInvitationNotFound
+	}
+
+	return indexes[0], nil
+}
+
+// Builds the indexed invitations from the sent files they were stored in, in the order of the indexes
+func (m *model) getSentInvitations(ctx context.Context, dbID thread.ID, indexes []*SentInvitationIndexSchema) ([]*SentInvitationSchema, error) {
+	files := []*SentFileSchema{}
+	loaded := make(map[string]bool)
+	for _, index := range indexes {
+		for _, id := range index.SentFileIds {
+			if loaded[id] {
+				continue
+			}
+
+			file := &SentFileSchema{}
+			if err := m.threads.FindByID(ctx, dbID, sentFileModelName, id, file); err != nil {
+				return nil, err
+			}
+
+			loaded[id] = true
+			files = append(files, file)
+		}
+	}
+
+	return selectSentInvitations(indexes, files), nil
+}
+
+// Builds the indexed invitations from the sent files, the files can include other invitations too
+func selectSentInvitations(indexes []*SentInvitationIndexSchema, files []*SentFileSchema) []*SentInvitationSchema {
+	byID := make(map[string]*SentInvitationSchema)
+	for _, invitation := range groupSentInvitations(files) {
+		byID[invitation.InvitationId] = invitation
+	}
+
+	invitations := make([]*SentInvitationSchema, 0, len(indexes))
+	for _, index := range indexes {
+		if invitation, exists := byID[index.InvitationId]; exists {
+			invitations = append(invitations, invitation)
+		}
+	}
+
+	return invitations
+}
+
+// Updates an existing sent invitation in each of the sent files it includes
+func (m *model) UpdateSentInvitation(ctx context.Context, invitation *SentInvitationSchema) (*SentInvitationSchema, error) {
+	metaCtx, metaDbID, err := m.initSentFileModel(ctx)
+	if err != nil && metaDbID == nil {
+		return nil, err
+	}
+
+	files := make([]*SentFileSchema, 0, len(invitation.SentFileIds))
+	for _, id := range invitation.SentFileIds {
+		file := &SentFileSchema{}
+		if err := m.threads.FindByID(metaCtx, *metaDbID, sentFileModelName, id, file); err != nil {
+			return nil, err
+		}
+
+		for i := range file.Invitations {
+			if file.Invitations[i].InvitationId == invitation.InvitationId {
+				file.Invitations[i] = invitation.SentFileInvitationSchema
+			}
+		}
+		files = append(files, file)
+	}
+
+	if err := m.saveSentFiles(metaCtx, *metaDbID, files); err != nil {
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (m *model) saveSentFiles(ctx context.Context, dbID thread.ID, files []*SentFileSchema) error {
+	if len(files) == 0 {
+		return nil
+	}
+
+	instances := make(client.Instances, 0, len(files))
+	for _, file := range files {
+		instances = append(instances, file)
+	}
+
+	return m.threads.Save(ctx, dbID, sentFileModelName, instances)
+}
+
+// Indexes the invitations stored in the sent files that are not indexed yet
+func (m *model) indexSentInvitations(ctx context.Context, dbID thread.ID) error {
+	rawFiles, err := m.threads.Find(ctx, dbID, sentFileModelName, db.OrderByID(), &SentFileSchema{})
+	if err != nil {
+		return err
+	}
+
+	if rawFiles == nil {
+		return nil
+	}
+
+	rawIndexes, err := m.threads.Find(ctx, dbID, sentInvitationModelName, &db.Query{}, &SentInvitationIndexSchema{})
+	if err != nil {
+		return err
+	}
+
+	indexed := make(map[string]bool)
+	if rawIndexes != nil {
+		for _, index := range rawIndexes.([]*SentInvitationIndexSchema) {
+			indexed[index.InvitationId] = true
+		}
+	}
+
+	instances := client.Instances{}
+	for _, invitation := range groupSentInvitations(rawFiles.([]*SentFileSchema)) {
+		if indexed[invitation.InvitationId] {
+			continue
+		}
+
+		instances = append(instances, &SentInvitationIndexSchema{
+			InvitationId: invitation.InvitationId,
+			SentFileIds:  invitation.SentFileIds,
+			CreatedAt:    invitation.CreatedAt,
+		})
+	}
+
+	if len(instances) == 0 {
+		return nil
+	}
+
+	_, err = m.threads.Create(ctx, dbID, sentInvitationModelName, instances)
+	return err
+}
+
+// Builds the invitations from the sent files they were stored in, in the order they were sent
+func groupSentInvitations(files []*SentFileSchema) []*SentInvitationSchema {
+	invitations := []*SentInvitationSchema{}
+	byID := make(map[string]*SentInvitationSchema)
+
+	for _, file := range files {
+		for _, fi := range file.Invitations {
+			invitation, exists := byID[fi.InvitationId]
+			if !exists {
+				// invitations sent before roles were stored granted view access
+				if fi.Role == domain.NoneShareRole {
+					fi.Role = domain.ViewerShareRole
+				}
+
+				invitation = &SentInvitationSchema{SentFileInvitationSchema: fi}
+				byID[fi.InvitationId] = invitation
+				invitations = append(invitations, invitation)
+			}
+
+			invitation.ItemPaths = append(invitation.ItemPaths, domain.FullPath{
+				DbId:      file.DbID,
+				Bucket:    file.Bucket,
+				Path:      file.Path,
+				BucketKey: file.BucketKey,
+			})
+			invitation.Keys = append(invitation.Keys, file.EncryptionKey)
+			invitation.SentFileIds = append(invitation.SentFileIds, file.ID.String())
+		}
+	}
+
+	sort.SliceStable(invitations, func(i, j int) bool {
+		return invitations[i].CreatedAt < invitations[j].CreatedAt
+	})
+
+	return invitations
+}
+
 func (m *model) initSentFileModel(ctx context.Context) (context.Context, *thread.ID, error) {
 	metaCtx, dbID, err := m.getMetaThreadContext(ctx)
 	if err != nil {
@@ -166,6 +536,10 @@ func (m *model) initSentFileModel(ctx context.Context) (context.Context, *thread
 		log.Debug("initSentFileModel: collection already exists")
 	}
 
+	if err := m.threads.NewCollection(metaCtx, *dbID, GetSentInvitationCollectionConfig()); err != nil {
+		log.Debug("initSentFileModel: sent invitation collection already exists")
+	}
+
 	return metaCtx, dbID, nil
 }
 
@@ -175,3 +549,14 @@ func GetSentFileCollectionConfig() db.CollectionConfig {
 		Schema: util.SchemaFromInstance(&SentFileSchema{}, false),
 	}
 }
+
+func GetSentInvitationCollectionConfig() db.CollectionConfig {
+	return db.CollectionConfig{
+		Name:   sentInvitationModelName,
+		Schema: util.SchemaFromInstance(&SentInvitationIndexSchema{}, false),
+		Indexes: []db.Index{{
+			Path:   "invitationId",
+			Unique: true,
+		}},
+	}
+}
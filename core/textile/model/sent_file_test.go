@@ -0,0 +1,84 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroupSentInvitations_ShouldBuildInvitationsFromSentFiles(t *testing.T) {
+	first := SentFileInvitationSchema{InvitationId: "first", Status: domain.PENDING, CreatedAt: 1}
+	second := SentFileInvitationSchema{InvitationId: "second", Status: domain.ACCEPTED, CreatedAt: 2}
+
+	files := []*SentFileSchema{
+		{
+			ID:                          "file-a",
+			SentFileViaInvitationSchema: SentFileViaInvitationSchema{Bucket: "personal", Path: "/a.txt", EncryptionKey: []byte("a")},
+			Invitations:                 []SentFileInvitationSchema{second, first},
+		},
+		{
+			ID:                          "file-b",
+			SentFileViaInvitationSchema: SentFileViaInvitationSchema{Bucket: "personal", Path: "/b.txt", EncryptionKey: []byte("b")},
+			Invitations:                 []SentFileInvitationSchema{first},
+		},
+		{
+			ID:                          "file-c",
+			SentFileViaInvitationSchema: SentFileViaInvitationSchema{Bucket: "personal", Path: "/c.txt"},
+		},
+	}
+
+	invitations := groupSentInvitations(files)
+
+	assert.Len(t, invitations, 2)
+	assert.Equal(t, "first", invitations[0].InvitationId)
+	assert.Equal(t, domain.PENDING, invitations[0].Status)
+	assert.Equal(t, []string{"file-a", "file-b"}, invitations[0].SentFileIds)
+	assert.Equal(t, [][]byte{[]byte("a"), []byte("b")}, invitations[0].Keys)
+	assert.Equal(t, "/b.txt", invitations[0].ItemPaths[1].Path)
+	assert.Equal(t, "second", invitations[1].InvitationId)
+	assert.Equal(t, []string{"file-a"}, invitations[1].SentFileIds)
+}
+
+func TestGroupSentInvitations_ShouldDefaultMissingRoleToViewer(t *testing.T) {
+	files := []*SentFileSchema{
+		{
+			ID: "file-a",
+			Invitations: []SentFileInvitationSchema{
+				{InvitationId: "before-roles", CreatedAt: 1},
+				{InvitationId: "editor", Role: domain.EditorShareRole, CreatedAt: 2},
+			},
+		},
+	}
+
+	invitations := groupSentInvitations(files)
+
+	assert.Len(t, invitations, 2)
+	assert.Equal(t, domain.ViewerShareRole, invitations[0].Role)
+	assert.Equal(t, domain.EditorShareRole, invitations[1].Role)
+}
+
+func TestSelectSentInvitations_ShouldOnlyReturnIndexedInvitationsInIndexOrder(t *testing.T) {
+	files := []*SentFileSchema{
+		{
+			ID: "file-a",
+			Invitations: []SentFileInvitationSchema{
+				{InvitationId: "first", CreatedAt: 1},
+				{InvitationId: "second", CreatedAt: 2},
+				{InvitationId: "third", CreatedAt: 3},
+			},
+		},
+	}
+	indexes := []*SentInvitationIndexSchema{
+		{InvitationId: "third"},
+		{InvitationId: "first"},
+		{InvitationId: "missing"},
+	}
+
+	invitations := selectSentInvitations(indexes, files)
+
+	assert.Len(t, invitations, 2)
+	assert.Equal(t, "third", invitations[0].InvitationId)
+	assert.Equal(t, "first", invitations[1].InvitationId)
+	assert.Equal(t, []string{"file-a"}, invitations[0].SentFileIds)
+}
@@ -91,6 +91,43 @@ func (m *model) FindSharedPublicKey(ctx context.Context, pubKey string) (*Shared
 	return files[0], nil
 }
 
+// Replaces a shared public key with the one it was rotated to
+func (m *model) ReplaceSharedPublicKey(ctx context.Context, oldPubKey, newPubKey string) (*SharedPublicKeySchema, error) {
+	existing, err := m.FindSharedPublicKey(ctx, oldPubKey)
+	if err != nil {
+		return m.CreateSharedPublicKey(ctx, newPubKey)
+	}
+
+	if _, err := m.FindSharedPublicKey(ctx, newPubKey); err == nil {
+		// the new key is already known, only the old one has to go
+		metaCtx, dbID, err := m.initSharedPublicKey(ctx)
+		if err != nil && dbID == nil {
+			return nil, err
+		}
+
+		if err := m.threads.Delete(metaCtx, *dbID, sharedPublicKeyModel, []string{existing.ID.String()}); err != nil {
+			return nil, err
+		}
+
+		return m.FindSharedPublicKey(ctx, newPubKey)
+	}
+
+	metaCtx, dbID, err := m.initSharedPublicKey(ctx)
+	if err != nil && dbID == nil {
+		return nil, err
+	}
+
+	existing.PublicKey = newPubKey
+	existing.UpdatedAt = time.Now().UnixNano()
+
+	instances := client.Instances{existing}
+	if err := m.threads.Save(metaCtx, *dbID, sharedPublicKeyModel, instances); err != nil {
+		return nil, err
+	}
+
+	return existing, nil
+}
+
 func (m *model) initSharedPublicKey(ctx context.Context) (context.Context, *thread.ID, error) {
 	metaCtx, dbID, err := m.getMetaThreadContext(ctx)
 	if err != nil {
@@ -6,15 +6,23 @@ import (
 )
 
 type Notifier struct {
-	s sync.Synchronizer
+	s        sync.Synchronizer
+	onUpload func(bucketSlug string)
 }
 
-func New(s sync.Synchronizer) *Notifier {
+// New returns a notifier that syncs uploaded files, onUpload is optional and
+// is called after each upload while the bucket is still locked
+func New(s sync.Synchronizer, onUpload func(bucketSlug string)) *Notifier {
 	return &Notifier{
-		s: s,
+		s:        s,
+		onUpload: onUpload,
 	}
 }
 
 func (n *Notifier) OnUploadFile(bucketSlug string, bucketPath string, result path.Resolved, root path.Path) {
 	n.s.NotifyItemAdded(bucketSlug, bucketPath)
+
+	if n.onUpload != nil {
+		n.onUpload(bucketSlug)
+	}
 }
@@ -3,12 +3,15 @@ package textile
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/opentracing/opentracing-go"
 
 	"github.com/FleekHQ/space-daemon/config"
 
 	"github.com/FleekHQ/space-daemon/core/ipfs"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
 
 	"github.com/ipfs/go-cid"
 	"github.com/pkg/errors"
@@ -160,3 +163,131 @@ func (tc *textileClient) DownloadPublicItem(ctx context.Context, cid cid.Cid) (i
 	gatewayUrl := tc.cfg.GetString(config.TextileHubGatewayUrl, "https://hub.textile.io")
 	return ipfs.DownloadIpfsItemViaGateway(ctx, gatewayUrl, cid)
 }
+
+var errPublicLinkAlreadyRevoked = errors.New("public link is already revoked")
+
+// ListPublicLinks returns the public links generated by the user.
+// Links that expired or reached their max downloads are revoked in the background, see revokeInactivePublicLinks.
+func (tc *textileClient) ListPublicLinks(ctx context.Context, seek string, limit int) ([]*domain.PublicLink, string, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TextileClient.ListPublicLinks")
+	defer span.Finish()
+
+	links, err := tc.GetModel().ListPublicLinks(ctx, seek, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := []*domain.PublicLink{}
+	if len(links) == 0 {
+		return items, "", nil
+	}
+
+	for _, link := range links {
+		items = append(items, mapPublicLinkSchema(link))
+	}
+
+	offset := links[len(links)-1].ID.String()
+
+	return items, offset, nil
+}
+
+// RevokePublicLink removes the encrypted copy of a public link from the public share bucket.
+// This unpins the cid from the hub so the link stops working once gateways drop it from cache.
+func (tc *textileClient) RevokePublicLink(ctx context.Context, linkID string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "TextileClient.RevokePublicLink")
+	defer span.Finish()
+
+	link, err := tc.GetModel().FindPublicLink(ctx, linkID)
+	if err != nil {
+		return err
+	}
+
+	if link.Revoked {
+		return errPublicLinkAlreadyRevoked
+	}
+
+	return tc.revokePublicLink(ctx, link)
+}
+
+func (tc *textileClient) revokePublicLink(ctx context.Context, link *model.PublicLinkSchema) error {
+	b, err := tc.GetPublicShareBucket(ctx)
+	if err != nil {
+		return errors.Wrap(err, "failed to get public files bucket")
+	}
+
+	if _, err := b.DeleteDirOrFile(ctx, link.PublicPath); err != nil {
+		return errors.Wrap(err, "failed to unpin public file")
+	}
+	log.Debug("Unpinned public link", "cid:"+link.FileCid)
+
+	link.Revoked = true
+	_, err = tc.GetModel().UpdatePublicLink(ctx, link)
+	return err
+}
+
+// Revokes links that expired or reached their max downloads since the last check. Expiry is only enforced here,
+// the gateway keeps serving an expired link until its copy is unpinned.
+func (tc *textileClient) revokeInactivePublicLinks(ctx context.Context) {
+	links, err := tc.GetModel().ListPublicLinks(ctx, "", 0)
+	if err != nil {
+		log.Error("failed to list public links", err)
+		return
+	}
+
+	for _, link := range links {
+		if link.Revoked || link.IsActive() {
+			continue
+		}
+
+		if err := tc.revokePublicLink(ctx, link); err != nil {
+			log.Error("failed to revoke inactive public link "+link.ID.String(), err)
+		}
+	}
+}
+
+// CountPublicLinkDownload counts a download of a public link generated by the user and revokes
+// the link once it reaches its max downloads. Downloads of revoked links are not counted.
+func (tc *textileClient) CountPublicLinkDownload(ctx context.Context, fileCid string) error {
+	link, err := tc.GetModel().FindPublicLinkByCid(ctx, fileCid)
+	if err != nil {
+		return err
+	}
+
+	if link.Revoked {
+		return nil
+	}
+
+	link.DownloadCount++
+	if _, err := tc.GetModel().UpdatePublicLink(ctx, link); err != nil {
+		return err
+	}
+
+	if link.IsExhausted() {
+		return tc.revokePublicLink(ctx, link)
+	}
+
+	return nil
+}
+
+func mapPublicLinkSchema(link *model.PublicLinkSchema) *domain.PublicLink {
+	var expiresAt int64
+	if link.ExpiresAt != 0 {
+		expiresAt = time.Unix(0, link.ExpiresAt).Unix()
+	}
+
+	return &domain.PublicLink{
+		ID:            link.ID.String(),
+		Bucket:        link.Bucket,
+		DbID:          link.DbID,
+		Paths:         link.Paths,
+		FileName:      link.FileName,
+		FileCid:       link.FileCid,
+		Link:          link.Link,
+		CreatedAt:     time.Unix(0, link.CreatedAt).Unix(),
+		ExpiresAt:     expiresAt,
+		MaxDownloads:  link.MaxDownloads,
+		DownloadCount: link.DownloadCount,
+		Revoked:       link.Revoked,
+		Active:        link.IsActive(),
+	}
+}
@@ -4,12 +4,14 @@ import (
 	"context"
 	"encoding/hex"
 	"fmt"
+	gopath "path"
 	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
 
 	"github.com/FleekHQ/space-daemon/core/textile/model"
+	"github.com/FleekHQ/space-daemon/core/textile/utils"
 
 	"github.com/pkg/errors"
 
@@ -18,6 +20,7 @@ import (
 	"github.com/FleekHQ/space-daemon/log"
 	crypto "github.com/libp2p/go-libp2p-crypto"
 	"github.com/textileio/go-threads/core/thread"
+	buckets_pb "github.com/textileio/textile/v2/api/bucketsd/pb"
 	"github.com/textileio/textile/v2/buckets"
 )
 
@@ -26,24 +29,16 @@ func (tc *textileClient) ManageShareFilesViaPublicKey(
 	paths []domain.FullPath,
 	pubkeys []crypto.PubKey,
 	keys [][]byte,
-	role domain.SharedFilesRoleAction,
+	role domain.ShareRole,
 ) error {
 	var err error
 	ctx, err = tc.getHubCtx(ctx)
 	if err != nil {
 		return err
 	}
-	var bucketRole buckets.Role
-	switch role {
-	case domain.ReadWriteRoleAction:
-		// NOTE: setting to admin because receiving user
-		// should be able to see members and reshare
-		// as well
-		bucketRole = buckets.Admin
-	case domain.DeleteRoleAction:
-		bucketRole = buckets.None
-	default:
-		return errors.New("unsupported shared files role")
+	bucketRole, err := shareRoleToBucketRole(role)
+	if err != nil {
+		return err
 	}
 
 	for i, pth := range paths {
@@ -65,11 +60,149 @@ func (tc *textileClient) ManageShareFilesViaPublicKey(
 		if err != nil {
 			return err
 		}
+
+		// items under a folder keep their own roles once they are backed up,
+		// so the share has to be applied to the whole subtree
+		err = tc.pushPathAccessRolesToSubtree(ctx, sbc, pth.BucketKey, pth.Path, roles)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Pushes roles to every item under path. Does nothing if path is a file.
+func (tc *textileClient) pushPathAccessRolesToSubtree(
+	ctx context.Context,
+	sbc *SecureBucketClient,
+	bucketKey string,
+	path string,
+	roles map[string]buckets.Role,
+) error {
+	dir, err := sbc.ListPath(ctx, bucketKey, path)
+	if err != nil {
+		return err
+	}
+
+	if !dir.Item.IsDir {
+		return nil
+	}
+
+	for _, item := range dir.Item.Items {
+		if utils.IsMetaFileName(item.Name) {
+			continue
+		}
+
+		childPath := gopath.Join(path, item.Name)
+		if err := sbc.PushPathAccessRoles(ctx, bucketKey, childPath, roles); err != nil {
+			return err
+		}
+
+		if item.IsDir {
+			if err := tc.pushPathAccessRolesToSubtree(ctx, sbc, bucketKey, childPath, roles); err != nil {
+				return err
+			}
+		}
 	}
 
 	return nil
 }
 
+var errUnsupportedShareRole = errors.New("unsupported shared files role")
+
+func shareRoleToBucketRole(role domain.ShareRole) (buckets.Role, error) {
+	switch role {
+	case domain.NoneShareRole:
+		return buckets.None, nil
+	// NOTE: the hub has no comment permission so commenters are given read access,
+	// the daemon of the sharer keeps their role, see sentFileRoles
+	case domain.ViewerShareRole, domain.CommenterShareRole:
+		return buckets.Reader, nil
+	case domain.EditorShareRole:
+		return buckets.Writer, nil
+	case domain.AdminShareRole:
+		// admin can also see members and reshare
+		return buckets.Admin, nil
+	default:
+		return buckets.None, errUnsupportedShareRole
+	}
+}
+
+func bucketRoleToShareRole(role buckets.Role) domain.ShareRole {
+	switch role {
+	case buckets.Reader:
+		return domain.ViewerShareRole
+	case buckets.Writer:
+		return domain.EditorShareRole
+	case buckets.Admin:
+		return domain.AdminShareRole
+	default:
+		return domain.NoneShareRole
+	}
+}
+
+// Maps the roles of a path to the members that have access to it
+func membersFromRoles(rs map[string]buckets.Role) ([]domain.Member, error) {
+	members := make([]domain.Member, 0)
+	for pubk, role := range rs {
+		key := &thread.Libp2pPubKey{}
+
+		err := key.UnmarshalString(pubk)
+		if err != nil {
+			log.Error(fmt.Sprintf("key.UnmarshalString(pubk=%+v)", pubk), err)
+			return nil, err
+		}
+
+		pk := key.PubKey
+
+		b, err := pk.Raw()
+		if err != nil {
+			return nil, err
+		}
+
+		members = append(members, domain.Member{
+			Address:   address.DeriveAddress(pk),
+			PublicKey: hex.EncodeToString(b),
+			Role:      bucketRoleToShareRole(role),
+		})
+	}
+
+	return members, nil
+}
+
+// Returns the roles the user gave to the members a path was shared with, by their hex encoded public key.
+// Paths the user did not share have no roles.
+func (tc *textileClient) sentFileRoles(ctx context.Context, dbID, bucket, path string) map[string]domain.ShareRole {
+	roles := make(map[string]domain.ShareRole)
+
+	file, err := tc.GetModel().FindSentFileForPath(ctx, dbID, bucket, path)
+	if err != nil {
+		return roles
+	}
+
+	// invitations are kept in the order they were sent, so the last one sets the role
+	for _, invitation := range file.Invitations {
+		if invitation.Status == domain.REJECTED || invitation.Status == domain.CANCELLED {
+			continue
+		}
+		roles[invitation.InviteePublicKey] = invitation.Role
+	}
+
+	return roles
+}
+
+// Reports the members the hub gives read access as commenters when the user shared the path with them as such
+func withSentFileRoles(members []domain.Member, roles map[string]domain.ShareRole) []domain.Member {
+	for i, member := range members {
+		if member.Role == domain.ViewerShareRole && roles[member.PublicKey] == domain.CommenterShareRole {
+			members[i].Role = domain.CommenterShareRole
+		}
+	}
+
+	return members
+}
+
 var errInvitationNotPending = errors.New("invitation is no more pending")
 var errInvitationAlreadyAccepted = errors.New("invitation is already accepted")
 var errInvitationAlreadyRejected = errors.New("invitation is already rejected")
@@ -297,58 +430,109 @@ func (tc *textileClient) buildInvitationSharedDirEntry(
 		return nil, err
 	}
 
-	ipfsHash := f.Item.Cid
-	name := f.Item.Name
-	isDir := false
-	size := f.GetItem().Size
-	ext := strings.Replace(filepath.Ext(name), ".", "", -1)
-	updatedAt := f.GetItem().Metadata.UpdatedAt
+	fileBucket := file.Bucket
+	fileDbID := file.DbID
+
+	if isSentFiles {
+		fileBucket = defaultPersonalBucketSlug
+		fileDbID = ""
+	}
 
-	rs, err := sbc.PullPathAccessRoles(ctx, file.BucketKey, file.Path)
+	res, err := tc.buildSharedPathItemEntry(ctx, sbc, file, f.Item, file.Path, isSentFiles)
 	if err != nil {
-		// TEMP: returning empty members list until we
-		// fix it on textile side
-		//return nil, "", err
-		rs = make(map[string]buckets.Role)
+		return nil, err
 	}
+	res.Bucket = fileBucket
+	res.DbID = fileDbID
+	res.Created = time.Unix(0, file.CreatedAt).Format(time.RFC3339)
 
-	members := make([]domain.Member, 0)
-	for pubk, _ := range rs {
-		key := &thread.Libp2pPubKey{}
+	return res, nil
+}
 
-		err = key.UnmarshalString(pubk)
-		if err != nil {
-			log.Error(fmt.Sprintf("key.UnmarshalString(pubk=%+v)", pubk), err)
-			return nil, err
-		}
+// GetReceivedDirectory lists the items inside a folder that was shared with the user.
+// path can be the shared folder itself or any folder under it.
+func (tc *textileClient) GetReceivedDirectory(
+	ctx context.Context,
+	dbID, bucket, path string,
+) ([]*domain.SharedDirEntry, error) {
+	file, err := tc.GetModel().FindReceivedFileForPath(ctx, dbID, bucket, path)
+	if err != nil {
+		return nil, err
+	}
 
-		pk := key.PubKey
+	ctx, _, err = tc.getBucketContext(ctx, file.DbID, file.Bucket, true, file.EncryptionKey)
+	if err != nil {
+		return nil, err
+	}
 
-		b, err := pk.Raw()
+	sbc := tc.getSecureBucketsClient(tc.hb)
+
+	dir, err := sbc.ListPath(ctx, file.BucketKey, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dir.Item.IsDir {
+		return nil, errors.New("shared path is not a directory")
+	}
+
+	items := []*domain.SharedDirEntry{}
+	for _, item := range dir.Item.Items {
+		if utils.IsMetaFileName(item.Name) {
+			continue
+		}
+
+		res, err := tc.buildSharedPathItemEntry(ctx, sbc, file, item, gopath.Join(path, item.Name), false)
 		if err != nil {
 			return nil, err
 		}
+		res.Bucket = file.Bucket
+		res.DbID = file.DbID
 
-		members = append(members, domain.Member{
-			Address:   address.DeriveAddress(pk),
-			PublicKey: hex.EncodeToString(b),
-		})
+		items = append(items, res)
 	}
 
-	fileBucket := file.Bucket
-	fileDbID := file.DbID
+	return items, nil
+}
 
-	if isSentFiles {
-		fileBucket = defaultPersonalBucketSlug
-		fileDbID = ""
+func (tc *textileClient) buildSharedPathItemEntry(
+	ctx context.Context,
+	sbc *SecureBucketClient,
+	file *model.ReceivedFileSchema,
+	item *buckets_pb.PathItem,
+	itemPath string,
+	locallyAvailable bool,
+) (*domain.SharedDirEntry, error) {
+	ipfsHash := item.Cid
+	name := item.Name
+	isDir := item.IsDir
+	size := item.Size
+	ext := strings.Replace(filepath.Ext(name), ".", "", -1)
+	// the hub does not keep creation times, items are listed as created when the share was received
+	createdAt := file.CreatedAt
+	updatedAt := createdAt
+	if item.Metadata != nil && item.Metadata.UpdatedAt != 0 {
+		updatedAt = item.Metadata.UpdatedAt
+	}
+
+	rs, err := sbc.PullPathAccessRoles(ctx, file.BucketKey, itemPath)
+	if err != nil {
+		// TEMP: returning empty members list until we
+		// fix it on textile side
+		//return nil, "", err
+		rs = make(map[string]buckets.Role)
 	}
 
+	members, err := membersFromRoles(rs)
+	if err != nil {
+		return nil, err
+	}
+	members = withSentFileRoles(members, tc.sentFileRoles(ctx, file.DbID, file.Bucket, itemPath))
+
 	res := &domain.SharedDirEntry{
-		Bucket: fileBucket,
-		DbID:   fileDbID,
 		FileInfo: domain.FileInfo{
 			IpfsHash:         ipfsHash,
-			LocallyAvailable: isSentFiles,
+			LocallyAvailable: locallyAvailable,
 			BackedUp:         true,
 
 			// TODO: Reflect correct state when we add local updates syncing to remote
@@ -356,12 +540,12 @@ func (tc *textileClient) buildInvitationSharedDirEntry(
 			RestoreInProgress: false,
 
 			DirEntry: domain.DirEntry{
-				Path:          file.Path,
+				Path:          itemPath,
 				IsDir:         isDir,
 				Name:          name,
 				SizeInBytes:   strconv.FormatInt(size, 10),
 				FileExtension: ext,
-				Created:       time.Unix(0, file.CreatedAt).Format(time.RFC3339),
+				Created:       time.Unix(0, createdAt).Format(time.RFC3339),
 				Updated:       time.Unix(0, updatedAt).Format(time.RFC3339),
 			},
 		},
@@ -400,30 +584,18 @@ func (tc *textileClient) GetPathAccessRoles(ctx context.Context, b Bucket, path
 
 	// log.Debug(fmt.Sprintf("PullPathAccessRoles roles=%+v", rs))
 
-	members := make([]domain.Member, 0)
-	for pubk, _ := range rs {
-		key := &thread.Libp2pPubKey{}
-
-		err = key.UnmarshalString(pubk)
-		if err != nil {
-			log.Error(fmt.Sprintf("key.UnmarshalString(pubk=%+v)", pubk), err)
-			return nil, err
-		}
-
-		pk := key.PubKey
-
-		b, err := pk.Raw()
-		if err != nil {
-			return nil, err
-		}
+	members, err := membersFromRoles(rs)
+	if err != nil {
+		return nil, err
+	}
 
-		members = append(members, domain.Member{
-			Address:   address.DeriveAddress(pk),
-			PublicKey: hex.EncodeToString(b),
-		})
+	// files of the personal bucket are shared from its mirror bucket
+	sentBucket := bucketSlug
+	if bucketSlug == GetDefaultBucketSlug() {
+		sentBucket = GetDefaultMirrorBucketSlug()
 	}
 
-	return members, nil
+	return withSentFileRoles(members, tc.sentFileRoles(ctx, bucket.RemoteDbID, sentBucket, path)), nil
 }
 
 // return true if file was shared
@@ -448,3 +620,127 @@ func (tc *textileClient) isSharedFile(ctx context.Context, bucket Bucket, path s
 
 	return len(roles) > 0
 }
+
+// ListSentInvitations returns the invitations sent by the user along with their status.
+// Pending invitations that expired are reported as expired, the invitee loses access to the files
+// on the next expiry check.
+func (tc *textileClient) ListSentInvitations(
+	ctx context.Context,
+	seek string,
+	limit int,
+) ([]*domain.SentInvitation, string, error) {
+	invitations, err := tc.GetModel().ListSentInvitations(ctx, seek, limit)
+	if err != nil {
+		return nil, "", err
+	}
+
+	items := []*domain.SentInvitation{}
+	if len(invitations) == 0 {
+		return items, "", nil
+	}
+
+	for _, invitation := range invitations {
+		items = append(items, mapSentInvitationSchema(invitation))
+	}
+
+	offset := invitations[len(invitations)-1].InvitationId
+
+	return items, offset, nil
+}
+
+// removes the invitee access to the files of an expired invitation and marks it as rejected
+func (tc *textileClient) expireSentInvitation(ctx context.Context, invitation *model.SentInvitationSchema) error {
+	b, err := hex.DecodeString(invitation.InviteePublicKey)
+	if err != nil {
+		return err
+	}
+
+	pk, err := crypto.UnmarshalEd25519PublicKey(b)
+	if err != nil {
+		return err
+	}
+
+	err = tc.ManageShareFilesViaPublicKey(ctx, invitation.ItemPaths, []crypto.PubKey{pk}, invitation.Keys, domain.NoneShareRole)
+	if err != nil {
+		return err
+	}
+
+	invitation.Status = domain.REJECTED
+	_, err = tc.GetModel().UpdateSentInvitation(ctx, invitation)
+	return err
+}
+
+// expires pending invitations that reached their expiry date since the last check
+func (tc *textileClient) expireSentInvitations(ctx context.Context) {
+	invitations, err := tc.GetModel().ListSentInvitations(ctx, "", 0)
+	if err != nil {
+		log.Error("failed to list sent invitations", err)
+		return
+	}
+
+	for _, invitation := range invitations {
+		if !invitation.IsExpired() {
+			continue
+		}
+
+		if err := tc.expireSentInvitation(ctx, invitation); err != nil {
+			log.Error("failed to expire sent invitation "+invitation.InvitationId, err)
+		}
+	}
+}
+
+var errInvalidInvitationReply = errors.New("invitation reply was not sent by the invitee")
+
+// updates the status of a pending sent invitation after the invitee replied to it
+func (tc *textileClient) updateSentInvitationStatus(ctx context.Context, invitationID string, status domain.InvitationStatus, sender thread.PubKey) error {
+	invitation, err := tc.GetModel().FindSentInvitation(ctx, invitationID)
+	if err != nil {
+		return err
+	}
+
+	// anyone knowing the invitation id could otherwise accept or reject it
+	if !isSentInvitationInvitee(invitation, sender) {
+		return errInvalidInvitationReply
+	}
+
+	// the invitation was cancelled or expired before the reply arrived
+	if invitation.Status != domain.PENDING {
+		log.Debug("Ignoring reply to sent invitation that is no more pending", "invitationId:"+invitationID)
+		return nil
+	}
+
+	invitation.Status = status
+	_, err = tc.GetModel().UpdateSentInvitation(ctx, invitation)
+	return err
+}
+
+// checks the sender is the public key the invitation was sent to
+func isSentInvitationInvitee(invitation *model.SentInvitationSchema, sender thread.PubKey) bool {
+	if sender == nil {
+		return false
+	}
+
+	invitee, err := decodeHexPublicKey(invitation.InviteePublicKey)
+	if err != nil {
+		return false
+	}
+
+	return thread.NewLibp2pPubKey(invitee).Equals(sender)
+}
+
+func mapSentInvitationSchema(invitation *model.SentInvitationSchema) *domain.SentInvitation {
+	var expiresAt int64
+	if invitation.ExpiresAt != 0 {
+		expiresAt = time.Unix(0, invitation.ExpiresAt).Unix()
+	}
+
+	return &domain.SentInvitation{
+		InvitationID:     invitation.InvitationId,
+		InviteePublicKey: invitation.InviteePublicKey,
+		ItemPaths:        invitation.ItemPaths,
+		Status:           invitation.Status,
+		CreatedAt:        time.Unix(0, invitation.CreatedAt).Unix(),
+		ExpiresAt:        expiresAt,
+		Expired:          invitation.IsExpired(),
+	}
+}
@@ -0,0 +1,73 @@
+package textile
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
+	crypto "github.com/libp2p/go-libp2p-crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/buckets"
+)
+
+func TestShareRoleToBucketRole_GivesCommentersReadAccess(t *testing.T) {
+	role, err := shareRoleToBucketRole(domain.CommenterShareRole)
+	assert.Nil(t, err)
+	assert.Equal(t, buckets.Reader, role)
+}
+
+func TestWithSentFileRoles_ReportsCommenters(t *testing.T) {
+	members := []domain.Member{
+		{PublicKey: "commenter", Role: domain.ViewerShareRole},
+		{PublicKey: "viewer", Role: domain.ViewerShareRole},
+		{PublicKey: "editor", Role: domain.EditorShareRole},
+		{PublicKey: "unknown", Role: domain.ViewerShareRole},
+	}
+	roles := map[string]domain.ShareRole{
+		"commenter": domain.CommenterShareRole,
+		"viewer":    domain.ViewerShareRole,
+		// the hub is the source of truth for the access granted
+		"editor": domain.CommenterShareRole,
+	}
+
+	members = withSentFileRoles(members, roles)
+
+	assert.Equal(t, domain.CommenterShareRole, members[0].Role)
+	assert.Equal(t, domain.ViewerShareRole, members[1].Role)
+	assert.Equal(t, domain.EditorShareRole, members[2].Role)
+	assert.Equal(t, domain.ViewerShareRole, members[3].Role)
+}
+
+func TestIsSentInvitationInvitee_OnlyAcceptsTheInvitee(t *testing.T) {
+	_, invitee, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.Nil(t, err)
+	_, other, err := crypto.GenerateEd25519Key(rand.Reader)
+	assert.Nil(t, err)
+
+	raw, _ := invitee.Raw()
+	invitation := &model.SentInvitationSchema{}
+	invitation.InviteePublicKey = hex.EncodeToString(raw)
+
+	assert.True(t, isSentInvitationInvitee(invitation, thread.NewLibp2pPubKey(invitee)))
+	assert.False(t, isSentInvitationInvitee(invitation, thread.NewLibp2pPubKey(other)))
+	assert.False(t, isSentInvitationInvitee(invitation, nil))
+}
+
+func TestMapSentInvitationSchema_ReportsExpiredPendingInvitations(t *testing.T) {
+	past := time.Now().Add(-time.Hour).UnixNano()
+	invitation := &model.SentInvitationSchema{}
+	invitation.Status = domain.PENDING
+	invitation.ExpiresAt = past
+
+	mapped := mapSentInvitationSchema(invitation)
+	assert.True(t, mapped.Expired)
+	assert.Equal(t, domain.PENDING, mapped.Status)
+	assert.Equal(t, time.Unix(0, past).Unix(), mapped.ExpiresAt)
+
+	invitation.Status = domain.ACCEPTED
+	assert.False(t, mapSentInvitationSchema(invitation).Expired)
+}
@@ -0,0 +1,179 @@
+package textile
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	tc "github.com/textileio/go-threads/api/client"
+	"github.com/textileio/go-threads/core/db"
+	"github.com/textileio/go-threads/core/thread"
+	"github.com/textileio/textile/v2/api/common"
+	"github.com/textileio/textile/v2/api/usersd/client"
+	"github.com/textileio/textile/v2/cmd"
+	textileMail "github.com/textileio/textile/v2/mail"
+	mail "github.com/textileio/textile/v2/mail/local"
+)
+
+const signerMailboxReconnectInterval = 5 * time.Second
+
+// signerMailbox is the hub mailbox of an identity kept by an external signer.
+// The local mailbox of the textile mail library stores the identity private key in its config file,
+// so it cannot be used when the daemon does not have the key. Messages are opened through the signer.
+type signerMailbox struct {
+	clients   *cmd.Clients
+	id        thread.Identity
+	apiKey    string
+	apiSecret string
+
+	tokenLock sync.Mutex
+	token     thread.Token
+}
+
+func newSignerMailbox(ctx context.Context, clients *cmd.Clients, id thread.Identity, apiKey, apiSecret string) (*signerMailbox, error) {
+	m := &signerMailbox{
+		clients:   clients,
+		id:        id,
+		apiKey:    apiKey,
+		apiSecret: apiSecret,
+	}
+
+	ctx, err := m.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := clients.Users.SetupMailbox(ctx); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+func (m *signerMailbox) Identity() thread.Identity {
+	return m.id
+}
+
+func (m *signerMailbox) SendMessage(ctx context.Context, to thread.PubKey, body []byte) (client.Message, error) {
+	ctx, err := m.context(ctx)
+	if err != nil {
+		return client.Message{}, err
+	}
+
+	return m.clients.Users.SendMessage(ctx, m.id, to, body)
+}
+
+func (m *signerMailbox) ListInboxMessages(ctx context.Context, opts ...client.ListOption) ([]client.Message, error) {
+	ctx, err := m.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.clients.Users.ListInboxMessages(ctx, opts...)
+}
+
+// WatchInbox sends the inbox events to mevents, same as the local mailbox of the textile mail library
+func (m *signerMailbox) WatchInbox(ctx context.Context, mevents chan<- mail.MailboxEvent, offline bool) (<-chan cmd.WatchState, error) {
+	ctx, err := m.context(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	box, err := m.clients.Users.SetupMailbox(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !offline {
+		return m.listenWhileConnected(ctx, box, mevents)
+	}
+
+	return cmd.Watch(ctx, func(ctx context.Context) (<-chan cmd.WatchState, error) {
+		return m.listenWhileConnected(ctx, box, mevents)
+	}, signerMailboxReconnectInterval)
+}
+
+func (m *signerMailbox) listenWhileConnected(ctx context.Context, boxID thread.ID, mevents chan<- mail.MailboxEvent) (<-chan cmd.WatchState, error) {
+	state := make(chan cmd.WatchState)
+	go func() {
+		defer close(state)
+
+		events, err := m.clients.Threads.Listen(ctx, boxID, []tc.ListenOption{{
+			Type:       tc.ListenAll,
+			Collection: textileMail.InboxCollectionName,
+		}})
+		if err != nil {
+			state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
+			return
+		}
+
+		errs := make(chan error)
+		go func() {
+			for e := range events {
+				if e.Err != nil {
+					errs <- e.Err
+					continue
+				}
+
+				switch e.Action.Type {
+				case tc.ActionCreate, tc.ActionSave:
+					var msg client.Message
+					if err := msg.UnmarshalInstance(e.Action.Instance); err != nil {
+						errs <- err
+						return
+					}
+
+					eventType := mail.MessageRead
+					if e.Action.Type == tc.ActionCreate {
+						eventType = mail.NewMessage
+					}
+
+					mevents <- mail.MailboxEvent{
+						Type:      eventType,
+						MessageID: db.InstanceID(e.Action.InstanceID),
+						Message:   msg,
+					}
+				case tc.ActionDelete:
+					mevents <- mail.MailboxEvent{
+						Type:      mail.MessageDeleted,
+						MessageID: db.InstanceID(e.Action.InstanceID),
+					}
+				}
+			}
+		}()
+
+		state <- cmd.WatchState{State: cmd.Online}
+
+		select {
+		case err := <-errs:
+			state <- cmd.WatchState{Err: err, Aborted: !cmd.IsConnectionError(err)}
+		case <-ctx.Done():
+		}
+	}()
+
+	return state, nil
+}
+
+func (m *signerMailbox) context(ctx context.Context) (context.Context, error) {
+	ctx = common.NewAPIKeyContext(ctx, m.apiKey)
+	if m.apiSecret != "" {
+		var err error
+		ctx, err = common.CreateAPISigContext(ctx, time.Now().Add(time.Hour), m.apiSecret)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	m.tokenLock.Lock()
+	defer m.tokenLock.Unlock()
+
+	if m.token == "" {
+		tok, err := m.clients.Threads.GetToken(ctx, m.id)
+		if err != nil {
+			return nil, err
+		}
+		m.token = tok
+	}
+
+	return thread.NewTokenContext(ctx, m.token), nil
+}
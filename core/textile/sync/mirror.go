@@ -3,6 +3,7 @@ package sync
 import (
 	"context"
 	"fmt"
+	gopath "path"
 
 	"github.com/FleekHQ/space-daemon/config"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
@@ -79,31 +80,64 @@ func (s *synchronizer) addCurrentUserAsFileOwner(ctx context.Context, bucket, pa
 		return err
 	}
 
-	roles := make(map[string]buckets.Role)
-	pk, err := s.kc.GetStoredPublicKey()
+	mirror, err := s.getMirrorBucket(ctx, bucket)
 	if err != nil {
 		return err
 	}
-	tpk := thread.NewLibp2pPubKey(pk)
-	roles[tpk.String()] = buckets.Admin
 
-	mirror, err := s.getMirrorBucket(ctx, bucket)
+	bucketsClient := mirror.GetClient()
+	bucketCtx, _, err := s.getBucketCtx(ctx, bucketModel.RemoteDbID, bucketModel.RemoteBucketSlug, true, bucketModel.EncryptionKey)
 	if err != nil {
 		return err
 	}
 
-	bucketsClient := mirror.GetClient()
-	bucketCtx, _, err := s.getBucketCtx(ctx, bucketModel.RemoteDbID, bucketModel.RemoteBucketSlug, true, bucketModel.EncryptionKey)
+	pk, err := s.kc.GetStoredPublicKey()
 	if err != nil {
 		return err
 	}
+	owner := thread.NewLibp2pPubKey(pk).String()
+
+	// New items get their own empty roles on the hub, so roles of the nearest shared folder
+	// are copied over to keep the item visible to the users the folder was shared with.
+	roles := make(map[string]buckets.Role)
+	for parent := gopath.Dir(path); parent != "." && parent != "/"; parent = gopath.Dir(parent) {
+		parentRoles, err := bucketsClient.PullPathAccessRoles(bucketCtx, mirror.GetData().Key, parent)
+		if err != nil {
+			log.Debug(fmt.Sprintf("unable to pull roles of parent folder %s", parent), err.Error())
+			continue
+		}
+
+		if !isSharedWithOthers(parentRoles, owner) {
+			continue
+		}
+
+		for k, r := range parentRoles {
+			roles[k] = r
+		}
+		break
+	}
+
+	roles[owner] = buckets.Admin
 
 	return bucketsClient.PushPathAccessRoles(bucketCtx, mirror.GetData().Key, path, roles)
 }
 
+func isSharedWithOthers(roles map[string]buckets.Role, owner string) bool {
+	for k, r := range roles {
+		if k != owner && r != buckets.None {
+			return true
+		}
+	}
+
+	return false
+}
+
 // Creates a mirror bucket.
 func (s *synchronizer) createMirrorBucket(ctx context.Context, slug string, enckey []byte) (*model.MirrorBucketSchema, error) {
-	newSlug := slug + "_mirror"
+	newSlug, err := s.mirrorBucketSlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
 	log.Debug("Creating a new mirror bucket with slug " + newSlug)
 	dbID, err := s.createMirrorThread(ctx, newSlug)
 	if err != nil {
@@ -147,6 +181,29 @@ func (s *synchronizer) createMirrorBucket(ctx context.Context, slug string, enck
 	}, nil
 }
 
+// Returns the slug of the mirror of a new bucket.
+// A bucket renamed from the same slug keeps its mirror, so another slug is used in that case.
+func (s *synchronizer) mirrorBucketSlug(ctx context.Context, slug string) (string, error) {
+	buckets, err := s.model.ListBuckets(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	used := make(map[string]bool)
+	for _, b := range buckets {
+		if b.Slug != slug && b.MirrorBucketSchema != nil {
+			used[b.RemoteBucketSlug] = true
+		}
+	}
+
+	mirrorSlug := slug + "_mirror"
+	for i := 1; used[mirrorSlug]; i++ {
+		mirrorSlug = fmt.Sprintf("%s_mirror_%d", slug, i)
+	}
+
+	return mirrorSlug, nil
+}
+
 // Creates a remote hub thread for the mirror bucket
 func (s *synchronizer) createMirrorThread(ctx context.Context, slug string) (*thread.ID, error) {
 	log.Debug("createMirrorThread: Generating a new threadID ...")
@@ -123,6 +123,11 @@ func (s *synchronizer) uploadAllFilesInPath(ctx context.Context, bucket, path st
 			continue
 		}
 
+		if s.isLazyRestored(bucket, item.Path) {
+			// the placeholder of a file restored with metadata only, the mirror has its content
+			continue
+		}
+
 		// If the current item is a file, we add it to the queue so that it both gets pinned and synced
 		s.NotifyItemAdded(bucket, item.Path)
 	}
@@ -175,3 +180,51 @@ func (s *synchronizer) deleteAllFilesInPath(ctx context.Context, bucket, path st
 
 	return nil
 }
+
+// Removes the content of the backed up files in path from the mirror bucket, their mirror files are kept
+func (s *synchronizer) unpinBackedUpFilesInPath(ctx context.Context, bucket, path string) error {
+	localBucket, err := s.getBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	dir, err := localBucket.ListDirectory(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range dir.Item.Items {
+		if utils.IsMetaFileName(item.Name) {
+			continue
+		}
+
+		if item.IsDir {
+			err := s.unpinBackedUpFilesInPath(ctx, bucket, item.Path)
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		mf, err := s.model.FindMirrorFileByPathAndBucketSlug(ctx, item.Path, bucket)
+		if err != nil {
+			return err
+		}
+
+		if mf == nil || !mf.Backup {
+			continue
+		}
+
+		if err := s.unsetMirrorFileBackup(ctx, item.Path, bucket); err != nil {
+			return err
+		}
+
+		uft := newTask(unpinFileTask, []string{bucket, item.Path})
+		s.enqueueTask(uft, s.filePinningQueue)
+	}
+
+	s.notifySyncNeeded()
+
+	return nil
+}
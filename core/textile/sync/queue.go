@@ -104,6 +104,27 @@ func (s *synchronizer) restoreQueue() error {
 	return nil
 }
 
+// Dequeues the tasks of the bucket that did not start yet, they are removed from the queue on the next sync
+func (s *synchronizer) dequeueBucketTasks(bucket string, queue *list.List) []*Task {
+	dequeued := []*Task{}
+	for curr := queue.Front(); curr != nil; curr = curr.Next() {
+		task := curr.Value.(*Task)
+		if len(task.Args) == 0 || task.Args[0] != bucket {
+			continue
+		}
+
+		if task.State != taskQueued && task.State != taskFailed {
+			continue
+		}
+
+		task.State = taskDequeued
+		delete(s.queueHashMap, task.ID)
+		dequeued = append(dequeued, task)
+	}
+
+	return dequeued
+}
+
 func (s *synchronizer) isTaskEnqueued(task *Task) bool {
 	existingTask := s.queueHashMap[task.ID]
 	if existingTask == nil {
@@ -1,9 +1,14 @@
 package sync
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"regexp"
+	"strings"
 
 	"github.com/FleekHQ/space-daemon/core/events"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/textile/bucket"
 	"github.com/FleekHQ/space-daemon/core/textile/utils"
 	"github.com/FleekHQ/space-daemon/log"
@@ -30,23 +35,114 @@ func (s *synchronizer) newerBucketPath(ctx context.Context, srcBucket, targetBuc
 	return targetBucket, nil
 }
 
-// restore bucket by downloading files to the local from the mirror bucket
-func (s *synchronizer) restoreBucket(ctx context.Context, bucketSlug string) error {
+const lazyRestoreKeyPrefix = "lazyRestore#"
 
+// file of the mirror bucket a restore downloads
+type restoreCandidate struct {
+	path string
+	item *bucket.DirEntries
+	// the local bucket has the content of an older version
+	locallyAvailable bool
+	// the content is downloaded when the file is opened
+	lazy bool
+}
+
+// restore bucket by downloading files to the local from the mirror bucket.
+// Only the files under the path prefixes are restored, all of them without prefixes.
+// With metadataOnly, files are listed locally with their metadata and not downloaded until they are opened.
+func (s *synchronizer) restoreBucket(ctx context.Context, bucketSlug string, prefixes []string, metadataOnly bool) error {
+	bucketModel, err := s.model.FindBucket(ctx, bucketSlug)
+	if err != nil {
+		return err
+	}
+
+	candidates, err := s.restoreCandidates(ctx, bucketSlug, prefixes, metadataOnly)
+	if err != nil {
+		return err
+	}
+
+	var localBucket bucket.BucketInterface
+	if metadataOnly {
+		if localBucket, err = s.getBucket(ctx, bucketSlug); err != nil {
+			return err
+		}
+	}
+
+	for _, c := range candidates {
+		if c.lazy {
+			if err := s.restoreMetadata(ctx, localBucket, bucketSlug, c); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if s.eventNotifier != nil && c.item != nil {
+			info := utils.MapDirEntryToFileInfo(api_buckets_pb.ListPathResponse(*c.item), c.path)
+			info.BackedUp = true
+			info.LocallyAvailable = c.locallyAvailable
+			info.RestoreInProgress = true
+			s.eventNotifier.SendFileEvent(events.NewFileEvent(info, events.FileRestoring, bucketSlug, bucketModel.DbID))
+		}
+
+		s.NotifyFileRestore(bucketSlug, c.path)
+	}
+
+	return nil
+}
+
+// PlanBucketRestore returns the files a restore with the same options would download and their total size
+func (s *synchronizer) PlanBucketRestore(ctx context.Context, bucketSlug string, prefixes []string, metadataOnly bool) (domain.RestorePlan, error) {
+	candidates, err := s.restoreCandidates(ctx, bucketSlug, prefixes, metadataOnly)
+	if err != nil {
+		return domain.RestorePlan{}, err
+	}
+
+	plan := domain.RestorePlan{
+		Files: []domain.RestoreFile{},
+	}
+	for _, c := range candidates {
+		if c.lazy {
+			continue
+		}
+
+		var size int64
+		if c.item != nil {
+			size = c.item.Item.Size
+		}
+
+		plan.Files = append(plan.Files, domain.RestoreFile{
+			Path:        c.path,
+			SizeInBytes: size,
+		})
+		plan.TotalBytes += size
+	}
+
+	return plan, nil
+}
+
+// Returns the files of the mirror bucket under the prefixes that are newer than the local ones
+func (s *synchronizer) restoreCandidates(ctx context.Context, bucketSlug string, prefixes []string, metadataOnly bool) ([]restoreCandidate, error) {
 	localBucket, err := s.getBucket(ctx, bucketSlug)
 	if err != nil {
 		log.Error("Error in getBucket", err)
-		return err
+		return nil, err
 	}
 
 	mirrorBucket, err := s.getMirrorBucket(ctx, bucketSlug)
 	if err != nil {
 		log.Error("Error in getMirrorBucket", err)
-		return err
+		return nil, err
 	}
 
+	candidates := []restoreCandidate{}
 	iterator := func(c context.Context, b *bucket.Bucket, itemPath string) error {
+		if !matchesRestorePrefixes(itemPath, prefixes) {
+			return nil
+		}
+
+		// files restored with metadata only are listed locally without their content
 		exists, _ := localBucket.FileExists(c, itemPath)
+		exists = exists && !s.isLazyRestored(bucketSlug, itemPath)
 
 		if exists {
 			newerBucket, err := s.newerBucketPath(c, localBucket, mirrorBucket, itemPath)
@@ -60,27 +156,142 @@ func (s *synchronizer) restoreBucket(ctx context.Context, bucketSlug string) err
 			}
 		}
 
-		bucketModel, err := s.model.FindBucket(ctx, bucketSlug)
-		if err != nil {
-			return err
+		candidate := restoreCandidate{
+			path:             itemPath,
+			locallyAvailable: exists,
 		}
 
-		item, err := mirrorBucket.ListDirectory(ctx, itemPath)
-		if s.eventNotifier != nil && err == nil {
-			info := utils.MapDirEntryToFileInfo(api_buckets_pb.ListPathResponse(*item), itemPath)
-			info.BackedUp = true
-			info.LocallyAvailable = exists
-			info.RestoreInProgress = true
-			s.eventNotifier.SendFileEvent(events.NewFileEvent(info, events.FileRestoring, bucketSlug, bucketModel.DbID))
+		if item, err := mirrorBucket.ListDirectory(c, itemPath); err == nil {
+			candidate.item = item
 		}
 
-		s.NotifyFileRestore(bucketSlug, itemPath)
+		// without the mirror listing there is no metadata to restore, the content is restored instead
+		candidate.lazy = metadataOnly && candidate.item != nil
+
+		candidates = append(candidates, candidate)
 		return nil
 	}
 
 	if _, err = mirrorBucket.Each(ctx, "", iterator, true); err != nil {
+		return nil, err
+	}
+
+	return candidates, nil
+}
+
+// Writes the listing and metadata of the file to the local bucket without its content.
+// Files the local bucket does not list yet get an empty placeholder, its metadata is kept with the lazy restore key.
+func (s *synchronizer) restoreMetadata(ctx context.Context, localBucket bucket.BucketInterface, bucketSlug string, c restoreCandidate) error {
+	if _, err := localBucket.ListDirectory(ctx, c.path); err != nil {
+		// not notified, so the placeholder is not backed up over the mirror file
+		if _, _, err := localBucket.DownloadFile(ctx, c.path, bytes.NewReader(nil)); err != nil {
+			return err
+		}
+	}
+
+	lazy := domain.LazyRestoredFile{
+		Cid:         c.item.Item.Cid,
+		SizeInBytes: c.item.Item.Size,
+	}
+	if c.item.Item.Metadata != nil {
+		lazy.UpdatedAt = c.item.Item.Metadata.UpdatedAt
+	}
+
+	out, err := json.Marshal(lazy)
+	if err != nil {
+		return err
+	}
+
+	return s.st.Set([]byte(getLazyRestoreKey(bucketSlug, c.path)), out)
+}
+
+// RestoreLazyFile downloads the content of a file restored with metadata only.
+// It does nothing for other files.
+func (s *synchronizer) RestoreLazyFile(ctx context.Context, bucketSlug, path string) error {
+	if !s.isLazyRestored(bucketSlug, path) {
+		return nil
+	}
+
+	path = lazyRestorePath(path)
+	if err := s.restoreFile(ctx, bucketSlug, path); err != nil {
 		return err
 	}
 
+	return s.st.Remove([]byte(getLazyRestoreKey(bucketSlug, path)))
+}
+
+// GetLazyRestoredFile returns the metadata of a file restored without its content
+func (s *synchronizer) GetLazyRestoredFile(bucketSlug, path string) (domain.LazyRestoredFile, bool) {
+	val, err := s.st.Get([]byte(getLazyRestoreKey(bucketSlug, path)))
+	if err != nil {
+		return domain.LazyRestoredFile{}, false
+	}
+
+	var lazy domain.LazyRestoredFile
+	if err := json.Unmarshal(val, &lazy); err != nil {
+		return domain.LazyRestoredFile{}, false
+	}
+
+	return lazy, true
+}
+
+func (s *synchronizer) isLazyRestored(bucketSlug, path string) bool {
+	_, err := s.st.Get([]byte(getLazyRestoreKey(bucketSlug, path)))
+	return err == nil
+}
+
+// Removes the lazy restore keys of the bucket, they are set again for newBucket if it is not empty
+func (s *synchronizer) removeLazyRestoreKeys(bucket, newBucket string) error {
+	prefix := lazyRestoreKeyPrefix + bucket + "#"
+	keys, err := s.st.KeysWithPrefix(prefix)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if newBucket != "" {
+			cid, err := s.st.Get([]byte(key))
+			if err != nil {
+				return err
+			}
+
+			if err := s.st.Set([]byte(getLazyRestoreKey(newBucket, strings.TrimPrefix(key, prefix))), cid); err != nil {
+				return err
+			}
+		}
+
+		if err := s.st.Remove([]byte(key)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
+
+func getLazyRestoreKey(bucketSlug, path string) string {
+	return lazyRestoreKeyPrefix + bucketSlug + "#" + lazyRestorePath(path)
+}
+
+var ipfsPathPrefix = regexp.MustCompile(`^/ip(f|n)s/[^/]*`)
+
+// Lazy restore keys use the path relative to the bucket root, listings return it under the ipfs path of the root
+func lazyRestorePath(path string) string {
+	return strings.TrimPrefix(ipfsPathPrefix.ReplaceAllString(path, ""), "/")
+}
+
+// Returns true if the bucket path is under one of the prefixes, or there are no prefixes
+func matchesRestorePrefixes(path string, prefixes []string) bool {
+	if len(prefixes) == 0 {
+		return true
+	}
+
+	path = strings.Trim(path, "/")
+	for _, prefix := range prefixes {
+		prefix = strings.Trim(prefix, "/")
+		if prefix == "" || path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+
+	return false
+}
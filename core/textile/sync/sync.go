@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/FleekHQ/space-daemon/core/events"
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 )
 
 type EventNotifier interface {
@@ -14,10 +15,16 @@ type Synchronizer interface {
 	NotifyItemAdded(bucket, path string)
 	NotifyItemRemoved(bucket, path string)
 	NotifyBucketCreated(bucket string, enckey []byte)
+	NotifyBucketRemoved(bucket string)
+	NotifyBucketRenamed(bucket, newBucket string)
 	NotifyBucketBackupOn(bucket string)
 	NotifyBucketBackupOff(bucket string)
 	NotifyBucketRestore(bucket string)
+	NotifySelectiveBucketRestore(bucket string, prefixes []string, metadataOnly bool)
 	NotifyFileRestore(bucket, path string)
+	PlanBucketRestore(ctx context.Context, bucket string, prefixes []string, metadataOnly bool) (domain.RestorePlan, error)
+	RestoreLazyFile(ctx context.Context, bucket, path string) error
+	GetLazyRestoredFile(bucket, path string) (domain.LazyRestoredFile, bool)
 	NotifyBucketStartup(bucket string)
 	NotifyIndexItemAdded(bucket, path, dbId string)
 	Start(ctx context.Context)
@@ -2,17 +2,21 @@ package sync_test
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	sy "sync"
 	"testing"
 
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/textile"
 	"github.com/FleekHQ/space-daemon/core/textile/bucket"
+	"github.com/FleekHQ/space-daemon/core/textile/model"
 	"github.com/FleekHQ/space-daemon/core/textile/sync"
 	"github.com/FleekHQ/space-daemon/mocks"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/textileio/go-threads/core/thread"
+	buckets_pb "github.com/textileio/textile/v2/api/bucketsd/pb"
 )
 
 var (
@@ -130,3 +134,124 @@ func TestSync_Restore(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, s.String(), s2.String())
 }
+
+func TestSync_NotifyBucketRenamed_And_Removed(t *testing.T) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	s := initSync(t)
+	ctx := context.Background()
+
+	s.NotifyItemAdded("Bucket", "path")
+	s.NotifyItemAdded("Other", "path")
+
+	mockStore.On("KeysWithPrefix", mock.Anything).Return([]string{}, nil)
+	s.NotifyBucketRenamed("Bucket", "Renamed")
+	s.NotifyBucketRemoved("Other")
+
+	// only the task moved to the renamed bucket is processed
+	mockModel.On("FindBucket", mock.Anything, "Renamed").Return(nil, errors.New("some error"))
+	mockStore.On("Set", mock.Anything, mock.Anything).Return(nil)
+
+	s.Start(ctx)
+
+	s.Shutdown()
+
+	// the dequeued tasks stay in the queue until a sync succeeds
+	expectedState := "Textile sync [file pinning]: Total: 0, Queued: 0, Pending: 0, Failed: 0\nTextile sync [buckets]: Total: 3, Queued: 1, Pending: 0, Failed: 0\n"
+
+	assert.Equal(t, expectedState, s.String())
+	mockModel.AssertExpectations(t)
+}
+
+func TestSync_PlanBucketRestore(t *testing.T) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	s := initSync(t)
+	ctx := context.Background()
+
+	localBucket := new(mocks.Bucket)
+	mirrorBucket := new(mocks.Bucket)
+	mockClient.On("GetBucket", mock.Anything, "personal", (*textile.GetBucketForRemoteFileInput)(nil)).Return(localBucket, nil)
+	mockClient.On("GetBucket", mock.Anything, "personal", mockRemoteFile).Return(mirrorBucket, nil)
+
+	mirrorBucket.On("Each", mock.Anything, "", mock.Anything, true).Run(func(args mock.Arguments) {
+		iterator := args.Get(2).(func(context.Context, *bucket.Bucket, string) error)
+		for _, path := range []string{"docs/a.txt", "docs/sub/b.txt", "photos/c.jpg"} {
+			assert.Nil(t, iterator(ctx, nil, path))
+		}
+	}).Return(3, nil)
+
+	sizes := map[string]int64{"docs/a.txt": 10, "docs/sub/b.txt": 20}
+	for path, size := range sizes {
+		mirrorBucket.On("ListDirectory", mock.Anything, path).Return(&bucket.DirEntries{
+			Item: &buckets_pb.PathItem{Cid: "cid", Size: size},
+		}, nil)
+		localBucket.On("FileExists", mock.Anything, path).Return(false, nil)
+	}
+
+	// a.txt is listed without its content, b.txt is missing from the local bucket
+	localBucket.On("ListDirectory", mock.Anything, "docs/a.txt").Return(&bucket.DirEntries{
+		Item: &buckets_pb.PathItem{Cid: "cid"},
+	}, nil)
+	localBucket.On("ListDirectory", mock.Anything, "docs/sub/b.txt").Return(nil, errors.New("not found"))
+
+	plan, err := s.PlanBucketRestore(ctx, "personal", []string{"/docs"}, false)
+	assert.Nil(t, err)
+	assert.Equal(t, domain.RestorePlan{
+		Files: []domain.RestoreFile{
+			{Path: "docs/a.txt", SizeInBytes: 10},
+			{Path: "docs/sub/b.txt", SizeInBytes: 20},
+		},
+		TotalBytes: 30,
+	}, plan)
+
+	// the content of files restored with metadata only is downloaded on open, listed or not
+	plan, err = s.PlanBucketRestore(ctx, "personal", []string{"/docs"}, true)
+	assert.Nil(t, err)
+	assert.Equal(t, domain.RestorePlan{
+		Files: []domain.RestoreFile{},
+	}, plan)
+}
+
+func TestSync_RestoreLazyFile_DownloadsContentOfListedFile(t *testing.T) {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	s := initSync(t)
+	ctx := context.Background()
+
+	localBucket := new(mocks.Bucket)
+	mirrorBucket := new(mocks.Bucket)
+	mockClient.On("GetBucket", mock.Anything, "personal", (*textile.GetBucketForRemoteFileInput)(nil)).Return(localBucket, nil)
+	mockClient.On("GetBucket", mock.Anything, "personal", mockRemoteFile).Return(mirrorBucket, nil)
+
+	key := []byte("lazyRestore#personal#docs/a.txt")
+	lazy, err := json.Marshal(domain.LazyRestoredFile{Cid: "cid", SizeInBytes: 10, UpdatedAt: 5})
+	assert.Nil(t, err)
+	mockStore.On("Get", key).Return(lazy, nil)
+	mockStore.On("Remove", key).Return(nil)
+
+	// the local listing is as new as the mirror one, the content is downloaded anyway
+	mirrorBucket.On("GetFile", mock.Anything, "docs/a.txt", mock.Anything).Return(nil)
+	mirrorBucket.On("ListDirectory", mock.Anything, "docs/a.txt").Return(&bucket.DirEntries{
+		Item: &buckets_pb.PathItem{Cid: "cid", Size: 10},
+	}, nil)
+	localBucket.On("DownloadFile", mock.Anything, "docs/a.txt", mock.Anything).Return(nil, nil, nil)
+	localBucket.On("Slug").Return("personal")
+	// the hub roles of the owner are not set, it is only logged
+	mockModel.On("FindBucket", mock.Anything, "personal").Return(nil, errors.New("offline")).Once()
+	mockModel.On("FindBucket", mock.Anything, "personal").Return(&model.BucketSchema{Slug: "personal"}, nil)
+
+	restored, exists := s.GetLazyRestoredFile("personal", "/docs/a.txt")
+	assert.True(t, exists)
+	assert.Equal(t, int64(10), restored.SizeInBytes)
+
+	err = s.RestoreLazyFile(ctx, "personal", "/docs/a.txt")
+	assert.Nil(t, err)
+
+	localBucket.AssertCalled(t, "DownloadFile", mock.Anything, "docs/a.txt", mock.Anything)
+	localBucket.AssertNotCalled(t, "UpdatedAt", mock.Anything, mock.Anything)
+	mockStore.AssertCalled(t, "Remove", key)
+}
@@ -149,6 +149,20 @@ func (s *synchronizer) NotifyBucketRestore(bucket string) {
 	s.notifySyncNeeded()
 }
 
+// NotifySelectiveBucketRestore restores the files under the path prefixes only,
+// and only their listing with metadataOnly, their content is downloaded when they are opened
+func (s *synchronizer) NotifySelectiveBucketRestore(bucket string, prefixes []string, metadataOnly bool) {
+	mode := restoreContent
+	if metadataOnly {
+		mode = restoreMetadataOnly
+	}
+
+	t := newTask(bucketRestoreTask, append([]string{bucket, mode}, prefixes...))
+	s.enqueueTask(t, s.taskQueue)
+
+	s.notifySyncNeeded()
+}
+
 func (s *synchronizer) NotifyFileRestore(bucket, path string) {
 	t := newTask(restoreFileTask, []string{bucket, path})
 	s.enqueueTask(t, s.taskQueue)
@@ -158,7 +172,7 @@ func (s *synchronizer) NotifyFileRestore(bucket, path string) {
 
 func (s *synchronizer) NotifyBucketStartup(bucket string) {
 	s.NotifyBucketRestore(bucket)
-	s.NotifyBucketBackupOn(bucket) // does nothing if !bucket.Backup
+	s.NotifyBucketBackupOn(bucket) // does nothing if the backup policy is off
 
 	s.notifySyncNeeded()
 }
@@ -172,6 +186,34 @@ func (s *synchronizer) NotifyIndexItemAdded(bucket, path, dbId string) {
 	s.notifySyncNeeded()
 }
 
+// NotifyBucketRemoved drops the pending tasks of a deleted bucket
+func (s *synchronizer) NotifyBucketRemoved(bucket string) {
+	s.dequeueBucketTasks(bucket, s.taskQueue)
+	s.dequeueBucketTasks(bucket, s.filePinningQueue)
+
+	if err := s.removeLazyRestoreKeys(bucket, ""); err != nil {
+		log.Error("Error removing lazy restore keys of bucket "+bucket, err)
+	}
+}
+
+// NotifyBucketRenamed moves the pending tasks of a renamed bucket to its new slug
+func (s *synchronizer) NotifyBucketRenamed(bucket, newBucket string) {
+	for _, queue := range []*list.List{s.taskQueue, s.filePinningQueue} {
+		for _, task := range s.dequeueBucketTasks(bucket, queue) {
+			t := newTask(task.Type, append([]string{newBucket}, task.Args[1:]...))
+			t.Parallelizable = task.Parallelizable
+			t.MaxRetries = task.MaxRetries
+			s.enqueueTask(t, queue)
+		}
+	}
+
+	if err := s.removeLazyRestoreKeys(bucket, newBucket); err != nil {
+		log.Error("Error moving lazy restore keys of bucket "+bucket, err)
+	}
+
+	s.notifySyncNeeded()
+}
+
 func (s *synchronizer) notifySyncNeeded() {
 	if !s.isRunning {
 		return
@@ -9,6 +9,7 @@ import (
 
 	"golang.org/x/sync/errgroup"
 
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/textile/model"
 	api_buckets_pb "github.com/textileio/textile/v2/api/bucketsd/pb"
 
@@ -39,6 +40,19 @@ func (s *synchronizer) processAddItem(ctx context.Context, task *Task) error {
 		return err
 	}
 
+	// the placeholder of a file restored with metadata only is not added, its content was replaced
+	if s.isLazyRestored(bucket, path) {
+		if err := s.st.Remove([]byte(getLazyRestoreKey(bucket, path))); err != nil {
+			return err
+		}
+	}
+
+	// only the thread of the bucket is backed up, file content is not uploaded
+	if bucketModel.GetSettings().BackupPolicy == domain.BackupMetadataOnly {
+		s.NotifyIndexItemAdded(bucket, path, "")
+		return nil
+	}
+
 	mirrorFile, err := s.model.FindMirrorFileByPathAndBucketSlug(ctx, path, bucket)
 
 	if bucketModel.Backup && mirrorFile == nil {
@@ -181,7 +195,8 @@ func (s *synchronizer) processBucketBackupOn(ctx context.Context, task *Task) er
 	}
 
 	// race
-	if bucketModel.Backup == false {
+	policy := bucketModel.GetSettings().BackupPolicy
+	if policy == domain.BackupOff {
 		return nil
 	}
 
@@ -194,6 +209,10 @@ func (s *synchronizer) processBucketBackupOn(ctx context.Context, task *Task) er
 		return err
 	}
 
+	if policy == domain.BackupMetadataOnly {
+		return s.unpinBackedUpFilesInPath(ctx, bucket, "")
+	}
+
 	return s.uploadAllFilesInPath(ctx, bucket, "")
 }
 
@@ -210,7 +229,7 @@ func (s *synchronizer) processBucketBackupOff(ctx context.Context, task *Task) e
 	}
 
 	// race
-	if bucketModel.Backup == true {
+	if bucketModel.GetSettings().BackupPolicy != domain.BackupOff {
 		return nil
 	}
 
@@ -244,7 +263,15 @@ func (s *synchronizer) processBucketRestoreTask(ctx context.Context, task *Task)
 		return errors.New("trying to restore a bucket that has not been replicated. Recreating mirror bucket.")
 	}
 
-	if err := s.restoreBucket(ctx, bucket); err != nil {
+	// tasks of selective restores have the mode and the path prefixes after the bucket
+	prefixes := []string{}
+	metadataOnly := false
+	if len(task.Args) > 1 {
+		metadataOnly = task.Args[1] == restoreMetadataOnly
+		prefixes = task.Args[2:]
+	}
+
+	if err := s.restoreBucket(ctx, bucket, prefixes, metadataOnly); err != nil {
 		return err
 	}
 
@@ -259,24 +286,37 @@ func (s *synchronizer) processRestoreFile(ctx context.Context, task *Task) error
 	bucket := task.Args[0]
 	path := task.Args[1]
 
-	localBucket, err := s.getBucket(ctx, bucket)
-	if err != nil {
+	if err := s.restoreFile(ctx, bucket, path); err != nil {
 		return err
 	}
 
-	mirrorBucket, err := s.getMirrorBucket(ctx, bucket)
+	// restored before it was opened
+	return s.st.Remove([]byte(getLazyRestoreKey(bucket, path)))
+}
+
+// downloads the file from the mirror bucket if it is newer there
+func (s *synchronizer) restoreFile(ctx context.Context, bucket, path string) error {
+	localBucket, err := s.getBucket(ctx, bucket)
 	if err != nil {
 		return err
 	}
 
-	newerBucket, err := s.newerBucketPath(ctx, localBucket, mirrorBucket, path)
+	mirrorBucket, err := s.getMirrorBucket(ctx, bucket)
 	if err != nil {
 		return err
 	}
 
-	if newerBucket == localBucket {
-		// do not overwrite: mirror is not newer
-		return nil
+	// files restored with metadata only are listed locally as new as the mirror, without their content
+	if !s.isLazyRestored(bucket, path) {
+		newerBucket, err := s.newerBucketPath(ctx, localBucket, mirrorBucket, path)
+		if err != nil {
+			return err
+		}
+
+		if newerBucket == localBucket {
+			// do not overwrite: mirror is not newer
+			return nil
+		}
 	}
 
 	// TODO: use timestamp or CID for check
@@ -20,6 +20,12 @@ const (
 	removeIndexItemTask taskType = "REMOVE_INDEX_ITEM"
 )
 
+// modes of the bucket restore tasks
+const (
+	restoreContent      = "CONTENT"
+	restoreMetadataOnly = "METADATA_ONLY"
+)
+
 type taskState string
 
 const (
@@ -44,11 +44,19 @@ type Client interface {
 	GetThreadsConnection() (*threadsClient.Client, error)
 	GetModel() model.Model
 	ListBuckets(ctx context.Context) ([]Bucket, error)
+	GetManagedThreadKeys(ctx context.Context) (map[string]string, error)
+	StoreBucketThreadKeys(ctx context.Context) error
 	ShareBucket(ctx context.Context, bucketSlug string) (*db.Info, error)
 	JoinBucket(ctx context.Context, slug string, ti *domain.ThreadInfo) (bool, error)
 	CreateBucket(ctx context.Context, bucketSlug string) (Bucket, error)
+	DeleteBucket(ctx context.Context, bucketSlug string) error
+	RenameBucket(ctx context.Context, bucketSlug, newBucketSlug string) (Bucket, error)
 	ToggleBucketBackup(ctx context.Context, bucketSlug string, bucketBackup bool) (bool, error)
-	BucketBackupRestore(ctx context.Context, bucketSlug string) error
+	GetBucketSettings(ctx context.Context, bucketSlug string) (domain.BucketSettings, error)
+	UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (domain.BucketSettings, error)
+	BucketBackupRestore(ctx context.Context, bucketSlug string, opts domain.RestoreOptions) (domain.RestorePlan, error)
+	RestoreLazyFile(ctx context.Context, bucketSlug, path string) error
+	GetLazyRestoredFile(bucketSlug, path string) (domain.LazyRestoredFile, bool)
 	SendMessage(ctx context.Context, recipient crypto.PubKey, body []byte) (*client.Message, error)
 	Shutdown() error
 	WaitForReady() chan bool
@@ -61,7 +69,13 @@ type Client interface {
 		paths []domain.FullPath,
 		pubkeys []crypto.PubKey,
 		keys [][]byte,
-		role domain.SharedFilesRoleAction,
+		role domain.ShareRole,
+	) error
+	ManageBucketsAccess(
+		ctx context.Context,
+		bucketSchemas []*model.BucketSchema,
+		pubkey crypto.PubKey,
+		role domain.ShareRole,
 	) error
 	AcceptSharedFilesInvitation(ctx context.Context, invitation domain.Invitation) (domain.Invitation, error)
 	RejectSharedFilesInvitation(ctx context.Context, invitation domain.Invitation) (domain.Invitation, error)
@@ -72,16 +86,23 @@ type Client interface {
 	RemoveKeys(ctx context.Context) error
 	AttachMailboxNotifier(notif GrpcMailboxNotifier)
 	AttachSynchronizerNotifier(notif sync.EventNotifier)
+	AttachListenerHandler(handler EventHandler)
 	GetReceivedFiles(ctx context.Context, accepted bool, seek string, limit int) ([]*domain.SharedDirEntry, string, error)
+	GetReceivedDirectory(ctx context.Context, dbID, bucket, path string) ([]*domain.SharedDirEntry, error)
+	ListSentInvitations(ctx context.Context, seek string, limit int) ([]*domain.SentInvitation, string, error)
 	GetPublicReceivedFile(ctx context.Context, cidHash string, accepted bool) (*domain.SharedDirEntry, string, error)
 	GetSentFiles(ctx context.Context, seek string, limit int) ([]*domain.SharedDirEntry, string, error)
 	GetPathAccessRoles(ctx context.Context, b Bucket, path string) ([]domain.Member, error)
 	GetPublicShareBucket(ctx context.Context) (Bucket, error)
 	DownloadPublicItem(ctx context.Context, cid cid.Cid) (io.ReadCloser, error)
+	ListPublicLinks(ctx context.Context, seek string, limit int) ([]*domain.PublicLink, string, error)
+	RevokePublicLink(ctx context.Context, linkID string) error
+	CountPublicLinkDownload(ctx context.Context, fileCid string) error
 	GetFailedHealthchecks() int
 	DeleteAccount(ctx context.Context) error
 	Listen(ctx context.Context, dbID, threadName string) (<-chan threadsClient.ListenEvent, error)
 	RestoreDB(ctx context.Context) error
+	ResetIdentity(ctx context.Context) error
 	DisableSync()
 }
 
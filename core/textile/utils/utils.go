@@ -3,7 +3,6 @@ package utils
 import (
 	"context"
 	"crypto/rand"
-	"crypto/sha512"
 	"encoding/base32"
 	"encoding/binary"
 	"encoding/hex"
@@ -27,7 +26,6 @@ import (
 	bucketsproto "github.com/textileio/textile/v2/api/bucketsd/pb"
 	"github.com/textileio/textile/v2/api/common"
 	"github.com/textileio/textile/v2/cmd"
-	"golang.org/x/crypto/pbkdf2"
 )
 
 func CastDbIDToString(dbID thread.ID) string {
@@ -63,18 +61,8 @@ func NewDeterministicThreadID(kc keychain.Keychain, threadVariant DeterministicT
 	size := 32
 	variant := thread.Raw
 
-	priv, _, err := kc.GetStoredKeyPairInLibP2PFormat()
-	if err != nil {
-		return thread.ID([]byte{}), err
-	}
-
-	privInBytes, err := priv.Raw()
-	if err != nil {
-		return thread.ID([]byte{}), err
-	}
-
 	// Do a key derivation based on the private key, a constant nonce, and the thread variant
-	num := pbkdf2.Key(privInBytes, []byte("threadID"+threadVariant), 256, size, sha512.New)
+	num, err := kc.DeriveKey([]byte("threadID"+threadVariant), size)
 	if err != nil {
 		return thread.ID([]byte{}), err
 	}
@@ -113,8 +101,7 @@ func GetThreadContext(parentCtx context.Context, threadName string, dbID thread.
 	}
 
 	var publicKey crypto.PubKey
-	var privKey crypto.PrivKey
-	if privKey, publicKey, err = kc.GetStoredKeyPairInLibP2PFormat(); err != nil {
+	if publicKey, err = kc.GetStoredPublicKey(); err != nil {
 		return nil, err
 	}
 
@@ -124,7 +111,12 @@ func GetThreadContext(parentCtx context.Context, threadName string, dbID thread.
 	}
 
 	if threadsClient != nil {
-		tok, err := threadsClient.GetToken(ctx, thread.NewLibp2pIdentity(privKey))
+		identity, err := keychain.NewSignerIdentity(ctx, kc.GetSigner())
+		if err != nil {
+			return nil, err
+		}
+
+		tok, err := threadsClient.GetToken(ctx, identity)
 		if err != nil {
 			return nil, err
 		}
@@ -200,7 +192,7 @@ func FindOrCreateDeterministicThread(
 		return nil, err
 	}
 
-	pk, _, err := kc.GetStoredKeyPairInLibP2PFormat()
+	pk, err := kc.GetLogKey()
 	if err != nil {
 		return nil, err
 	}
@@ -1,13 +1,19 @@
 package utils_test
 
 import (
+	"crypto/sha512"
 	"encoding/hex"
+	"strings"
 	"testing"
 
+	"github.com/99designs/keyring"
+	"github.com/FleekHQ/space-daemon/core/keychain"
 	"github.com/FleekHQ/space-daemon/core/textile/utils"
 	"github.com/FleekHQ/space-daemon/mocks"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"golang.org/x/crypto/pbkdf2"
 )
 
 var (
@@ -32,30 +38,47 @@ func initMocks(t *testing.T) {
 	mockPrivKey, _ = crypto.UnmarshalEd25519PrivateKey(privKeyBytes)
 }
 
+// keychain holding the given private key in a mocked keyring
+func newTestKeychain(privKeyHex string) keychain.Keychain {
+	privKeyBytes, _ := hex.DecodeString(privKeyHex)
+	priv, _ := crypto.UnmarshalEd25519PrivateKey(privKeyBytes)
+	pubBytes, _ := priv.GetPublic().Raw()
+
+	st := new(mocks.Store)
+	st.On("IsOpen").Return(true)
+	st.On("Get", []byte(keychain.PublicKeyStoreKey)).Return(pubBytes, nil)
+
+	ring := new(mocks.Keyring)
+	ring.On("GetMetadata", mock.Anything).Return(keyring.Metadata{}, nil)
+	ring.On("Get", keychain.PrivateKeyStoreKey).Return(keyring.Item{
+		Key:  keychain.PrivateKeyStoreKey,
+		Data: []byte(privKeyHex + "___"),
+	}, nil)
+
+	return keychain.New(keychain.WithStore(st), keychain.WithKeyring(ring))
+}
+
 func TestUtils_NewDeterministicThreadID(t *testing.T) {
 	initMocks(t)
 
-	mockKeychain.On(
-		"GetStoredKeyPairInLibP2PFormat",
-	).Return(mockPrivKey, mockPubKey, nil)
+	mockPrivKeyHex := "dd55f8921f90fdf31c6ef9ad86bd90605602fd7d32dc8ea66ab72deb6a82821c67730a6678566ead5911d71304854daddb1fe98a396551a4be01de65da01f3a9"
+	kc := newTestKeychain(mockPrivKeyHex)
 
-	threadID, err := utils.NewDeterministicThreadID(mockKeychain, utils.MetathreadThreadVariant)
+	threadID, err := utils.NewDeterministicThreadID(kc, utils.MetathreadThreadVariant)
 	assert.Nil(t, err)
-	threadIDCopy, err := utils.NewDeterministicThreadID(mockKeychain, utils.MetathreadThreadVariant)
+	threadIDCopy, err := utils.NewDeterministicThreadID(kc, utils.MetathreadThreadVariant)
 	assert.Nil(t, err)
 
 	// Generate a thread ID from a different private key (changed the last char)
-	mockPrivKeyHex := "dd55f8921f90fdf31c6ef9ad86bd90605602fd7d32dc8ea66ab72deb6a82821c67730a6678566ead5911d71304854daddb1fe98a396551a4be01de65da01f3a8"
-	privKeyBytes, _ := hex.DecodeString(mockPrivKeyHex)
-	diffPrivKey, _ := crypto.UnmarshalEd25519PrivateKey(privKeyBytes)
-	newMockKeychain := new(mocks.Keychain)
-	newMockKeychain.On(
-		"GetStoredKeyPairInLibP2PFormat",
-	).Return(diffPrivKey, nil, nil)
-
-	diffThreadID, err := utils.NewDeterministicThreadID(newMockKeychain, utils.MetathreadThreadVariant)
+	diffPrivKeyHex := "dd55f8921f90fdf31c6ef9ad86bd90605602fd7d32dc8ea66ab72deb6a82821c67730a6678566ead5911d71304854daddb1fe98a396551a4be01de65da01f3a8"
+	diffThreadID, err := utils.NewDeterministicThreadID(newTestKeychain(diffPrivKeyHex), utils.MetathreadThreadVariant)
 	assert.Nil(t, err)
 
 	assert.Equal(t, threadID, threadIDCopy)
 	assert.NotEqual(t, threadID, diffThreadID)
+
+	// the id must not change across versions, otherwise the metathread could not be restored
+	privKeyBytes, _ := hex.DecodeString(mockPrivKeyHex)
+	num := pbkdf2.Key(privKeyBytes, []byte("threadID"+utils.MetathreadThreadVariant), 256, 32, sha512.New)
+	assert.True(t, strings.HasSuffix(string(threadID.Bytes()), string(num)))
 }
@@ -0,0 +1,127 @@
+package vault
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/FleekHQ/space-daemon/config"
+)
+
+// Backend stores encrypted vaults.
+// The vault service key (vsk) is derived from the vault key and is required to fetch a vault back.
+type Backend interface {
+	Put(uuid string, v *StoredVault, backupType string, apiToken string) error
+	Get(uuid string, vsk string, backupType string) (encryptedVault string, err error)
+}
+
+// Supported vault backends, selected through config.SpaceVaultBackend
+const (
+	HTTPBackendName       = "http"
+	FilesystemBackendName = "filesystem"
+	WebDAVBackendName     = "webdav"
+)
+
+var (
+	ErrVaultNotFound       = errors.New("Not Found Error: vault does not exist")
+	ErrIncorrectVaultCreds = errors.New("Unauthorized Error: Incorrect uuid or password.")
+	ErrUnsupportedBackend  = errors.New("unsupported vault backend")
+	errInvalidVaultUuid    = errors.New("invalid vault uuid")
+	errInvalidBackupType   = errors.New("invalid vault backup type")
+)
+
+// VaultRecord is how self-hosted backends persist a vault.
+// Only a hash of the vsk is kept, same as the hosted vault service.
+type VaultRecord struct {
+	Vault   string `json:"vault"`
+	VskHash string `json:"vskHash"`
+	Type    string `json:"type"`
+}
+
+// NewRecord creates the record to persist for a stored vault
+func NewRecord(v *StoredVault, backupType string) *VaultRecord {
+	return &VaultRecord{
+		Vault:   v.Vault,
+		VskHash: hashVsk(v.Vsk),
+		Type:    backupType,
+	}
+}
+
+// Open returns the encrypted vault if the vsk and backup type match the record
+func (r *VaultRecord) Open(vsk string, backupType string) (string, error) {
+	if r.Type != backupType || subtle.ConstantTimeCompare([]byte(r.VskHash), []byte(hashVsk(vsk))) != 1 {
+		return "", ErrIncorrectVaultCreds
+	}
+
+	return r.Vault, nil
+}
+
+func hashVsk(vsk string) string {
+	h := sha256.Sum256([]byte(vsk))
+	return base64.RawStdEncoding.EncodeToString(h[:])
+}
+
+func marshalRecord(r *VaultRecord) ([]byte, error) {
+	return json.Marshal(r)
+}
+
+func unmarshalRecord(data []byte) (*VaultRecord, error) {
+	var r VaultRecord
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+var (
+	validUuid       = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+	validBackupType = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+)
+
+// name of the file holding the vault of the given backup type, so each type of a uuid is kept apart.
+// uuids and types are validated so they cannot escape the vault folder
+func recordFileName(uuid string, backupType string) (string, error) {
+	if !validUuid.MatchString(uuid) {
+		return "", errInvalidVaultUuid
+	}
+
+	if !validBackupType.MatchString(backupType) {
+		return "", errInvalidBackupType
+	}
+
+	return fmt.Sprintf("%s.%s.vault.json", uuid, strings.ToLower(backupType)), nil
+}
+
+// NewFromConfig creates the vault using the backend selected in the config.
+// Defaults to the hosted vault API.
+func NewFromConfig(cfg config.Config) (Vault, error) {
+	salt := cfg.GetString(config.SpaceVaultSaltSecret, "")
+	vaultURL := cfg.GetString(config.SpaceVaultAPIURL, "")
+
+	switch cfg.GetString(config.SpaceVaultBackend, HTTPBackendName) {
+	case HTTPBackendName, "":
+		return New(vaultURL, salt), nil
+	case FilesystemBackendName:
+		dir := cfg.GetString(config.SpaceVaultPath, "")
+		if dir == "" {
+			dir = filepath.Join(cfg.GetString(config.SpaceStorePath, ""), "vaults")
+		}
+		return NewWithBackend(NewFilesystemBackend(dir), salt), nil
+	case WebDAVBackendName:
+		u, err := url.Parse(vaultURL)
+		if err != nil {
+			return nil, err
+		}
+		return NewWithBackend(NewWebDAVBackend(u), salt), nil
+	default:
+		return nil, ErrUnsupportedBackend
+	}
+}
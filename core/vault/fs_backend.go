@@ -0,0 +1,74 @@
+package vault
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// FilesystemBackend keeps vaults as files in a local folder, e.g. in a USB drive
+type FilesystemBackend struct {
+	dir string
+}
+
+func NewFilesystemBackend(dir string) *FilesystemBackend {
+	return &FilesystemBackend{
+		dir: dir,
+	}
+}
+
+// Put stores the vault. The api token is not needed as the folder is owned by the user.
+func (b *FilesystemBackend) Put(uuid string, v *StoredVault, backupType string, apiToken string) error {
+	name, err := recordFileName(uuid, backupType)
+	if err != nil {
+		return err
+	}
+
+	data, err := marshalRecord(NewRecord(v, backupType))
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(b.dir, 0700); err != nil {
+		return err
+	}
+
+	// write to a temp file first so an interrupted write never corrupts an existing vault
+	tmp, err := ioutil.TempFile(b.dir, name+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), filepath.Join(b.dir, name))
+}
+
+func (b *FilesystemBackend) Get(uuid string, vsk string, backupType string) (string, error) {
+	name, err := recordFileName(uuid, backupType)
+	if err != nil {
+		return "", err
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(b.dir, name))
+	if os.IsNotExist(err) {
+		return "", ErrVaultNotFound
+	}
+	if err != nil {
+		return "", err
+	}
+
+	r, err := unmarshalRecord(data)
+	if err != nil {
+		return "", err
+	}
+
+	return r.Open(vsk, backupType)
+}
@@ -0,0 +1,130 @@
+package vault
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+)
+
+// httpBackend talks to the hosted vault API or any server implementing the same protocol
+type httpBackend struct {
+	vaultAPIURL string
+}
+
+type storeVaultRequest struct {
+	Vault string `json:"vault"`
+	Vsk   string `json:"vsk"`
+	Type  string `json:"type"`
+}
+
+type retrieveVaultRequest struct {
+	Vsk  string `json:"vsk"`
+	Type string `json:"type"`
+}
+
+type retrieveVaultResponse struct {
+	EncryptedVault string `json:"encryptedVault"`
+}
+
+func NewHTTPBackend(vaultAPIURL string) *httpBackend {
+	return &httpBackend{
+		vaultAPIURL: vaultAPIURL,
+	}
+}
+
+// Put stores the vault. The API identifies the vault uuid through the api token.
+func (b *httpBackend) Put(uuid string, v *StoredVault, backupType string, apiToken string) error {
+	reqJSON, err := json.Marshal(&storeVaultRequest{
+		Vault: v.Vault,
+		Vsk:   v.Vsk,
+		Type:  backupType,
+	})
+	if err != nil {
+		return err
+	}
+
+	client := &http.Client{
+		CheckRedirect: http.DefaultClient.CheckRedirect,
+	}
+	req, err := http.NewRequest("POST", b.vaultAPIURL+"/vaults", bytes.NewBuffer(reqJSON))
+	if err != nil {
+		return err
+	}
+	req.Header.Add("Authorization", apiToken)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	_, err = parseAPIResponse(resp)
+	return err
+}
+
+func (b *httpBackend) Get(uuid string, vsk string, backupType string) (string, error) {
+	reqJSON, err := json.Marshal(&retrieveVaultRequest{
+		Vsk:  vsk,
+		Type: backupType,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := http.Post(
+		b.vaultAPIURL+"/vaults/"+uuid,
+		"application/json",
+		bytes.NewBuffer(reqJSON),
+	)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusNotFound:
+		return "", ErrVaultNotFound
+	case http.StatusUnauthorized:
+		return "", ErrIncorrectVaultCreds
+	}
+
+	body, err := parseAPIResponse(resp)
+	if err != nil {
+		return "", err
+	}
+
+	var parsedBody retrieveVaultResponse
+	err = json.Unmarshal(body, &parsedBody)
+	if err != nil {
+		return "", err
+	}
+
+	return parsedBody.EncryptedVault, nil
+}
+
+func parseAPIResponse(resp *http.Response) ([]byte, error) {
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
+		var returnedErr domain.APIError
+		err = json.Unmarshal(body, &returnedErr)
+		if err != nil {
+			return nil, err
+		}
+
+		if returnedErr.Message != "" {
+			return nil, errors.New(returnedErr.Message)
+		}
+
+		return nil, errors.New("Unexpected API error")
+	}
+
+	return body, nil
+}
@@ -1,7 +1,6 @@
 package vault
 
 import (
-	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
@@ -10,15 +9,14 @@ import (
 	"encoding/json"
 	"errors"
 	"io"
-	"io/ioutil"
-	"net/http"
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/crypto/scrypt"
 )
 
 type vault struct {
-	vaultAPIURL     string
+	backend         Backend
 	vaultSaltSecret string
 }
 
@@ -32,7 +30,24 @@ const (
 type VkVersion string
 
 const (
+	// PBKDF2 based key derivation
 	VkVersion1 VkVersion = "V1"
+	// scrypt based (memory-hard) key derivation
+	VkVersion2 VkVersion = "V2"
+)
+
+// CurrentVkVersion is used to store new vaults.
+// Vaults stored with older versions are still retrieved and can be migrated by storing them again.
+const CurrentVkVersion = VkVersion2
+
+// versions tried when retrieving a vault, newest first
+var retrieveVersions = []VkVersion{VkVersion2, VkVersion1}
+
+// scrypt parameters of VkVersion2
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
 )
 
 // AES requires key length equal to 16, 24 or 32 bytes
@@ -43,34 +58,28 @@ type VaultItem struct {
 	Value    string
 }
 
-type storeVaultRequest struct {
-	Vault string `json:"vault"`
-	Vsk   string `json:"vsk"`
-	Type  string `json:"type"`
-}
-
 type StoredVault struct {
 	Vault string
 	Vsk   string
 }
 
-type retrieveVaultRequest struct {
-	Vsk  string `json:"vsk"`
-	Type string `json:"type"`
-}
-
-type retrieveVaultResponse struct {
-	EncryptedVault string `json:"encryptedVault"`
-}
-
 type Vault interface {
 	Store(uuid string, passphrase string, backupType domain.KeyBackupType, apiToken string, items []VaultItem) (*StoredVault, error)
 	Retrieve(uuid string, passphrase string, backupType domain.KeyBackupType) ([]VaultItem, error)
+	// RetrieveWithVersion also returns the key derivation version the vault was stored with
+	RetrieveWithVersion(uuid string, passphrase string, backupType domain.KeyBackupType) ([]VaultItem, VkVersion, error)
 }
 
+// New creates a vault stored in the hosted vault API
 func New(vaultAPIURL string, vaultSaltSecret string) *vault {
+	return NewWithBackend(NewHTTPBackend(vaultAPIURL), vaultSaltSecret)
+}
+
+// NewWithBackend creates a vault stored in the given backend.
+// Vaults are encrypted before reaching the backend, so backends never see the vault key.
+func NewWithBackend(backend Backend, vaultSaltSecret string) *vault {
 	return &vault{
-		vaultAPIURL:     vaultAPIURL,
+		backend:         backend,
 		vaultSaltSecret: vaultSaltSecret,
 	}
 }
@@ -83,7 +92,10 @@ func (v *vault) Store(uuid string, passphrase string, backupType domain.KeyBacku
 	}
 
 	// Compute vault key
-	vk := v.computeVk(uuid, passphrase, VkVersion1)
+	vk, err := v.computeVk(uuid, passphrase, CurrentVkVersion)
+	if err != nil {
+		return nil, err
+	}
 
 	// Encrypt vault file using vault key
 	encVf, err := encrypt(vf, vk)
@@ -92,82 +104,68 @@ func (v *vault) Store(uuid string, passphrase string, backupType domain.KeyBacku
 	}
 
 	// Compute vault service key
-	vsk := v.computeVsk(vk, passphrase, VkVersion1)
+	vsk := v.computeVsk(vk, passphrase, CurrentVkVersion)
 
 	// Submit encrypted file and vsk to vault service
-	storeRequest := &storeVaultRequest{
+	result := &StoredVault{
 		Vault: base64.RawStdEncoding.EncodeToString(encVf),
 		Vsk:   base64.RawStdEncoding.EncodeToString(vsk),
-		Type:  domain.KeyBackupType(backupType).String(),
-	}
-	reqJSON, err := json.Marshal(storeRequest)
-	if err != nil {
-		return nil, err
-	}
-
-	client := &http.Client{
-		CheckRedirect: http.DefaultClient.CheckRedirect,
 	}
-	req, err := http.NewRequest("POST", v.vaultAPIURL+"/vaults", bytes.NewBuffer(reqJSON))
-	req.Header.Add("Authorization", apiToken)
 
-	resp, err := client.Do(req)
-	if err != nil {
+	if err := v.backend.Put(uuid, result, backupType.String(), apiToken); err != nil {
 		return nil, err
 	}
-	defer resp.Body.Close()
 
-	_, err = parseAPIResponse(resp)
-	if err != nil {
-		return nil, err
-	}
-
-	result := &StoredVault{
-		Vault: storeRequest.Vault,
-		Vsk:   storeRequest.Vsk,
-	}
 	return result, nil
 }
 
 func (v *vault) Retrieve(uuid string, passphrase string, backupType domain.KeyBackupType) ([]VaultItem, error) {
-	// Compute vault key
-	vk := v.computeVk(uuid, passphrase, VkVersion1)
+	items, _, err := v.RetrieveWithVersion(uuid, passphrase, backupType)
+	return items, err
+}
 
-	// Compute vault service key
-	vsk := v.computeVsk(vk, passphrase, VkVersion1)
+// RetrieveWithVersion fetches the vault trying each key derivation version, newest first,
+// since the vault service does not know which version a vault was stored with.
+func (v *vault) RetrieveWithVersion(
+	uuid string,
+	passphrase string,
+	backupType domain.KeyBackupType,
+) ([]VaultItem, VkVersion, error) {
+	var err error
+	for _, version := range retrieveVersions {
+		var items []VaultItem
+		items, err = v.retrieve(uuid, passphrase, backupType, version)
+		if err == nil {
+			return items, version, nil
+		}
 
-	// Send retrieve request to vault service
-	reqJSON, err := json.Marshal(&retrieveVaultRequest{
-		Vsk:  base64.RawStdEncoding.EncodeToString(vsk),
-		Type: domain.KeyBackupType(backupType).String(),
-	})
-	if err != nil {
-		return nil, err
+		// only try the older version if there is no vault for the vsk of this one. Backends answer with
+		// incorrect credentials in that case too, since they do not tell whether a uuid has a vault
+		if err != ErrVaultNotFound && err != ErrIncorrectVaultCreds {
+			return nil, "", err
+		}
 	}
 
-	resp, err := http.Post(
-		v.vaultAPIURL+"/vaults/"+uuid,
-		"application/json",
-		bytes.NewBuffer(reqJSON),
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer resp.Body.Close()
+	return nil, "", err
+}
 
-	body, err := parseAPIResponse(resp)
+func (v *vault) retrieve(uuid string, passphrase string, backupType domain.KeyBackupType, version VkVersion) ([]VaultItem, error) {
+	// Compute vault key
+	vk, err := v.computeVk(uuid, passphrase, version)
 	if err != nil {
 		return nil, err
 	}
 
-	var parsedBody retrieveVaultResponse
-	err = json.Unmarshal(body, &parsedBody)
+	// Compute vault service key
+	vsk := v.computeVsk(vk, passphrase, version)
+
+	// Fetch the encrypted vault from the vault service
+	encVfBase64, err := v.backend.Get(uuid, base64.RawStdEncoding.EncodeToString(vsk), backupType.String())
 	if err != nil {
 		return nil, err
 	}
 
 	// Decrypt encrypted vault file
-	encVfBase64 := parsedBody.EncryptedVault
 	encVf, err := base64.RawStdEncoding.DecodeString(encVfBase64)
 	if err != nil {
 		return nil, err
@@ -187,14 +185,22 @@ func (v *vault) Retrieve(uuid string, passphrase string, backupType domain.KeyBa
 	return items, nil
 }
 
-func (v *vault) computeVk(uuid string, pass string, version VkVersion) []byte {
-	// In the future, we can increase iterations by doing a switch and mapping
-	// version to a given amount of iterations.
+var errUnknownVkVersion = errors.New("unknown vault key version")
 
-	// version = V1 defaults to 100.000 iterations
-	iterations := 100000
+func (v *vault) computeVk(uuid string, pass string, version VkVersion) ([]byte, error) {
+	salt := []byte(string(version) + v.vaultSaltSecret + uuid)
 
-	return pbkdf2.Key([]byte(pass), []byte(string(version)+v.vaultSaltSecret+uuid), iterations, vaultKeyLength, sha512.New)
+	switch version {
+	case VkVersion1:
+		// version = V1 defaults to 100.000 iterations
+		iterations := 100000
+
+		return pbkdf2.Key([]byte(pass), salt, iterations, vaultKeyLength, sha512.New), nil
+	case VkVersion2:
+		return scrypt.Key([]byte(pass), salt, scryptN, scryptR, scryptP, vaultKeyLength)
+	default:
+		return nil, errUnknownVkVersion
+	}
 }
 
 func (v *vault) computeVsk(vk []byte, pass string, version VkVersion) []byte {
@@ -244,26 +250,3 @@ func decrypt(ciphertext []byte, key []byte) ([]byte, error) {
 		nil,
 	)
 }
-
-func parseAPIResponse(resp *http.Response) ([]byte, error) {
-	body, err := ioutil.ReadAll(resp.Body)
-	if err != nil {
-		return nil, err
-	}
-
-	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
-		var returnedErr domain.APIError
-		err = json.Unmarshal(body, &returnedErr)
-		if err != nil {
-			return nil, err
-		}
-
-		if returnedErr.Message != "" {
-			return nil, errors.New(returnedErr.Message)
-		}
-
-		return nil, errors.New("Unexpected API error")
-	}
-
-	return body, nil
-}
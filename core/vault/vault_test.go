@@ -1,8 +1,10 @@
 package vault_test
 
 import (
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
 	"testing"
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
@@ -157,3 +159,113 @@ func TestVault_RetrieveServerError(t *testing.T) {
 	assert.NotNil(t, err)
 	assert.Nil(t, retrievedItems)
 }
+
+func TestVault_Retrieve_ShouldNotFallBack_OnServerErrors(t *testing.T) {
+	attempts := 0
+	retrieveVaultMock := func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte(`{ "message": "Internal Server Error"}`))
+	}
+
+	handler := http.NewServeMux()
+	handler.HandleFunc("/vaults/"+testUuid, retrieveVaultMock)
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	v := vault.New(server.URL, testSaltSecret)
+
+	retrievedItems, err := v.Retrieve(testUuid, testPassphrase, domain.PASSWORD)
+
+	assert.EqualError(t, err, "Internal Server Error")
+	assert.Nil(t, retrievedItems)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestVault_FilesystemBackend_StoreAndRetrieve(t *testing.T) {
+	dir, err := ioutil.TempDir("", "space-vault-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	testVaultItems := []vault.VaultItem{
+		{
+			ItemType: vault.PrivateKeyWithMnemonic,
+			Value:    "SomePrivateKey",
+		},
+	}
+
+	v := vault.NewWithBackend(vault.NewFilesystemBackend(dir), testSaltSecret)
+
+	_, err = v.Store(testUuid, testPassphrase, domain.PASSWORD, "", testVaultItems)
+	assert.Nil(t, err)
+
+	retrievedItems, err := v.Retrieve(testUuid, testPassphrase, domain.PASSWORD)
+	assert.Nil(t, err)
+	assert.Equal(t, testVaultItems, retrievedItems)
+
+	// wrong passphrase or backup type should not return the vault
+	_, err = v.Retrieve(testUuid, "wrongpass", domain.PASSWORD)
+	assert.Equal(t, vault.ErrIncorrectVaultCreds, err)
+
+	_, err = v.Retrieve(testUuid, testPassphrase, domain.GOOGLE)
+	assert.Equal(t, vault.ErrVaultNotFound, err)
+}
+
+func TestVault_FilesystemBackend_KeepsBackupTypesApart(t *testing.T) {
+	dir, err := ioutil.TempDir("", "space-vault-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	passwordItems := []vault.VaultItem{{ItemType: vault.PrivateKeyWithMnemonic, Value: "PasswordPrivateKey"}}
+	googleItems := []vault.VaultItem{{ItemType: vault.PrivateKeyWithMnemonic, Value: "GooglePrivateKey"}}
+
+	v := vault.NewWithBackend(vault.NewFilesystemBackend(dir), testSaltSecret)
+
+	_, err = v.Store(testUuid, testPassphrase, domain.PASSWORD, "", passwordItems)
+	assert.Nil(t, err)
+	_, err = v.Store(testUuid, "googletoken", domain.GOOGLE, "", googleItems)
+	assert.Nil(t, err)
+
+	retrievedItems, err := v.Retrieve(testUuid, testPassphrase, domain.PASSWORD)
+	assert.Nil(t, err)
+	assert.Equal(t, passwordItems, retrievedItems)
+
+	retrievedItems, err = v.Retrieve(testUuid, "googletoken", domain.GOOGLE)
+	assert.Nil(t, err)
+	assert.Equal(t, googleItems, retrievedItems)
+}
+
+func TestVault_FilesystemBackend_RetrieveNotFound(t *testing.T) {
+	dir, err := ioutil.TempDir("", "space-vault-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	v := vault.NewWithBackend(vault.NewFilesystemBackend(dir), testSaltSecret)
+
+	retrievedItems, err := v.Retrieve(testUuid, testPassphrase, domain.PASSWORD)
+	assert.Equal(t, vault.ErrVaultNotFound, err)
+	assert.Nil(t, retrievedItems)
+}
+
+func TestVault_RetrieveWithVersion_ReturnsStoredVersion(t *testing.T) {
+	dir, err := ioutil.TempDir("", "space-vault-test")
+	assert.Nil(t, err)
+	defer os.RemoveAll(dir)
+
+	testVaultItems := []vault.VaultItem{
+		{
+			ItemType: vault.PrivateKeyWithMnemonic,
+			Value:    "SomePrivateKey",
+		},
+	}
+
+	v := vault.NewWithBackend(vault.NewFilesystemBackend(dir), testSaltSecret)
+
+	_, err = v.Store(testUuid, testPassphrase, domain.PASSWORD, "", testVaultItems)
+	assert.Nil(t, err)
+
+	retrievedItems, version, err := v.RetrieveWithVersion(testUuid, testPassphrase, domain.PASSWORD)
+	assert.Nil(t, err)
+	assert.Equal(t, vault.CurrentVkVersion, version)
+	assert.Equal(t, testVaultItems, retrievedItems)
+}
@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// webDAVBackend keeps vaults as files in a WebDAV server (e.g. Nextcloud).
+// Credentials are taken from the user info of the url, if any.
+type webDAVBackend struct {
+	baseURL  string
+	username string
+	password string
+	client   *http.Client
+}
+
+func NewWebDAVBackend(u *url.URL) *webDAVBackend {
+	b := &webDAVBackend{
+		client: &http.Client{},
+	}
+
+	if u.User != nil {
+		b.username = u.User.Username()
+		b.password, _ = u.User.Password()
+	}
+
+	withoutCreds := *u
+	withoutCreds.User = nil
+	b.baseURL = strings.TrimSuffix(withoutCreds.String(), "/")
+
+	return b
+}
+
+func (b *webDAVBackend) do(method, name string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, b.baseURL+"/"+name, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if b.username != "" {
+		req.SetBasicAuth(b.username, b.password)
+	}
+
+	return b.client.Do(req)
+}
+
+func (b *webDAVBackend) Put(uuid string, v *StoredVault, backupType string, apiToken string) error {
+	name, err := recordFileName(uuid, backupType)
+	if err != nil {
+		return err
+	}
+
+	data, err := marshalRecord(NewRecord(v, backupType))
+	if err != nil {
+		return err
+	}
+
+	resp, err := b.do(http.MethodPut, name, data)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webdav vault upload failed with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (b *webDAVBackend) Get(uuid string, vsk string, backupType string) (string, error) {
+	name, err := recordFileName(uuid, backupType)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := b.do(http.MethodGet, name, nil)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return "", ErrVaultNotFound
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return "", fmt.Errorf("webdav vault download failed with status %d", resp.StatusCode)
+	}
+
+	data, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+
+	r, err := unmarshalRecord(data)
+	if err != nil {
+		return "", err
+	}
+
+	return r.Open(vsk, backupType)
+}
@@ -0,0 +1,266 @@
+package watcher
+
+import (
+	"context"
+	"path/filepath"
+	"sort"
+	s "strings"
+	"sync"
+	"time"
+
+	"github.com/radovskyb/watcher"
+)
+
+// DefaultDebounceDelay is the time a file must be quiet before its changes are sent to the handlers
+const DefaultDebounceDelay = time.Second
+
+// debouncer holds the events of each file until it has no changes for a while and merges them,
+// so the bursts of events of a save end up in a single event.
+// Editors saving to a temporary file renamed over the original, or removing the original before writing it again,
+// produce a single write of the original.
+type debouncer struct {
+	delay   time.Duration
+	deliver func(ctx context.Context, event watcher.Event)
+
+	lock    sync.Mutex
+	pending map[string]*debouncedEvent
+
+	// handlers get one event at a time, as from the watch loop, in the order the events are taken.
+	// It is acquired before lock.
+	deliverLock sync.Mutex
+}
+
+type debouncedEvent struct {
+	ctx   context.Context
+	event watcher.Event
+	timer *time.Timer
+}
+
+func newDebouncer(delay time.Duration, deliver func(ctx context.Context, event watcher.Event)) *debouncer {
+	return &debouncer{
+		delay:   delay,
+		deliver: deliver,
+		pending: make(map[string]*debouncedEvent),
+	}
+}
+
+// Add merges the event with the pending one of its path. Folder events are not delayed,
+// but the pending events they affect are sent before them.
+func (d *debouncer) Add(ctx context.Context, event watcher.Event) {
+	if !event.FileInfo.IsDir() {
+		// file events are only delayed, the watch loop does not wait for the handlers
+		d.lock.Lock()
+		d.add(ctx, event)
+		d.lock.Unlock()
+		return
+	}
+
+	d.takeAndSend(func() []*debouncedEvent {
+		return d.add(ctx, event)
+	})
+}
+
+func (d *debouncer) add(ctx context.Context, event watcher.Event) []*debouncedEvent {
+	isDir := event.FileInfo.IsDir()
+
+	switch {
+	case isDir && (event.Op == watcher.Rename || event.Op == watcher.Move):
+		ready := d.take(event.OldPath, event.Path)
+		ready = append(ready, &debouncedEvent{ctx: ctx, event: event})
+
+		// changes of the folder content are sent after it, at their new path
+		oldPrefix := event.OldPath + string(filepath.Separator)
+		newPrefix := event.Path + string(filepath.Separator)
+		for _, p := range d.takeUnder(oldPrefix) {
+			p.event.Path = newPrefix + s.TrimPrefix(p.event.Path, oldPrefix)
+			if s.HasPrefix(p.event.OldPath, oldPrefix) {
+				p.event.OldPath = newPrefix + s.TrimPrefix(p.event.OldPath, oldPrefix)
+			}
+			ready = append(ready, p)
+		}
+
+		return ready
+	case isDir && event.Op == watcher.Remove:
+		// changes of the folder content are sent before, except the ones removed with it
+		ready := []*debouncedEvent{}
+		prefix := event.Path + string(filepath.Separator)
+		for path, p := range d.pending {
+			if !s.HasPrefix(path, prefix) {
+				continue
+			}
+
+			if p.event.Op == watcher.Remove {
+				ready = append(ready, d.take(path)...)
+			} else {
+				d.drop(path)
+			}
+		}
+
+		return append(ready, &debouncedEvent{ctx: ctx, event: event})
+	case isDir:
+		return []*debouncedEvent{{ctx: ctx, event: event}}
+	case event.Op == watcher.Rename || event.Op == watcher.Move:
+		d.rename(ctx, event)
+		return nil
+	default:
+		d.merge(ctx, event)
+		return nil
+	}
+}
+
+// Delays the rename of a file at its new path, merged with the pending event of its old path
+func (d *debouncer) rename(ctx context.Context, event watcher.Event) {
+	if p, exists := d.pending[event.OldPath]; exists {
+		d.drop(event.OldPath)
+
+		switch p.event.Op {
+		case watcher.Create:
+			// a temporary file never sent to the handlers is renamed over the original
+			d.merge(ctx, watcher.Event{Op: watcher.Write, Path: event.Path, FileInfo: event.FileInfo})
+			return
+		case watcher.Rename, watcher.Move:
+			// renamed again, the handlers only see the rename from the first path
+			event.OldPath = p.event.OldPath
+			event.Op = renameOp(event.OldPath, event.Path)
+		}
+	}
+
+	if event.OldPath == event.Path {
+		d.merge(ctx, watcher.Event{Op: watcher.Write, Path: event.Path, FileInfo: event.FileInfo})
+		return
+	}
+
+	// the file renamed replaces the pending changes of the new path
+	d.drop(event.Path)
+	d.wait(ctx, event)
+}
+
+// Merges the event with the pending one of the path and restarts the wait
+func (d *debouncer) merge(ctx context.Context, event watcher.Event) {
+	if event.Op == watcher.Create {
+		if r := d.renamedFrom(event.Path); r != nil {
+			// the original is written again after being renamed, as in saves keeping a backup copy
+			r.event = watcher.Event{Op: watcher.Create, Path: r.event.Path, FileInfo: r.event.FileInfo}
+			event.Op = watcher.Write
+		}
+	}
+
+	p, exists := d.pending[event.Path]
+	if !exists {
+		d.wait(ctx, event)
+		return
+	}
+
+	prev := p.event.Op
+	renamed := prev == watcher.Rename || prev == watcher.Move
+	switch {
+	case prev == watcher.Create && event.Op == watcher.Remove:
+		// the handlers never saw the file
+		d.drop(event.Path)
+		return
+	case renamed && event.Op == watcher.Remove:
+		// the handlers only see the removal of the first path
+		d.drop(event.Path)
+		d.merge(ctx, watcher.Event{Op: watcher.Remove, Path: p.event.OldPath, FileInfo: event.FileInfo})
+		return
+	case prev == watcher.Create || renamed:
+		p.event.FileInfo = event.FileInfo
+	case event.Op == watcher.Create:
+		// removed or written and then created again, as in saves that remove the original first
+		p.event = watcher.Event{Op: watcher.Write, Path: event.Path, FileInfo: event.FileInfo}
+	default:
+		p.event = event
+	}
+
+	p.ctx = ctx
+	p.timer.Reset(d.delay)
+}
+
+// Adds the event of a path without pending events, it is sent after the delay
+func (d *debouncer) wait(ctx context.Context, event watcher.Event) {
+	p := &debouncedEvent{ctx: ctx, event: event}
+	p.timer = time.AfterFunc(d.delay, func() {
+		d.takeAndSend(func() []*debouncedEvent {
+			if d.pending[event.Path] != p {
+				return nil
+			}
+
+			delete(d.pending, event.Path)
+			return []*debouncedEvent{p}
+		})
+	})
+	d.pending[event.Path] = p
+}
+
+// Returns the pending rename of a file from the path
+func (d *debouncer) renamedFrom(path string) *debouncedEvent {
+	for _, p := range d.pending {
+		if (p.event.Op == watcher.Rename || p.event.Op == watcher.Move) && p.event.OldPath == path {
+			return p
+		}
+	}
+
+	return nil
+}
+
+func renameOp(oldPath, path string) watcher.Op {
+	if filepath.Dir(oldPath) == filepath.Dir(path) {
+		return watcher.Rename
+	}
+
+	return watcher.Move
+}
+
+// Removes the pending events of the paths and returns them
+func (d *debouncer) take(paths ...string) []*debouncedEvent {
+	taken := []*debouncedEvent{}
+	for _, path := range paths {
+		if p, exists := d.pending[path]; exists {
+			p.timer.Stop()
+			delete(d.pending, path)
+			taken = append(taken, p)
+		}
+	}
+
+	return taken
+}
+
+// Removes the pending events of the paths starting with the prefix and returns them in path order
+func (d *debouncer) takeUnder(prefix string) []*debouncedEvent {
+	paths := []string{}
+	for path := range d.pending {
+		if s.HasPrefix(path, prefix) {
+			paths = append(paths, path)
+		}
+	}
+	sort.Strings(paths)
+
+	return d.take(paths...)
+}
+
+func (d *debouncer) drop(path string) {
+	d.take(path)
+}
+
+// Takes the events to send and sends them before any other event is taken,
+// so the handlers get the events in the order they were taken
+func (d *debouncer) takeAndSend(take func() []*debouncedEvent) {
+	d.deliverLock.Lock()
+	defer d.deliverLock.Unlock()
+
+	d.lock.Lock()
+	ready := take()
+	d.lock.Unlock()
+
+	for _, p := range ready {
+		d.deliver(p.ctx, p.event)
+	}
+}
+
+// Stop sends the pending events right away, so no change is lost when the watcher closes.
+// It should not be called holding a lock the handlers need, since they run before it returns.
+func (d *debouncer) Stop() {
+	d.takeAndSend(func() []*debouncedEvent {
+		return d.takeUnder("")
+	})
+}
@@ -0,0 +1,174 @@
+package watcher
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/radovskyb/watcher"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDebounceDelay = 50 * time.Millisecond
+
+type recordedEvents struct {
+	lock   sync.Mutex
+	events []watcher.Event
+}
+
+func (r *recordedEvents) deliver(ctx context.Context, event watcher.Event) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	r.events = append(r.events, event)
+}
+
+func (r *recordedEvents) ops() []string {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	ops := []string{}
+	for _, e := range r.events {
+		op := e.Op.String() + " " + filepath.Base(e.Path)
+		if e.OldPath != "" {
+			op = op + " from " + filepath.Base(e.OldPath)
+		}
+		ops = append(ops, op)
+	}
+
+	return ops
+}
+
+func testFileInfo(t *testing.T, isDir bool) os.FileInfo {
+	dir, err := ioutil.TempDir("", "space-debounce")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+
+	path := dir
+	if !isDir {
+		path = filepath.Join(dir, "file")
+		assert.Nil(t, ioutil.WriteFile(path, []byte("content"), 0644))
+	}
+
+	info, err := os.Stat(path)
+	assert.Nil(t, err)
+
+	return info
+}
+
+func debounceEvents(t *testing.T, events ...watcher.Event) []string {
+	r := &recordedEvents{}
+	d := newDebouncer(testDebounceDelay, r.deliver)
+
+	for _, e := range events {
+		d.Add(context.Background(), e)
+	}
+	<-time.After(testDebounceDelay * 4)
+
+	return r.ops()
+}
+
+func TestDebouncer_MergesWritesOfQuietFile(t *testing.T) {
+	file := testFileInfo(t, false)
+
+	assert.Equal(t, []string{"CREATE a.txt"}, debounceEvents(t,
+		watcher.Event{Op: watcher.Create, Path: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Write, Path: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Write, Path: "/root/a.txt", FileInfo: file},
+	))
+
+	// temporary files never reach the handlers
+	assert.Equal(t, []string{}, debounceEvents(t,
+		watcher.Event{Op: watcher.Create, Path: "/root/.a.txt.swp", FileInfo: file},
+		watcher.Event{Op: watcher.Write, Path: "/root/.a.txt.swp", FileInfo: file},
+		watcher.Event{Op: watcher.Remove, Path: "/root/.a.txt.swp", FileInfo: file},
+	))
+}
+
+func TestDebouncer_RecognizesAtomicSaves(t *testing.T) {
+	file := testFileInfo(t, false)
+
+	// written to a temporary file renamed over the original
+	assert.Equal(t, []string{"WRITE a.txt"}, debounceEvents(t,
+		watcher.Event{Op: watcher.Create, Path: "/root/a.txt.tmp", FileInfo: file},
+		watcher.Event{Op: watcher.Write, Path: "/root/a.txt.tmp", FileInfo: file},
+		watcher.Event{Op: watcher.Rename, Path: "/root/a.txt", OldPath: "/root/a.txt.tmp", FileInfo: file},
+	))
+
+	// original removed and written again
+	assert.Equal(t, []string{"WRITE a.txt"}, debounceEvents(t,
+		watcher.Event{Op: watcher.Remove, Path: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Create, Path: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Write, Path: "/root/a.txt", FileInfo: file},
+	))
+
+	// original renamed to a backup copy, written again and the backup removed
+	assert.Equal(t, []string{"WRITE a.txt"}, debounceEvents(t,
+		watcher.Event{Op: watcher.Rename, Path: "/root/a.txt~", OldPath: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Create, Path: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Write, Path: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Remove, Path: "/root/a.txt~", FileInfo: file},
+	))
+}
+
+func TestDebouncer_KeepsRenamesAndFolderEvents(t *testing.T) {
+	file := testFileInfo(t, false)
+	dir := testFileInfo(t, true)
+
+	assert.Equal(t, []string{"MOVE c.txt from a.txt"}, debounceEvents(t,
+		watcher.Event{Op: watcher.Rename, Path: "/root/b.txt", OldPath: "/root/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Move, Path: "/root/folder/c.txt", OldPath: "/root/b.txt", FileInfo: file},
+	))
+
+	// the folder is removed after the changes of its content
+	assert.Equal(t, []string{"CREATE folder", "REMOVE b.txt", "REMOVE folder"}, debounceEvents(t,
+		watcher.Event{Op: watcher.Create, Path: "/root/folder", FileInfo: dir},
+		watcher.Event{Op: watcher.Create, Path: "/root/folder/a.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Remove, Path: "/root/folder/b.txt", FileInfo: file},
+		watcher.Event{Op: watcher.Remove, Path: "/root/folder", FileInfo: dir},
+	))
+}
+
+func TestDebouncer_MovesContentChangesWithRenamedFolder(t *testing.T) {
+	file := testFileInfo(t, false)
+	dir := testFileInfo(t, true)
+
+	r := &recordedEvents{}
+	d := newDebouncer(testDebounceDelay, r.deliver)
+
+	d.Add(context.Background(), watcher.Event{Op: watcher.Create, Path: "/root/folder/a.txt", FileInfo: file})
+	d.Add(context.Background(), watcher.Event{Op: watcher.Rename, Path: "/root/folder/c.txt", OldPath: "/root/folder/b.txt", FileInfo: file})
+	d.Add(context.Background(), watcher.Event{Op: watcher.Rename, Path: "/root/renamed", OldPath: "/root/folder", FileInfo: dir})
+	<-time.After(testDebounceDelay * 4)
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	// the changes of the content are sent after the folder, at their new path
+	assert.Equal(t, []watcher.Event{
+		{Op: watcher.Rename, Path: "/root/renamed", OldPath: "/root/folder", FileInfo: dir},
+		{Op: watcher.Create, Path: "/root/renamed/a.txt", FileInfo: file},
+		{Op: watcher.Rename, Path: "/root/renamed/c.txt", OldPath: "/root/renamed/b.txt", FileInfo: file},
+	}, r.events)
+}
+
+func TestDebouncer_StopSendsPendingEvents(t *testing.T) {
+	file := testFileInfo(t, false)
+
+	r := &recordedEvents{}
+	d := newDebouncer(testDebounceDelay, r.deliver)
+
+	d.Add(context.Background(), watcher.Event{Op: watcher.Write, Path: "/root/a.txt", FileInfo: file})
+	d.Stop()
+
+	assert.Equal(t, []string{"WRITE a.txt"}, r.ops())
+
+	// not sent again once the delay is over
+	<-time.After(testDebounceDelay * 4)
+	assert.Equal(t, []string{"WRITE a.txt"}, r.ops())
+}
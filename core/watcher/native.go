@@ -0,0 +1,528 @@
+package watcher
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	s "strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/radovskyb/watcher"
+
+	fsutils "github.com/FleekHQ/space-daemon/core/space/services"
+	"github.com/FleekHQ/space-daemon/log"
+)
+
+// ErrWatchLimitReached is returned when the OS has no watches left for a path (fs.inotify.max_user_watches on Linux)
+var ErrWatchLimitReached = errors.New("folder watch limit of the OS reached, raise it or use the poll watcher backend")
+
+// Time the rename of an entry waits for the create event of its new path
+const renameDelay = 50 * time.Millisecond
+
+// nativeWatcher receives the changes from the OS with fsnotify.
+// Folders are watched with their subfolders, single files are watched through their parent folder.
+type nativeWatcher struct {
+	publisher
+	fsw *fsnotify.Watcher
+
+	lock     sync.Mutex
+	closed   bool
+	closedCh chan struct{}
+
+	watchLock sync.RWMutex
+	// folders watched with their subfolders
+	folders map[string]bool
+	// files watched on their own
+	files map[string]bool
+	// last known info of the watched entries, removed entries are published with it
+	infos map[string]os.FileInfo
+
+	// guards the renamed entry and the ready events
+	readyLock sync.Mutex
+	// entry renamed by the last OS event, waiting for the create event of its new path
+	renamed *pendingEvent
+
+	// events ready to publish, in order
+	ready []watcher.Event
+	// signals the watch loop that events are ready
+	readyCh chan struct{}
+}
+
+type pendingEvent struct {
+	event watcher.Event
+	timer *time.Timer
+}
+
+// NewNative creates a folder watcher notified of changes by the OS
+func NewNative(configs ...Option) (*nativeWatcher, error) {
+	options := watcherOptions{}
+	for _, config := range configs {
+		config(&options)
+	}
+
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	nw := &nativeWatcher{
+		publisher: publisher{options: options},
+		fsw:       fsw,
+		closedCh:  make(chan struct{}),
+		folders:   make(map[string]bool),
+		files:     make(map[string]bool),
+		infos:     make(map[string]os.FileInfo),
+		readyCh:   make(chan struct{}, 1),
+	}
+	nw.setupDebounce()
+
+	for _, path := range options.paths {
+		path, err := expandPath(path)
+		if err != nil {
+			fsw.Close()
+			return nil, err
+		}
+
+		if err := nw.AddFolder(path); err != nil {
+			fsw.Close()
+			return nil, err
+		}
+	}
+
+	return nw, nil
+}
+
+func (nw *nativeWatcher) AddFile(path string) error {
+	if fsutils.IsPathDir(path) {
+		return errors.New(fmt.Sprintf("unable to watch path %s folder is not supporter", path))
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		return err
+	}
+
+	path = filepath.Clean(path)
+	if err := nw.fsw.Add(filepath.Dir(path)); err != nil {
+		return watchError(path, err)
+	}
+
+	nw.watchLock.Lock()
+	defer nw.watchLock.Unlock()
+	nw.files[path] = true
+	nw.infos[path] = info
+
+	return nil
+}
+
+// AddFolder watches the folder and everything inside it
+func (nw *nativeWatcher) AddFolder(path string) error {
+	if !fsutils.IsPathDir(path) {
+		return errors.New(fmt.Sprintf("unable to watch path %s, it is not a folder", path))
+	}
+
+	path = filepath.Clean(path)
+	if _, err := nw.addRecursive(path); err != nil {
+		return err
+	}
+
+	nw.watchLock.Lock()
+	nw.folders[path] = true
+	nw.watchLock.Unlock()
+
+	return nw.loadIgnoreRules(path)
+}
+
+// RemoveFolder stops watching the folder and everything inside it.
+// Files watched on their own inside the folder keep being watched.
+func (nw *nativeWatcher) RemoveFolder(path string) error {
+	path = filepath.Clean(path)
+
+	nw.removeWatches(path)
+	nw.forget(path)
+
+	nw.watchLock.Lock()
+	delete(nw.folders, path)
+	files := []string{}
+	for file := range nw.files {
+		if s.HasPrefix(file, path+string(filepath.Separator)) {
+			files = append(files, file)
+		}
+	}
+	nw.watchLock.Unlock()
+
+	nw.removeIgnoreRules(path)
+
+	for _, file := range files {
+		if err := nw.AddFile(file); err != nil {
+			log.Error("Failed to keep watching file "+file, err)
+		}
+	}
+
+	return nil
+}
+
+// Watches the folder and its subfolders and returns the entries found inside it
+func (nw *nativeWatcher) addRecursive(root string) ([]watcher.Event, error) {
+	found := []watcher.Event{}
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// removed while walking
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+
+		if info.IsDir() {
+			if err := nw.fsw.Add(path); err != nil {
+				return watchError(path, err)
+			}
+		}
+
+		nw.watchLock.Lock()
+		nw.infos[path] = info
+		nw.watchLock.Unlock()
+
+		if path != root {
+			found = append(found, watcher.Event{Op: watcher.Create, Path: path, FileInfo: info})
+		}
+
+		return nil
+	})
+
+	return found, err
+}
+
+// The watch limit is only reached when adding paths, after the watcher started, so the paths are not polled instead
+func watchError(path string, err error) error {
+	if err == syscall.ENOSPC {
+		return fmt.Errorf("%s: %s", ErrWatchLimitReached.Error(), path)
+	}
+
+	return err
+}
+
+// Returns true if the path is a watched file or inside a watched folder
+func (nw *nativeWatcher) isWatched(path string) bool {
+	nw.watchLock.RLock()
+	defer nw.watchLock.RUnlock()
+
+	if nw.files[path] {
+		return true
+	}
+
+	for folder := range nw.folders {
+		if s.HasPrefix(path, folder+string(filepath.Separator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (nw *nativeWatcher) lastInfo(path string) (os.FileInfo, bool) {
+	nw.watchLock.RLock()
+	defer nw.watchLock.RUnlock()
+
+	info, exists := nw.infos[path]
+	return info, exists
+}
+
+// Forgets the entry and everything inside it
+func (nw *nativeWatcher) forget(path string) {
+	nw.watchLock.Lock()
+	defer nw.watchLock.Unlock()
+
+	prefix := path + string(filepath.Separator)
+	for p := range nw.infos {
+		if p == path || s.HasPrefix(p, prefix) {
+			delete(nw.infos, p)
+		}
+	}
+}
+
+// Watch will start listening of changes on the watched paths and trigger the handler with any update events
+// This is a block operation
+func (nw *nativeWatcher) Watch(ctx context.Context) error {
+	log.Debug("Starting native watcher", fmt.Sprintf("filePath:%s", nw.options.paths))
+
+	for {
+		select {
+		case <-nw.closedCh:
+			log.Debug("Watcher graceful shutdown triggered")
+			return nil
+		case <-ctx.Done():
+			nw.Close()
+		case ev, ok := <-nw.fsw.Events:
+			if !ok {
+				return nil
+			}
+			nw.handleOSEvent(ev)
+		case <-nw.readyCh:
+			for _, event := range nw.takeReady() {
+				nw.publishEvent(ctx, event)
+			}
+		case err, ok := <-nw.fsw.Errors:
+			if !ok {
+				return nil
+			}
+			log.Error("Native watcher error", err)
+		}
+	}
+}
+
+// Translates an OS event into the watcher events, the rename of an entry is followed by the create of its new path.
+// Events are handed to the publisher as they come, the bursts of events of a save are merged by its debouncer.
+func (nw *nativeWatcher) handleOSEvent(ev fsnotify.Event) {
+	path := filepath.Clean(ev.Name)
+	if !nw.isWatched(path) {
+		return
+	}
+
+	switch {
+	case ev.Op&fsnotify.Create == fsnotify.Create:
+		info, err := os.Lstat(path)
+		if err != nil {
+			return
+		}
+
+		if renamed := nw.takeRenamed(info); renamed != nil {
+			nw.handleMoved(renamed.event, path, info)
+			return
+		}
+		nw.settleRecreated(path, info)
+
+		_, existed := nw.lastInfo(path)
+		nw.setInfo(path, info)
+
+		if info.IsDir() {
+			// entries created before the folder was watched have no events
+			found, err := nw.addRecursive(path)
+			if err != nil {
+				log.Error("Failed to watch folder "+path, err)
+			}
+			nw.send(watcher.Event{Op: watcher.Create, Path: path, FileInfo: info})
+			for _, event := range found {
+				nw.send(event)
+			}
+			return
+		}
+
+		// an existing file replaced by another one, as in atomic saves
+		if existed {
+			nw.send(watcher.Event{Op: watcher.Write, Path: path, FileInfo: info})
+			return
+		}
+
+		nw.send(watcher.Event{Op: watcher.Create, Path: path, FileInfo: info})
+	case ev.Op&fsnotify.Write == fsnotify.Write:
+		info, err := os.Lstat(path)
+		if err != nil {
+			return
+		}
+		nw.setInfo(path, info)
+		nw.send(watcher.Event{Op: watcher.Write, Path: path, FileInfo: info})
+	case ev.Op&fsnotify.Remove == fsnotify.Remove, ev.Op&fsnotify.Rename == fsnotify.Rename:
+		// created and removed before it could be seen, a new path is then a plain create
+		if _, exists := nw.lastInfo(path); !exists {
+			return
+		}
+
+		if ev.Op&fsnotify.Remove == fsnotify.Remove {
+			nw.send(watcher.Event{Op: watcher.Remove, Path: path, FileInfo: nw.removedInfo(path)})
+			nw.forget(path)
+			return
+		}
+
+		nw.setRenamed(watcher.Event{Op: watcher.Remove, Path: path, FileInfo: nw.removedInfo(path)})
+	}
+}
+
+// Publishes the rename or move of an entry, its old path is no longer watched
+func (nw *nativeWatcher) handleMoved(old watcher.Event, path string, info os.FileInfo) {
+	nw.forget(old.Path)
+	nw.setInfo(path, info)
+
+	if info.IsDir() {
+		// the OS keeps the watches of the subfolders under their old path
+		nw.removeWatches(old.Path)
+		if _, err := nw.addRecursive(path); err != nil {
+			log.Error("Failed to watch folder "+path, err)
+		}
+	}
+
+	nw.send(watcher.Event{Op: renameOp(old.Path, path), Path: path, OldPath: old.Path, FileInfo: info})
+}
+
+func (nw *nativeWatcher) removeWatches(root string) {
+	// errors are expected for the folders the OS already dropped
+	_ = nw.fsw.Remove(root)
+	for _, path := range nw.watchedFoldersUnder(root) {
+		_ = nw.fsw.Remove(path)
+	}
+}
+
+func (nw *nativeWatcher) watchedFoldersUnder(root string) []string {
+	nw.watchLock.RLock()
+	defer nw.watchLock.RUnlock()
+
+	folders := []string{}
+	prefix := root + string(filepath.Separator)
+	for path, info := range nw.infos {
+		if info.IsDir() && s.HasPrefix(path, prefix) {
+			folders = append(folders, path)
+		}
+	}
+
+	return folders
+}
+
+func (nw *nativeWatcher) setInfo(path string, info os.FileInfo) {
+	nw.watchLock.Lock()
+	defer nw.watchLock.Unlock()
+	nw.infos[path] = info
+}
+
+// Returns the last known info of a removed entry, or a placeholder if it was never seen
+func (nw *nativeWatcher) removedInfo(path string) os.FileInfo {
+	if info, exists := nw.lastInfo(path); exists {
+		return info
+	}
+
+	return &removedFileInfo{name: filepath.Base(path)}
+}
+
+// Keeps the renamed entry until the create of its new path. Without it, the entry was moved out of the watched paths.
+func (nw *nativeWatcher) setRenamed(event watcher.Event) {
+	nw.readyLock.Lock()
+	defer nw.readyLock.Unlock()
+
+	if nw.renamed != nil {
+		nw.renamed.timer.Stop()
+		nw.flushRenamedLocked()
+	}
+
+	p := &pendingEvent{event: event}
+	p.timer = time.AfterFunc(renameDelay, func() {
+		nw.readyLock.Lock()
+		defer nw.readyLock.Unlock()
+		if nw.renamed == p {
+			nw.flushRenamedLocked()
+		}
+	})
+	nw.renamed = p
+}
+
+func (nw *nativeWatcher) flushRenamedLocked() {
+	event := nw.renamed.event
+	nw.renamed = nil
+	nw.forget(event.Path)
+	nw.sendLocked(event)
+}
+
+// Settles the pending rename of an entry created again at its old path, as editors keeping a backup of the saved file do.
+// A file created again is a write of the original, a folder created again replaces the removed one.
+func (nw *nativeWatcher) settleRecreated(path string, info os.FileInfo) {
+	nw.readyLock.Lock()
+	defer nw.readyLock.Unlock()
+
+	renamed := nw.renamed
+	if renamed == nil || renamed.event.Path != path {
+		return
+	}
+
+	renamed.timer.Stop()
+	if info.IsDir() || renamed.event.FileInfo.IsDir() {
+		nw.flushRenamedLocked()
+		return
+	}
+
+	nw.renamed = nil
+}
+
+// Returns the renamed entry if the created one is the same file, other entries created meanwhile are not renames
+func (nw *nativeWatcher) takeRenamed(created os.FileInfo) *pendingEvent {
+	nw.readyLock.Lock()
+	defer nw.readyLock.Unlock()
+
+	renamed := nw.renamed
+	if renamed == nil || !os.SameFile(renamed.event.FileInfo, created) {
+		return nil
+	}
+
+	renamed.timer.Stop()
+	nw.renamed = nil
+	return renamed
+}
+
+// Hands the event to the watch loop
+func (nw *nativeWatcher) send(event watcher.Event) {
+	nw.readyLock.Lock()
+	defer nw.readyLock.Unlock()
+	nw.sendLocked(event)
+}
+
+func (nw *nativeWatcher) sendLocked(event watcher.Event) {
+	nw.ready = append(nw.ready, event)
+
+	select {
+	case nw.readyCh <- struct{}{}:
+	default:
+		// the loop was already signaled
+	}
+}
+
+func (nw *nativeWatcher) takeReady() []watcher.Event {
+	nw.readyLock.Lock()
+	defer nw.readyLock.Unlock()
+
+	ready := nw.ready
+	nw.ready = nil
+	return ready
+}
+
+// Close will stop the watching operation and unblock watch calls.
+// The pending events are sent to the handlers before it returns, outside of the watcher lock
+// since the handlers can call the watcher.
+func (nw *nativeWatcher) Close() {
+	nw.lock.Lock()
+	if nw.closed {
+		nw.lock.Unlock()
+		return
+	}
+
+	nw.closed = true
+	close(nw.closedCh)
+	if err := nw.fsw.Close(); err != nil {
+		log.Error("Failed to close native watcher", err)
+	}
+	nw.lock.Unlock()
+
+	if nw.debouncer != nil {
+		nw.debouncer.Stop()
+	}
+}
+
+func (nw *nativeWatcher) Shutdown() error {
+	nw.Close()
+	return nil
+}
+
+// Info of an entry removed before it was ever seen by the watcher
+type removedFileInfo struct {
+	name string
+}
+
+func (r *removedFileInfo) Name() string       { return r.name }
+func (r *removedFileInfo) Size() int64        { return 0 }
+func (r *removedFileInfo) Mode() os.FileMode  { return 0 }
+func (r *removedFileInfo) ModTime() time.Time { return time.Time{} }
+func (r *removedFileInfo) IsDir() bool        { return false }
+func (r *removedFileInfo) Sys() interface{}   { return nil }
@@ -1,9 +1,21 @@
 package watcher
 
+import "time"
+
 type watcherOptions struct {
-	paths []string
+	paths          []string
+	ignorePatterns []string
+	backend        string
+	debounceDelay  time.Duration
 }
 
+const (
+	// PollingBackend checks the watched paths for changes periodically
+	PollingBackend = "poll"
+	// NativeBackend receives the changes from the OS (inotify on Linux)
+	NativeBackend = "native"
+)
+
 // Option configuration for the FileWatcher
 // Use exported Option factory functions
 type Option func(option *watcherOptions)
@@ -17,3 +29,27 @@ func WithPaths(path ...string) Option {
 		}
 	}
 }
+
+// WithIgnorePatterns configures gitignore-style patterns ignored in every watched path,
+// in addition to the .spaceignore file at the root of the path
+func WithIgnorePatterns(patterns ...string) Option {
+	return func(option *watcherOptions) {
+		option.ignorePatterns = append(option.ignorePatterns, patterns...)
+	}
+}
+
+// WithBackend configures how changes are detected, either PollingBackend or NativeBackend.
+// Empty picks the native backend on Linux with polling as fallback.
+func WithBackend(backend string) Option {
+	return func(option *watcherOptions) {
+		option.backend = backend
+	}
+}
+
+// WithDebounce configures the time a file must be quiet before its changes are sent to the handlers,
+// merging the events of a save into one. Events are sent right away when not set.
+func WithDebounce(delay time.Duration) Option {
+	return func(option *watcherOptions) {
+		option.debounceDelay = delay
+	}
+}
@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"path/filepath"
+	"runtime"
 	s "strings"
 	"sync"
 
+	"github.com/FleekHQ/space-daemon/core/ignore"
 	fsutils "github.com/FleekHQ/space-daemon/core/space/services"
 	"github.com/mitchellh/go-homedir"
 
@@ -24,19 +27,72 @@ var (
 type FolderWatcher interface {
 	RegisterHandler(handler EventHandler)
 	AddFile(path string) error
+	AddFolder(path string) error
+	RemoveFolder(path string) error
+	IsIgnored(path string, isDir bool) bool
 	Watch(ctx context.Context) error
 	Close()
+	Shutdown() error
 }
 
+// Sends the events of a watcher implementation to the registered handlers
+type publisher struct {
+	options     watcherOptions
+	publishLock sync.RWMutex
+	handlers    []EventHandler
+
+	ignoreLock sync.RWMutex
+	// ignore rules of each watched folder
+	ignoreRules []*ignore.Matcher
+
+	// delays the events until the files are quiet, none when events are sent right away
+	debouncer *debouncer
+}
+
+func (fw *publisher) setupDebounce() {
+	if fw.options.debounceDelay > 0 {
+		fw.debouncer = newDebouncer(fw.options.debounceDelay, fw.deliverEvent)
+	}
+}
+
+// folderWatcher polls the watched paths for changes
 type folderWatcher struct {
+	publisher
 	w *watcher.Watcher
 
-	lock        sync.Mutex
-	publishLock sync.RWMutex
-	options     watcherOptions
-	started     bool
-	closed      bool
-	handlers    []EventHandler
+	lock    sync.Mutex
+	started bool
+	closed  bool
+}
+
+// NewFolderWatcher creates the folder watcher of the backend set in the options.
+// When no backend is set the native one is used on Linux, falling back to polling if it fails to start.
+func NewFolderWatcher(configs ...Option) (FolderWatcher, error) {
+	options := watcherOptions{}
+	for _, config := range configs {
+		config(&options)
+	}
+
+	switch options.backend {
+	case PollingBackend:
+		return New(configs...)
+	case NativeBackend:
+		return NewNative(configs...)
+	case "":
+		if runtime.GOOS != "linux" {
+			return New(configs...)
+		}
+
+		nw, err := NewNative(configs...)
+		if err != nil {
+			log.Warn("Native folder watcher failed to start, falling back to polling", "err:"+err.Error())
+			return New(configs...)
+		}
+
+		return nw, nil
+	default:
+		return nil, fmt.Errorf("unknown folder watcher backend %s", options.backend)
+	}
 }
 
 // New creates an new instance of folder watcher
@@ -47,31 +103,46 @@ func New(configs ...Option) (*folderWatcher, error) {
 	}
 
 	w := watcher.New()
+	fw := &folderWatcher{
+		publisher: publisher{options: options},
+		w:         w,
+	}
+	fw.setupDebounce()
 
 	for _, path := range options.paths {
-		if home, err := homedir.Dir(); err == nil {
-			// If the root directory contains ~, we replace it with the actual home directory
-			path = s.Replace(path, "~", home, -1)
+		path, err := expandPath(path)
+		if err != nil {
+			return nil, err
 		}
 
-		if path == "" {
-			log.Fatal(ErrFolderPathNotFound)
-			return nil, ErrFolderPathNotFound
+		err = w.AddRecursive(path)
+		if err != nil {
+			return nil, err
 		}
 
-		err := w.AddRecursive(path)
-		if err != nil {
+		if err := fw.loadIgnoreRules(path); err != nil {
 			return nil, err
 		}
 	}
 
-	return &folderWatcher{
-		w:       w,
-		options: options,
-	}, nil
+	return fw, nil
+}
+
+func expandPath(path string) (string, error) {
+	if home, err := homedir.Dir(); err == nil {
+		// If the root directory contains ~, we replace it with the actual home directory
+		path = s.Replace(path, "~", home, -1)
+	}
+
+	if path == "" {
+		log.Fatal(ErrFolderPathNotFound)
+		return "", ErrFolderPathNotFound
+	}
+
+	return path, nil
 }
 
-func (fw *folderWatcher) RegisterHandler(handler EventHandler) {
+func (fw *publisher) RegisterHandler(handler EventHandler) {
 	fw.publishLock.Lock()
 	defer fw.publishLock.Unlock()
 	fw.handlers = append(fw.handlers, handler)
@@ -89,6 +160,30 @@ func (fw *folderWatcher) AddFile(path string) error {
 	return err
 }
 
+// AddFolder watches the folder and everything inside it
+func (fw *folderWatcher) AddFolder(path string) error {
+	if !fsutils.IsPathDir(path) {
+		return errors.New(fmt.Sprintf("unable to watch path %s, it is not a folder", path))
+	}
+
+	if err := fw.w.AddRecursive(path); err != nil {
+		return err
+	}
+
+	return fw.loadIgnoreRules(path)
+}
+
+// RemoveFolder stops watching the folder and everything inside it
+func (fw *folderWatcher) RemoveFolder(path string) error {
+	path = filepath.Clean(path)
+	if err := fw.w.RemoveRecursive(path); err != nil {
+		return err
+	}
+
+	fw.removeIgnoreRules(path)
+	return nil
+}
+
 // Watch will start listening of changes on the folderWatcher path and trigger the handler with any update events
 // This is a block operation
 func (fw *folderWatcher) Watch(ctx context.Context) error {
@@ -104,11 +199,7 @@ func (fw *folderWatcher) Watch(ctx context.Context) error {
 				fw.Close()
 			case event, ok := <-fw.w.Event:
 				if ok {
-					if len(fw.handlers) == 0 {
-						fw.publishEventToHandler(ctx, &defaultWatcherHandler{}, event)
-					} else {
-						fw.publishEvent(ctx, event)
-					}
+					fw.publishEvent(ctx, event)
 				}
 			case err, ok := <-fw.w.Error:
 				if !ok {
@@ -139,25 +230,50 @@ func (fw *folderWatcher) setToStarted() {
 	fw.started = true
 }
 
-func (fw *folderWatcher) publishEvent(ctx context.Context, event watcher.Event) {
+func (fw *publisher) publishEvent(ctx context.Context, event watcher.Event) {
+	if fw.debouncer != nil {
+		fw.debouncer.Add(ctx, event)
+		return
+	}
+
+	fw.deliverEvent(ctx, event)
+}
+
+func (fw *publisher) deliverEvent(ctx context.Context, event watcher.Event) {
 	fw.publishLock.RLock()
 	defer fw.publishLock.RUnlock()
 
+	if len(fw.handlers) == 0 {
+		fw.publishEventToHandler(ctx, &defaultWatcherHandler{}, event)
+		return
+	}
+
 	for _, handler := range fw.handlers {
 		fw.publishEventToHandler(ctx, handler, event)
 	}
 }
 
-func (fw *folderWatcher) publishEventToHandler(
+func (fw *publisher) publishEventToHandler(
 	ctx context.Context,
 	handler EventHandler,
 	event watcher.Event,
 ) {
+	if filepath.Base(event.Path) == ignore.FileName {
+		if err := fw.reloadIgnoreRules(filepath.Dir(event.Path)); err != nil {
+			log.Error("Failed to reload ignore file "+event.Path, err)
+		}
+	}
+
 	if isBlacklisted(event.Path, event.FileInfo) {
 		log.Debug("Skipping blacklisted file/folder event")
 		return
 	}
 
+	if fw.IsIgnored(event.Path, event.FileInfo.IsDir()) {
+		log.Debug("Skipping ignored file/folder event", fmt.Sprintf("path:%s", event.Path))
+		return
+	}
+
 	switch event.Op {
 	case watcher.Create:
 		handler.OnCreate(ctx, event.Path, event.FileInfo)
@@ -172,17 +288,94 @@ func (fw *folderWatcher) publishEventToHandler(
 	}
 }
 
-// Close will stop the watching operation and unblock watch calls
+// Reads the ignore rules of a watched folder, replacing the previous ones
+func (fw *publisher) loadIgnoreRules(root string) error {
+	m, err := ignore.ForRoot(root, fw.options.ignorePatterns)
+	if err != nil {
+		return err
+	}
+
+	fw.ignoreLock.Lock()
+	defer fw.ignoreLock.Unlock()
+
+	for i, existing := range fw.ignoreRules {
+		if existing.Root() == root {
+			fw.ignoreRules[i] = m
+			return nil
+		}
+	}
+
+	fw.ignoreRules = append(fw.ignoreRules, m)
+	return nil
+}
+
+// Drops the ignore rules of a folder no longer watched
+func (fw *publisher) removeIgnoreRules(root string) {
+	fw.ignoreLock.Lock()
+	defer fw.ignoreLock.Unlock()
+
+	for i, existing := range fw.ignoreRules {
+		if existing.Root() == root {
+			fw.ignoreRules = append(fw.ignoreRules[:i], fw.ignoreRules[i+1:]...)
+			return
+		}
+	}
+}
+
+// Reads the .spaceignore file again after it changed, only the files at the root of watched folders are used
+func (fw *publisher) reloadIgnoreRules(dir string) error {
+	fw.ignoreLock.RLock()
+	watched := false
+	for _, m := range fw.ignoreRules {
+		watched = watched || m.Root() == dir
+	}
+	fw.ignoreLock.RUnlock()
+
+	if !watched {
+		return nil
+	}
+
+	return fw.loadIgnoreRules(dir)
+}
+
+// IsIgnored returns true if changes to the path are not sent to the handlers.
+// Paths are matched with the rules of the closest watched folder containing them,
+// or only the global patterns for files watched on their own.
+func (fw *publisher) IsIgnored(path string, isDir bool) bool {
+	fw.ignoreLock.RLock()
+	defer fw.ignoreLock.RUnlock()
+
+	var matcher *ignore.Matcher
+	for _, m := range fw.ignoreRules {
+		if s.HasPrefix(path, m.Root()+string(filepath.Separator)) && (matcher == nil || len(m.Root()) > len(matcher.Root())) {
+			matcher = m
+		}
+	}
+
+	if matcher == nil {
+		matcher = ignore.New(filepath.Dir(path), fw.options.ignorePatterns)
+	}
+
+	return matcher.Match(path, isDir)
+}
+
+// Close will stop the watching operation and unblock watch calls.
+// The pending events are sent to the handlers before it returns, outside of the watcher lock
+// since the handlers can call the watcher.
 func (fw *folderWatcher) Close() {
 	fw.lock.Lock()
-	defer fw.lock.Unlock()
-
 	if !fw.started || fw.closed {
+		fw.lock.Unlock()
 		return
 	}
 
 	fw.closed = true
 	fw.w.Close()
+	fw.lock.Unlock()
+
+	if fw.debouncer != nil {
+		fw.debouncer.Stop()
+	}
 }
 
 func (fw *folderWatcher) Shutdown() error {
@@ -2,10 +2,14 @@ package watcher
 
 import (
 	"context"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	"github.com/FleekHQ/space-daemon/core/ignore"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 
 	w "github.com/radovskyb/watcher"
@@ -107,3 +111,197 @@ func TestFolderWatcher_Watch_Triggers_Handler_OnRemove(t *testing.T) {
 	// cleanup
 	watcher.Close()
 }
+
+func TestFolderWatcher_IgnoresPatterns(t *testing.T) {
+	root, err := ioutil.TempDir("", "space-watcher")
+	assert.Nil(t, err)
+	defer os.RemoveAll(root)
+
+	err = ioutil.WriteFile(filepath.Join(root, ignore.FileName), []byte("build/\n"), 0644)
+	assert.Nil(t, err)
+
+	fw, err := New(WithPaths(root), WithIgnorePatterns("*.tmp"))
+	assert.Nil(t, err)
+
+	assert.True(t, fw.IsIgnored(filepath.Join(root, "notes.tmp"), false))
+	assert.True(t, fw.IsIgnored(filepath.Join(root, "build", "out.bin"), false))
+	assert.False(t, fw.IsIgnored(filepath.Join(root, "notes.txt"), false))
+
+	// files watched on their own only use the global patterns
+	assert.True(t, fw.IsIgnored(filepath.Join(os.TempDir(), "other", "notes.tmp"), false))
+	assert.False(t, fw.IsIgnored(filepath.Join(os.TempDir(), "other", "build"), true))
+}
+
+func startNativeWatcher(t *testing.T, configs ...Option) (string, *nativeWatcher) {
+	root, err := ioutil.TempDir("", "space-watcher")
+	assert.Nil(t, err)
+	t.Cleanup(func() {
+		os.RemoveAll(root)
+	})
+
+	nw, err := NewNative(append([]Option{WithPaths(root)}, configs...)...)
+	assert.Nil(t, err)
+	t.Cleanup(nw.Close)
+
+	go nw.Watch(context.Background())
+
+	return root, nw
+}
+
+func TestNativeWatcher_CoalescesWritesWithDebounce(t *testing.T) {
+	root, nw := startNativeWatcher(t, WithDebounce(50*time.Millisecond))
+	path := filepath.Join(root, "notes.txt")
+
+	handler := new(handlerMock)
+	handler.On("OnCreate", mock.Anything, path, mock.Anything).Return()
+	handler.On("OnWrite", mock.Anything, path, mock.Anything).Return()
+	nw.RegisterHandler(handler)
+
+	for i := 0; i < 3; i++ {
+		assert.Nil(t, ioutil.WriteFile(path, []byte("notes"), 0644))
+	}
+	<-time.After(time.Millisecond * 200)
+
+	handler.AssertNumberOfCalls(t, "OnCreate", 1)
+	handler.AssertNumberOfCalls(t, "OnWrite", 0)
+
+	// saved to a temporary file renamed over the original
+	assert.Nil(t, ioutil.WriteFile(path+".tmp", []byte("saved"), 0644))
+	assert.Nil(t, os.Rename(path+".tmp", path))
+	<-time.After(time.Millisecond * 200)
+
+	handler.AssertNumberOfCalls(t, "OnCreate", 1)
+	handler.AssertNumberOfCalls(t, "OnWrite", 1)
+}
+
+func TestNativeWatcher_Triggers_Handler_OnRename_And_OnMove(t *testing.T) {
+	root, nw := startNativeWatcher(t)
+	path := filepath.Join(root, "notes.txt")
+	renamed := filepath.Join(root, "renamed.txt")
+	moved := filepath.Join(root, "folder", "renamed.txt")
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("notes"), 0644))
+	assert.Nil(t, os.Mkdir(filepath.Join(root, "folder"), 0755))
+	<-time.After(time.Millisecond * 200)
+
+	handler := new(handlerMock)
+	handler.On("OnRename", mock.Anything, renamed, mock.Anything, path).Return()
+	handler.On("OnMove", mock.Anything, moved, mock.Anything, renamed).Return()
+	nw.RegisterHandler(handler)
+
+	assert.Nil(t, os.Rename(path, renamed))
+	<-time.After(time.Millisecond * 200)
+	assert.Nil(t, os.Rename(renamed, moved))
+	<-time.After(time.Millisecond * 200)
+
+	handler.AssertExpectations(t)
+}
+
+func TestNativeWatcher_DoesNotPairRenameWithAnotherCreate(t *testing.T) {
+	root, nw := startNativeWatcher(t)
+	path := filepath.Join(root, "notes.txt")
+	created := filepath.Join(root, "other.txt")
+
+	outside, err := ioutil.TempDir("", "space-watcher-outside")
+	assert.Nil(t, err)
+	defer os.RemoveAll(outside)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("notes"), 0644))
+	<-time.After(time.Millisecond * 200)
+
+	handler := new(handlerMock)
+	handler.On("OnRemove", mock.Anything, path, mock.Anything).Return()
+	handler.On("OnCreate", mock.Anything, created, mock.Anything).Return()
+	handler.On("OnWrite", mock.Anything, created, mock.Anything).Return()
+	nw.RegisterHandler(handler)
+
+	// moved out of the watched folder while another file is created
+	assert.Nil(t, os.Rename(path, filepath.Join(outside, "notes.txt")))
+	assert.Nil(t, ioutil.WriteFile(created, []byte("other"), 0644))
+	<-time.After(time.Millisecond * 200)
+
+	handler.AssertNumberOfCalls(t, "OnRemove", 1)
+	handler.AssertNumberOfCalls(t, "OnCreate", 1)
+	handler.AssertNotCalled(t, "OnRename", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNativeWatcher_RenameAwayAndRecreate_DoesNotRemove(t *testing.T) {
+	root, nw := startNativeWatcher(t)
+	path := filepath.Join(root, "notes.txt")
+
+	backups, err := ioutil.TempDir("", "space-watcher-backups")
+	assert.Nil(t, err)
+	defer os.RemoveAll(backups)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("notes"), 0644))
+	<-time.After(time.Millisecond * 200)
+
+	handler := new(handlerMock)
+	handler.On("OnWrite", mock.Anything, path, mock.Anything).Return()
+	nw.RegisterHandler(handler)
+
+	// saved keeping a backup of the original, which is renamed away before the file is written again
+	assert.Nil(t, os.Rename(path, filepath.Join(backups, "notes.txt~")))
+	assert.Nil(t, ioutil.WriteFile(path, []byte("saved"), 0644))
+	<-time.After(time.Millisecond * 200)
+
+	handler.AssertCalled(t, "OnWrite", mock.Anything, path, mock.Anything)
+	handler.AssertNotCalled(t, "OnRemove", mock.Anything, mock.Anything, mock.Anything)
+	handler.AssertNotCalled(t, "OnCreate", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNativeWatcher_RemoveFolder_StopsSendingEvents(t *testing.T) {
+	root, nw := startNativeWatcher(t)
+	assert.Nil(t, os.Mkdir(filepath.Join(root, "folder"), 0755))
+	<-time.After(time.Millisecond * 200)
+
+	handler := new(handlerMock)
+	nw.RegisterHandler(handler)
+
+	assert.Nil(t, nw.RemoveFolder(root))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(root, "notes.txt"), []byte("notes"), 0644))
+	assert.Nil(t, ioutil.WriteFile(filepath.Join(root, "folder", "notes.txt"), []byte("notes"), 0644))
+	<-time.After(time.Millisecond * 200)
+
+	handler.AssertNotCalled(t, "OnCreate", mock.Anything, mock.Anything, mock.Anything)
+	handler.AssertNotCalled(t, "OnWrite", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestNativeWatcher_Close_SendsPendingEventsOutsideTheWatcherLock(t *testing.T) {
+	root, nw := startNativeWatcher(t, WithDebounce(time.Hour))
+	path := filepath.Join(root, "notes.txt")
+
+	sending := make(chan struct{})
+	release := make(chan struct{})
+	handler := new(handlerMock)
+	handler.On("OnCreate", mock.Anything, path, mock.Anything).Run(func(args mock.Arguments) {
+		close(sending)
+		<-release
+	}).Return()
+	nw.RegisterHandler(handler)
+
+	assert.Nil(t, ioutil.WriteFile(path, []byte("notes"), 0644))
+	<-time.After(time.Millisecond * 200)
+
+	go nw.Close()
+	select {
+	case <-sending:
+	case <-time.After(time.Second):
+		t.Fatal("pending event not sent on close")
+	}
+
+	// closing again, as on shutdown, does not wait for the handlers
+	closed := make(chan struct{})
+	go func() {
+		nw.Close()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-time.After(time.Second):
+		t.Error("close waited for the handlers of the pending events")
+	}
+
+	close(release)
+	handler.AssertNumberOfCalls(t, "OnCreate", 1)
+}
@@ -5,14 +5,17 @@ import (
 	"fmt"
 	"net"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/rs/cors"
 
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 
+	"github.com/FleekHQ/space-daemon/core/events"
 	"github.com/FleekHQ/space-daemon/core/keychain"
 	"github.com/FleekHQ/space-daemon/core/space/fuse"
+	"github.com/FleekHQ/space-daemon/core/store"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/FleekHQ/space-daemon/core/space"
@@ -36,6 +39,15 @@ type serverOptions struct {
 	port          int
 	proxyPort     int // port for grpcweb proxy
 	restProxyPort int // port for rest api proxy
+	profiles      ProfileManager
+}
+
+// ProfileManager handles the profiles of the daemon.
+// Switching profiles restarts the profile components and sets the new service in the server.
+type ProfileManager interface {
+	ListProfiles() (profiles []string, active string)
+	CreateProfile(name string) error
+	SwitchProfile(ctx context.Context, name string) error
 }
 
 type grpcServer struct {
@@ -43,15 +55,21 @@ type grpcServer struct {
 	s          *grpc.Server
 	rpcProxy   *http.Server
 	restServer *http.Server
+	mu         sync.RWMutex
 	sv         space.Service
 	fc         *fuse.Controller
 	kc         keychain.Keychain
-	// TODO: see if we need to clean this up by gc or handle an array
-	fileEventStream         pb.SpaceApi_SubscribeServer
-	txlEventStream          pb.SpaceApi_TxlSubscribeServer
-	notificationEventStream pb.SpaceApi_NotificationSubscribeServer
-	isStarted               bool
-	readyCh                 chan bool
+	// each subscribe call gets its own subscription to these
+	fileEvents         *events.Bus
+	txlEvents          *events.Bus
+	notificationEvents *events.Bus
+	// logs of the sent events so subscribers can resume, nil if they could not be loaded
+	fileLog         *events.Log
+	notificationLog *events.Log
+	// keeps events in the order of their sequence numbers
+	publishMu sync.Mutex
+	isStarted bool
+	readyCh   chan bool
 }
 
 // Idea taken from here https://medium.com/soon-london/variadic-configuration-functions-in-go-8cef1c97ce99
@@ -59,22 +77,89 @@ type grpcServer struct {
 type ServerOption func(o *serverOptions)
 
 // gRPC server uses Service from core to handle requests
-func New(sv space.Service, fc *fuse.Controller, kc keychain.Keychain, opts ...ServerOption) *grpcServer {
+func New(sv space.Service, fc *fuse.Controller, kc keychain.Keychain, st store.Store, opts ...ServerOption) *grpcServer {
 	o := defaultServerOptions
 	for _, opt := range opts {
 		opt(&o)
 	}
 	srv := &grpcServer{
-		opts:    &o,
-		sv:      sv,
-		fc:      fc,
-		kc:      kc,
-		readyCh: make(chan bool, 1),
+		opts:               &o,
+		sv:                 sv,
+		fc:                 fc,
+		kc:                 kc,
+		fileEvents:         events.NewBus(),
+		txlEvents:          events.NewBus(),
+		notificationEvents: events.NewBus(),
+		readyCh:            make(chan bool, 1),
 	}
+	srv.fileLog, srv.notificationLog = openEventLogs(st)
 
 	return srv
 }
 
+// SetService replaces the service, fuse controller, keychain and store used to handle requests, e.g. after switching profiles
+func (srv *grpcServer) SetService(sv space.Service, fc *fuse.Controller, kc keychain.Keychain, st store.Store) {
+	fileLog, notificationLog := openEventLogs(st)
+
+	srv.mu.Lock()
+	defer srv.mu.Unlock()
+
+	srv.sv = sv
+	srv.fc = fc
+	srv.kc = kc
+	srv.fileLog = fileLog
+	srv.notificationLog = notificationLog
+}
+
+func openEventLogs(st store.Store) (*events.Log, *events.Log) {
+	fileLog, err := events.NewLog(st, "file", events.DefaultLogSize)
+	if err != nil {
+		log.Error("Failed to load the file events log, subscriptions will not be resumable", err)
+		fileLog = nil
+	}
+
+	notificationLog, err := events.NewLog(st, "notification", events.DefaultLogSize)
+	if err != nil {
+		log.Error("Failed to load the notification events log, subscriptions will not be resumable", err)
+		notificationLog = nil
+	}
+
+	return fileLog, notificationLog
+}
+
+func (srv *grpcServer) eventLogs() (fileLog *events.Log, notificationLog *events.Log) {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+
+	return srv.fileLog, srv.notificationLog
+}
+
+func (srv *grpcServer) service() space.Service {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+
+	return srv.sv
+}
+
+func (srv *grpcServer) fuseController() *fuse.Controller {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+
+	return srv.fc
+}
+
+func (srv *grpcServer) keychain() keychain.Keychain {
+	srv.mu.RLock()
+	defer srv.mu.RUnlock()
+
+	return srv.kc
+}
+
+// checks the app token of each request against the keychain of the active profile
+func (srv *grpcServer) authorize(ctx context.Context, fullMethodName string) (context.Context, error) {
+	return app_token_auth.New(srv.keychain()).Authorize(ctx, fullMethodName)
+}
+
 // Start grpc and api server with provided options
 func (srv *grpcServer) Start(ctx context.Context) error {
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%d", srv.opts.port))
@@ -85,11 +170,9 @@ func (srv *grpcServer) Start(ctx context.Context) error {
 
 	log.Info(fmt.Sprintf("listening on address %s", lis.Addr().String()))
 
-	appTokenAuth := app_token_auth.New(srv.kc)
-
 	srv.s = grpc.NewServer(
-		grpc.StreamInterceptor(grpc_auth.StreamServerInterceptor(appTokenAuth.Authorize)),
-		grpc.UnaryInterceptor(grpc_auth.UnaryServerInterceptor(appTokenAuth.Authorize)),
+		grpc.StreamInterceptor(grpc_auth.StreamServerInterceptor(srv.authorize)),
+		grpc.UnaryInterceptor(grpc_auth.UnaryServerInterceptor(srv.authorize)),
 	)
 	pb.RegisterSpaceApiServer(srv.s, srv)
 
@@ -190,6 +273,15 @@ func WithRestProxyPort(port int) ServerOption {
 	}
 }
 
+// WithProfileManager enables the profile endpoints
+func WithProfileManager(profiles ProfileManager) ServerOption {
+	return func(o *serverOptions) {
+		if profiles != nil {
+			o.profiles = profiles
+		}
+	}
+}
+
 func (srv *grpcServer) Shutdown() error {
 	if !srv.isStarted {
 		return nil
@@ -0,0 +1,57 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/FleekHQ/space-daemon/core/permissions"
+	"github.com/FleekHQ/space-daemon/mocks"
+	"github.com/dgraph-io/badger"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const listDirectoriesMethod = "/space.SpaceApi/ListDirectories"
+
+func newProfileKeychain(tok *permissions.AppToken) *mocks.Keychain {
+	kc := new(mocks.Keychain)
+	kc.On("GetAppToken", tok.Key).Return(tok, nil)
+	kc.On("GetAppToken", mock.Anything).Return(nil, errors.New("app token not found"))
+
+	return kc
+}
+
+func newEmptyStore() *mocks.Store {
+	st := new(mocks.Store)
+	st.On("Get", mock.Anything).Return(nil, badger.ErrKeyNotFound)
+	st.On("KeysWithPrefix", mock.Anything).Return([]string{}, nil)
+
+	return st
+}
+
+func authorizeWithToken(srv *grpcServer, tok *permissions.AppToken) error {
+	md := metadata.Pairs("authorization", "AppToken "+tok.GetAccessToken())
+	_, err := srv.authorize(metadata.NewIncomingContext(context.Background(), md), listDirectoriesMethod)
+
+	return err
+}
+
+func TestAuthorize_ChecksTokensOfTheActiveProfile(t *testing.T) {
+	firstToken, err := permissions.GenerateRandomToken(true, []string{})
+	assert.NoError(t, err)
+	secondToken, err := permissions.GenerateRandomToken(true, []string{})
+	assert.NoError(t, err)
+
+	srv := New(nil, nil, newProfileKeychain(firstToken), newEmptyStore())
+	assert.NoError(t, authorizeWithToken(srv, firstToken))
+	assert.Equal(t, codes.Unauthenticated, status.Code(authorizeWithToken(srv, secondToken)))
+
+	srv.SetService(nil, nil, newProfileKeychain(secondToken), newEmptyStore())
+
+	assert.NoError(t, authorizeWithToken(srv, secondToken))
+	assert.Equal(t, codes.Unauthenticated, status.Code(authorizeWithToken(srv, firstToken)))
+}
@@ -3,21 +3,49 @@ package grpc
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/FleekHQ/space-daemon/core/events"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/grpc/pb"
 	"github.com/FleekHQ/space-daemon/log"
-	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 var errNotImplemented = errors.New("Not implemented")
 
 func (srv *grpcServer) sendFileEvent(event *pb.FileEventResponse) {
-	if srv.fileEventStream != nil {
-		log.Info("sending events to client", event.String())
-		srv.fileEventStream.Send(event)
+	log.Info("sending events to client", event.String())
+	fileLog, _ := srv.eventLogs()
+
+	srv.publishMu.Lock()
+	defer srv.publishMu.Unlock()
+
+	event.Seq = appendToLog(fileLog, event)
+	srv.fileEvents.Publish(event)
+}
+
+// Appends the event to the log and returns its sequence number, 0 if it could not be logged
+func appendToLog(eventLog *events.Log, event proto.Message) uint64 {
+	if eventLog == nil {
+		return 0
+	}
+
+	data, err := proto.Marshal(event)
+	if err != nil {
+		log.Error("Failed to marshal event for the events log", err)
+		return 0
 	}
+
+	seq, err := eventLog.Append(data)
+	if err != nil {
+		log.Error("Failed to append event to the events log", err)
+		return 0
+	}
+
+	return seq
 }
 
 func (srv *grpcServer) SendFileEvent(event events.FileEvent) {
@@ -56,20 +84,22 @@ func mapFileEventToPb(eventType events.FileEventType) pb.EventType {
 		return pb.EventType_FOLDER_DELETED
 	case events.FolderUpdated:
 		return pb.EventType_FOLDER_UPDATED
+	case events.FileConflict:
+		return pb.EventType_ENTRY_CONFLICT
 	default:
 		return pb.EventType_ENTRY_ADDED
 	}
 }
 
 func (srv *grpcServer) sendTextileEvent(event *pb.TextileEventResponse) {
-	if srv.txlEventStream != nil {
-		log.Info("sending events to client")
-		srv.txlEventStream.Send(event)
-	}
+	log.Info("sending events to client")
+	srv.txlEvents.Publish(event)
 }
 
 func (srv *grpcServer) SendTextileEvent(event events.TextileEvent) {
-	pe := &pb.TextileEventResponse{}
+	pe := &pb.TextileEventResponse{
+		Bucket: event.BucketName,
+	}
 
 	srv.sendTextileEvent(pe)
 }
@@ -78,7 +108,7 @@ func (srv *grpcServer) ListDirectories(ctx context.Context, request *pb.ListDire
 	bucketName := request.Bucket
 	listMembers := !request.OmitMembers
 
-	entries, err := srv.sv.ListDirs(ctx, "", bucketName, listMembers)
+	entries, err := srv.service().ListDirs(ctx, "", bucketName, listMembers)
 	if err != nil {
 		return nil, err
 	}
@@ -98,7 +128,7 @@ func (srv *grpcServer) ListDirectory(
 ) (*pb.ListDirectoryResponse, error) {
 	listMembers := !request.OmitMembers
 
-	entries, err := srv.sv.ListDir(ctx, request.GetPath(), request.GetBucket(), listMembers)
+	entries, err := srv.service().ListDir(ctx, request.GetPath(), request.GetBucket(), listMembers)
 	if err != nil {
 		return nil, err
 	}
@@ -122,6 +152,7 @@ func mapFileInfoToDirectoryEntry(entries []domain.FileInfo) []*pb.ListDirectoryE
 			members = append(members, &pb.FileMember{
 				Address:   m.Address,
 				PublicKey: m.PublicKey,
+				Role:      mapShareRoleToPb(m.Role),
 			})
 		}
 
@@ -150,42 +181,168 @@ func mapFileInfoToDirectoryEntry(entries []domain.FileInfo) []*pb.ListDirectoryE
 	return dirEntries
 }
 
-func (srv *grpcServer) Subscribe(empty *empty.Empty, stream pb.SpaceApi_SubscribeServer) error {
-	srv.registerStream(stream)
-	// waits until request is done
-	select {
-	case <-stream.Context().Done():
-		break
+func (srv *grpcServer) Subscribe(request *pb.SubscribeRequest, stream pb.SpaceApi_SubscribeServer) error {
+	buckets := stringSet(request.Buckets)
+	types := map[pb.EventType]bool{}
+	for _, t := range request.Types {
+		types[t] = true
 	}
-	// clean up stream
-	srv.registerStream(nil)
-	log.Info("closing stream")
-	return nil
+
+	filter := func(e interface{}) bool {
+		event := e.(*pb.FileEventResponse)
+		if len(buckets) > 0 && !buckets[event.Bucket] {
+			return false
+		}
+		if len(types) > 0 && !types[event.Type] {
+			return false
+		}
+		if request.PathPrefix != "" && (event.Entry == nil || !strings.HasPrefix(event.Entry.Path, request.PathPrefix)) {
+			return false
+		}
+		return true
+	}
+
+	sub := srv.fileEvents.Subscribe(events.SubscribeOptions{
+		Filter:     filter,
+		Policy:     mapSlowSubscriberPolicy(request.SlowSubscriberPolicy),
+		BufferSize: int(request.BufferSize),
+	})
+	defer srv.fileEvents.Unsubscribe(sub)
+
+	fileLog, _ := srv.eventLogs()
+	decode := func(entry events.LogEntry) (interface{}, error) {
+		event := &pb.FileEventResponse{}
+		if err := proto.Unmarshal(entry.Data, event); err != nil {
+			return nil, err
+		}
+		event.Seq = entry.Seq
+
+		return event, nil
+	}
+
+	truncated := func() interface{} {
+		return &pb.FileEventResponse{Truncated: true}
+	}
+
+	return forwardEventsSince(stream.Context(), sub, fileLog, request.SinceSeq, decode, filter, truncated, func(event interface{}) error {
+		return stream.Send(event.(*pb.FileEventResponse))
+	})
 }
 
-func (srv *grpcServer) registerStream(stream pb.SpaceApi_SubscribeServer) {
-	srv.fileEventStream = stream
+func (srv *grpcServer) TxlSubscribe(request *pb.TxlSubscribeRequest, stream pb.SpaceApi_TxlSubscribeServer) error {
+	buckets := stringSet(request.Buckets)
+
+	sub := srv.txlEvents.Subscribe(events.SubscribeOptions{
+		Filter: func(e interface{}) bool {
+			return len(buckets) == 0 || buckets[e.(*pb.TextileEventResponse).Bucket]
+		},
+		Policy:     mapSlowSubscriberPolicy(request.SlowSubscriberPolicy),
+		BufferSize: int(request.BufferSize),
+	})
+	defer srv.txlEvents.Unsubscribe(sub)
+
+	return forwardEvents(stream.Context(), sub, func(event interface{}) error {
+		return stream.Send(event.(*pb.TextileEventResponse))
+	})
 }
 
-func (srv *grpcServer) TxlSubscribe(empty *empty.Empty, stream pb.SpaceApi_TxlSubscribeServer) error {
-	srv.registerTxlStream(stream)
-	// waits until request is done
-	select {
-	case <-stream.Context().Done():
-		break
+// Sends the events of the subscription to the stream until the client leaves or the bus closes the subscription
+func forwardEvents(ctx context.Context, sub *events.Subscription, send func(event interface{}) error) error {
+	for {
+		select {
+		case <-ctx.Done():
+			log.Info("closing stream")
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				log.Info("closing stream of slow subscriber")
+				return status.Error(codes.ResourceExhausted, sub.Err().Error())
+			}
+
+			if err := send(event); err != nil {
+				return err
+			}
+		}
 	}
-	// clean up stream
-	srv.registerTxlStream(nil)
-	log.Info("closing stream")
-	return nil
 }
 
-func (srv *grpcServer) registerTxlStream(stream pb.SpaceApi_TxlSubscribeServer) {
-	srv.txlEventStream = stream
+// events numbered by an events log
+type sequencedEvent interface {
+	GetSeq() uint64
+}
+
+// Sends the logged events after sinceSeq matching the filter, then the live events of the subscription.
+// If some of the events after sinceSeq are no longer logged, the event built by truncated is sent first.
+// The subscription is created before the log is read so no event is missed in between,
+// live events that were already replayed are skipped.
+func forwardEventsSince(
+	ctx context.Context,
+	sub *events.Subscription,
+	eventLog *events.Log,
+	sinceSeq uint64,
+	decode func(entry events.LogEntry) (interface{}, error),
+	filter events.Filter,
+	truncated func() interface{},
+	send func(event interface{}) error,
+) error {
+	var lastSeq uint64
+	if sinceSeq > 0 && eventLog != nil {
+		entries, isTruncated, err := eventLog.Since(sinceSeq)
+		if err != nil {
+			return err
+		}
+
+		if isTruncated {
+			if err := send(truncated()); err != nil {
+				return err
+			}
+		}
+
+		for _, entry := range entries {
+			event, err := decode(entry)
+			if err != nil {
+				return err
+			}
+
+			lastSeq = entry.Seq
+			if !filter(event) {
+				continue
+			}
+
+			if err := send(event); err != nil {
+				return err
+			}
+		}
+	}
+
+	return forwardEvents(ctx, sub, func(event interface{}) error {
+		if seq := event.(sequencedEvent).GetSeq(); seq != 0 && seq <= lastSeq {
+			return nil
+		}
+
+		return send(event)
+	})
+}
+
+func mapSlowSubscriberPolicy(policy pb.SlowSubscriberPolicy) events.SlowSubscriberPolicy {
+	if policy == pb.SlowSubscriberPolicy_DISCONNECT {
+		return events.Disconnect
+	}
+
+	return events.DropEvents
+}
+
+func stringSet(values []string) map[string]bool {
+	set := map[string]bool{}
+	for _, v := range values {
+		set[v] = true
+	}
+
+	return set
 }
 
 func (srv *grpcServer) OpenFile(ctx context.Context, request *pb.OpenFileRequest) (*pb.OpenFileResponse, error) {
-	fi, err := srv.sv.OpenFile(ctx, request.Path, request.Bucket, request.DbId)
+	fi, err := srv.service().OpenFile(ctx, request.Path, request.Bucket, request.DbId)
 	if err != nil {
 		return nil, err
 	}
@@ -196,7 +353,7 @@ func (srv *grpcServer) OpenFile(ctx context.Context, request *pb.OpenFileRequest
 func (srv *grpcServer) AddItems(request *pb.AddItemsRequest, stream pb.SpaceApi_AddItemsServer) error {
 	ctx := stream.Context()
 
-	results, totals, err := srv.sv.AddItems(ctx, request.SourcePaths, request.TargetPath, request.Bucket)
+	results, totals, err := srv.service().AddItems(ctx, request.SourcePaths, request.TargetPath, request.Bucket)
 	if err != nil {
 		return err
 	}
@@ -260,7 +417,7 @@ func (srv *grpcServer) AddItems(request *pb.AddItemsRequest, stream pb.SpaceApi_
 }
 
 func (srv *grpcServer) CreateFolder(ctx context.Context, request *pb.CreateFolderRequest) (*pb.CreateFolderResponse, error) {
-	err := srv.sv.CreateFolder(ctx, request.Path, request.Bucket)
+	err := srv.service().CreateFolder(ctx, request.Path, request.Bucket)
 	if err != nil {
 		return nil, err
 	}
@@ -268,8 +425,99 @@ func (srv *grpcServer) CreateFolder(ctx context.Context, request *pb.CreateFolde
 	return &pb.CreateFolderResponse{}, nil
 }
 
+func (srv *grpcServer) ListFileConflicts(ctx context.Context, request *pb.ListFileConflictsRequest) (*pb.ListFileConflictsResponse, error) {
+	conflicts, err := srv.service().ListFileConflicts(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListFileConflictsResponse{
+		Conflicts: []*pb.FileConflict{},
+	}
+	for _, c := range conflicts {
+		res.Conflicts = append(res.Conflicts, &pb.FileConflict{
+			LocalPath:  c.LocalPath,
+			Bucket:     c.BucketSlug,
+			BucketPath: c.BucketPath,
+			DbId:       c.DbId,
+			BaseCid:    c.BaseCid,
+			RemoteCid:  c.RemoteCid,
+			DetectedAt: c.DetectedAt,
+		})
+	}
+
+	return res, nil
+}
+
+func (srv *grpcServer) ResolveFileConflict(ctx context.Context, request *pb.ResolveFileConflictRequest) (*pb.ResolveFileConflictResponse, error) {
+	resolution, err := mapPbFileConflictResolution(request.Resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	err = srv.service().ResolveFileConflict(ctx, request.LocalPath, resolution)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResolveFileConflictResponse{}, nil
+}
+
+var errInvalidFileConflictResolution = errors.New("invalid file conflict resolution")
+
+func mapPbFileConflictResolution(resolution pb.FileConflictResolution) (domain.FileConflictResolution, error) {
+	switch resolution {
+	case pb.FileConflictResolution_KEEP_LOCAL:
+		return domain.KeepLocal, nil
+	case pb.FileConflictResolution_KEEP_REMOTE:
+		return domain.KeepRemote, nil
+	case pb.FileConflictResolution_KEEP_BOTH:
+		return domain.KeepBoth, nil
+	default:
+		return domain.KeepLocal, errInvalidFileConflictResolution
+	}
+}
+
+func (srv *grpcServer) AddSyncFolder(ctx context.Context, request *pb.AddSyncFolderRequest) (*pb.AddSyncFolderResponse, error) {
+	err := srv.service().AddSyncFolder(ctx, request.LocalPath, request.Bucket, request.BucketPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.AddSyncFolderResponse{}, nil
+}
+
+func (srv *grpcServer) RemoveSyncFolder(ctx context.Context, request *pb.RemoveSyncFolderRequest) (*pb.RemoveSyncFolderResponse, error) {
+	err := srv.service().RemoveSyncFolder(ctx, request.LocalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RemoveSyncFolderResponse{}, nil
+}
+
+func (srv *grpcServer) ListSyncFolders(ctx context.Context, request *pb.ListSyncFoldersRequest) (*pb.ListSyncFoldersResponse, error) {
+	folders, err := srv.service().ListSyncFolders(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.ListSyncFoldersResponse{
+		Folders: []*pb.SyncFolder{},
+	}
+	for _, f := range folders {
+		res.Folders = append(res.Folders, &pb.SyncFolder{
+			LocalPath:  f.LocalPath,
+			Bucket:     f.BucketSlug,
+			BucketPath: f.BucketPath,
+		})
+	}
+
+	return res, nil
+}
+
 func (srv *grpcServer) RemoveDirOrFile(ctx context.Context, request *pb.RemoveDirOrFileRequest) (*pb.RemoveDirOrFileResponse, error) {
-	err := srv.sv.RemoveDirOrFile(ctx, request.Path, request.Bucket)
+	err := srv.service().RemoveDirOrFile(ctx, request.Path, request.Bucket)
 	if err != nil {
 		return nil, err
 	}
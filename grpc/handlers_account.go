@@ -9,15 +9,15 @@ import (
 
 func (srv *grpcServer) DeleteAccount(ctx context.Context, request *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
 
-	if err := srv.fc.Unmount(); err != nil {
+	if err := srv.fuseController().Unmount(); err != nil {
 		return nil, errors.Wrap(err, "failed to unmount fuse drive")
 	}
 
-	if err := srv.sv.TruncateData(ctx); err != nil {
+	if err := srv.service().TruncateData(ctx); err != nil {
 		return nil, errors.Wrap(err, "error during clean up")
 	}
 
-	if err := srv.sv.DeleteKeypair(ctx); err != nil {
+	if err := srv.service().DeleteKeypair(ctx); err != nil {
 		return nil, errors.Wrap(err, "failed to remove keypair")
 	}
 
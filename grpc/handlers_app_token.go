@@ -7,7 +7,7 @@ import (
 )
 
 func (srv *grpcServer) InitializeMasterAppToken(ctx context.Context, request *pb.InitializeMasterAppTokenRequest) (*pb.InitializeMasterAppTokenResponse, error) {
-	appToken, err := srv.sv.InitializeMasterAppToken(ctx)
+	appToken, err := srv.service().InitializeMasterAppToken(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -2,6 +2,8 @@ package grpc
 
 import (
 	"context"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/grpc/pb"
 )
 
@@ -9,7 +11,7 @@ func (srv *grpcServer) ToggleBucketBackup(ctx context.Context, request *pb.Toggl
 	bucketSlug := request.Bucket
 	bucketBackup := request.Backup
 
-	err := srv.sv.ToggleBucketBackup(ctx, bucketSlug, bucketBackup)
+	err := srv.service().ToggleBucketBackup(ctx, bucketSlug, bucketBackup)
 	if err != nil {
 		return nil, err
 	}
@@ -19,13 +21,29 @@ func (srv *grpcServer) ToggleBucketBackup(ctx context.Context, request *pb.Toggl
 
 func (srv *grpcServer) BucketBackupRestore(ctx context.Context, request *pb.BucketBackupRestoreRequest) (*pb.BucketBackupRestoreResponse, error) {
 	bucketSlug := request.Bucket
+	opts := domain.RestoreOptions{
+		Paths:        request.Paths,
+		DryRun:       request.DryRun,
+		MetadataOnly: request.MetadataOnly,
+	}
 
-	err := srv.sv.BucketBackupRestore(ctx, bucketSlug)
+	plan, err := srv.service().BucketBackupRestore(ctx, bucketSlug, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.BucketBackupRestoreResponse{}, nil
+	files := []*pb.RestoreFile{}
+	for _, f := range plan.Files {
+		files = append(files, &pb.RestoreFile{
+			Path:        f.Path,
+			SizeInBytes: f.SizeInBytes,
+		})
+	}
+
+	return &pb.BucketBackupRestoreResponse{
+		Files:      files,
+		TotalBytes: plan.TotalBytes,
+	}, nil
 }
 
 func (srv *grpcServer) GetUsageInfo(ctx context.Context, request *pb.GetUsageInfoRequest) (*pb.GetUsageInfoResponse, error) {
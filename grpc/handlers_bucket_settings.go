@@ -0,0 +1,89 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/FleekHQ/space-daemon/core/space/domain"
+	"github.com/FleekHQ/space-daemon/grpc/pb"
+)
+
+var errMissingBucketSettings = errors.New("bucket settings are required")
+
+func (srv *grpcServer) GetBucketSettings(ctx context.Context, request *pb.GetBucketSettingsRequest) (*pb.GetBucketSettingsResponse, error) {
+	settings, err := srv.service().GetBucketSettings(ctx, request.Bucket)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetBucketSettingsResponse{
+		Settings: mapBucketSettingsToPb(settings),
+	}, nil
+}
+
+func (srv *grpcServer) UpdateBucketSettings(ctx context.Context, request *pb.UpdateBucketSettingsRequest) (*pb.UpdateBucketSettingsResponse, error) {
+	if request.Settings == nil {
+		return nil, errMissingBucketSettings
+	}
+
+	settings, err := mapPbBucketSettings(request.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err = srv.service().UpdateBucketSettings(ctx, request.Bucket, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateBucketSettingsResponse{
+		Settings: mapBucketSettingsToPb(settings),
+	}, nil
+}
+
+func mapPbBucketSettings(settings *pb.BucketSettings) (domain.BucketSettings, error) {
+	policy := domain.BackupOff
+	switch settings.BackupPolicy {
+	case pb.BackupPolicy_BACKUP_ON:
+		policy = domain.BackupOn
+	case pb.BackupPolicy_BACKUP_METADATA_ONLY:
+		policy = domain.BackupMetadataOnly
+	}
+
+	// an unset role keeps the least permissive role, same as buckets without settings
+	role := domain.ViewerShareRole
+	if settings.DefaultShareRole != pb.ShareRole_SHARE_ROLE_UNSPECIFIED {
+		var err error
+		role, err = mapPbShareRole(settings.DefaultShareRole)
+		if err != nil {
+			return domain.BucketSettings{}, err
+		}
+	}
+
+	return domain.BucketSettings{
+		BackupPolicy:      policy,
+		QuotaBytes:        settings.QuotaBytes,
+		QuotaAlertPercent: int(settings.QuotaAlertPercent),
+		VersionRetention:  int(settings.VersionRetention),
+		DefaultShareRole:  role,
+	}, nil
+}
+
+func mapBucketSettingsToPb(settings domain.BucketSettings) *pb.BucketSettings {
+	policy := pb.BackupPolicy_BACKUP_OFF
+	switch settings.BackupPolicy {
+	case domain.BackupOn:
+		policy = pb.BackupPolicy_BACKUP_ON
+	case domain.BackupMetadataOnly:
+		policy = pb.BackupPolicy_BACKUP_METADATA_ONLY
+	}
+
+	return &pb.BucketSettings{
+		BackupPolicy:      policy,
+		QuotaBytes:        settings.QuotaBytes,
+		QuotaAlertPercent: int32(settings.QuotaAlertPercent),
+		VersionRetention:  int32(settings.VersionRetention),
+		DefaultShareRole:  mapShareRoleToPb(settings.DefaultShareRole),
+		Encrypted:         settings.Encrypted,
+	}
+}
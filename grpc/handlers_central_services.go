@@ -7,7 +7,7 @@ import (
 )
 
 func (srv *grpcServer) GetAPISessionTokens(ctx context.Context, request *pb.GetAPISessionTokensRequest) (*pb.GetAPISessionTokensResponse, error) {
-	tokens, err := srv.sv.GetAPISessionTokens(ctx)
+	tokens, err := srv.service().GetAPISessionTokens(ctx)
 	if err != nil {
 		return nil, err
 	}
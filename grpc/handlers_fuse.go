@@ -18,11 +18,11 @@ func (srv *grpcServer) ToggleFuseDrive(ctx context.Context, request *pb.ToggleFu
 	defer span.Finish()
 
 	if request.MountDrive {
-		if err := srv.fc.Mount(); err != nil {
+		if err := srv.fuseController().Mount(); err != nil {
 			return nil, errors.Wrap(err, "failed to mount fuse drive")
 		}
 	} else {
-		if err := srv.fc.Unmount(); err != nil {
+		if err := srv.fuseController().Unmount(); err != nil {
 			return nil, errors.Wrap(err, "failed to unmount fuse drive")
 		}
 	}
@@ -35,14 +35,14 @@ func (srv *grpcServer) GetFuseDriveStatus(ctx context.Context, empty *empty.Empt
 	span, ctx := opentracing.StartSpanFromContext(ctx, "GetFuseDriveStatus")
 	defer span.Finish()
 
-	state, err := srv.fc.GetFuseState(ctx)
+	state, err := srv.fuseController().GetFuseState(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.FuseDriveResponse{
 		State:     fuseStateToRpcState(state),
-		MountPath: srv.fc.GetMountPath(),
+		MountPath: srv.fuseController().GetMountPath(),
 	}, nil
 }
 
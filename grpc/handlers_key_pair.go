@@ -3,11 +3,15 @@ package grpc
 import (
 	"context"
 
+	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/grpc/pb"
 )
 
 func (srv *grpcServer) GenerateKeyPair(ctx context.Context, request *pb.GenerateKeyPairRequest) (*pb.GenerateKeyPairResponse, error) {
-	mnemonic, err := srv.sv.GenerateKeyPair(ctx, false)
+	mnemonic, err := srv.service().GenerateKeyPair(ctx, false, domain.MnemonicOptions{
+		Passphrase: request.Passphrase,
+		Language:   request.Language,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -19,7 +23,10 @@ func (srv *grpcServer) GenerateKeyPair(ctx context.Context, request *pb.Generate
 }
 
 func (srv *grpcServer) GenerateKeyPairWithForce(ctx context.Context, request *pb.GenerateKeyPairRequest) (*pb.GenerateKeyPairResponse, error) {
-	mnemonic, err := srv.sv.GenerateKeyPair(ctx, true)
+	mnemonic, err := srv.service().GenerateKeyPair(ctx, true, domain.MnemonicOptions{
+		Passphrase: request.Passphrase,
+		Language:   request.Language,
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +37,7 @@ func (srv *grpcServer) GenerateKeyPairWithForce(ctx context.Context, request *pb
 }
 
 func (srv *grpcServer) GetPublicKey(ctx context.Context, request *pb.GetPublicKeyRequest) (*pb.GetPublicKeyResponse, error) {
-	pub, err := srv.sv.GetPublicKey(ctx)
+	pub, err := srv.service().GetPublicKey(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -41,7 +48,7 @@ func (srv *grpcServer) GetPublicKey(ctx context.Context, request *pb.GetPublicKe
 }
 
 func (srv *grpcServer) DeleteKeyPair(ctx context.Context, request *pb.DeleteKeyPairRequest) (*pb.DeleteKeyPairResponse, error) {
-	err := srv.sv.DeleteKeypair(ctx)
+	err := srv.service().DeleteKeypair(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -50,20 +57,54 @@ func (srv *grpcServer) DeleteKeyPair(ctx context.Context, request *pb.DeleteKeyP
 }
 
 func (srv *grpcServer) RestoreKeyPairViaMnemonic(ctx context.Context, request *pb.RestoreKeyPairViaMnemonicRequest) (*pb.RestoreKeyPairViaMnemonicResponse, error) {
-	if err := srv.sv.RestoreKeyPairFromMnemonic(ctx, request.Mnemonic); err != nil {
+	if err := srv.service().RestoreKeyPairFromMnemonic(ctx, request.Mnemonic, domain.MnemonicOptions{
+		Passphrase: request.Passphrase,
+		Language:   request.Language,
+	}); err != nil {
 		return nil, err
 	}
 
 	return &pb.RestoreKeyPairViaMnemonicResponse{}, nil
 }
 
+func (srv *grpcServer) ValidateMnemonic(ctx context.Context, request *pb.ValidateMnemonicRequest) (*pb.ValidateMnemonicResponse, error) {
+	validation, err := srv.service().ValidateMnemonic(ctx, request.Mnemonic, request.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ValidateMnemonicResponse{
+		Valid:        validation.Valid,
+		UnknownWords: validation.UnknownWords,
+		Reason:       validation.Reason,
+	}, nil
+}
+
 func (srv *grpcServer) GetStoredMnemonic(ctx context.Context, request *pb.GetStoredMnemonicRequest) (*pb.GetStoredMnemonicResponse, error) {
-	mnemonic, err := srv.sv.GetMnemonic(ctx)
+	mnemonic, language, err := srv.service().GetMnemonic(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.GetStoredMnemonicResponse{
 		Mnemonic: mnemonic,
+		Language: language,
+	}, nil
+}
+
+func (srv *grpcServer) RotateIdentityKey(ctx context.Context, request *pb.RotateIdentityKeyRequest) (*pb.RotateIdentityKeyResponse, error) {
+	rotation, err := srv.service().RotateIdentityKey(
+		ctx,
+		request.Uuid,
+		request.Passphrase,
+		domain.KeyBackupType(request.Type),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RotateIdentityKeyResponse{
+		Mnemonic:       rotation.Mnemonic,
+		BackupRequired: rotation.BackupRequired,
 	}, nil
 }
@@ -3,10 +3,11 @@ package grpc
 import (
 	"context"
 
+	"github.com/FleekHQ/space-daemon/core/events"
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/grpc/pb"
 	"github.com/FleekHQ/space-daemon/log"
-	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/proto"
 )
 
 func mapToPbNotification(n domain.Notification) *pb.Notification {
@@ -30,6 +31,7 @@ func mapToPbNotification(n domain.Notification) *pb.Notification {
 			InviterPublicKey: inv.InviterPublicKey,
 			Status:           pb.InvitationStatus(inv.Status),
 			ItemPaths:        pbpths,
+			ExpiresAt:        inv.ExpiresAt,
 		}
 		ro := &pb.Notification_InvitationValue{pbinv}
 		parsedNotif := &pb.Notification{
@@ -99,6 +101,35 @@ func mapToPbNotification(n domain.Notification) *pb.Notification {
 			Type:          pb.NotificationType(n.NotificationType),
 		}
 		return parsedNotif
+	case domain.KEY_SHARE:
+		ro := &pb.Notification_KeyShare{KeyShare: &pb.ReceivedKeyShare{
+			OwnerPublicKey: n.KeyShareValue.OwnerPublicKey,
+			Payload:        n.KeyShareValue.Payload,
+		}}
+		parsedNotif := &pb.Notification{
+			ID:            n.ID,
+			Body:          n.Body,
+			ReadAt:        n.ReadAt,
+			CreatedAt:     n.CreatedAt,
+			RelatedObject: ro,
+			Type:          pb.NotificationType(n.NotificationType),
+		}
+		return parsedNotif
+	case domain.KEY_ROTATION:
+		ro := &pb.Notification_KeyRotation{KeyRotation: &pb.KeyRotation{
+			OldPublicKey: n.KeyRotationValue.OldPublicKey,
+			NewPublicKey: n.KeyRotationValue.NewPublicKey,
+			RotatedAt:    n.KeyRotationValue.RotatedAt,
+		}}
+		parsedNotif := &pb.Notification{
+			ID:            n.ID,
+			Body:          n.Body,
+			ReadAt:        n.ReadAt,
+			CreatedAt:     n.CreatedAt,
+			RelatedObject: ro,
+			Type:          pb.NotificationType(n.NotificationType),
+		}
+		return parsedNotif
 	default:
 		parsedNotif := &pb.Notification{
 			ID:        n.ID,
@@ -112,7 +143,7 @@ func mapToPbNotification(n domain.Notification) *pb.Notification {
 }
 
 func (srv *grpcServer) SetNotificationsLastSeenAt(ctx context.Context, request *pb.SetNotificationsLastSeenAtRequest) (*pb.SetNotificationsLastSeenAtResponse, error) {
-	err := srv.sv.SetNotificationsLastSeenAt(request.Timestamp)
+	err := srv.service().SetNotificationsLastSeenAt(request.Timestamp)
 	if err != nil {
 		return nil, err
 	}
@@ -121,7 +152,7 @@ func (srv *grpcServer) SetNotificationsLastSeenAt(ctx context.Context, request *
 
 func (srv *grpcServer) GetNotifications(ctx context.Context, request *pb.GetNotificationsRequest) (*pb.GetNotificationsResponse, error) {
 	// textile expects int instead of int64 for limit field
-	n, err := srv.sv.GetNotifications(ctx, request.Seek, int(request.Limit))
+	n, err := srv.service().GetNotifications(ctx, request.Seek, int(request.Limit))
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +169,7 @@ func (srv *grpcServer) GetNotifications(ctx context.Context, request *pb.GetNoti
 		no = parsedNotifs[len(parsedNotifs)-1].ID
 	}
 
-	ls, err := srv.sv.GetNotificationsLastSeenAt()
+	ls, err := srv.service().GetNotificationsLastSeenAt()
 	if err != nil {
 		// error getting last seen at but we dont want to fail the
 		// whole request for that
@@ -160,7 +191,7 @@ func (srv *grpcServer) HandleFilesInvitation(
 	ctx context.Context,
 	request *pb.HandleFilesInvitationRequest,
 ) (*pb.HandleFilesInvitationResponse, error) {
-	err := srv.sv.HandleSharedFilesInvitation(ctx, request.InvitationID, request.Accept)
+	err := srv.service().HandleSharedFilesInvitation(ctx, request.InvitationID, request.Accept)
 	if err != nil {
 		return nil, err
 	}
@@ -168,28 +199,100 @@ func (srv *grpcServer) HandleFilesInvitation(
 	return &pb.HandleFilesInvitationResponse{}, nil
 }
 
-func (srv *grpcServer) NotificationSubscribe(empty *empty.Empty, stream pb.SpaceApi_NotificationSubscribeServer) error {
-	srv.registerNotificationStream(stream)
-	// waits until request is done
-	select {
-	case <-stream.Context().Done():
-		break
+func (srv *grpcServer) ListSentInvitations(
+	ctx context.Context,
+	request *pb.ListSentInvitationsRequest,
+) (*pb.ListSentInvitationsResponse, error) {
+	invitations, offset, err := srv.service().ListSentInvitations(ctx, request.Seek, int(request.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	pbInvitations := make([]*pb.SentInvitation, 0, len(invitations))
+	for _, inv := range invitations {
+		pbpths := make([]*pb.FullPath, 0, len(inv.ItemPaths))
+		for _, pth := range inv.ItemPaths {
+			pbpths = append(pbpths, &pb.FullPath{
+				Bucket: pth.Bucket,
+				DbId:   pth.DbId,
+				Path:   pth.Path,
+			})
+		}
+
+		pbInvitations = append(pbInvitations, &pb.SentInvitation{
+			InvitationID:     inv.InvitationID,
+			InviteePublicKey: inv.InviteePublicKey,
+			ItemPaths:        pbpths,
+			Status:           pb.InvitationStatus(inv.Status),
+			CreatedAt:        inv.CreatedAt,
+			ExpiresAt:        inv.ExpiresAt,
+			Expired:          inv.Expired,
+		})
+	}
+
+	return &pb.ListSentInvitationsResponse{
+		Invitations: pbInvitations,
+		NextOffset:  offset,
+	}, nil
+}
+
+func (srv *grpcServer) CancelInvitation(
+	ctx context.Context,
+	request *pb.CancelInvitationRequest,
+) (*pb.CancelInvitationResponse, error) {
+	if err := srv.service().CancelInvitation(ctx, request.InvitationID); err != nil {
+		return nil, err
 	}
-	// clean up stream
-	srv.registerNotificationStream(nil)
-	log.Info("closing stream")
-	return nil
+
+	return &pb.CancelInvitationResponse{}, nil
 }
 
-func (srv *grpcServer) registerNotificationStream(stream pb.SpaceApi_NotificationSubscribeServer) {
-	srv.notificationEventStream = stream
+func (srv *grpcServer) NotificationSubscribe(request *pb.NotificationSubscribeRequest, stream pb.SpaceApi_NotificationSubscribeServer) error {
+	types := map[pb.NotificationType]bool{}
+	for _, t := range request.Types {
+		types[t] = true
+	}
+
+	filter := func(e interface{}) bool {
+		return len(types) == 0 || types[e.(*pb.NotificationEventResponse).Notification.Type]
+	}
+
+	sub := srv.notificationEvents.Subscribe(events.SubscribeOptions{
+		Filter:     filter,
+		Policy:     mapSlowSubscriberPolicy(request.SlowSubscriberPolicy),
+		BufferSize: int(request.BufferSize),
+	})
+	defer srv.notificationEvents.Unsubscribe(sub)
+
+	_, notificationLog := srv.eventLogs()
+	decode := func(entry events.LogEntry) (interface{}, error) {
+		event := &pb.NotificationEventResponse{}
+		if err := proto.Unmarshal(entry.Data, event); err != nil {
+			return nil, err
+		}
+		event.Seq = entry.Seq
+
+		return event, nil
+	}
+
+	truncated := func() interface{} {
+		return &pb.NotificationEventResponse{Truncated: true}
+	}
+
+	return forwardEventsSince(stream.Context(), sub, notificationLog, request.SinceSeq, decode, filter, truncated, func(event interface{}) error {
+		return stream.Send(event.(*pb.NotificationEventResponse))
+	})
 }
 
 func (srv *grpcServer) sendNotificationEvent(event *pb.NotificationEventResponse) {
-	if srv.notificationEventStream != nil {
-		log.Info("sending events to client")
-		srv.notificationEventStream.Send(event)
-	}
+	log.Info("sending events to client")
+	_, notificationLog := srv.eventLogs()
+
+	srv.publishMu.Lock()
+	defer srv.publishMu.Unlock()
+
+	event.Seq = appendToLog(notificationLog, event)
+	srv.notificationEvents.Publish(event)
 }
 
 func (srv *grpcServer) SendNotificationEvent(notif *domain.Notification) {
@@ -0,0 +1,58 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/FleekHQ/space-daemon/grpc/pb"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+var errProfilesNotEnabled = errors.New("profiles are not enabled in this daemon")
+
+func (srv *grpcServer) ListProfiles(ctx context.Context, request *pb.ListProfilesRequest) (*pb.ListProfilesResponse, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "ListProfiles")
+	defer span.Finish()
+
+	if srv.opts.profiles == nil {
+		return nil, errProfilesNotEnabled
+	}
+
+	profiles, active := srv.opts.profiles.ListProfiles()
+
+	return &pb.ListProfilesResponse{
+		Profiles:      profiles,
+		ActiveProfile: active,
+	}, nil
+}
+
+func (srv *grpcServer) CreateProfile(ctx context.Context, request *pb.CreateProfileRequest) (*pb.CreateProfileResponse, error) {
+	span, _ := opentracing.StartSpanFromContext(ctx, "CreateProfile")
+	defer span.Finish()
+
+	if srv.opts.profiles == nil {
+		return nil, errProfilesNotEnabled
+	}
+
+	if err := srv.opts.profiles.CreateProfile(request.Name); err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateProfileResponse{}, nil
+}
+
+// SwitchProfile returns once the services of the new profile are ready
+func (srv *grpcServer) SwitchProfile(ctx context.Context, request *pb.SwitchProfileRequest) (*pb.SwitchProfileResponse, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "SwitchProfile")
+	defer span.Finish()
+
+	if srv.opts.profiles == nil {
+		return nil, errProfilesNotEnabled
+	}
+
+	if err := srv.opts.profiles.SwitchProfile(ctx, request.Name); err != nil {
+		return nil, errors.Wrap(err, "failed to switch profile")
+	}
+
+	return &pb.SwitchProfileResponse{}, nil
+}
@@ -15,7 +15,7 @@ func (srv *grpcServer) SearchFiles(ctx context.Context, request *pb.SearchFilesR
 		}, nil
 	}
 
-	entries, err := srv.sv.SearchFiles(ctx, request.Query)
+	entries, err := srv.service().SearchFiles(ctx, request.Query)
 	if err != nil {
 		return nil, err
 	}
@@ -3,12 +3,15 @@ package grpc
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/core/util/address"
 	"github.com/FleekHQ/space-daemon/grpc/pb"
 	"github.com/libp2p/go-libp2p-core/crypto"
 	"github.com/opentracing/opentracing-go"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (srv *grpcServer) ShareFilesViaPublicKey(ctx context.Context, request *pb.ShareFilesViaPublicKeyRequest) (*pb.ShareFilesViaPublicKeyResponse, error) {
@@ -38,13 +41,25 @@ func (srv *grpcServer) ShareFilesViaPublicKey(ctx context.Context, request *pb.S
 		cleanedPaths = append(cleanedPaths, *cleanedPath)
 	}
 
+	// clients from before roles were introduced don't set one
+	var role domain.ShareRole
+	var err error
+	if request.Role == pb.ShareRole_SHARE_ROLE_UNSPECIFIED {
+		role, err = srv.service().DefaultShareRole(ctx, cleanedPaths)
+	} else {
+		role, err = mapPbShareRole(request.Role)
+	}
+	if err != nil {
+		return nil, err
+	}
+
 	// fail before since actual sharing is irreversible
-	err := srv.sv.AddRecentlySharedPublicKeys(ctx, pks)
+	err = srv.service().AddRecentlySharedPublicKeys(ctx, pks)
 	if err != nil {
 		return nil, err
 	}
 
-	err = srv.sv.ShareFilesViaPublicKey(ctx, cleanedPaths, pks)
+	err = srv.service().ShareFilesViaPublicKey(ctx, cleanedPaths, pks, role, request.ExpiresAt)
 	if err != nil {
 		return nil, err
 	}
@@ -52,6 +67,80 @@ func (srv *grpcServer) ShareFilesViaPublicKey(ctx context.Context, request *pb.S
 	return &pb.ShareFilesViaPublicKeyResponse{}, nil
 }
 
+func (srv *grpcServer) ChangeShareRole(ctx context.Context, request *pb.ChangeShareRoleRequest) (*pb.ChangeShareRoleResponse, error) {
+	var pks []crypto.PubKey
+
+	for _, pk := range request.PublicKeys {
+		b, err := hex.DecodeString(pk)
+		if err != nil {
+			return nil, err
+		}
+		p, err := crypto.UnmarshalEd25519PublicKey(b)
+		if err != nil {
+			return nil, err
+		}
+		pks = append(pks, p)
+	}
+
+	var domainPaths []domain.FullPath
+	for _, path := range request.Paths {
+		domainPaths = append(domainPaths, domain.FullPath{
+			Bucket: path.Bucket,
+			Path:   path.Path,
+			DbId:   path.DbId,
+		})
+	}
+
+	// unlike new shares, a missing role is not defaulted since it would change the existing grants
+	role, err := mapPbShareRole(request.Role)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	err = srv.service().ChangeShareRole(ctx, domainPaths, pks, role)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangeShareRoleResponse{}, nil
+}
+
+var errInvalidShareRole = errors.New("invalid share role")
+
+func mapPbShareRole(role pb.ShareRole) (domain.ShareRole, error) {
+	switch role {
+	case pb.ShareRole_VIEWER:
+		return domain.ViewerShareRole, nil
+	case pb.ShareRole_COMMENTER:
+		return domain.CommenterShareRole, nil
+	case pb.ShareRole_EDITOR:
+		return domain.EditorShareRole, nil
+	case pb.ShareRole_ADMIN:
+		return domain.AdminShareRole, nil
+	case pb.ShareRole_NO_ACCESS:
+		return domain.NoneShareRole, nil
+	default:
+		return domain.NoneShareRole, errInvalidShareRole
+	}
+}
+
+func mapShareRoleToPb(role domain.ShareRole) pb.ShareRole {
+	switch role {
+	case domain.ViewerShareRole:
+		return pb.ShareRole_VIEWER
+	case domain.CommenterShareRole:
+		return pb.ShareRole_COMMENTER
+	case domain.EditorShareRole:
+		return pb.ShareRole_EDITOR
+	case domain.AdminShareRole:
+		return pb.ShareRole_ADMIN
+	case domain.NoneShareRole:
+		return pb.ShareRole_NO_ACCESS
+	default:
+		return pb.ShareRole_SHARE_ROLE_UNSPECIFIED
+	}
+}
+
 func (srv *grpcServer) UnshareFilesViaPublicKey(
 	ctx context.Context,
 	request *pb.UnshareFilesViaPublicKeyRequest,
@@ -81,13 +170,21 @@ func (srv *grpcServer) UnshareFilesViaPublicKey(
 		domainPaths = append(domainPaths, cleanedPath)
 	}
 
-	err := srv.sv.UnshareFilesViaPublicKey(ctx, domainPaths, pks)
+	err := srv.service().UnshareFilesViaPublicKey(ctx, domainPaths, pks)
 
 	return &pb.UnshareFilesViaPublicKeyResponse{}, err
 }
 
 func (srv *grpcServer) GetSharedWithMeFiles(ctx context.Context, request *pb.GetSharedWithMeFilesRequest) (*pb.GetSharedWithMeFilesResponse, error) {
-	entries, offset, err := srv.sv.GetSharedWithMeFiles(ctx, request.Seek, int(request.Limit))
+	var entries []*domain.SharedDirEntry
+	var offset string
+	var err error
+
+	if request.Path != "" {
+		entries, err = srv.service().GetSharedWithMeDirectory(ctx, request.DbId, request.Bucket, request.Path)
+	} else {
+		entries, offset, err = srv.service().GetSharedWithMeFiles(ctx, request.Seek, int(request.Limit))
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -101,6 +198,7 @@ func (srv *grpcServer) GetSharedWithMeFiles(ctx context.Context, request *pb.Get
 			members = append(members, &pb.FileMember{
 				PublicKey: m.PublicKey,
 				Address:   m.Address,
+				Role:      mapShareRoleToPb(m.Role),
 			})
 		}
 
@@ -140,7 +238,7 @@ func (srv *grpcServer) GetSharedWithMeFiles(ctx context.Context, request *pb.Get
 }
 
 func (srv *grpcServer) GetSharedByMeFiles(ctx context.Context, request *pb.GetSharedByMeFilesRequest) (*pb.GetSharedByMeFilesResponse, error) {
-	entries, offset, err := srv.sv.GetSharedByMeFiles(ctx, request.Seek, int(request.Limit))
+	entries, offset, err := srv.service().GetSharedByMeFiles(ctx, request.Seek, int(request.Limit))
 	if err != nil {
 		return nil, err
 	}
@@ -154,6 +252,7 @@ func (srv *grpcServer) GetSharedByMeFiles(ctx context.Context, request *pb.GetSh
 			members = append(members, &pb.FileMember{
 				PublicKey: m.PublicKey,
 				Address:   m.Address,
+				Role:      mapShareRoleToPb(m.Role),
 			})
 		}
 
@@ -195,7 +294,13 @@ func (srv *grpcServer) GeneratePublicFileLink(ctx context.Context, request *pb.G
 	span, ctx := opentracing.StartSpanFromContext(ctx, "GeneratePublicFileLink")
 	defer span.Finish()
 
-	res, err := srv.sv.GenerateFilesSharingLink(ctx, request.Password, request.ItemPaths, request.Bucket, request.DbId)
+	opts := domain.PublicLinkOptions{
+		ExpiresAt:    request.ExpiresAt,
+		MaxDownloads: request.MaxDownloads,
+		EmbedKey:     request.EmbedKey,
+	}
+
+	res, err := srv.service().GenerateFilesSharingLink(ctx, request.Password, request.ItemPaths, request.Bucket, request.DbId, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -203,11 +308,56 @@ func (srv *grpcServer) GeneratePublicFileLink(ctx context.Context, request *pb.G
 	return &pb.GeneratePublicFileLinkResponse{
 		Link:    res.SpaceDownloadLink,
 		FileCid: res.SharedFileCid,
+		LinkId:  res.LinkID,
+	}, nil
+}
+
+func (srv *grpcServer) ListPublicLinks(ctx context.Context, request *pb.ListPublicLinksRequest) (*pb.ListPublicLinksResponse, error) {
+	links, offset, err := srv.service().ListPublicLinks(ctx, request.Seek, int(request.Limit))
+	if err != nil {
+		return nil, err
+	}
+
+	pbLinks := make([]*pb.PublicLink, 0, len(links))
+	for _, link := range links {
+		pbLinks = append(pbLinks, &pb.PublicLink{
+			Id:            link.ID,
+			Bucket:        link.Bucket,
+			DbId:          link.DbID,
+			ItemPaths:     link.Paths,
+			FileName:      link.FileName,
+			FileCid:       link.FileCid,
+			Link:          link.Link,
+			CreatedAt:     link.CreatedAt,
+			ExpiresAt:     link.ExpiresAt,
+			MaxDownloads:  link.MaxDownloads,
+			DownloadCount: link.DownloadCount,
+			Revoked:       link.Revoked,
+			Active:        link.Active,
+		})
+	}
+
+	return &pb.ListPublicLinksResponse{
+		Links:      pbLinks,
+		NextOffset: offset,
 	}, nil
 }
 
+func (srv *grpcServer) RevokePublicLink(ctx context.Context, request *pb.RevokePublicLinkRequest) (*pb.RevokePublicLinkResponse, error) {
+	if err := srv.service().RevokePublicLink(ctx, request.LinkId); err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokePublicLinkResponse{}, nil
+}
+
 func (srv *grpcServer) OpenPublicFile(ctx context.Context, request *pb.OpenPublicFileRequest) (*pb.OpenPublicFileResponse, error) {
-	res, err := srv.sv.OpenSharedFile(ctx, request.FileCid, request.Password, request.Filename)
+	hash := request.FileCid
+	if request.Link != "" {
+		hash = request.Link
+	}
+
+	res, err := srv.service().OpenSharedFile(ctx, hash, request.Password, request.Filename)
 	if err != nil {
 		return nil, err
 	}
@@ -220,7 +370,7 @@ func (srv *grpcServer) OpenPublicFile(ctx context.Context, request *pb.OpenPubli
 func (srv *grpcServer) GetRecentlySharedWith(ctx context.Context, request *pb.GetRecentlySharedWithRequest) (*pb.GetRecentlySharedWithResponse, error) {
 	fileMembers := make([]*pb.FileMember, 0)
 
-	pks, err := srv.sv.RecentlySharedPublicKeys(ctx)
+	pks, err := srv.service().RecentlySharedPublicKeys(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -30,7 +30,7 @@ func parseBucket(ctx context.Context, b textile.Bucket) *pb.Bucket {
 }
 
 func (srv *grpcServer) CreateBucket(ctx context.Context, request *pb.CreateBucketRequest) (*pb.CreateBucketResponse, error) {
-	b, err := srv.sv.CreateBucket(ctx, request.Slug)
+	b, err := srv.service().CreateBucket(ctx, request.Slug)
 	if err != nil {
 		return nil, err
 	}
@@ -42,7 +42,7 @@ func (srv *grpcServer) CreateBucket(ctx context.Context, request *pb.CreateBucke
 }
 
 func (srv *grpcServer) ListBuckets(ctx context.Context, request *pb.ListBucketsRequest) (*pb.ListBucketsResponse, error) {
-	buckets, err := srv.sv.ListBuckets(ctx)
+	buckets, err := srv.service().ListBuckets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -59,8 +59,27 @@ func (srv *grpcServer) ListBuckets(ctx context.Context, request *pb.ListBucketsR
 	}, nil
 }
 
+func (srv *grpcServer) DeleteBucket(ctx context.Context, request *pb.DeleteBucketRequest) (*pb.DeleteBucketResponse, error) {
+	if err := srv.service().DeleteBucket(ctx, request.Bucket); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteBucketResponse{}, nil
+}
+
+func (srv *grpcServer) RenameBucket(ctx context.Context, request *pb.RenameBucketRequest) (*pb.RenameBucketResponse, error) {
+	b, err := srv.service().RenameBucket(ctx, request.Bucket, request.NewSlug)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RenameBucketResponse{
+		Bucket: parseBucket(ctx, b),
+	}, nil
+}
+
 func (srv *grpcServer) ShareBucket(ctx context.Context, request *pb.ShareBucketRequest) (*pb.ShareBucketResponse, error) {
-	i, err := srv.sv.ShareBucket(ctx, request.Bucket)
+	i, err := srv.service().ShareBucket(ctx, request.Bucket)
 	if err != nil {
 		return nil, err
 	}
@@ -79,7 +98,7 @@ func (srv *grpcServer) JoinBucket(ctx context.Context, request *pb.JoinBucketReq
 		Addresses: request.Threadinfo.Addresses,
 		Key:       request.Threadinfo.Key,
 	}
-	r, err := srv.sv.JoinBucket(ctx, request.Bucket, ti)
+	r, err := srv.service().JoinBucket(ctx, request.Bucket, ti)
 	if err != nil {
 		return nil, err
 	}
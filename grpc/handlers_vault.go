@@ -2,42 +2,118 @@ package grpc
 
 import (
 	"context"
+	"encoding/hex"
 
 	"github.com/FleekHQ/space-daemon/core/space/domain"
 	"github.com/FleekHQ/space-daemon/grpc/pb"
+	"github.com/libp2p/go-libp2p-core/crypto"
 )
 
 func (srv *grpcServer) BackupKeysByPassphrase(ctx context.Context, request *pb.BackupKeysByPassphraseRequest) (*pb.BackupKeysByPassphraseResponse, error) {
 	resp := &pb.BackupKeysByPassphraseResponse{}
-	err := srv.sv.BackupKeysByPassphrase(ctx, request.Uuid, request.Passphrase, domain.KeyBackupType(request.Type))
+	err := srv.service().BackupKeysByPassphrase(ctx, request.Uuid, request.Passphrase, domain.KeyBackupType(request.Type))
 
 	return resp, err
 }
 
 func (srv *grpcServer) RecoverKeysByPassphrase(ctx context.Context, request *pb.RecoverKeysByPassphraseRequest) (*pb.RecoverKeysByPassphraseResponse, error) {
 	resp := &pb.RecoverKeysByPassphraseResponse{}
-	err := srv.sv.RecoverKeysByPassphrase(ctx, request.Uuid, request.Passphrase, domain.KeyBackupType(request.Type))
+	err := srv.service().RecoverKeysByPassphrase(ctx, request.Uuid, request.Passphrase, domain.KeyBackupType(request.Type))
+
+	return resp, err
+}
+
+func (srv *grpcServer) ChangeBackupPassphrase(ctx context.Context, request *pb.ChangeBackupPassphraseRequest) (*pb.ChangeBackupPassphraseResponse, error) {
+	resp := &pb.ChangeBackupPassphraseResponse{}
+	err := srv.service().ChangeBackupPassphrase(
+		ctx,
+		request.Uuid,
+		request.OldPassphrase,
+		request.NewPassphrase,
+		domain.KeyBackupType(request.Type),
+	)
 
 	return resp, err
 }
 
 func (srv *grpcServer) CreateLocalKeysBackup(ctx context.Context, request *pb.CreateLocalKeysBackupRequest) (*pb.CreateLocalKeysBackupResponse, error) {
 	resp := &pb.CreateLocalKeysBackupResponse{}
-	err := srv.sv.CreateLocalKeysBackup(ctx, request.PathToKeyBackup)
+	opts := domain.LocalKeysBackupOptions{
+		IncludeMnemonic:   request.IncludeMnemonic,
+		IncludeAppTokens:  request.IncludeAppTokens,
+		IncludeThreadKeys: request.IncludeThreadKeys,
+	}
+	err := srv.service().CreateLocalKeysBackup(ctx, request.PathToKeyBackup, request.Passphrase, opts)
 
 	return resp, err
 }
 
 func (srv *grpcServer) RecoverKeysByLocalBackup(ctx context.Context, request *pb.RecoverKeysByLocalBackupRequest) (*pb.RecoverKeysByLocalBackupResponse, error) {
 	resp := &pb.RecoverKeysByLocalBackupResponse{}
-	err := srv.sv.RecoverKeysByLocalBackup(ctx, request.PathToKeyBackup)
+	err := srv.service().RecoverKeysByLocalBackup(ctx, request.PathToKeyBackup, request.Passphrase)
 
 	return resp, err
 }
 
 func (srv *grpcServer) TestKeysPassphrase(ctx context.Context, request *pb.TestKeysPassphraseRequest) (*pb.TestKeysPassphraseResponse, error) {
 	resp := &pb.TestKeysPassphraseResponse{}
-	err := srv.sv.TestPassphrase(ctx, request.Uuid, request.Passphrase)
+	err := srv.service().TestPassphrase(ctx, request.Uuid, request.Passphrase)
+
+	return resp, err
+}
+
+func (srv *grpcServer) SplitKeyIntoShares(ctx context.Context, request *pb.SplitKeyIntoSharesRequest) (*pb.SplitKeyIntoSharesResponse, error) {
+	recipients := make([]crypto.PubKey, 0, len(request.RecipientPublicKeys))
+	for _, pk := range request.RecipientPublicKeys {
+		b, err := hex.DecodeString(pk)
+		if err != nil {
+			return nil, err
+		}
+
+		p, err := crypto.UnmarshalEd25519PublicKey(b)
+		if err != nil {
+			return nil, err
+		}
+
+		recipients = append(recipients, p)
+	}
+
+	shares, err := srv.service().SplitKeyIntoShares(
+		ctx,
+		int(request.TotalShares),
+		int(request.Threshold),
+		request.ExportDirectory,
+		recipients,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	pbShares := make([]*pb.KeyShare, 0, len(shares))
+	for _, share := range shares {
+		pbShares = append(pbShares, &pb.KeyShare{
+			Index:              int64(share.Index),
+			Payload:            share.Payload,
+			FilePath:           share.FilePath,
+			RecipientPublicKey: share.RecipientPublicKey,
+		})
+	}
+
+	return &pb.SplitKeyIntoSharesResponse{Shares: pbShares}, nil
+}
+
+func (srv *grpcServer) RecoverFromShares(ctx context.Context, request *pb.RecoverFromSharesRequest) (*pb.RecoverFromSharesResponse, error) {
+	resp := &pb.RecoverFromSharesResponse{}
+
+	shares := make([]domain.KeyShare, 0, len(request.Shares)+len(request.FilePaths))
+	for _, payload := range request.Shares {
+		shares = append(shares, domain.KeyShare{Payload: payload})
+	}
+	for _, path := range request.FilePaths {
+		shares = append(shares, domain.KeyShare{FilePath: path})
+	}
+
+	err := srv.service().RecoverFromShares(ctx, shares)
 
 	return resp, err
 }
@@ -31,6 +31,118 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type BackupPolicy int32
+
+const (
+	BackupPolicy_BACKUP_OFF BackupPolicy = 0
+	BackupPolicy_BACKUP_ON  BackupPolicy = 1
+	// only the bucket thread is replicated, file content is not uploaded so files can not be shared
+	BackupPolicy_BACKUP_METADATA_ONLY BackupPolicy = 2
+)
+
+// Enum value maps for BackupPolicy.
+var (
+	BackupPolicy_name = map[int32]string{
+		0: "BACKUP_OFF",
+		1: "BACKUP_ON",
+		2: "BACKUP_METADATA_ONLY",
+	}
+	BackupPolicy_value = map[string]int32{
+		"BACKUP_OFF":           0,
+		"BACKUP_ON":            1,
+		"BACKUP_METADATA_ONLY": 2,
+	}
+)
+
+func (x BackupPolicy) Enum() *BackupPolicy {
+	p := new(BackupPolicy)
+	*p = x
+	return p
+}
+
+func (x BackupPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BackupPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_space_proto_enumTypes[0].Descriptor()
+}
+
+func (BackupPolicy) Type() protoreflect.EnumType {
+	return &file_space_proto_enumTypes[0]
+}
+
+func (x BackupPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BackupPolicy.Descriptor instead.
+func (BackupPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{0}
+}
+
+// ordered from the least to the most permissive role, same as the roles of the daemon
+type ShareRole int32
+
+const (
+	// new shares without a role use the least permissive default share role of the buckets,
+	// changing the role of a share requires one
+	ShareRole_SHARE_ROLE_UNSPECIFIED ShareRole = 0
+	ShareRole_VIEWER                 ShareRole = 1
+	// read access on the hub like viewers, the daemon of the sharer keeps the role apart
+	ShareRole_COMMENTER ShareRole = 2
+	ShareRole_EDITOR    ShareRole = 3
+	ShareRole_ADMIN     ShareRole = 4
+	ShareRole_NO_ACCESS ShareRole = 5
+)
+
+// Enum value maps for ShareRole.
+var (
+	ShareRole_name = map[int32]string{
+		0: "SHARE_ROLE_UNSPECIFIED",
+		1: "VIEWER",
+		2: "COMMENTER",
+		3: "EDITOR",
+		4: "ADMIN",
+		5: "NO_ACCESS",
+	}
+	ShareRole_value = map[string]int32{
+		"SHARE_ROLE_UNSPECIFIED": 0,
+		"VIEWER":                 1,
+		"COMMENTER":              2,
+		"EDITOR":                 3,
+		"ADMIN":                  4,
+		"NO_ACCESS":              5,
+	}
+)
+
+func (x ShareRole) Enum() *ShareRole {
+	p := new(ShareRole)
+	*p = x
+	return p
+}
+
+func (x ShareRole) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareRole) Descriptor() protoreflect.EnumDescriptor {
+	return file_space_proto_enumTypes[1].Descriptor()
+}
+
+func (ShareRole) Type() protoreflect.EnumType {
+	return &file_space_proto_enumTypes[1]
+}
+
+func (x ShareRole) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareRole.Descriptor instead.
+func (ShareRole) EnumDescriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{1}
+}
+
 type EventType int32
 
 const (
@@ -44,21 +156,24 @@ const (
 	EventType_FOLDER_ADDED              EventType = 7
 	EventType_FOLDER_DELETED            EventType = 8
 	EventType_FOLDER_UPDATED            EventType = 9
+	// an opened file was changed locally after its bucket version changed, see ListFileConflicts
+	EventType_ENTRY_CONFLICT EventType = 10
 )
 
 // Enum value maps for EventType.
 var (
 	EventType_name = map[int32]string{
-		0: "ENTRY_ADDED",
-		1: "ENTRY_DELETED",
-		2: "ENTRY_UPDATED",
-		3: "ENTRY_BACKUP_IN_PROGRESS",
-		4: "ENTRY_BACKUP_READY",
-		5: "ENTRY_RESTORE_IN_PROGRESS",
-		6: "ENTRY_RESTORE_READY",
-		7: "FOLDER_ADDED",
-		8: "FOLDER_DELETED",
-		9: "FOLDER_UPDATED",
+		0:  "ENTRY_ADDED",
+		1:  "ENTRY_DELETED",
+		2:  "ENTRY_UPDATED",
+		3:  "ENTRY_BACKUP_IN_PROGRESS",
+		4:  "ENTRY_BACKUP_READY",
+		5:  "ENTRY_RESTORE_IN_PROGRESS",
+		6:  "ENTRY_RESTORE_READY",
+		7:  "FOLDER_ADDED",
+		8:  "FOLDER_DELETED",
+		9:  "FOLDER_UPDATED",
+		10: "ENTRY_CONFLICT",
 	}
 	EventType_value = map[string]int32{
 		"ENTRY_ADDED":               0,
@@ -71,6 +186,7 @@ var (
 		"FOLDER_ADDED":              7,
 		"FOLDER_DELETED":            8,
 		"FOLDER_UPDATED":            9,
+		"ENTRY_CONFLICT":            10,
 	}
 )
 
@@ -85,11 +201,11 @@ func (x EventType) String() string {
 }
 
 func (EventType) Descriptor() protoreflect.EnumDescriptor {
-	return file_space_proto_enumTypes[0].Descriptor()
+	return file_space_proto_enumTypes[2].Descriptor()
 }
 
 func (EventType) Type() protoreflect.EnumType {
-	return &file_space_proto_enumTypes[0]
+	return &file_space_proto_enumTypes[2]
 }
 
 func (x EventType) Number() protoreflect.EnumNumber {
@@ -98,7 +214,112 @@ func (x EventType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use EventType.Descriptor instead.
 func (EventType) EnumDescriptor() ([]byte, []int) {
-	return file_space_proto_rawDescGZIP(), []int{0}
+	return file_space_proto_rawDescGZIP(), []int{2}
+}
+
+// What happens when a subscriber does not read events as fast as they are sent
+type SlowSubscriberPolicy int32
+
+const (
+	// events are dropped until the subscriber catches up
+	SlowSubscriberPolicy_DROP_EVENTS SlowSubscriberPolicy = 0
+	// the stream is closed with a RESOURCE_EXHAUSTED error
+	SlowSubscriberPolicy_DISCONNECT SlowSubscriberPolicy = 1
+)
+
+// Enum value maps for SlowSubscriberPolicy.
+var (
+	SlowSubscriberPolicy_name = map[int32]string{
+		0: "DROP_EVENTS",
+		1: "DISCONNECT",
+	}
+	SlowSubscriberPolicy_value = map[string]int32{
+		"DROP_EVENTS": 0,
+		"DISCONNECT":  1,
+	}
+)
+
+func (x SlowSubscriberPolicy) Enum() *SlowSubscriberPolicy {
+	p := new(SlowSubscriberPolicy)
+	*p = x
+	return p
+}
+
+func (x SlowSubscriberPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SlowSubscriberPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_space_proto_enumTypes[3].Descriptor()
+}
+
+func (SlowSubscriberPolicy) Type() protoreflect.EnumType {
+	return &file_space_proto_enumTypes[3]
+}
+
+func (x SlowSubscriberPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SlowSubscriberPolicy.Descriptor instead.
+func (SlowSubscriberPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{3}
+}
+
+type FileConflictResolution int32
+
+const (
+	// requests without a resolution are rejected
+	FileConflictResolution_FILE_CONFLICT_RESOLUTION_UNSPECIFIED FileConflictResolution = 0
+	// upload the local version over the bucket one
+	FileConflictResolution_KEEP_LOCAL FileConflictResolution = 1
+	// replace the local version with the bucket one
+	FileConflictResolution_KEEP_REMOTE FileConflictResolution = 2
+	// upload the local version next to the bucket one with a conflict suffix and replace it locally
+	FileConflictResolution_KEEP_BOTH FileConflictResolution = 3
+)
+
+// Enum value maps for FileConflictResolution.
+var (
+	FileConflictResolution_name = map[int32]string{
+		0: "FILE_CONFLICT_RESOLUTION_UNSPECIFIED",
+		1: "KEEP_LOCAL",
+		2: "KEEP_REMOTE",
+		3: "KEEP_BOTH",
+	}
+	FileConflictResolution_value = map[string]int32{
+		"FILE_CONFLICT_RESOLUTION_UNSPECIFIED": 0,
+		"KEEP_LOCAL":                           1,
+		"KEEP_REMOTE":                          2,
+		"KEEP_BOTH":                            3,
+	}
+)
+
+func (x FileConflictResolution) Enum() *FileConflictResolution {
+	p := new(FileConflictResolution)
+	*p = x
+	return p
+}
+
+func (x FileConflictResolution) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FileConflictResolution) Descriptor() protoreflect.EnumDescriptor {
+	return file_space_proto_enumTypes[4].Descriptor()
+}
+
+func (FileConflictResolution) Type() protoreflect.EnumType {
+	return &file_space_proto_enumTypes[4]
+}
+
+func (x FileConflictResolution) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FileConflictResolution.Descriptor instead.
+func (FileConflictResolution) EnumDescriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{4}
 }
 
 type KeyBackupType int32
@@ -137,11 +358,11 @@ func (x KeyBackupType) String() string {
 }
 
 func (KeyBackupType) Descriptor() protoreflect.EnumDescriptor {
-	return file_space_proto_enumTypes[1].Descriptor()
+	return file_space_proto_enumTypes[5].Descriptor()
 }
 
 func (KeyBackupType) Type() protoreflect.EnumType {
-	return &file_space_proto_enumTypes[1]
+	return &file_space_proto_enumTypes[5]
 }
 
 func (x KeyBackupType) Number() protoreflect.EnumNumber {
@@ -150,7 +371,7 @@ func (x KeyBackupType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use KeyBackupType.Descriptor instead.
 func (KeyBackupType) EnumDescriptor() ([]byte, []int) {
-	return file_space_proto_rawDescGZIP(), []int{1}
+	return file_space_proto_rawDescGZIP(), []int{5}
 }
 
 type FuseState int32
@@ -189,11 +410,11 @@ func (x FuseState) String() string {
 }
 
 func (FuseState) Descriptor() protoreflect.EnumDescriptor {
-	return file_space_proto_enumTypes[2].Descriptor()
+	return file_space_proto_enumTypes[6].Descriptor()
 }
 
 func (FuseState) Type() protoreflect.EnumType {
-	return &file_space_proto_enumTypes[2]
+	return &file_space_proto_enumTypes[6]
 }
 
 func (x FuseState) Number() protoreflect.EnumNumber {
@@ -202,17 +423,20 @@ func (x FuseState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FuseState.Descriptor instead.
 func (FuseState) EnumDescriptor() ([]byte, []int) {
-	return file_space_proto_rawDescGZIP(), []int{2}
+	return file_space_proto_rawDescGZIP(), []int{6}
 }
 
 type NotificationType int32
 
 const (
-	NotificationType_UNKNOWN            NotificationType = 0
-	NotificationType_INVITATION         NotificationType = 1
-	NotificationType_USAGEALERT         NotificationType = 2
-	NotificationType_INVITATION_REPLY   NotificationType = 3
-	NotificationType_REVOKED_INVITATION NotificationType = 4
+	NotificationType_UNKNOWN              NotificationType = 0
+	NotificationType_INVITATION           NotificationType = 1
+	NotificationType_USAGEALERT           NotificationType = 2
+	NotificationType_INVITATION_REPLY     NotificationType = 3
+	NotificationType_REVOKED_INVITATION   NotificationType = 4
+	NotificationType_KEY_SHARE            NotificationType = 5
+	NotificationType_KEY_ROTATION         NotificationType = 6
+	NotificationType_PUBLIC_LINK_DOWNLOAD NotificationType = 7
 )
 
 // Enum value maps for NotificationType.
@@ -223,13 +447,19 @@ var (
 		2: "USAGEALERT",
 		3: "INVITATION_REPLY",
 		4: "REVOKED_INVITATION",
+		5: "KEY_SHARE",
+		6: "KEY_ROTATION",
+		7: "PUBLIC_LINK_DOWNLOAD",
 	}
 	NotificationType_value = map[string]int32{
-		"UNKNOWN":            0,
-		"INVITATION":         1,
-		"USAGEALERT":         2,
-		"INVITATION_REPLY":   3,
-		"REVOKED_INVITATION": 4,
+		"UNKNOWN":              0,
+		"INVITATION":           1,
+		"USAGEALERT":           2,
+		"INVITATION_REPLY":     3,
+		"REVOKED_INVITATION":   4,
+		"KEY_SHARE":            5,
+		"KEY_ROTATION":         6,
+		"PUBLIC_LINK_DOWNLOAD": 7,
 	}
 )
 
@@ -244,11 +474,11 @@ func (x NotificationType) String() string {
 }
 
 func (NotificationType) Descriptor() protoreflect.EnumDescriptor {
-	return file_space_proto_enumTypes[3].Descriptor()
+	return file_space_proto_enumTypes[7].Descriptor()
 }
 
 func (NotificationType) Type() protoreflect.EnumType {
-	return &file_space_proto_enumTypes[3]
+	return &file_space_proto_enumTypes[7]
 }
 
 func (x NotificationType) Number() protoreflect.EnumNumber {
@@ -257,15 +487,16 @@ func (x NotificationType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use NotificationType.Descriptor instead.
 func (NotificationType) EnumDescriptor() ([]byte, []int) {
-	return file_space_proto_rawDescGZIP(), []int{3}
+	return file_space_proto_rawDescGZIP(), []int{7}
 }
 
 type InvitationStatus int32
 
 const (
-	InvitationStatus_PENDING  InvitationStatus = 0
-	InvitationStatus_ACCEPTED InvitationStatus = 1
-	InvitationStatus_REJECTED InvitationStatus = 2
+	InvitationStatus_PENDING   InvitationStatus = 0
+	InvitationStatus_ACCEPTED  InvitationStatus = 1
+	InvitationStatus_REJECTED  InvitationStatus = 2
+	InvitationStatus_CANCELLED InvitationStatus = 3
 )
 
 // Enum value maps for InvitationStatus.
@@ -274,11 +505,13 @@ var (
 		0: "PENDING",
 		1: "ACCEPTED",
 		2: "REJECTED",
+		3: "CANCELLED",
 	}
 	InvitationStatus_value = map[string]int32{
-		"PENDING":  0,
-		"ACCEPTED": 1,
-		"REJECTED": 2,
+		"PENDING":   0,
+		"ACCEPTED":  1,
+		"REJECTED":  2,
+		"CANCELLED": 3,
 	}
 )
 
@@ -293,11 +526,11 @@ func (x InvitationStatus) String() string {
 }
 
 func (InvitationStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_space_proto_enumTypes[4].Descriptor()
+	return file_space_proto_enumTypes[8].Descriptor()
 }
 
 func (InvitationStatus) Type() protoreflect.EnumType {
-	return &file_space_proto_enumTypes[4]
+	return &file_space_proto_enumTypes[8]
 }
 
 func (x InvitationStatus) Number() protoreflect.EnumNumber {
@@ -306,7 +539,7 @@ func (x InvitationStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use InvitationStatus.Descriptor instead.
 func (InvitationStatus) EnumDescriptor() ([]byte, []int) {
-	return file_space_proto_rawDescGZIP(), []int{4}
+	return file_space_proto_rawDescGZIP(), []int{8}
 }
 
 type SearchFilesRequest struct {
@@ -566,6 +799,11 @@ type GetSharedWithMeFilesRequest struct {
 
 	Seek  string `protobuf:"bytes,1,opt,name=seek,proto3" json:"seek,omitempty"`
 	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// optional fields to list the content of a shared folder
+	// instead of the top level shared items. seek and limit are ignored.
+	DbId   string `protobuf:"bytes,3,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	Bucket string `protobuf:"bytes,4,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path   string `protobuf:"bytes,5,opt,name=path,proto3" json:"path,omitempty"`
 }
 
 func (x *GetSharedWithMeFilesRequest) Reset() {
@@ -614,6 +852,27 @@ func (x *GetSharedWithMeFilesRequest) GetLimit() int64 {
 	return 0
 }
 
+func (x *GetSharedWithMeFilesRequest) GetDbId() string {
+	if x != nil {
+		return x.DbId
+	}
+	return ""
+}
+
+func (x *GetSharedWithMeFilesRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GetSharedWithMeFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
 type GetSharedWithMeFilesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -989,16 +1248,28 @@ func (*ToggleBucketBackupResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{12}
 }
 
-type BucketBackupRestoreRequest struct {
+type BucketSettings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-}
-
-func (x *BucketBackupRestoreRequest) Reset() {
-	*x = BucketBackupRestoreRequest{}
+	BackupPolicy BackupPolicy `protobuf:"varint,1,opt,name=backupPolicy,proto3,enum=space.BackupPolicy" json:"backupPolicy,omitempty"`
+	// local storage quota in bytes, 0 for no quota
+	QuotaBytes int64 `protobuf:"varint,2,opt,name=quotaBytes,proto3" json:"quotaBytes,omitempty"`
+	// percentage of the quota at which a USAGEALERT notification is sent, 100 if 0
+	QuotaAlertPercent int32 `protobuf:"varint,3,opt,name=quotaAlertPercent,proto3" json:"quotaAlertPercent,omitempty"`
+	// number of versions of each file to keep, 0 keeps all of them. Stored for clients only for now
+	VersionRetention int32 `protobuf:"varint,4,opt,name=versionRetention,proto3" json:"versionRetention,omitempty"`
+	// role used by ShareFilesViaPublicKey and ChangeShareRole when no role is given, VIEWER if unspecified
+	DefaultShareRole ShareRole `protobuf:"varint,5,opt,name=defaultShareRole,proto3,enum=space.ShareRole" json:"defaultShareRole,omitempty"`
+	// read only, files backed up to the hub are encrypted with the bucket key. Every bucket gets its key when it is
+	// created and there is no unencrypted mode. Changing the key would mean re-uploading every backed up file and
+	// would break the links and shares using it, so UpdateBucketSettings ignores this field
+	Encrypted bool `protobuf:"varint,6,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+}
+
+func (x *BucketSettings) Reset() {
+	*x = BucketSettings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1006,13 +1277,13 @@ func (x *BucketBackupRestoreRequest) Reset() {
 	}
 }
 
-func (x *BucketBackupRestoreRequest) String() string {
+func (x *BucketSettings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BucketBackupRestoreRequest) ProtoMessage() {}
+func (*BucketSettings) ProtoMessage() {}
 
-func (x *BucketBackupRestoreRequest) ProtoReflect() protoreflect.Message {
+func (x *BucketSettings) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1024,26 +1295,63 @@ func (x *BucketBackupRestoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BucketBackupRestoreRequest.ProtoReflect.Descriptor instead.
-func (*BucketBackupRestoreRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BucketSettings.ProtoReflect.Descriptor instead.
+func (*BucketSettings) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *BucketBackupRestoreRequest) GetBucket() string {
+func (x *BucketSettings) GetBackupPolicy() BackupPolicy {
 	if x != nil {
-		return x.Bucket
+		return x.BackupPolicy
 	}
-	return ""
+	return BackupPolicy_BACKUP_OFF
 }
 
-type BucketBackupRestoreResponse struct {
+func (x *BucketSettings) GetQuotaBytes() int64 {
+	if x != nil {
+		return x.QuotaBytes
+	}
+	return 0
+}
+
+func (x *BucketSettings) GetQuotaAlertPercent() int32 {
+	if x != nil {
+		return x.QuotaAlertPercent
+	}
+	return 0
+}
+
+func (x *BucketSettings) GetVersionRetention() int32 {
+	if x != nil {
+		return x.VersionRetention
+	}
+	return 0
+}
+
+func (x *BucketSettings) GetDefaultShareRole() ShareRole {
+	if x != nil {
+		return x.DefaultShareRole
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+func (x *BucketSettings) GetEncrypted() bool {
+	if x != nil {
+		return x.Encrypted
+	}
+	return false
+}
+
+type GetBucketSettingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *BucketBackupRestoreResponse) Reset() {
-	*x = BucketBackupRestoreResponse{}
+func (x *GetBucketSettingsRequest) Reset() {
+	*x = GetBucketSettingsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1051,13 +1359,13 @@ func (x *BucketBackupRestoreResponse) Reset() {
 	}
 }
 
-func (x *BucketBackupRestoreResponse) String() string {
+func (x *GetBucketSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BucketBackupRestoreResponse) ProtoMessage() {}
+func (*GetBucketSettingsRequest) ProtoMessage() {}
 
-func (x *BucketBackupRestoreResponse) ProtoReflect() protoreflect.Message {
+func (x *GetBucketSettingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1069,22 +1377,28 @@ func (x *BucketBackupRestoreResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BucketBackupRestoreResponse.ProtoReflect.Descriptor instead.
-func (*BucketBackupRestoreResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBucketSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetBucketSettingsRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{14}
 }
 
-type ListDirectoriesRequest struct {
+func (x *GetBucketSettingsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type GetBucketSettingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket      string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	OmitMembers bool   `protobuf:"varint,2,opt,name=omitMembers,proto3" json:"omitMembers,omitempty"`
+	Settings *BucketSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *ListDirectoriesRequest) Reset() {
-	*x = ListDirectoriesRequest{}
+func (x *GetBucketSettingsResponse) Reset() {
+	*x = GetBucketSettingsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1092,13 +1406,13 @@ func (x *ListDirectoriesRequest) Reset() {
 	}
 }
 
-func (x *ListDirectoriesRequest) String() string {
+func (x *GetBucketSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDirectoriesRequest) ProtoMessage() {}
+func (*GetBucketSettingsResponse) ProtoMessage() {}
 
-func (x *ListDirectoriesRequest) ProtoReflect() protoreflect.Message {
+func (x *GetBucketSettingsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1110,36 +1424,29 @@ func (x *ListDirectoriesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDirectoriesRequest.ProtoReflect.Descriptor instead.
-func (*ListDirectoriesRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetBucketSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetBucketSettingsResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ListDirectoriesRequest) GetBucket() string {
-	if x != nil {
-		return x.Bucket
-	}
-	return ""
-}
-
-func (x *ListDirectoriesRequest) GetOmitMembers() bool {
+func (x *GetBucketSettingsResponse) GetSettings() *BucketSettings {
 	if x != nil {
-		return x.OmitMembers
+		return x.Settings
 	}
-	return false
+	return nil
 }
 
-type FileMember struct {
+type UpdateBucketSettingsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Bucket   string          `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Settings *BucketSettings `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *FileMember) Reset() {
-	*x = FileMember{}
+func (x *UpdateBucketSettingsRequest) Reset() {
+	*x = UpdateBucketSettingsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1147,13 +1454,13 @@ func (x *FileMember) Reset() {
 	}
 }
 
-func (x *FileMember) String() string {
+func (x *UpdateBucketSettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileMember) ProtoMessage() {}
+func (*UpdateBucketSettingsRequest) ProtoMessage() {}
 
-func (x *FileMember) ProtoReflect() protoreflect.Message {
+func (x *UpdateBucketSettingsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1165,47 +1472,35 @@ func (x *FileMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileMember.ProtoReflect.Descriptor instead.
-func (*FileMember) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBucketSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateBucketSettingsRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *FileMember) GetPublicKey() string {
+func (x *UpdateBucketSettingsRequest) GetBucket() string {
 	if x != nil {
-		return x.PublicKey
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *FileMember) GetAddress() string {
+func (x *UpdateBucketSettingsRequest) GetSettings() *BucketSettings {
 	if x != nil {
-		return x.Address
+		return x.Settings
 	}
-	return ""
+	return nil
 }
 
-type ListDirectoryEntry struct {
+type UpdateBucketSettingsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path                string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	IsDir               bool          `protobuf:"varint,2,opt,name=isDir,proto3" json:"isDir,omitempty"`
-	Name                string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	SizeInBytes         string        `protobuf:"bytes,4,opt,name=sizeInBytes,proto3" json:"sizeInBytes,omitempty"`
-	Created             string        `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
-	Updated             string        `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty"`
-	FileExtension       string        `protobuf:"bytes,7,opt,name=fileExtension,proto3" json:"fileExtension,omitempty"`
-	IpfsHash            string        `protobuf:"bytes,8,opt,name=ipfsHash,proto3" json:"ipfsHash,omitempty"`
-	IsLocallyAvailable  bool          `protobuf:"varint,9,opt,name=isLocallyAvailable,proto3" json:"isLocallyAvailable,omitempty"`
-	BackupCount         int64         `protobuf:"varint,10,opt,name=backupCount,proto3" json:"backupCount,omitempty"`
-	Members             []*FileMember `protobuf:"bytes,11,rep,name=members,proto3" json:"members,omitempty"`
-	IsBackupInProgress  bool          `protobuf:"varint,12,opt,name=isBackupInProgress,proto3" json:"isBackupInProgress,omitempty"`
-	IsRestoreInProgress bool          `protobuf:"varint,13,opt,name=isRestoreInProgress,proto3" json:"isRestoreInProgress,omitempty"`
+	Settings *BucketSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *ListDirectoryEntry) Reset() {
-	*x = ListDirectoryEntry{}
+func (x *UpdateBucketSettingsResponse) Reset() {
+	*x = UpdateBucketSettingsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1213,13 +1508,13 @@ func (x *ListDirectoryEntry) Reset() {
 	}
 }
 
-func (x *ListDirectoryEntry) String() string {
+func (x *UpdateBucketSettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDirectoryEntry) ProtoMessage() {}
+func (*UpdateBucketSettingsResponse) ProtoMessage() {}
 
-func (x *ListDirectoryEntry) ProtoReflect() protoreflect.Message {
+func (x *UpdateBucketSettingsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1231,116 +1526,34 @@ func (x *ListDirectoryEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDirectoryEntry.ProtoReflect.Descriptor instead.
-func (*ListDirectoryEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateBucketSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateBucketSettingsResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ListDirectoryEntry) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ListDirectoryEntry) GetIsDir() bool {
-	if x != nil {
-		return x.IsDir
-	}
-	return false
-}
-
-func (x *ListDirectoryEntry) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *ListDirectoryEntry) GetSizeInBytes() string {
-	if x != nil {
-		return x.SizeInBytes
-	}
-	return ""
-}
-
-func (x *ListDirectoryEntry) GetCreated() string {
-	if x != nil {
-		return x.Created
-	}
-	return ""
-}
-
-func (x *ListDirectoryEntry) GetUpdated() string {
-	if x != nil {
-		return x.Updated
-	}
-	return ""
-}
-
-func (x *ListDirectoryEntry) GetFileExtension() string {
-	if x != nil {
-		return x.FileExtension
-	}
-	return ""
-}
-
-func (x *ListDirectoryEntry) GetIpfsHash() string {
-	if x != nil {
-		return x.IpfsHash
-	}
-	return ""
-}
-
-func (x *ListDirectoryEntry) GetIsLocallyAvailable() bool {
-	if x != nil {
-		return x.IsLocallyAvailable
-	}
-	return false
-}
-
-func (x *ListDirectoryEntry) GetBackupCount() int64 {
-	if x != nil {
-		return x.BackupCount
-	}
-	return 0
-}
-
-func (x *ListDirectoryEntry) GetMembers() []*FileMember {
+func (x *UpdateBucketSettingsResponse) GetSettings() *BucketSettings {
 	if x != nil {
-		return x.Members
+		return x.Settings
 	}
 	return nil
 }
 
-func (x *ListDirectoryEntry) GetIsBackupInProgress() bool {
-	if x != nil {
-		return x.IsBackupInProgress
-	}
-	return false
-}
-
-func (x *ListDirectoryEntry) GetIsRestoreInProgress() bool {
-	if x != nil {
-		return x.IsRestoreInProgress
-	}
-	return false
-}
-
-type SharedListDirectoryEntry struct {
+type BucketBackupRestoreRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entry        *ListDirectoryEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
-	DbId         string              `protobuf:"bytes,2,opt,name=dbId,proto3" json:"dbId,omitempty"`
-	Bucket       string              `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	IsPublicLink bool                `protobuf:"varint,4,opt,name=isPublicLink,proto3" json:"isPublicLink,omitempty"`
-	SharedBy     string              `protobuf:"bytes,5,opt,name=sharedBy,proto3" json:"sharedBy,omitempty"`
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// path prefixes restored, all the files when empty
+	Paths []string `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	// only reports the files that would be downloaded
+	DryRun bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	// lists the files with their metadata and downloads their content when they are opened
+	MetadataOnly bool `protobuf:"varint,4,opt,name=metadataOnly,proto3" json:"metadataOnly,omitempty"`
 }
 
-func (x *SharedListDirectoryEntry) Reset() {
-	*x = SharedListDirectoryEntry{}
+func (x *BucketBackupRestoreRequest) Reset() {
+	*x = BucketBackupRestoreRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1348,13 +1561,13 @@ func (x *SharedListDirectoryEntry) Reset() {
 	}
 }
 
-func (x *SharedListDirectoryEntry) String() string {
+func (x *BucketBackupRestoreRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SharedListDirectoryEntry) ProtoMessage() {}
+func (*BucketBackupRestoreRequest) ProtoMessage() {}
 
-func (x *SharedListDirectoryEntry) ProtoReflect() protoreflect.Message {
+func (x *BucketBackupRestoreRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1366,56 +1579,50 @@ func (x *SharedListDirectoryEntry) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SharedListDirectoryEntry.ProtoReflect.Descriptor instead.
-func (*SharedListDirectoryEntry) Descriptor() ([]byte, []int) {
+// Deprecated: Use BucketBackupRestoreRequest.ProtoReflect.Descriptor instead.
+func (*BucketBackupRestoreRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SharedListDirectoryEntry) GetEntry() *ListDirectoryEntry {
-	if x != nil {
-		return x.Entry
-	}
-	return nil
-}
-
-func (x *SharedListDirectoryEntry) GetDbId() string {
+func (x *BucketBackupRestoreRequest) GetBucket() string {
 	if x != nil {
-		return x.DbId
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *SharedListDirectoryEntry) GetBucket() string {
+func (x *BucketBackupRestoreRequest) GetPaths() []string {
 	if x != nil {
-		return x.Bucket
+		return x.Paths
 	}
-	return ""
+	return nil
 }
 
-func (x *SharedListDirectoryEntry) GetIsPublicLink() bool {
+func (x *BucketBackupRestoreRequest) GetDryRun() bool {
 	if x != nil {
-		return x.IsPublicLink
+		return x.DryRun
 	}
 	return false
 }
 
-func (x *SharedListDirectoryEntry) GetSharedBy() string {
+func (x *BucketBackupRestoreRequest) GetMetadataOnly() bool {
 	if x != nil {
-		return x.SharedBy
+		return x.MetadataOnly
 	}
-	return ""
+	return false
 }
 
-type ListDirectoriesResponse struct {
+type RestoreFile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*ListDirectoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Path        string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	SizeInBytes int64  `protobuf:"varint,2,opt,name=sizeInBytes,proto3" json:"sizeInBytes,omitempty"`
 }
 
-func (x *ListDirectoriesResponse) Reset() {
-	*x = ListDirectoriesResponse{}
+func (x *RestoreFile) Reset() {
+	*x = RestoreFile{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1423,13 +1630,13 @@ func (x *ListDirectoriesResponse) Reset() {
 	}
 }
 
-func (x *ListDirectoriesResponse) String() string {
+func (x *RestoreFile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDirectoriesResponse) ProtoMessage() {}
+func (*RestoreFile) ProtoMessage() {}
 
-func (x *ListDirectoriesResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreFile) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1441,30 +1648,37 @@ func (x *ListDirectoriesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDirectoriesResponse.ProtoReflect.Descriptor instead.
-func (*ListDirectoriesResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreFile.ProtoReflect.Descriptor instead.
+func (*RestoreFile) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *ListDirectoriesResponse) GetEntries() []*ListDirectoryEntry {
+func (x *RestoreFile) GetPath() string {
 	if x != nil {
-		return x.Entries
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-type ListDirectoryRequest struct {
+func (x *RestoreFile) GetSizeInBytes() int64 {
+	if x != nil {
+		return x.SizeInBytes
+	}
+	return 0
+}
+
+type BucketBackupRestoreResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path        string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Bucket      string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	OmitMembers bool   `protobuf:"varint,3,opt,name=omitMembers,proto3" json:"omitMembers,omitempty"`
+	// files that would be downloaded, only set for dry runs
+	Files      []*RestoreFile `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	TotalBytes int64          `protobuf:"varint,2,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
 }
 
-func (x *ListDirectoryRequest) Reset() {
-	*x = ListDirectoryRequest{}
+func (x *BucketBackupRestoreResponse) Reset() {
+	*x = BucketBackupRestoreResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1472,13 +1686,13 @@ func (x *ListDirectoryRequest) Reset() {
 	}
 }
 
-func (x *ListDirectoryRequest) String() string {
+func (x *BucketBackupRestoreResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDirectoryRequest) ProtoMessage() {}
+func (*BucketBackupRestoreResponse) ProtoMessage() {}
 
-func (x *ListDirectoryRequest) ProtoReflect() protoreflect.Message {
+func (x *BucketBackupRestoreResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1490,42 +1704,36 @@ func (x *ListDirectoryRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDirectoryRequest.ProtoReflect.Descriptor instead.
-func (*ListDirectoryRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use BucketBackupRestoreResponse.ProtoReflect.Descriptor instead.
+func (*BucketBackupRestoreResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *ListDirectoryRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *ListDirectoryRequest) GetBucket() string {
+func (x *BucketBackupRestoreResponse) GetFiles() []*RestoreFile {
 	if x != nil {
-		return x.Bucket
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-func (x *ListDirectoryRequest) GetOmitMembers() bool {
+func (x *BucketBackupRestoreResponse) GetTotalBytes() int64 {
 	if x != nil {
-		return x.OmitMembers
+		return x.TotalBytes
 	}
-	return false
+	return 0
 }
 
-type ListDirectoryResponse struct {
+type ListDirectoriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Entries []*ListDirectoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	Bucket      string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	OmitMembers bool   `protobuf:"varint,2,opt,name=omitMembers,proto3" json:"omitMembers,omitempty"`
 }
 
-func (x *ListDirectoryResponse) Reset() {
-	*x = ListDirectoryResponse{}
+func (x *ListDirectoriesRequest) Reset() {
+	*x = ListDirectoriesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1533,13 +1741,13 @@ func (x *ListDirectoryResponse) Reset() {
 	}
 }
 
-func (x *ListDirectoryResponse) String() string {
+func (x *ListDirectoriesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListDirectoryResponse) ProtoMessage() {}
+func (*ListDirectoriesRequest) ProtoMessage() {}
 
-func (x *ListDirectoryResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDirectoriesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1551,28 +1759,37 @@ func (x *ListDirectoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListDirectoryResponse.ProtoReflect.Descriptor instead.
-func (*ListDirectoryResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDirectoriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectoriesRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ListDirectoryResponse) GetEntries() []*ListDirectoryEntry {
+func (x *ListDirectoriesRequest) GetBucket() string {
 	if x != nil {
-		return x.Entries
+		return x.Bucket
 	}
-	return nil
+	return ""
 }
 
-type CreateBucketRequest struct {
+func (x *ListDirectoriesRequest) GetOmitMembers() bool {
+	if x != nil {
+		return x.OmitMembers
+	}
+	return false
+}
+
+type FileMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
+	PublicKey string    `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Address   string    `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Role      ShareRole `protobuf:"varint,3,opt,name=role,proto3,enum=space.ShareRole" json:"role,omitempty"`
 }
 
-func (x *CreateBucketRequest) Reset() {
-	*x = CreateBucketRequest{}
+func (x *FileMember) Reset() {
+	*x = FileMember{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1580,13 +1797,13 @@ func (x *CreateBucketRequest) Reset() {
 	}
 }
 
-func (x *CreateBucketRequest) String() string {
+func (x *FileMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBucketRequest) ProtoMessage() {}
+func (*FileMember) ProtoMessage() {}
 
-func (x *CreateBucketRequest) ProtoReflect() protoreflect.Message {
+func (x *FileMember) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1598,31 +1815,54 @@ func (x *CreateBucketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBucketRequest.ProtoReflect.Descriptor instead.
-func (*CreateBucketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileMember.ProtoReflect.Descriptor instead.
+func (*FileMember) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *CreateBucketRequest) GetSlug() string {
+func (x *FileMember) GetPublicKey() string {
 	if x != nil {
-		return x.Slug
+		return x.PublicKey
 	}
 	return ""
 }
 
-type BucketMember struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *FileMember) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
 
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	PublicKey string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
-	IsOwner   bool   `protobuf:"varint,3,opt,name=isOwner,proto3" json:"isOwner,omitempty"`
-	HasJoined bool   `protobuf:"varint,4,opt,name=hasJoined,proto3" json:"hasJoined,omitempty"`
+func (x *FileMember) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
 }
 
-func (x *BucketMember) Reset() {
-	*x = BucketMember{}
+type ListDirectoryEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path                string        `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	IsDir               bool          `protobuf:"varint,2,opt,name=isDir,proto3" json:"isDir,omitempty"`
+	Name                string        `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	SizeInBytes         string        `protobuf:"bytes,4,opt,name=sizeInBytes,proto3" json:"sizeInBytes,omitempty"`
+	Created             string        `protobuf:"bytes,5,opt,name=created,proto3" json:"created,omitempty"`
+	Updated             string        `protobuf:"bytes,6,opt,name=updated,proto3" json:"updated,omitempty"`
+	FileExtension       string        `protobuf:"bytes,7,opt,name=fileExtension,proto3" json:"fileExtension,omitempty"`
+	IpfsHash            string        `protobuf:"bytes,8,opt,name=ipfsHash,proto3" json:"ipfsHash,omitempty"`
+	IsLocallyAvailable  bool          `protobuf:"varint,9,opt,name=isLocallyAvailable,proto3" json:"isLocallyAvailable,omitempty"`
+	BackupCount         int64         `protobuf:"varint,10,opt,name=backupCount,proto3" json:"backupCount,omitempty"`
+	Members             []*FileMember `protobuf:"bytes,11,rep,name=members,proto3" json:"members,omitempty"`
+	IsBackupInProgress  bool          `protobuf:"varint,12,opt,name=isBackupInProgress,proto3" json:"isBackupInProgress,omitempty"`
+	IsRestoreInProgress bool          `protobuf:"varint,13,opt,name=isRestoreInProgress,proto3" json:"isRestoreInProgress,omitempty"`
+}
+
+func (x *ListDirectoryEntry) Reset() {
+	*x = ListDirectoryEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1630,13 +1870,13 @@ func (x *BucketMember) Reset() {
 	}
 }
 
-func (x *BucketMember) String() string {
+func (x *ListDirectoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BucketMember) ProtoMessage() {}
+func (*ListDirectoryEntry) ProtoMessage() {}
 
-func (x *BucketMember) ProtoReflect() protoreflect.Message {
+func (x *ListDirectoryEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1648,57 +1888,116 @@ func (x *BucketMember) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BucketMember.ProtoReflect.Descriptor instead.
-func (*BucketMember) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDirectoryEntry.ProtoReflect.Descriptor instead.
+func (*ListDirectoryEntry) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *BucketMember) GetAddress() string {
+func (x *ListDirectoryEntry) GetPath() string {
 	if x != nil {
-		return x.Address
+		return x.Path
 	}
 	return ""
 }
 
-func (x *BucketMember) GetPublicKey() string {
+func (x *ListDirectoryEntry) GetIsDir() bool {
 	if x != nil {
-		return x.PublicKey
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *ListDirectoryEntry) GetName() string {
+	if x != nil {
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BucketMember) GetIsOwner() bool {
+func (x *ListDirectoryEntry) GetSizeInBytes() string {
 	if x != nil {
-		return x.IsOwner
+		return x.SizeInBytes
+	}
+	return ""
+}
+
+func (x *ListDirectoryEntry) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+func (x *ListDirectoryEntry) GetUpdated() string {
+	if x != nil {
+		return x.Updated
+	}
+	return ""
+}
+
+func (x *ListDirectoryEntry) GetFileExtension() string {
+	if x != nil {
+		return x.FileExtension
+	}
+	return ""
+}
+
+func (x *ListDirectoryEntry) GetIpfsHash() string {
+	if x != nil {
+		return x.IpfsHash
+	}
+	return ""
+}
+
+func (x *ListDirectoryEntry) GetIsLocallyAvailable() bool {
+	if x != nil {
+		return x.IsLocallyAvailable
 	}
 	return false
 }
 
-func (x *BucketMember) GetHasJoined() bool {
+func (x *ListDirectoryEntry) GetBackupCount() int64 {
 	if x != nil {
-		return x.HasJoined
+		return x.BackupCount
+	}
+	return 0
+}
+
+func (x *ListDirectoryEntry) GetMembers() []*FileMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *ListDirectoryEntry) GetIsBackupInProgress() bool {
+	if x != nil {
+		return x.IsBackupInProgress
 	}
 	return false
 }
 
-type Bucket struct {
+func (x *ListDirectoryEntry) GetIsRestoreInProgress() bool {
+	if x != nil {
+		return x.IsRestoreInProgress
+	}
+	return false
+}
+
+type SharedListDirectoryEntry struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Key              string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
-	Name             string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path             string          `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	CreatedAt        int64           `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt        int64           `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
-	Members          []*BucketMember `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
-	IsPersonalBucket bool            `protobuf:"varint,7,opt,name=isPersonalBucket,proto3" json:"isPersonalBucket,omitempty"`
-	IsBackupEnabled  bool            `protobuf:"varint,8,opt,name=isBackupEnabled,proto3" json:"isBackupEnabled,omitempty"`
-	ItemsCount       int32           `protobuf:"varint,9,opt,name=itemsCount,proto3" json:"itemsCount,omitempty"`
+	Entry        *ListDirectoryEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	DbId         string              `protobuf:"bytes,2,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	Bucket       string              `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	IsPublicLink bool                `protobuf:"varint,4,opt,name=isPublicLink,proto3" json:"isPublicLink,omitempty"`
+	SharedBy     string              `protobuf:"bytes,5,opt,name=sharedBy,proto3" json:"sharedBy,omitempty"`
 }
 
-func (x *Bucket) Reset() {
-	*x = Bucket{}
+func (x *SharedListDirectoryEntry) Reset() {
+	*x = SharedListDirectoryEntry{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1706,13 +2005,13 @@ func (x *Bucket) Reset() {
 	}
 }
 
-func (x *Bucket) String() string {
+func (x *SharedListDirectoryEntry) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Bucket) ProtoMessage() {}
+func (*SharedListDirectoryEntry) ProtoMessage() {}
 
-func (x *Bucket) ProtoReflect() protoreflect.Message {
+func (x *SharedListDirectoryEntry) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1724,84 +2023,56 @@ func (x *Bucket) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
-func (*Bucket) Descriptor() ([]byte, []int) {
+// Deprecated: Use SharedListDirectoryEntry.ProtoReflect.Descriptor instead.
+func (*SharedListDirectoryEntry) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *Bucket) GetKey() string {
+func (x *SharedListDirectoryEntry) GetEntry() *ListDirectoryEntry {
 	if x != nil {
-		return x.Key
+		return x.Entry
 	}
-	return ""
+	return nil
 }
 
-func (x *Bucket) GetName() string {
+func (x *SharedListDirectoryEntry) GetDbId() string {
 	if x != nil {
-		return x.Name
+		return x.DbId
 	}
 	return ""
 }
 
-func (x *Bucket) GetPath() string {
+func (x *SharedListDirectoryEntry) GetBucket() string {
 	if x != nil {
-		return x.Path
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *Bucket) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Bucket) GetUpdatedAt() int64 {
-	if x != nil {
-		return x.UpdatedAt
-	}
-	return 0
-}
-
-func (x *Bucket) GetMembers() []*BucketMember {
-	if x != nil {
-		return x.Members
-	}
-	return nil
-}
-
-func (x *Bucket) GetIsPersonalBucket() bool {
-	if x != nil {
-		return x.IsPersonalBucket
-	}
-	return false
-}
-
-func (x *Bucket) GetIsBackupEnabled() bool {
+func (x *SharedListDirectoryEntry) GetIsPublicLink() bool {
 	if x != nil {
-		return x.IsBackupEnabled
+		return x.IsPublicLink
 	}
 	return false
 }
 
-func (x *Bucket) GetItemsCount() int32 {
+func (x *SharedListDirectoryEntry) GetSharedBy() string {
 	if x != nil {
-		return x.ItemsCount
+		return x.SharedBy
 	}
-	return 0
+	return ""
 }
 
-type CreateBucketResponse struct {
+type ListDirectoriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket *Bucket `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Entries []*ListDirectoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
 }
 
-func (x *CreateBucketResponse) Reset() {
-	*x = CreateBucketResponse{}
+func (x *ListDirectoriesResponse) Reset() {
+	*x = ListDirectoriesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1809,13 +2080,13 @@ func (x *CreateBucketResponse) Reset() {
 	}
 }
 
-func (x *CreateBucketResponse) String() string {
+func (x *ListDirectoriesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateBucketResponse) ProtoMessage() {}
+func (*ListDirectoriesResponse) ProtoMessage() {}
 
-func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDirectoriesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1827,26 +2098,30 @@ func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateBucketResponse.ProtoReflect.Descriptor instead.
-func (*CreateBucketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDirectoriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectoriesResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *CreateBucketResponse) GetBucket() *Bucket {
+func (x *ListDirectoriesResponse) GetEntries() []*ListDirectoryEntry {
 	if x != nil {
-		return x.Bucket
+		return x.Entries
 	}
 	return nil
 }
 
-type GenerateKeyPairRequest struct {
+type ListDirectoryRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Path        string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Bucket      string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	OmitMembers bool   `protobuf:"varint,3,opt,name=omitMembers,proto3" json:"omitMembers,omitempty"`
 }
 
-func (x *GenerateKeyPairRequest) Reset() {
-	*x = GenerateKeyPairRequest{}
+func (x *ListDirectoryRequest) Reset() {
+	*x = ListDirectoryRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1854,13 +2129,13 @@ func (x *GenerateKeyPairRequest) Reset() {
 	}
 }
 
-func (x *GenerateKeyPairRequest) String() string {
+func (x *ListDirectoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateKeyPairRequest) ProtoMessage() {}
+func (*ListDirectoryRequest) ProtoMessage() {}
 
-func (x *GenerateKeyPairRequest) ProtoReflect() protoreflect.Message {
+func (x *ListDirectoryRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1872,35 +2147,56 @@ func (x *GenerateKeyPairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateKeyPairRequest.ProtoReflect.Descriptor instead.
-func (*GenerateKeyPairRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*ListDirectoryRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{26}
 }
 
-type GenerateKeyPairResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+func (x *ListDirectoryRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
 }
 
-func (x *GenerateKeyPairResponse) Reset() {
-	*x = GenerateKeyPairResponse{}
-	if protoimpl.UnsafeEnabled {
+func (x *ListDirectoryRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *ListDirectoryRequest) GetOmitMembers() bool {
+	if x != nil {
+		return x.OmitMembers
+	}
+	return false
+}
+
+type ListDirectoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Entries []*ListDirectoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+}
+
+func (x *ListDirectoryResponse) Reset() {
+	*x = ListDirectoryResponse{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GenerateKeyPairResponse) String() string {
+func (x *ListDirectoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateKeyPairResponse) ProtoMessage() {}
+func (*ListDirectoryResponse) ProtoMessage() {}
 
-func (x *GenerateKeyPairResponse) ProtoReflect() protoreflect.Message {
+func (x *ListDirectoryResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1912,26 +2208,28 @@ func (x *GenerateKeyPairResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateKeyPairResponse.ProtoReflect.Descriptor instead.
-func (*GenerateKeyPairResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListDirectoryResponse.ProtoReflect.Descriptor instead.
+func (*ListDirectoryResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *GenerateKeyPairResponse) GetMnemonic() string {
+func (x *ListDirectoryResponse) GetEntries() []*ListDirectoryEntry {
 	if x != nil {
-		return x.Mnemonic
+		return x.Entries
 	}
-	return ""
+	return nil
 }
 
-type GetStoredMnemonicRequest struct {
+type CreateBucketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Slug string `protobuf:"bytes,1,opt,name=slug,proto3" json:"slug,omitempty"`
 }
 
-func (x *GetStoredMnemonicRequest) Reset() {
-	*x = GetStoredMnemonicRequest{}
+func (x *CreateBucketRequest) Reset() {
+	*x = CreateBucketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1939,13 +2237,13 @@ func (x *GetStoredMnemonicRequest) Reset() {
 	}
 }
 
-func (x *GetStoredMnemonicRequest) String() string {
+func (x *CreateBucketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoredMnemonicRequest) ProtoMessage() {}
+func (*CreateBucketRequest) ProtoMessage() {}
 
-func (x *GetStoredMnemonicRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateBucketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1957,21 +2255,31 @@ func (x *GetStoredMnemonicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoredMnemonicRequest.ProtoReflect.Descriptor instead.
-func (*GetStoredMnemonicRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBucketRequest.ProtoReflect.Descriptor instead.
+func (*CreateBucketRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{28}
 }
 
-type GetStoredMnemonicResponse struct {
+func (x *CreateBucketRequest) GetSlug() string {
+	if x != nil {
+		return x.Slug
+	}
+	return ""
+}
+
+type BucketMember struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	PublicKey string `protobuf:"bytes,2,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	IsOwner   bool   `protobuf:"varint,3,opt,name=isOwner,proto3" json:"isOwner,omitempty"`
+	HasJoined bool   `protobuf:"varint,4,opt,name=hasJoined,proto3" json:"hasJoined,omitempty"`
 }
 
-func (x *GetStoredMnemonicResponse) Reset() {
-	*x = GetStoredMnemonicResponse{}
+func (x *BucketMember) Reset() {
+	*x = BucketMember{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1979,13 +2287,13 @@ func (x *GetStoredMnemonicResponse) Reset() {
 	}
 }
 
-func (x *GetStoredMnemonicResponse) String() string {
+func (x *BucketMember) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStoredMnemonicResponse) ProtoMessage() {}
+func (*BucketMember) ProtoMessage() {}
 
-func (x *GetStoredMnemonicResponse) ProtoReflect() protoreflect.Message {
+func (x *BucketMember) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1997,28 +2305,57 @@ func (x *GetStoredMnemonicResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStoredMnemonicResponse.ProtoReflect.Descriptor instead.
-func (*GetStoredMnemonicResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BucketMember.ProtoReflect.Descriptor instead.
+func (*BucketMember) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *GetStoredMnemonicResponse) GetMnemonic() string {
+func (x *BucketMember) GetAddress() string {
 	if x != nil {
-		return x.Mnemonic
+		return x.Address
 	}
 	return ""
 }
 
-type RestoreKeyPairViaMnemonicRequest struct {
+func (x *BucketMember) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *BucketMember) GetIsOwner() bool {
+	if x != nil {
+		return x.IsOwner
+	}
+	return false
+}
+
+func (x *BucketMember) GetHasJoined() bool {
+	if x != nil {
+		return x.HasJoined
+	}
+	return false
+}
+
+type Bucket struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Key              string          `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Name             string          `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path             string          `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	CreatedAt        int64           `protobuf:"varint,4,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt        int64           `protobuf:"varint,5,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	Members          []*BucketMember `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	IsPersonalBucket bool            `protobuf:"varint,7,opt,name=isPersonalBucket,proto3" json:"isPersonalBucket,omitempty"`
+	IsBackupEnabled  bool            `protobuf:"varint,8,opt,name=isBackupEnabled,proto3" json:"isBackupEnabled,omitempty"`
+	ItemsCount       int32           `protobuf:"varint,9,opt,name=itemsCount,proto3" json:"itemsCount,omitempty"`
 }
 
-func (x *RestoreKeyPairViaMnemonicRequest) Reset() {
-	*x = RestoreKeyPairViaMnemonicRequest{}
+func (x *Bucket) Reset() {
+	*x = Bucket{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,13 +2363,13 @@ func (x *RestoreKeyPairViaMnemonicRequest) Reset() {
 	}
 }
 
-func (x *RestoreKeyPairViaMnemonicRequest) String() string {
+func (x *Bucket) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreKeyPairViaMnemonicRequest) ProtoMessage() {}
+func (*Bucket) ProtoMessage() {}
 
-func (x *RestoreKeyPairViaMnemonicRequest) ProtoReflect() protoreflect.Message {
+func (x *Bucket) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2044,26 +2381,84 @@ func (x *RestoreKeyPairViaMnemonicRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreKeyPairViaMnemonicRequest.ProtoReflect.Descriptor instead.
-func (*RestoreKeyPairViaMnemonicRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Bucket.ProtoReflect.Descriptor instead.
+func (*Bucket) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RestoreKeyPairViaMnemonicRequest) GetMnemonic() string {
+func (x *Bucket) GetKey() string {
 	if x != nil {
-		return x.Mnemonic
+		return x.Key
 	}
 	return ""
 }
 
-type RestoreKeyPairViaMnemonicResponse struct {
+func (x *Bucket) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Bucket) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *Bucket) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Bucket) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Bucket) GetMembers() []*BucketMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+func (x *Bucket) GetIsPersonalBucket() bool {
+	if x != nil {
+		return x.IsPersonalBucket
+	}
+	return false
+}
+
+func (x *Bucket) GetIsBackupEnabled() bool {
+	if x != nil {
+		return x.IsBackupEnabled
+	}
+	return false
+}
+
+func (x *Bucket) GetItemsCount() int32 {
+	if x != nil {
+		return x.ItemsCount
+	}
+	return 0
+}
+
+type CreateBucketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bucket *Bucket `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *RestoreKeyPairViaMnemonicResponse) Reset() {
-	*x = RestoreKeyPairViaMnemonicResponse{}
+func (x *CreateBucketResponse) Reset() {
+	*x = CreateBucketResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2071,13 +2466,13 @@ func (x *RestoreKeyPairViaMnemonicResponse) Reset() {
 	}
 }
 
-func (x *RestoreKeyPairViaMnemonicResponse) String() string {
+func (x *CreateBucketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestoreKeyPairViaMnemonicResponse) ProtoMessage() {}
+func (*CreateBucketResponse) ProtoMessage() {}
 
-func (x *RestoreKeyPairViaMnemonicResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateBucketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2089,24 +2484,32 @@ func (x *RestoreKeyPairViaMnemonicResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestoreKeyPairViaMnemonicResponse.ProtoReflect.Descriptor instead.
-func (*RestoreKeyPairViaMnemonicResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateBucketResponse.ProtoReflect.Descriptor instead.
+func (*CreateBucketResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{31}
 }
 
-type FileEventResponse struct {
+func (x *CreateBucketResponse) GetBucket() *Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+type GenerateKeyPairRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type   EventType           `protobuf:"varint,1,opt,name=type,proto3,enum=space.EventType" json:"type,omitempty"`
-	Entry  *ListDirectoryEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
-	Bucket string              `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	DbId   string              `protobuf:"bytes,4,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	// optional BIP39 passphrase, it is not stored and is required again to restore the key
+	Passphrase string `protobuf:"bytes,1,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// wordlist language, english if empty. One of english, chinese_simplified, chinese_traditional,
+	// french, italian, japanese, korean or spanish
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (x *FileEventResponse) Reset() {
-	*x = FileEventResponse{}
+func (x *GenerateKeyPairRequest) Reset() {
+	*x = GenerateKeyPairRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2114,13 +2517,13 @@ func (x *FileEventResponse) Reset() {
 	}
 }
 
-func (x *FileEventResponse) String() string {
+func (x *GenerateKeyPairRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileEventResponse) ProtoMessage() {}
+func (*GenerateKeyPairRequest) ProtoMessage() {}
 
-func (x *FileEventResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateKeyPairRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2132,49 +2535,35 @@ func (x *FileEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileEventResponse.ProtoReflect.Descriptor instead.
-func (*FileEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateKeyPairRequest.ProtoReflect.Descriptor instead.
+func (*GenerateKeyPairRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *FileEventResponse) GetType() EventType {
+func (x *GenerateKeyPairRequest) GetPassphrase() string {
 	if x != nil {
-		return x.Type
+		return x.Passphrase
 	}
-	return EventType_ENTRY_ADDED
+	return ""
 }
 
-func (x *FileEventResponse) GetEntry() *ListDirectoryEntry {
+func (x *GenerateKeyPairRequest) GetLanguage() string {
 	if x != nil {
-		return x.Entry
+		return x.Language
 	}
-	return nil
+	return ""
 }
 
-func (x *FileEventResponse) GetBucket() string {
-	if x != nil {
-		return x.Bucket
-	}
-	return ""
-}
-
-func (x *FileEventResponse) GetDbId() string {
-	if x != nil {
-		return x.DbId
-	}
-	return ""
-}
-
-type TextileEventResponse struct {
+type GenerateKeyPairResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
 }
 
-func (x *TextileEventResponse) Reset() {
-	*x = TextileEventResponse{}
+func (x *GenerateKeyPairResponse) Reset() {
+	*x = GenerateKeyPairResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2182,13 +2571,13 @@ func (x *TextileEventResponse) Reset() {
 	}
 }
 
-func (x *TextileEventResponse) String() string {
+func (x *GenerateKeyPairResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TextileEventResponse) ProtoMessage() {}
+func (*GenerateKeyPairResponse) ProtoMessage() {}
 
-func (x *TextileEventResponse) ProtoReflect() protoreflect.Message {
+func (x *GenerateKeyPairResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2200,30 +2589,26 @@ func (x *TextileEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TextileEventResponse.ProtoReflect.Descriptor instead.
-func (*TextileEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GenerateKeyPairResponse.ProtoReflect.Descriptor instead.
+func (*GenerateKeyPairResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *TextileEventResponse) GetBucket() string {
+func (x *GenerateKeyPairResponse) GetMnemonic() string {
 	if x != nil {
-		return x.Bucket
+		return x.Mnemonic
 	}
 	return ""
 }
 
-type OpenFileRequest struct {
+type GetStoredMnemonicRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	DbId   string `protobuf:"bytes,3,opt,name=dbId,proto3" json:"dbId,omitempty"` // optional field to specify shared with me file
 }
 
-func (x *OpenFileRequest) Reset() {
-	*x = OpenFileRequest{}
+func (x *GetStoredMnemonicRequest) Reset() {
+	*x = GetStoredMnemonicRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2231,13 +2616,13 @@ func (x *OpenFileRequest) Reset() {
 	}
 }
 
-func (x *OpenFileRequest) String() string {
+func (x *GetStoredMnemonicRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenFileRequest) ProtoMessage() {}
+func (*GetStoredMnemonicRequest) ProtoMessage() {}
 
-func (x *OpenFileRequest) ProtoReflect() protoreflect.Message {
+func (x *GetStoredMnemonicRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2249,42 +2634,23 @@ func (x *OpenFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenFileRequest.ProtoReflect.Descriptor instead.
-func (*OpenFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStoredMnemonicRequest.ProtoReflect.Descriptor instead.
+func (*GetStoredMnemonicRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *OpenFileRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *OpenFileRequest) GetBucket() string {
-	if x != nil {
-		return x.Bucket
-	}
-	return ""
-}
-
-func (x *OpenFileRequest) GetDbId() string {
-	if x != nil {
-		return x.DbId
-	}
-	return ""
-}
-
-type OpenFileResponse struct {
+type GetStoredMnemonicResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	// wordlist language of the mnemonic, needed to restore from it
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (x *OpenFileResponse) Reset() {
-	*x = OpenFileResponse{}
+func (x *GetStoredMnemonicResponse) Reset() {
+	*x = GetStoredMnemonicResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2292,13 +2658,13 @@ func (x *OpenFileResponse) Reset() {
 	}
 }
 
-func (x *OpenFileResponse) String() string {
+func (x *GetStoredMnemonicResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenFileResponse) ProtoMessage() {}
+func (*GetStoredMnemonicResponse) ProtoMessage() {}
 
-func (x *OpenFileResponse) ProtoReflect() protoreflect.Message {
+func (x *GetStoredMnemonicResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2310,30 +2676,37 @@ func (x *OpenFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenFileResponse.ProtoReflect.Descriptor instead.
-func (*OpenFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetStoredMnemonicResponse.ProtoReflect.Descriptor instead.
+func (*GetStoredMnemonicResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *OpenFileResponse) GetLocation() string {
+func (x *GetStoredMnemonicResponse) GetMnemonic() string {
 	if x != nil {
-		return x.Location
+		return x.Mnemonic
 	}
 	return ""
 }
 
-type OpenPublicFileRequest struct {
+func (x *GetStoredMnemonicResponse) GetLanguage() string {
+	if x != nil {
+		return x.Language
+	}
+	return ""
+}
+
+type RestoreKeyPairViaMnemonicRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FileCid  string `protobuf:"bytes,1,opt,name=fileCid,proto3" json:"fileCid,omitempty"`
-	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
-	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	Mnemonic   string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Language   string `protobuf:"bytes,3,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (x *OpenPublicFileRequest) Reset() {
-	*x = OpenPublicFileRequest{}
+func (x *RestoreKeyPairViaMnemonicRequest) Reset() {
+	*x = RestoreKeyPairViaMnemonicRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2341,13 +2714,13 @@ func (x *OpenPublicFileRequest) Reset() {
 	}
 }
 
-func (x *OpenPublicFileRequest) String() string {
+func (x *RestoreKeyPairViaMnemonicRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenPublicFileRequest) ProtoMessage() {}
+func (*RestoreKeyPairViaMnemonicRequest) ProtoMessage() {}
 
-func (x *OpenPublicFileRequest) ProtoReflect() protoreflect.Message {
+func (x *RestoreKeyPairViaMnemonicRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2359,42 +2732,40 @@ func (x *OpenPublicFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPublicFileRequest.ProtoReflect.Descriptor instead.
-func (*OpenPublicFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreKeyPairViaMnemonicRequest.ProtoReflect.Descriptor instead.
+func (*RestoreKeyPairViaMnemonicRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *OpenPublicFileRequest) GetFileCid() string {
+func (x *RestoreKeyPairViaMnemonicRequest) GetMnemonic() string {
 	if x != nil {
-		return x.FileCid
+		return x.Mnemonic
 	}
 	return ""
 }
 
-func (x *OpenPublicFileRequest) GetPassword() string {
+func (x *RestoreKeyPairViaMnemonicRequest) GetPassphrase() string {
 	if x != nil {
-		return x.Password
+		return x.Passphrase
 	}
 	return ""
 }
 
-func (x *OpenPublicFileRequest) GetFilename() string {
+func (x *RestoreKeyPairViaMnemonicRequest) GetLanguage() string {
 	if x != nil {
-		return x.Filename
+		return x.Language
 	}
 	return ""
 }
 
-type OpenPublicFileResponse struct {
+type RestoreKeyPairViaMnemonicResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 }
 
-func (x *OpenPublicFileResponse) Reset() {
-	*x = OpenPublicFileResponse{}
+func (x *RestoreKeyPairViaMnemonicResponse) Reset() {
+	*x = RestoreKeyPairViaMnemonicResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2402,13 +2773,13 @@ func (x *OpenPublicFileResponse) Reset() {
 	}
 }
 
-func (x *OpenPublicFileResponse) String() string {
+func (x *RestoreKeyPairViaMnemonicResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OpenPublicFileResponse) ProtoMessage() {}
+func (*RestoreKeyPairViaMnemonicResponse) ProtoMessage() {}
 
-func (x *OpenPublicFileResponse) ProtoReflect() protoreflect.Message {
+func (x *RestoreKeyPairViaMnemonicResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2420,33 +2791,22 @@ func (x *OpenPublicFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OpenPublicFileResponse.ProtoReflect.Descriptor instead.
-func (*OpenPublicFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RestoreKeyPairViaMnemonicResponse.ProtoReflect.Descriptor instead.
+func (*RestoreKeyPairViaMnemonicResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *OpenPublicFileResponse) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
-}
-
-type AddItemsRequest struct {
+type ValidateMnemonicRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// full paths to file or Folder on FS. Needs to be a location available to the daemon
-	SourcePaths []string `protobuf:"bytes,1,rep,name=sourcePaths,proto3" json:"sourcePaths,omitempty"`
-	// target path in bucket.
-	TargetPath string `protobuf:"bytes,2,opt,name=targetPath,proto3" json:"targetPath,omitempty"`
-	// The bucket in which to save the item
-	Bucket string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	Language string `protobuf:"bytes,2,opt,name=language,proto3" json:"language,omitempty"`
 }
 
-func (x *AddItemsRequest) Reset() {
-	*x = AddItemsRequest{}
+func (x *ValidateMnemonicRequest) Reset() {
+	*x = ValidateMnemonicRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2454,13 +2814,13 @@ func (x *AddItemsRequest) Reset() {
 	}
 }
 
-func (x *AddItemsRequest) String() string {
+func (x *ValidateMnemonicRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddItemsRequest) ProtoMessage() {}
+func (*ValidateMnemonicRequest) ProtoMessage() {}
 
-func (x *AddItemsRequest) ProtoReflect() protoreflect.Message {
+func (x *ValidateMnemonicRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2472,44 +2832,38 @@ func (x *AddItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddItemsRequest.ProtoReflect.Descriptor instead.
-func (*AddItemsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateMnemonicRequest.ProtoReflect.Descriptor instead.
+func (*ValidateMnemonicRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *AddItemsRequest) GetSourcePaths() []string {
-	if x != nil {
-		return x.SourcePaths
-	}
-	return nil
-}
-
-func (x *AddItemsRequest) GetTargetPath() string {
+func (x *ValidateMnemonicRequest) GetMnemonic() string {
 	if x != nil {
-		return x.TargetPath
+		return x.Mnemonic
 	}
 	return ""
 }
 
-func (x *AddItemsRequest) GetBucket() string {
+func (x *ValidateMnemonicRequest) GetLanguage() string {
 	if x != nil {
-		return x.Bucket
+		return x.Language
 	}
 	return ""
 }
 
-type AddItemResult struct {
+type ValidateMnemonicResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SourcePath string `protobuf:"bytes,1,opt,name=sourcePath,proto3" json:"sourcePath,omitempty"`
-	BucketPath string `protobuf:"bytes,2,opt,name=bucketPath,proto3" json:"bucketPath,omitempty"`
-	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// words not found in the wordlist, usually typos
+	UnknownWords []string `protobuf:"bytes,2,rep,name=unknownWords,proto3" json:"unknownWords,omitempty"`
+	Reason       string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (x *AddItemResult) Reset() {
-	*x = AddItemResult{}
+func (x *ValidateMnemonicResponse) Reset() {
+	*x = ValidateMnemonicResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2517,13 +2871,13 @@ func (x *AddItemResult) Reset() {
 	}
 }
 
-func (x *AddItemResult) String() string {
+func (x *ValidateMnemonicResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddItemResult) ProtoMessage() {}
+func (*ValidateMnemonicResponse) ProtoMessage() {}
 
-func (x *AddItemResult) ProtoReflect() protoreflect.Message {
+func (x *ValidateMnemonicResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2535,46 +2889,54 @@ func (x *AddItemResult) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddItemResult.ProtoReflect.Descriptor instead.
-func (*AddItemResult) Descriptor() ([]byte, []int) {
+// Deprecated: Use ValidateMnemonicResponse.ProtoReflect.Descriptor instead.
+func (*ValidateMnemonicResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *AddItemResult) GetSourcePath() string {
+func (x *ValidateMnemonicResponse) GetValid() bool {
 	if x != nil {
-		return x.SourcePath
+		return x.Valid
 	}
-	return ""
+	return false
 }
 
-func (x *AddItemResult) GetBucketPath() string {
+func (x *ValidateMnemonicResponse) GetUnknownWords() []string {
 	if x != nil {
-		return x.BucketPath
+		return x.UnknownWords
 	}
-	return ""
+	return nil
 }
 
-func (x *AddItemResult) GetError() string {
+func (x *ValidateMnemonicResponse) GetReason() string {
 	if x != nil {
-		return x.Error
+		return x.Reason
 	}
 	return ""
 }
 
-type AddItemsResponse struct {
+type SubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Result         *AddItemResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
-	TotalFiles     int64          `protobuf:"varint,2,opt,name=totalFiles,proto3" json:"totalFiles,omitempty"`
-	TotalBytes     int64          `protobuf:"varint,3,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
-	CompletedFiles int64          `protobuf:"varint,4,opt,name=completedFiles,proto3" json:"completedFiles,omitempty"`
-	CompletedBytes int64          `protobuf:"varint,5,opt,name=completedBytes,proto3" json:"completedBytes,omitempty"`
-}
-
-func (x *AddItemsResponse) Reset() {
-	*x = AddItemsResponse{}
+	// only events of these buckets, all buckets if empty
+	Buckets []string `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	// only events of these types, all types if empty
+	Types []EventType `protobuf:"varint,2,rep,packed,name=types,proto3,enum=space.EventType" json:"types,omitempty"`
+	// only events of entries under this path
+	PathPrefix           string               `protobuf:"bytes,3,opt,name=pathPrefix,proto3" json:"pathPrefix,omitempty"`
+	SlowSubscriberPolicy SlowSubscriberPolicy `protobuf:"varint,4,opt,name=slowSubscriberPolicy,proto3,enum=space.SlowSubscriberPolicy" json:"slowSubscriberPolicy,omitempty"`
+	// number of events buffered for the subscriber, 100 if not set
+	BufferSize int64 `protobuf:"varint,5,opt,name=bufferSize,proto3" json:"bufferSize,omitempty"`
+	// replays the logged events after this sequence number before the live ones, only live events if 0.
+	// If events after sinceSeq are no longer in the log, an event with truncated set is sent first
+	// and the client should list the directories again.
+	SinceSeq uint64 `protobuf:"varint,6,opt,name=sinceSeq,proto3" json:"sinceSeq,omitempty"`
+}
+
+func (x *SubscribeRequest) Reset() {
+	*x = SubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2582,13 +2944,13 @@ func (x *AddItemsResponse) Reset() {
 	}
 }
 
-func (x *AddItemsResponse) String() string {
+func (x *SubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddItemsResponse) ProtoMessage() {}
+func (*SubscribeRequest) ProtoMessage() {}
 
-func (x *AddItemsResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2600,59 +2962,65 @@ func (x *AddItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddItemsResponse.ProtoReflect.Descriptor instead.
-func (*AddItemsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *AddItemsResponse) GetResult() *AddItemResult {
+func (x *SubscribeRequest) GetBuckets() []string {
 	if x != nil {
-		return x.Result
+		return x.Buckets
 	}
 	return nil
 }
 
-func (x *AddItemsResponse) GetTotalFiles() int64 {
+func (x *SubscribeRequest) GetTypes() []EventType {
 	if x != nil {
-		return x.TotalFiles
+		return x.Types
 	}
-	return 0
+	return nil
 }
 
-func (x *AddItemsResponse) GetTotalBytes() int64 {
+func (x *SubscribeRequest) GetPathPrefix() string {
 	if x != nil {
-		return x.TotalBytes
+		return x.PathPrefix
 	}
-	return 0
+	return ""
 }
 
-func (x *AddItemsResponse) GetCompletedFiles() int64 {
+func (x *SubscribeRequest) GetSlowSubscriberPolicy() SlowSubscriberPolicy {
 	if x != nil {
-		return x.CompletedFiles
+		return x.SlowSubscriberPolicy
+	}
+	return SlowSubscriberPolicy_DROP_EVENTS
+}
+
+func (x *SubscribeRequest) GetBufferSize() int64 {
+	if x != nil {
+		return x.BufferSize
 	}
 	return 0
 }
 
-func (x *AddItemsResponse) GetCompletedBytes() int64 {
+func (x *SubscribeRequest) GetSinceSeq() uint64 {
 	if x != nil {
-		return x.CompletedBytes
+		return x.SinceSeq
 	}
 	return 0
 }
 
-type CreateFolderRequest struct {
+type TxlSubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// target path in bucket to add new empty folder
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// The bucket in which to add the folder
-	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Buckets              []string             `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	SlowSubscriberPolicy SlowSubscriberPolicy `protobuf:"varint,2,opt,name=slowSubscriberPolicy,proto3,enum=space.SlowSubscriberPolicy" json:"slowSubscriberPolicy,omitempty"`
+	BufferSize           int64                `protobuf:"varint,3,opt,name=bufferSize,proto3" json:"bufferSize,omitempty"`
 }
 
-func (x *CreateFolderRequest) Reset() {
-	*x = CreateFolderRequest{}
+func (x *TxlSubscribeRequest) Reset() {
+	*x = TxlSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2660,13 +3028,13 @@ func (x *CreateFolderRequest) Reset() {
 	}
 }
 
-func (x *CreateFolderRequest) String() string {
+func (x *TxlSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFolderRequest) ProtoMessage() {}
+func (*TxlSubscribeRequest) ProtoMessage() {}
 
-func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
+func (x *TxlSubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2678,34 +3046,46 @@ func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
-func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TxlSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*TxlSubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *CreateFolderRequest) GetPath() string {
+func (x *TxlSubscribeRequest) GetBuckets() []string {
 	if x != nil {
-		return x.Path
+		return x.Buckets
 	}
-	return ""
+	return nil
 }
 
-func (x *CreateFolderRequest) GetBucket() string {
+func (x *TxlSubscribeRequest) GetSlowSubscriberPolicy() SlowSubscriberPolicy {
 	if x != nil {
-		return x.Bucket
+		return x.SlowSubscriberPolicy
 	}
-	return ""
+	return SlowSubscriberPolicy_DROP_EVENTS
 }
 
-// not sure we need to return anything other than an error if we failed
-type CreateFolderResponse struct {
+func (x *TxlSubscribeRequest) GetBufferSize() int64 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+type NotificationSubscribeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Types                []NotificationType   `protobuf:"varint,1,rep,packed,name=types,proto3,enum=space.NotificationType" json:"types,omitempty"`
+	SlowSubscriberPolicy SlowSubscriberPolicy `protobuf:"varint,2,opt,name=slowSubscriberPolicy,proto3,enum=space.SlowSubscriberPolicy" json:"slowSubscriberPolicy,omitempty"`
+	BufferSize           int64                `protobuf:"varint,3,opt,name=bufferSize,proto3" json:"bufferSize,omitempty"`
+	// same as in SubscribeRequest
+	SinceSeq uint64 `protobuf:"varint,4,opt,name=sinceSeq,proto3" json:"sinceSeq,omitempty"`
 }
 
-func (x *CreateFolderResponse) Reset() {
-	*x = CreateFolderResponse{}
+func (x *NotificationSubscribeRequest) Reset() {
+	*x = NotificationSubscribeRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2713,13 +3093,13 @@ func (x *CreateFolderResponse) Reset() {
 	}
 }
 
-func (x *CreateFolderResponse) String() string {
+func (x *NotificationSubscribeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateFolderResponse) ProtoMessage() {}
+func (*NotificationSubscribeRequest) ProtoMessage() {}
 
-func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
+func (x *NotificationSubscribeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2731,23 +3111,56 @@ func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateFolderResponse.ProtoReflect.Descriptor instead.
-func (*CreateFolderResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use NotificationSubscribeRequest.ProtoReflect.Descriptor instead.
+func (*NotificationSubscribeRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{42}
 }
 
-type BackupKeysByPassphraseRequest struct {
+func (x *NotificationSubscribeRequest) GetTypes() []NotificationType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *NotificationSubscribeRequest) GetSlowSubscriberPolicy() SlowSubscriberPolicy {
+	if x != nil {
+		return x.SlowSubscriberPolicy
+	}
+	return SlowSubscriberPolicy_DROP_EVENTS
+}
+
+func (x *NotificationSubscribeRequest) GetBufferSize() int64 {
+	if x != nil {
+		return x.BufferSize
+	}
+	return 0
+}
+
+func (x *NotificationSubscribeRequest) GetSinceSeq() uint64 {
+	if x != nil {
+		return x.SinceSeq
+	}
+	return 0
+}
+
+type FileEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid       string        `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Passphrase string        `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	Type       KeyBackupType `protobuf:"varint,3,opt,name=type,proto3,enum=space.KeyBackupType" json:"type,omitempty"`
+	Type   EventType           `protobuf:"varint,1,opt,name=type,proto3,enum=space.EventType" json:"type,omitempty"`
+	Entry  *ListDirectoryEntry `protobuf:"bytes,2,opt,name=entry,proto3" json:"entry,omitempty"`
+	Bucket string              `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	DbId   string              `protobuf:"bytes,4,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	// increasing number of the event, used to resume the subscription
+	Seq uint64 `protobuf:"varint,5,opt,name=seq,proto3" json:"seq,omitempty"`
+	// set on an event without entry when resuming from sinceSeq missed events that are no longer logged
+	Truncated bool `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated,omitempty"`
 }
 
-func (x *BackupKeysByPassphraseRequest) Reset() {
-	*x = BackupKeysByPassphraseRequest{}
+func (x *FileEventResponse) Reset() {
+	*x = FileEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2755,13 +3168,13 @@ func (x *BackupKeysByPassphraseRequest) Reset() {
 	}
 }
 
-func (x *BackupKeysByPassphraseRequest) String() string {
+func (x *FileEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupKeysByPassphraseRequest) ProtoMessage() {}
+func (*FileEventResponse) ProtoMessage() {}
 
-func (x *BackupKeysByPassphraseRequest) ProtoReflect() protoreflect.Message {
+func (x *FileEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2773,40 +3186,63 @@ func (x *BackupKeysByPassphraseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackupKeysByPassphraseRequest.ProtoReflect.Descriptor instead.
-func (*BackupKeysByPassphraseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileEventResponse.ProtoReflect.Descriptor instead.
+func (*FileEventResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *BackupKeysByPassphraseRequest) GetUuid() string {
+func (x *FileEventResponse) GetType() EventType {
 	if x != nil {
-		return x.Uuid
+		return x.Type
+	}
+	return EventType_ENTRY_ADDED
+}
+
+func (x *FileEventResponse) GetEntry() *ListDirectoryEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *FileEventResponse) GetBucket() string {
+	if x != nil {
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *BackupKeysByPassphraseRequest) GetPassphrase() string {
+func (x *FileEventResponse) GetDbId() string {
 	if x != nil {
-		return x.Passphrase
+		return x.DbId
 	}
 	return ""
 }
 
-func (x *BackupKeysByPassphraseRequest) GetType() KeyBackupType {
+func (x *FileEventResponse) GetSeq() uint64 {
 	if x != nil {
-		return x.Type
+		return x.Seq
 	}
-	return KeyBackupType_PASSWORD
+	return 0
 }
 
-type BackupKeysByPassphraseResponse struct {
+func (x *FileEventResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type TextileEventResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *BackupKeysByPassphraseResponse) Reset() {
-	*x = BackupKeysByPassphraseResponse{}
+func (x *TextileEventResponse) Reset() {
+	*x = TextileEventResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2814,13 +3250,13 @@ func (x *BackupKeysByPassphraseResponse) Reset() {
 	}
 }
 
-func (x *BackupKeysByPassphraseResponse) String() string {
+func (x *TextileEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BackupKeysByPassphraseResponse) ProtoMessage() {}
+func (*TextileEventResponse) ProtoMessage() {}
 
-func (x *BackupKeysByPassphraseResponse) ProtoReflect() protoreflect.Message {
+func (x *TextileEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2832,23 +3268,30 @@ func (x *BackupKeysByPassphraseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BackupKeysByPassphraseResponse.ProtoReflect.Descriptor instead.
-func (*BackupKeysByPassphraseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TextileEventResponse.ProtoReflect.Descriptor instead.
+func (*TextileEventResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{44}
 }
 
-type RecoverKeysByPassphraseRequest struct {
+func (x *TextileEventResponse) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type OpenFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid       string        `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Passphrase string        `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
-	Type       KeyBackupType `protobuf:"varint,3,opt,name=type,proto3,enum=space.KeyBackupType" json:"type,omitempty"`
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	DbId   string `protobuf:"bytes,3,opt,name=dbId,proto3" json:"dbId,omitempty"` // optional field to specify shared with me file
 }
 
-func (x *RecoverKeysByPassphraseRequest) Reset() {
-	*x = RecoverKeysByPassphraseRequest{}
+func (x *OpenFileRequest) Reset() {
+	*x = OpenFileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2856,13 +3299,13 @@ func (x *RecoverKeysByPassphraseRequest) Reset() {
 	}
 }
 
-func (x *RecoverKeysByPassphraseRequest) String() string {
+func (x *OpenFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverKeysByPassphraseRequest) ProtoMessage() {}
+func (*OpenFileRequest) ProtoMessage() {}
 
-func (x *RecoverKeysByPassphraseRequest) ProtoReflect() protoreflect.Message {
+func (x *OpenFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2874,40 +3317,42 @@ func (x *RecoverKeysByPassphraseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverKeysByPassphraseRequest.ProtoReflect.Descriptor instead.
-func (*RecoverKeysByPassphraseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenFileRequest.ProtoReflect.Descriptor instead.
+func (*OpenFileRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RecoverKeysByPassphraseRequest) GetUuid() string {
+func (x *OpenFileRequest) GetPath() string {
 	if x != nil {
-		return x.Uuid
+		return x.Path
 	}
 	return ""
 }
 
-func (x *RecoverKeysByPassphraseRequest) GetPassphrase() string {
+func (x *OpenFileRequest) GetBucket() string {
 	if x != nil {
-		return x.Passphrase
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *RecoverKeysByPassphraseRequest) GetType() KeyBackupType {
+func (x *OpenFileRequest) GetDbId() string {
 	if x != nil {
-		return x.Type
+		return x.DbId
 	}
-	return KeyBackupType_PASSWORD
+	return ""
 }
 
-type RecoverKeysByPassphraseResponse struct {
+type OpenFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 }
 
-func (x *RecoverKeysByPassphraseResponse) Reset() {
-	*x = RecoverKeysByPassphraseResponse{}
+func (x *OpenFileResponse) Reset() {
+	*x = OpenFileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2915,13 +3360,13 @@ func (x *RecoverKeysByPassphraseResponse) Reset() {
 	}
 }
 
-func (x *RecoverKeysByPassphraseResponse) String() string {
+func (x *OpenFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverKeysByPassphraseResponse) ProtoMessage() {}
+func (*OpenFileResponse) ProtoMessage() {}
 
-func (x *RecoverKeysByPassphraseResponse) ProtoReflect() protoreflect.Message {
+func (x *OpenFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2933,22 +3378,33 @@ func (x *RecoverKeysByPassphraseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverKeysByPassphraseResponse.ProtoReflect.Descriptor instead.
-func (*RecoverKeysByPassphraseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenFileResponse.ProtoReflect.Descriptor instead.
+func (*OpenFileResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{46}
 }
 
-type TestKeysPassphraseRequest struct {
+func (x *OpenFileResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type OpenPublicFileRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Uuid       string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	FileCid  string `protobuf:"bytes,1,opt,name=fileCid,proto3" json:"fileCid,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Filename string `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	// optional full public link, used instead of fileCid.
+	// If the link embeds its key, password can be empty
+	Link string `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
 }
 
-func (x *TestKeysPassphraseRequest) Reset() {
-	*x = TestKeysPassphraseRequest{}
+func (x *OpenPublicFileRequest) Reset() {
+	*x = OpenPublicFileRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2956,13 +3412,13 @@ func (x *TestKeysPassphraseRequest) Reset() {
 	}
 }
 
-func (x *TestKeysPassphraseRequest) String() string {
+func (x *OpenPublicFileRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestKeysPassphraseRequest) ProtoMessage() {}
+func (*OpenPublicFileRequest) ProtoMessage() {}
 
-func (x *TestKeysPassphraseRequest) ProtoReflect() protoreflect.Message {
+func (x *OpenPublicFileRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2974,33 +3430,49 @@ func (x *TestKeysPassphraseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestKeysPassphraseRequest.ProtoReflect.Descriptor instead.
-func (*TestKeysPassphraseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenPublicFileRequest.ProtoReflect.Descriptor instead.
+func (*OpenPublicFileRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *TestKeysPassphraseRequest) GetUuid() string {
+func (x *OpenPublicFileRequest) GetFileCid() string {
 	if x != nil {
-		return x.Uuid
+		return x.FileCid
 	}
 	return ""
 }
 
-func (x *TestKeysPassphraseRequest) GetPassphrase() string {
+func (x *OpenPublicFileRequest) GetPassword() string {
 	if x != nil {
-		return x.Passphrase
+		return x.Password
 	}
 	return ""
 }
 
-type TestKeysPassphraseResponse struct {
+func (x *OpenPublicFileRequest) GetFilename() string {
+	if x != nil {
+		return x.Filename
+	}
+	return ""
+}
+
+func (x *OpenPublicFileRequest) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+type OpenPublicFileResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Location string `protobuf:"bytes,1,opt,name=location,proto3" json:"location,omitempty"`
 }
 
-func (x *TestKeysPassphraseResponse) Reset() {
-	*x = TestKeysPassphraseResponse{}
+func (x *OpenPublicFileResponse) Reset() {
+	*x = OpenPublicFileResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3008,13 +3480,13 @@ func (x *TestKeysPassphraseResponse) Reset() {
 	}
 }
 
-func (x *TestKeysPassphraseResponse) String() string {
+func (x *OpenPublicFileResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TestKeysPassphraseResponse) ProtoMessage() {}
+func (*OpenPublicFileResponse) ProtoMessage() {}
 
-func (x *TestKeysPassphraseResponse) ProtoReflect() protoreflect.Message {
+func (x *OpenPublicFileResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3026,22 +3498,33 @@ func (x *TestKeysPassphraseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TestKeysPassphraseResponse.ProtoReflect.Descriptor instead.
-func (*TestKeysPassphraseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use OpenPublicFileResponse.ProtoReflect.Descriptor instead.
+func (*OpenPublicFileResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{48}
 }
 
-type ThreadInfo struct {
+func (x *OpenPublicFileResponse) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+type AddItemsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	Key       string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// full paths to file or Folder on FS. Needs to be a location available to the daemon
+	SourcePaths []string `protobuf:"bytes,1,rep,name=sourcePaths,proto3" json:"sourcePaths,omitempty"`
+	// target path in bucket.
+	TargetPath string `protobuf:"bytes,2,opt,name=targetPath,proto3" json:"targetPath,omitempty"`
+	// The bucket in which to save the item
+	Bucket string `protobuf:"bytes,3,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *ThreadInfo) Reset() {
-	*x = ThreadInfo{}
+func (x *AddItemsRequest) Reset() {
+	*x = AddItemsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3049,13 +3532,13 @@ func (x *ThreadInfo) Reset() {
 	}
 }
 
-func (x *ThreadInfo) String() string {
+func (x *AddItemsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThreadInfo) ProtoMessage() {}
+func (*AddItemsRequest) ProtoMessage() {}
 
-func (x *ThreadInfo) ProtoReflect() protoreflect.Message {
+func (x *AddItemsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3067,35 +3550,44 @@ func (x *ThreadInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThreadInfo.ProtoReflect.Descriptor instead.
-func (*ThreadInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddItemsRequest.ProtoReflect.Descriptor instead.
+func (*AddItemsRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *ThreadInfo) GetAddresses() []string {
+func (x *AddItemsRequest) GetSourcePaths() []string {
 	if x != nil {
-		return x.Addresses
+		return x.SourcePaths
 	}
 	return nil
 }
 
-func (x *ThreadInfo) GetKey() string {
+func (x *AddItemsRequest) GetTargetPath() string {
 	if x != nil {
-		return x.Key
+		return x.TargetPath
 	}
 	return ""
 }
 
-type ShareBucketRequest struct {
+func (x *AddItemsRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type AddItemResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	SourcePath string `protobuf:"bytes,1,opt,name=sourcePath,proto3" json:"sourcePath,omitempty"`
+	BucketPath string `protobuf:"bytes,2,opt,name=bucketPath,proto3" json:"bucketPath,omitempty"`
+	Error      string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *ShareBucketRequest) Reset() {
-	*x = ShareBucketRequest{}
+func (x *AddItemResult) Reset() {
+	*x = AddItemResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3103,13 +3595,13 @@ func (x *ShareBucketRequest) Reset() {
 	}
 }
 
-func (x *ShareBucketRequest) String() string {
+func (x *AddItemResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareBucketRequest) ProtoMessage() {}
+func (*AddItemResult) ProtoMessage() {}
 
-func (x *ShareBucketRequest) ProtoReflect() protoreflect.Message {
+func (x *AddItemResult) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3121,28 +3613,46 @@ func (x *ShareBucketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareBucketRequest.ProtoReflect.Descriptor instead.
-func (*ShareBucketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddItemResult.ProtoReflect.Descriptor instead.
+func (*AddItemResult) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *ShareBucketRequest) GetBucket() string {
+func (x *AddItemResult) GetSourcePath() string {
 	if x != nil {
-		return x.Bucket
+		return x.SourcePath
 	}
 	return ""
 }
 
-type ShareBucketResponse struct {
+func (x *AddItemResult) GetBucketPath() string {
+	if x != nil {
+		return x.BucketPath
+	}
+	return ""
+}
+
+func (x *AddItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type AddItemsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Threadinfo *ThreadInfo `protobuf:"bytes,1,opt,name=threadinfo,proto3" json:"threadinfo,omitempty"`
+	Result         *AddItemResult `protobuf:"bytes,1,opt,name=result,proto3" json:"result,omitempty"`
+	TotalFiles     int64          `protobuf:"varint,2,opt,name=totalFiles,proto3" json:"totalFiles,omitempty"`
+	TotalBytes     int64          `protobuf:"varint,3,opt,name=totalBytes,proto3" json:"totalBytes,omitempty"`
+	CompletedFiles int64          `protobuf:"varint,4,opt,name=completedFiles,proto3" json:"completedFiles,omitempty"`
+	CompletedBytes int64          `protobuf:"varint,5,opt,name=completedBytes,proto3" json:"completedBytes,omitempty"`
 }
 
-func (x *ShareBucketResponse) Reset() {
-	*x = ShareBucketResponse{}
+func (x *AddItemsResponse) Reset() {
+	*x = AddItemsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3150,13 +3660,13 @@ func (x *ShareBucketResponse) Reset() {
 	}
 }
 
-func (x *ShareBucketResponse) String() string {
+func (x *AddItemsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareBucketResponse) ProtoMessage() {}
+func (*AddItemsResponse) ProtoMessage() {}
 
-func (x *ShareBucketResponse) ProtoReflect() protoreflect.Message {
+func (x *AddItemsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3168,29 +3678,59 @@ func (x *ShareBucketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareBucketResponse.ProtoReflect.Descriptor instead.
-func (*ShareBucketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddItemsResponse.ProtoReflect.Descriptor instead.
+func (*AddItemsResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *ShareBucketResponse) GetThreadinfo() *ThreadInfo {
+func (x *AddItemsResponse) GetResult() *AddItemResult {
 	if x != nil {
-		return x.Threadinfo
+		return x.Result
 	}
 	return nil
 }
 
-type JoinBucketRequest struct {
+func (x *AddItemsResponse) GetTotalFiles() int64 {
+	if x != nil {
+		return x.TotalFiles
+	}
+	return 0
+}
+
+func (x *AddItemsResponse) GetTotalBytes() int64 {
+	if x != nil {
+		return x.TotalBytes
+	}
+	return 0
+}
+
+func (x *AddItemsResponse) GetCompletedFiles() int64 {
+	if x != nil {
+		return x.CompletedFiles
+	}
+	return 0
+}
+
+func (x *AddItemsResponse) GetCompletedBytes() int64 {
+	if x != nil {
+		return x.CompletedBytes
+	}
+	return 0
+}
+
+type CreateFolderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Threadinfo *ThreadInfo `protobuf:"bytes,1,opt,name=threadinfo,proto3" json:"threadinfo,omitempty"`
-	Bucket     string      `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// target path in bucket to add new empty folder
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The bucket in which to add the folder
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *JoinBucketRequest) Reset() {
-	*x = JoinBucketRequest{}
+func (x *CreateFolderRequest) Reset() {
+	*x = CreateFolderRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[52]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3198,13 +3738,13 @@ func (x *JoinBucketRequest) Reset() {
 	}
 }
 
-func (x *JoinBucketRequest) String() string {
+func (x *CreateFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinBucketRequest) ProtoMessage() {}
+func (*CreateFolderRequest) ProtoMessage() {}
 
-func (x *JoinBucketRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateFolderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[52]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3216,35 +3756,34 @@ func (x *JoinBucketRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinBucketRequest.ProtoReflect.Descriptor instead.
-func (*JoinBucketRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFolderRequest.ProtoReflect.Descriptor instead.
+func (*CreateFolderRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *JoinBucketRequest) GetThreadinfo() *ThreadInfo {
+func (x *CreateFolderRequest) GetPath() string {
 	if x != nil {
-		return x.Threadinfo
+		return x.Path
 	}
-	return nil
+	return ""
 }
 
-func (x *JoinBucketRequest) GetBucket() string {
+func (x *CreateFolderRequest) GetBucket() string {
 	if x != nil {
 		return x.Bucket
 	}
 	return ""
 }
 
-type JoinBucketResponse struct {
+// not sure we need to return anything other than an error if we failed
+type CreateFolderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *JoinBucketResponse) Reset() {
-	*x = JoinBucketResponse{}
+func (x *CreateFolderResponse) Reset() {
+	*x = CreateFolderResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3252,13 +3791,13 @@ func (x *JoinBucketResponse) Reset() {
 	}
 }
 
-func (x *JoinBucketResponse) String() string {
+func (x *CreateFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinBucketResponse) ProtoMessage() {}
+func (*CreateFolderResponse) ProtoMessage() {}
 
-func (x *JoinBucketResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateFolderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3270,29 +3809,30 @@ func (x *JoinBucketResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinBucketResponse.ProtoReflect.Descriptor instead.
-func (*JoinBucketResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateFolderResponse.ProtoReflect.Descriptor instead.
+func (*CreateFolderResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *JoinBucketResponse) GetResult() bool {
-	if x != nil {
-		return x.Result
-	}
-	return false
-}
-
-type ShareFilesViaPublicKeyRequest struct {
+type FileConflict struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKeys []string    `protobuf:"bytes,1,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
-	Paths      []*FullPath `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	// path of the opened file
+	LocalPath  string `protobuf:"bytes,1,opt,name=localPath,proto3" json:"localPath,omitempty"`
+	Bucket     string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	BucketPath string `protobuf:"bytes,3,opt,name=bucketPath,proto3" json:"bucketPath,omitempty"`
+	DbId       string `protobuf:"bytes,4,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	// version the local file is based on
+	BaseCid string `protobuf:"bytes,5,opt,name=baseCid,proto3" json:"baseCid,omitempty"`
+	// version in the bucket when the conflict was detected
+	RemoteCid  string `protobuf:"bytes,6,opt,name=remoteCid,proto3" json:"remoteCid,omitempty"`
+	DetectedAt int64  `protobuf:"varint,7,opt,name=detectedAt,proto3" json:"detectedAt,omitempty"`
 }
 
-func (x *ShareFilesViaPublicKeyRequest) Reset() {
-	*x = ShareFilesViaPublicKeyRequest{}
+func (x *FileConflict) Reset() {
+	*x = FileConflict{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3300,13 +3840,13 @@ func (x *ShareFilesViaPublicKeyRequest) Reset() {
 	}
 }
 
-func (x *ShareFilesViaPublicKeyRequest) String() string {
+func (x *FileConflict) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareFilesViaPublicKeyRequest) ProtoMessage() {}
+func (*FileConflict) ProtoMessage() {}
 
-func (x *ShareFilesViaPublicKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *FileConflict) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3318,111 +3858,83 @@ func (x *ShareFilesViaPublicKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareFilesViaPublicKeyRequest.ProtoReflect.Descriptor instead.
-func (*ShareFilesViaPublicKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FileConflict.ProtoReflect.Descriptor instead.
+func (*FileConflict) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *ShareFilesViaPublicKeyRequest) GetPublicKeys() []string {
+func (x *FileConflict) GetLocalPath() string {
 	if x != nil {
-		return x.PublicKeys
+		return x.LocalPath
 	}
-	return nil
+	return ""
 }
 
-func (x *ShareFilesViaPublicKeyRequest) GetPaths() []*FullPath {
+func (x *FileConflict) GetBucket() string {
 	if x != nil {
-		return x.Paths
+		return x.Bucket
 	}
-	return nil
-}
-
-type FullPath struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	DbId   string `protobuf:"bytes,1,opt,name=dbId,proto3" json:"dbId,omitempty"` // optional field to specify shared with me file
-	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	return ""
 }
 
-func (x *FullPath) Reset() {
-	*x = FullPath{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_space_proto_msgTypes[55]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FileConflict) GetBucketPath() string {
+	if x != nil {
+		return x.BucketPath
 	}
+	return ""
 }
 
-func (x *FullPath) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*FullPath) ProtoMessage() {}
-
-func (x *FullPath) ProtoReflect() protoreflect.Message {
-	mi := &file_space_proto_msgTypes[55]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FileConflict) GetDbId() string {
+	if x != nil {
+		return x.DbId
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use FullPath.ProtoReflect.Descriptor instead.
-func (*FullPath) Descriptor() ([]byte, []int) {
-	return file_space_proto_rawDescGZIP(), []int{55}
+	return ""
 }
 
-func (x *FullPath) GetDbId() string {
+func (x *FileConflict) GetBaseCid() string {
 	if x != nil {
-		return x.DbId
+		return x.BaseCid
 	}
 	return ""
 }
 
-func (x *FullPath) GetBucket() string {
+func (x *FileConflict) GetRemoteCid() string {
 	if x != nil {
-		return x.Bucket
+		return x.RemoteCid
 	}
 	return ""
 }
 
-func (x *FullPath) GetPath() string {
+func (x *FileConflict) GetDetectedAt() int64 {
 	if x != nil {
-		return x.Path
+		return x.DetectedAt
 	}
-	return ""
+	return 0
 }
 
-type ShareFilesViaPublicKeyResponse struct {
+type ListFileConflictsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *ShareFilesViaPublicKeyResponse) Reset() {
-	*x = ShareFilesViaPublicKeyResponse{}
+func (x *ListFileConflictsRequest) Reset() {
+	*x = ListFileConflictsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_space_proto_msgTypes[56]
+		mi := &file_space_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ShareFilesViaPublicKeyResponse) String() string {
+func (x *ListFileConflictsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareFilesViaPublicKeyResponse) ProtoMessage() {}
+func (*ListFileConflictsRequest) ProtoMessage() {}
 
-func (x *ShareFilesViaPublicKeyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_space_proto_msgTypes[56]
+func (x *ListFileConflictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3433,22 +3945,69 @@ func (x *ShareFilesViaPublicKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareFilesViaPublicKeyResponse.ProtoReflect.Descriptor instead.
-func (*ShareFilesViaPublicKeyResponse) Descriptor() ([]byte, []int) {
-	return file_space_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use ListFileConflictsRequest.ProtoReflect.Descriptor instead.
+func (*ListFileConflictsRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{55}
 }
 
-type UnshareFilesViaPublicKeyRequest struct {
+type ListFileConflictsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PublicKeys []string    `protobuf:"bytes,1,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
-	Paths      []*FullPath `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Conflicts []*FileConflict `protobuf:"bytes,1,rep,name=conflicts,proto3" json:"conflicts,omitempty"`
 }
 
-func (x *UnshareFilesViaPublicKeyRequest) Reset() {
-	*x = UnshareFilesViaPublicKeyRequest{}
+func (x *ListFileConflictsResponse) Reset() {
+	*x = ListFileConflictsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFileConflictsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFileConflictsResponse) ProtoMessage() {}
+
+func (x *ListFileConflictsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFileConflictsResponse.ProtoReflect.Descriptor instead.
+func (*ListFileConflictsResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListFileConflictsResponse) GetConflicts() []*FileConflict {
+	if x != nil {
+		return x.Conflicts
+	}
+	return nil
+}
+
+type ResolveFileConflictRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LocalPath  string                 `protobuf:"bytes,1,opt,name=localPath,proto3" json:"localPath,omitempty"`
+	Resolution FileConflictResolution `protobuf:"varint,2,opt,name=resolution,proto3,enum=space.FileConflictResolution" json:"resolution,omitempty"`
+}
+
+func (x *ResolveFileConflictRequest) Reset() {
+	*x = ResolveFileConflictRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3456,13 +4015,13 @@ func (x *UnshareFilesViaPublicKeyRequest) Reset() {
 	}
 }
 
-func (x *UnshareFilesViaPublicKeyRequest) String() string {
+func (x *ResolveFileConflictRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnshareFilesViaPublicKeyRequest) ProtoMessage() {}
+func (*ResolveFileConflictRequest) ProtoMessage() {}
 
-func (x *UnshareFilesViaPublicKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *ResolveFileConflictRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3474,33 +4033,33 @@ func (x *UnshareFilesViaPublicKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnshareFilesViaPublicKeyRequest.ProtoReflect.Descriptor instead.
-func (*UnshareFilesViaPublicKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveFileConflictRequest.ProtoReflect.Descriptor instead.
+func (*ResolveFileConflictRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *UnshareFilesViaPublicKeyRequest) GetPublicKeys() []string {
+func (x *ResolveFileConflictRequest) GetLocalPath() string {
 	if x != nil {
-		return x.PublicKeys
+		return x.LocalPath
 	}
-	return nil
+	return ""
 }
 
-func (x *UnshareFilesViaPublicKeyRequest) GetPaths() []*FullPath {
+func (x *ResolveFileConflictRequest) GetResolution() FileConflictResolution {
 	if x != nil {
-		return x.Paths
+		return x.Resolution
 	}
-	return nil
+	return FileConflictResolution_FILE_CONFLICT_RESOLUTION_UNSPECIFIED
 }
 
-type UnshareFilesViaPublicKeyResponse struct {
+type ResolveFileConflictResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *UnshareFilesViaPublicKeyResponse) Reset() {
-	*x = UnshareFilesViaPublicKeyResponse{}
+func (x *ResolveFileConflictResponse) Reset() {
+	*x = ResolveFileConflictResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3508,13 +4067,13 @@ func (x *UnshareFilesViaPublicKeyResponse) Reset() {
 	}
 }
 
-func (x *UnshareFilesViaPublicKeyResponse) String() string {
+func (x *ResolveFileConflictResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnshareFilesViaPublicKeyResponse) ProtoMessage() {}
+func (*ResolveFileConflictResponse) ProtoMessage() {}
 
-func (x *UnshareFilesViaPublicKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *ResolveFileConflictResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3526,26 +4085,26 @@ func (x *UnshareFilesViaPublicKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnshareFilesViaPublicKeyResponse.ProtoReflect.Descriptor instead.
-func (*UnshareFilesViaPublicKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ResolveFileConflictResponse.ProtoReflect.Descriptor instead.
+func (*ResolveFileConflictResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{58}
 }
 
-type GeneratePublicFileLinkRequest struct {
+type AddSyncFolderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Bucket    string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
-	ItemPaths []string `protobuf:"bytes,2,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
-	Password  string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
-	// optional field to specify db id
-	// for shared with me files
-	DbId string `protobuf:"bytes,4,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	// local folder to sync, created if it does not exist
+	LocalPath string `protobuf:"bytes,1,opt,name=localPath,proto3" json:"localPath,omitempty"`
+	// the bucket to sync with, the default bucket if empty
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	// path in the bucket the folder is synced with, the root if empty
+	BucketPath string `protobuf:"bytes,3,opt,name=bucketPath,proto3" json:"bucketPath,omitempty"`
 }
 
-func (x *GeneratePublicFileLinkRequest) Reset() {
-	*x = GeneratePublicFileLinkRequest{}
+func (x *AddSyncFolderRequest) Reset() {
+	*x = AddSyncFolderRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3553,13 +4112,13 @@ func (x *GeneratePublicFileLinkRequest) Reset() {
 	}
 }
 
-func (x *GeneratePublicFileLinkRequest) String() string {
+func (x *AddSyncFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePublicFileLinkRequest) ProtoMessage() {}
+func (*AddSyncFolderRequest) ProtoMessage() {}
 
-func (x *GeneratePublicFileLinkRequest) ProtoReflect() protoreflect.Message {
+func (x *AddSyncFolderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3571,50 +4130,40 @@ func (x *GeneratePublicFileLinkRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratePublicFileLinkRequest.ProtoReflect.Descriptor instead.
-func (*GeneratePublicFileLinkRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSyncFolderRequest.ProtoReflect.Descriptor instead.
+func (*AddSyncFolderRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *GeneratePublicFileLinkRequest) GetBucket() string {
+func (x *AddSyncFolderRequest) GetLocalPath() string {
 	if x != nil {
-		return x.Bucket
+		return x.LocalPath
 	}
 	return ""
 }
 
-func (x *GeneratePublicFileLinkRequest) GetItemPaths() []string {
-	if x != nil {
-		return x.ItemPaths
-	}
-	return nil
-}
-
-func (x *GeneratePublicFileLinkRequest) GetPassword() string {
+func (x *AddSyncFolderRequest) GetBucket() string {
 	if x != nil {
-		return x.Password
+		return x.Bucket
 	}
 	return ""
 }
 
-func (x *GeneratePublicFileLinkRequest) GetDbId() string {
+func (x *AddSyncFolderRequest) GetBucketPath() string {
 	if x != nil {
-		return x.DbId
+		return x.BucketPath
 	}
 	return ""
 }
 
-type GeneratePublicFileLinkResponse struct {
+type AddSyncFolderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Link    string `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
-	FileCid string `protobuf:"bytes,2,opt,name=fileCid,proto3" json:"fileCid,omitempty"`
 }
 
-func (x *GeneratePublicFileLinkResponse) Reset() {
-	*x = GeneratePublicFileLinkResponse{}
+func (x *AddSyncFolderResponse) Reset() {
+	*x = AddSyncFolderResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3622,13 +4171,13 @@ func (x *GeneratePublicFileLinkResponse) Reset() {
 	}
 }
 
-func (x *GeneratePublicFileLinkResponse) String() string {
+func (x *AddSyncFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratePublicFileLinkResponse) ProtoMessage() {}
+func (*AddSyncFolderResponse) ProtoMessage() {}
 
-func (x *GeneratePublicFileLinkResponse) ProtoReflect() protoreflect.Message {
+func (x *AddSyncFolderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3640,35 +4189,21 @@ func (x *GeneratePublicFileLinkResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratePublicFileLinkResponse.ProtoReflect.Descriptor instead.
-func (*GeneratePublicFileLinkResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddSyncFolderResponse.ProtoReflect.Descriptor instead.
+func (*AddSyncFolderResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GeneratePublicFileLinkResponse) GetLink() string {
-	if x != nil {
-		return x.Link
-	}
-	return ""
-}
-
-func (x *GeneratePublicFileLinkResponse) GetFileCid() string {
-	if x != nil {
-		return x.FileCid
-	}
-	return ""
-}
-
-type ToggleFuseRequest struct {
+type RemoveSyncFolderRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MountDrive bool `protobuf:"varint,1,opt,name=mountDrive,proto3" json:"mountDrive,omitempty"`
+	LocalPath string `protobuf:"bytes,1,opt,name=localPath,proto3" json:"localPath,omitempty"`
 }
 
-func (x *ToggleFuseRequest) Reset() {
-	*x = ToggleFuseRequest{}
+func (x *RemoveSyncFolderRequest) Reset() {
+	*x = RemoveSyncFolderRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3676,13 +4211,13 @@ func (x *ToggleFuseRequest) Reset() {
 	}
 }
 
-func (x *ToggleFuseRequest) String() string {
+func (x *RemoveSyncFolderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ToggleFuseRequest) ProtoMessage() {}
+func (*RemoveSyncFolderRequest) ProtoMessage() {}
 
-func (x *ToggleFuseRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveSyncFolderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3694,29 +4229,26 @@ func (x *ToggleFuseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ToggleFuseRequest.ProtoReflect.Descriptor instead.
-func (*ToggleFuseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSyncFolderRequest.ProtoReflect.Descriptor instead.
+func (*RemoveSyncFolderRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *ToggleFuseRequest) GetMountDrive() bool {
+func (x *RemoveSyncFolderRequest) GetLocalPath() string {
 	if x != nil {
-		return x.MountDrive
+		return x.LocalPath
 	}
-	return false
+	return ""
 }
 
-type FuseDriveResponse struct {
+type RemoveSyncFolderResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	State     FuseState `protobuf:"varint,1,opt,name=state,proto3,enum=space.FuseState" json:"state,omitempty"`
-	MountPath string    `protobuf:"bytes,2,opt,name=mountPath,proto3" json:"mountPath,omitempty"`
 }
 
-func (x *FuseDriveResponse) Reset() {
-	*x = FuseDriveResponse{}
+func (x *RemoveSyncFolderResponse) Reset() {
+	*x = RemoveSyncFolderResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3724,13 +4256,13 @@ func (x *FuseDriveResponse) Reset() {
 	}
 }
 
-func (x *FuseDriveResponse) String() string {
+func (x *RemoveSyncFolderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FuseDriveResponse) ProtoMessage() {}
+func (*RemoveSyncFolderResponse) ProtoMessage() {}
 
-func (x *FuseDriveResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveSyncFolderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3742,33 +4274,23 @@ func (x *FuseDriveResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FuseDriveResponse.ProtoReflect.Descriptor instead.
-func (*FuseDriveResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveSyncFolderResponse.ProtoReflect.Descriptor instead.
+func (*RemoveSyncFolderResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *FuseDriveResponse) GetState() FuseState {
-	if x != nil {
-		return x.State
-	}
-	return FuseState_UNSUPPORTED
-}
-
-func (x *FuseDriveResponse) GetMountPath() string {
-	if x != nil {
-		return x.MountPath
-	}
-	return ""
-}
-
-type ListBucketsRequest struct {
+type SyncFolder struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LocalPath  string `protobuf:"bytes,1,opt,name=localPath,proto3" json:"localPath,omitempty"`
+	Bucket     string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	BucketPath string `protobuf:"bytes,3,opt,name=bucketPath,proto3" json:"bucketPath,omitempty"`
 }
 
-func (x *ListBucketsRequest) Reset() {
-	*x = ListBucketsRequest{}
+func (x *SyncFolder) Reset() {
+	*x = SyncFolder{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3776,13 +4298,13 @@ func (x *ListBucketsRequest) Reset() {
 	}
 }
 
-func (x *ListBucketsRequest) String() string {
+func (x *SyncFolder) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsRequest) ProtoMessage() {}
+func (*SyncFolder) ProtoMessage() {}
 
-func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
+func (x *SyncFolder) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3794,21 +4316,40 @@ func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
-func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SyncFolder.ProtoReflect.Descriptor instead.
+func (*SyncFolder) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{63}
 }
 
-type ListBucketsResponse struct {
+func (x *SyncFolder) GetLocalPath() string {
+	if x != nil {
+		return x.LocalPath
+	}
+	return ""
+}
+
+func (x *SyncFolder) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *SyncFolder) GetBucketPath() string {
+	if x != nil {
+		return x.BucketPath
+	}
+	return ""
+}
+
+type ListSyncFoldersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Buckets []*Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
 }
 
-func (x *ListBucketsResponse) Reset() {
-	*x = ListBucketsResponse{}
+func (x *ListSyncFoldersRequest) Reset() {
+	*x = ListSyncFoldersRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3816,13 +4357,13 @@ func (x *ListBucketsResponse) Reset() {
 	}
 }
 
-func (x *ListBucketsResponse) String() string {
+func (x *ListSyncFoldersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListBucketsResponse) ProtoMessage() {}
+func (*ListSyncFoldersRequest) ProtoMessage() {}
 
-func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
+func (x *ListSyncFoldersRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3834,31 +4375,21 @@ func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
-func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSyncFoldersRequest.ProtoReflect.Descriptor instead.
+func (*ListSyncFoldersRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *ListBucketsResponse) GetBuckets() []*Bucket {
-	if x != nil {
-		return x.Buckets
-	}
-	return nil
-}
-
-type Invitation struct {
+type ListSyncFoldersResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviterPublicKey string           `protobuf:"bytes,1,opt,name=inviterPublicKey,proto3" json:"inviterPublicKey,omitempty"`
-	InvitationID     string           `protobuf:"bytes,2,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
-	Status           InvitationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=space.InvitationStatus" json:"status,omitempty"`
-	ItemPaths        []*FullPath      `protobuf:"bytes,5,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
+	Folders []*SyncFolder `protobuf:"bytes,1,rep,name=folders,proto3" json:"folders,omitempty"`
 }
 
-func (x *Invitation) Reset() {
-	*x = Invitation{}
+func (x *ListSyncFoldersResponse) Reset() {
+	*x = ListSyncFoldersResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3866,13 +4397,13 @@ func (x *Invitation) Reset() {
 	}
 }
 
-func (x *Invitation) String() string {
+func (x *ListSyncFoldersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Invitation) ProtoMessage() {}
+func (*ListSyncFoldersResponse) ProtoMessage() {}
 
-func (x *Invitation) ProtoReflect() protoreflect.Message {
+func (x *ListSyncFoldersResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3884,51 +4415,30 @@ func (x *Invitation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
-func (*Invitation) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListSyncFoldersResponse.ProtoReflect.Descriptor instead.
+func (*ListSyncFoldersResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *Invitation) GetInviterPublicKey() string {
-	if x != nil {
-		return x.InviterPublicKey
-	}
-	return ""
-}
-
-func (x *Invitation) GetInvitationID() string {
-	if x != nil {
-		return x.InvitationID
-	}
-	return ""
-}
-
-func (x *Invitation) GetStatus() InvitationStatus {
-	if x != nil {
-		return x.Status
-	}
-	return InvitationStatus_PENDING
-}
-
-func (x *Invitation) GetItemPaths() []*FullPath {
+func (x *ListSyncFoldersResponse) GetFolders() []*SyncFolder {
 	if x != nil {
-		return x.ItemPaths
+		return x.Folders
 	}
 	return nil
 }
 
-type UsageAlert struct {
+type BackupKeysByPassphraseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Used    int64  `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
-	Limit   int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Uuid       string        `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Passphrase string        `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Type       KeyBackupType `protobuf:"varint,3,opt,name=type,proto3,enum=space.KeyBackupType" json:"type,omitempty"`
 }
 
-func (x *UsageAlert) Reset() {
-	*x = UsageAlert{}
+func (x *BackupKeysByPassphraseRequest) Reset() {
+	*x = BackupKeysByPassphraseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3936,13 +4446,13 @@ func (x *UsageAlert) Reset() {
 	}
 }
 
-func (x *UsageAlert) String() string {
+func (x *BackupKeysByPassphraseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UsageAlert) ProtoMessage() {}
+func (*BackupKeysByPassphraseRequest) ProtoMessage() {}
 
-func (x *UsageAlert) ProtoReflect() protoreflect.Message {
+func (x *BackupKeysByPassphraseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3954,42 +4464,40 @@ func (x *UsageAlert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UsageAlert.ProtoReflect.Descriptor instead.
-func (*UsageAlert) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackupKeysByPassphraseRequest.ProtoReflect.Descriptor instead.
+func (*BackupKeysByPassphraseRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *UsageAlert) GetUsed() int64 {
+func (x *BackupKeysByPassphraseRequest) GetUuid() string {
 	if x != nil {
-		return x.Used
+		return x.Uuid
 	}
-	return 0
+	return ""
 }
 
-func (x *UsageAlert) GetLimit() int64 {
+func (x *BackupKeysByPassphraseRequest) GetPassphrase() string {
 	if x != nil {
-		return x.Limit
+		return x.Passphrase
 	}
-	return 0
+	return ""
 }
 
-func (x *UsageAlert) GetMessage() string {
+func (x *BackupKeysByPassphraseRequest) GetType() KeyBackupType {
 	if x != nil {
-		return x.Message
+		return x.Type
 	}
-	return ""
+	return KeyBackupType_PASSWORD
 }
 
-type InvitationAccept struct {
+type BackupKeysByPassphraseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	InvitationID string `protobuf:"bytes,2,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
 }
 
-func (x *InvitationAccept) Reset() {
-	*x = InvitationAccept{}
+func (x *BackupKeysByPassphraseResponse) Reset() {
+	*x = BackupKeysByPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3997,13 +4505,13 @@ func (x *InvitationAccept) Reset() {
 	}
 }
 
-func (x *InvitationAccept) String() string {
+func (x *BackupKeysByPassphraseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvitationAccept) ProtoMessage() {}
+func (*BackupKeysByPassphraseResponse) ProtoMessage() {}
 
-func (x *InvitationAccept) ProtoReflect() protoreflect.Message {
+func (x *BackupKeysByPassphraseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4015,29 +4523,24 @@ func (x *InvitationAccept) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvitationAccept.ProtoReflect.Descriptor instead.
-func (*InvitationAccept) Descriptor() ([]byte, []int) {
+// Deprecated: Use BackupKeysByPassphraseResponse.ProtoReflect.Descriptor instead.
+func (*BackupKeysByPassphraseResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *InvitationAccept) GetInvitationID() string {
-	if x != nil {
-		return x.InvitationID
-	}
-	return ""
-}
-
-type RevokedInvitation struct {
+type ChangeBackupPassphraseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InviterPublicKey string      `protobuf:"bytes,1,opt,name=inviterPublicKey,proto3" json:"inviterPublicKey,omitempty"`
-	ItemPaths        []*FullPath `protobuf:"bytes,5,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
+	Uuid          string        `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	OldPassphrase string        `protobuf:"bytes,2,opt,name=oldPassphrase,proto3" json:"oldPassphrase,omitempty"`
+	NewPassphrase string        `protobuf:"bytes,3,opt,name=newPassphrase,proto3" json:"newPassphrase,omitempty"`
+	Type          KeyBackupType `protobuf:"varint,4,opt,name=type,proto3,enum=space.KeyBackupType" json:"type,omitempty"`
 }
 
-func (x *RevokedInvitation) Reset() {
-	*x = RevokedInvitation{}
+func (x *ChangeBackupPassphraseRequest) Reset() {
+	*x = ChangeBackupPassphraseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4045,13 +4548,13 @@ func (x *RevokedInvitation) Reset() {
 	}
 }
 
-func (x *RevokedInvitation) String() string {
+func (x *ChangeBackupPassphraseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RevokedInvitation) ProtoMessage() {}
+func (*ChangeBackupPassphraseRequest) ProtoMessage() {}
 
-func (x *RevokedInvitation) ProtoReflect() protoreflect.Message {
+func (x *ChangeBackupPassphraseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4063,46 +4566,47 @@ func (x *RevokedInvitation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RevokedInvitation.ProtoReflect.Descriptor instead.
-func (*RevokedInvitation) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeBackupPassphraseRequest.ProtoReflect.Descriptor instead.
+func (*ChangeBackupPassphraseRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *RevokedInvitation) GetInviterPublicKey() string {
+func (x *ChangeBackupPassphraseRequest) GetUuid() string {
 	if x != nil {
-		return x.InviterPublicKey
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *RevokedInvitation) GetItemPaths() []*FullPath {
+func (x *ChangeBackupPassphraseRequest) GetOldPassphrase() string {
 	if x != nil {
-		return x.ItemPaths
+		return x.OldPassphrase
 	}
-	return nil
+	return ""
 }
 
-type Notification struct {
+func (x *ChangeBackupPassphraseRequest) GetNewPassphrase() string {
+	if x != nil {
+		return x.NewPassphrase
+	}
+	return ""
+}
+
+func (x *ChangeBackupPassphraseRequest) GetType() KeyBackupType {
+	if x != nil {
+		return x.Type
+	}
+	return KeyBackupType_PASSWORD
+}
+
+type ChangeBackupPassphraseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"` // underlying message id from textile
-	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
-	Body    string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
-	// Types that are assignable to RelatedObject:
-	//	*Notification_InvitationValue
-	//	*Notification_UsageAlert
-	//	*Notification_InvitationAccept
-	//	*Notification_RevokedInvitation
-	RelatedObject isNotification_RelatedObject `protobuf_oneof:"relatedObject"`
-	Type          NotificationType             `protobuf:"varint,8,opt,name=type,proto3,enum=space.NotificationType" json:"type,omitempty"`
-	CreatedAt     int64                        `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	ReadAt        int64                        `protobuf:"varint,10,opt,name=readAt,proto3" json:"readAt,omitempty"`
 }
 
-func (x *Notification) Reset() {
-	*x = Notification{}
+func (x *ChangeBackupPassphraseResponse) Reset() {
+	*x = ChangeBackupPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4110,13 +4614,13 @@ func (x *Notification) Reset() {
 	}
 }
 
-func (x *Notification) String() string {
+func (x *ChangeBackupPassphraseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Notification) ProtoMessage() {}
+func (*ChangeBackupPassphraseResponse) ProtoMessage() {}
 
-func (x *Notification) ProtoReflect() protoreflect.Message {
+func (x *ChangeBackupPassphraseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4128,127 +4632,23 @@ func (x *Notification) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
-func (*Notification) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeBackupPassphraseResponse.ProtoReflect.Descriptor instead.
+func (*ChangeBackupPassphraseResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *Notification) GetID() string {
-	if x != nil {
-		return x.ID
-	}
-	return ""
-}
-
-func (x *Notification) GetSubject() string {
-	if x != nil {
-		return x.Subject
-	}
-	return ""
-}
-
-func (x *Notification) GetBody() string {
-	if x != nil {
-		return x.Body
-	}
-	return ""
-}
-
-func (m *Notification) GetRelatedObject() isNotification_RelatedObject {
-	if m != nil {
-		return m.RelatedObject
-	}
-	return nil
-}
-
-func (x *Notification) GetInvitationValue() *Invitation {
-	if x, ok := x.GetRelatedObject().(*Notification_InvitationValue); ok {
-		return x.InvitationValue
-	}
-	return nil
-}
-
-func (x *Notification) GetUsageAlert() *UsageAlert {
-	if x, ok := x.GetRelatedObject().(*Notification_UsageAlert); ok {
-		return x.UsageAlert
-	}
-	return nil
-}
-
-func (x *Notification) GetInvitationAccept() *InvitationAccept {
-	if x, ok := x.GetRelatedObject().(*Notification_InvitationAccept); ok {
-		return x.InvitationAccept
-	}
-	return nil
-}
-
-func (x *Notification) GetRevokedInvitation() *RevokedInvitation {
-	if x, ok := x.GetRelatedObject().(*Notification_RevokedInvitation); ok {
-		return x.RevokedInvitation
-	}
-	return nil
-}
-
-func (x *Notification) GetType() NotificationType {
-	if x != nil {
-		return x.Type
-	}
-	return NotificationType_UNKNOWN
-}
-
-func (x *Notification) GetCreatedAt() int64 {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return 0
-}
-
-func (x *Notification) GetReadAt() int64 {
-	if x != nil {
-		return x.ReadAt
-	}
-	return 0
-}
-
-type isNotification_RelatedObject interface {
-	isNotification_RelatedObject()
-}
-
-type Notification_InvitationValue struct {
-	InvitationValue *Invitation `protobuf:"bytes,4,opt,name=invitationValue,proto3,oneof"`
-}
-
-type Notification_UsageAlert struct {
-	UsageAlert *UsageAlert `protobuf:"bytes,5,opt,name=usageAlert,proto3,oneof"`
-}
-
-type Notification_InvitationAccept struct {
-	InvitationAccept *InvitationAccept `protobuf:"bytes,6,opt,name=invitationAccept,proto3,oneof"`
-}
-
-type Notification_RevokedInvitation struct {
-	RevokedInvitation *RevokedInvitation `protobuf:"bytes,7,opt,name=revokedInvitation,proto3,oneof"`
-}
-
-func (*Notification_InvitationValue) isNotification_RelatedObject() {}
-
-func (*Notification_UsageAlert) isNotification_RelatedObject() {}
-
-func (*Notification_InvitationAccept) isNotification_RelatedObject() {}
-
-func (*Notification_RevokedInvitation) isNotification_RelatedObject() {}
-
-type HandleFilesInvitationRequest struct {
+type RecoverKeysByPassphraseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	InvitationID string `protobuf:"bytes,1,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
-	Accept       bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+	Uuid       string        `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Passphrase string        `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Type       KeyBackupType `protobuf:"varint,3,opt,name=type,proto3,enum=space.KeyBackupType" json:"type,omitempty"`
 }
 
-func (x *HandleFilesInvitationRequest) Reset() {
-	*x = HandleFilesInvitationRequest{}
+func (x *RecoverKeysByPassphraseRequest) Reset() {
+	*x = RecoverKeysByPassphraseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4256,13 +4656,13 @@ func (x *HandleFilesInvitationRequest) Reset() {
 	}
 }
 
-func (x *HandleFilesInvitationRequest) String() string {
+func (x *RecoverKeysByPassphraseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleFilesInvitationRequest) ProtoMessage() {}
+func (*RecoverKeysByPassphraseRequest) ProtoMessage() {}
 
-func (x *HandleFilesInvitationRequest) ProtoReflect() protoreflect.Message {
+func (x *RecoverKeysByPassphraseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4274,33 +4674,40 @@ func (x *HandleFilesInvitationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleFilesInvitationRequest.ProtoReflect.Descriptor instead.
-func (*HandleFilesInvitationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoverKeysByPassphraseRequest.ProtoReflect.Descriptor instead.
+func (*RecoverKeysByPassphraseRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *HandleFilesInvitationRequest) GetInvitationID() string {
+func (x *RecoverKeysByPassphraseRequest) GetUuid() string {
 	if x != nil {
-		return x.InvitationID
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *HandleFilesInvitationRequest) GetAccept() bool {
+func (x *RecoverKeysByPassphraseRequest) GetPassphrase() string {
 	if x != nil {
-		return x.Accept
+		return x.Passphrase
 	}
-	return false
+	return ""
 }
 
-type HandleFilesInvitationResponse struct {
+func (x *RecoverKeysByPassphraseRequest) GetType() KeyBackupType {
+	if x != nil {
+		return x.Type
+	}
+	return KeyBackupType_PASSWORD
+}
+
+type RecoverKeysByPassphraseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *HandleFilesInvitationResponse) Reset() {
-	*x = HandleFilesInvitationResponse{}
+func (x *RecoverKeysByPassphraseResponse) Reset() {
+	*x = RecoverKeysByPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4308,13 +4715,13 @@ func (x *HandleFilesInvitationResponse) Reset() {
 	}
 }
 
-func (x *HandleFilesInvitationResponse) String() string {
+func (x *RecoverKeysByPassphraseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HandleFilesInvitationResponse) ProtoMessage() {}
+func (*RecoverKeysByPassphraseResponse) ProtoMessage() {}
 
-func (x *HandleFilesInvitationResponse) ProtoReflect() protoreflect.Message {
+func (x *RecoverKeysByPassphraseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4326,35 +4733,36 @@ func (x *HandleFilesInvitationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HandleFilesInvitationResponse.ProtoReflect.Descriptor instead.
-func (*HandleFilesInvitationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecoverKeysByPassphraseResponse.ProtoReflect.Descriptor instead.
+func (*RecoverKeysByPassphraseResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{71}
 }
 
-type NotificationEventResponse struct {
+type TestKeysPassphraseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Notification *Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	Uuid       string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
 }
 
-func (x *NotificationEventResponse) Reset() {
-	*x = NotificationEventResponse{}
-	if protoimpl.UnsafeEnabled {
+func (x *TestKeysPassphraseRequest) Reset() {
+	*x = TestKeysPassphraseRequest{}
+	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotificationEventResponse) String() string {
+func (x *TestKeysPassphraseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotificationEventResponse) ProtoMessage() {}
+func (*TestKeysPassphraseRequest) ProtoMessage() {}
 
-func (x *NotificationEventResponse) ProtoReflect() protoreflect.Message {
+func (x *TestKeysPassphraseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4366,29 +4774,33 @@ func (x *NotificationEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotificationEventResponse.ProtoReflect.Descriptor instead.
-func (*NotificationEventResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestKeysPassphraseRequest.ProtoReflect.Descriptor instead.
+func (*TestKeysPassphraseRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *NotificationEventResponse) GetNotification() *Notification {
+func (x *TestKeysPassphraseRequest) GetUuid() string {
 	if x != nil {
-		return x.Notification
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-type GetNotificationsRequest struct {
+func (x *TestKeysPassphraseRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type TestKeysPassphraseResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Seek  string `protobuf:"bytes,1,opt,name=seek,proto3" json:"seek,omitempty"`
-	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetNotificationsRequest) Reset() {
-	*x = GetNotificationsRequest{}
+func (x *TestKeysPassphraseResponse) Reset() {
+	*x = TestKeysPassphraseResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4396,13 +4808,13 @@ func (x *GetNotificationsRequest) Reset() {
 	}
 }
 
-func (x *GetNotificationsRequest) String() string {
+func (x *TestKeysPassphraseResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationsRequest) ProtoMessage() {}
+func (*TestKeysPassphraseResponse) ProtoMessage() {}
 
-func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+func (x *TestKeysPassphraseResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4414,37 +4826,22 @@ func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
-func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TestKeysPassphraseResponse.ProtoReflect.Descriptor instead.
+func (*TestKeysPassphraseResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetNotificationsRequest) GetSeek() string {
-	if x != nil {
-		return x.Seek
-	}
-	return ""
-}
-
-func (x *GetNotificationsRequest) GetLimit() int64 {
-	if x != nil {
-		return x.Limit
-	}
-	return 0
-}
-
-type GetNotificationsResponse struct {
+type ThreadInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
-	NextOffset    string          `protobuf:"bytes,2,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
-	LastSeenAt    int64           `protobuf:"varint,3,opt,name=lastSeenAt,proto3" json:"lastSeenAt,omitempty"`
+	Addresses []string `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Key       string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
 }
 
-func (x *GetNotificationsResponse) Reset() {
-	*x = GetNotificationsResponse{}
+func (x *ThreadInfo) Reset() {
+	*x = ThreadInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4452,13 +4849,13 @@ func (x *GetNotificationsResponse) Reset() {
 	}
 }
 
-func (x *GetNotificationsResponse) String() string {
+func (x *ThreadInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetNotificationsResponse) ProtoMessage() {}
+func (*ThreadInfo) ProtoMessage() {}
 
-func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
+func (x *ThreadInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4470,42 +4867,35 @@ func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
-func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ThreadInfo.ProtoReflect.Descriptor instead.
+func (*ThreadInfo) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{74}
 }
 
-func (x *GetNotificationsResponse) GetNotifications() []*Notification {
+func (x *ThreadInfo) GetAddresses() []string {
 	if x != nil {
-		return x.Notifications
+		return x.Addresses
 	}
 	return nil
 }
 
-func (x *GetNotificationsResponse) GetNextOffset() string {
+func (x *ThreadInfo) GetKey() string {
 	if x != nil {
-		return x.NextOffset
+		return x.Key
 	}
 	return ""
 }
 
-func (x *GetNotificationsResponse) GetLastSeenAt() int64 {
-	if x != nil {
-		return x.LastSeenAt
-	}
-	return 0
-}
-
-type ReadNotificationRequest struct {
+type ShareBucketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *ReadNotificationRequest) Reset() {
-	*x = ReadNotificationRequest{}
+func (x *ShareBucketRequest) Reset() {
+	*x = ShareBucketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4513,13 +4903,13 @@ func (x *ReadNotificationRequest) Reset() {
 	}
 }
 
-func (x *ReadNotificationRequest) String() string {
+func (x *ShareBucketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadNotificationRequest) ProtoMessage() {}
+func (*ShareBucketRequest) ProtoMessage() {}
 
-func (x *ReadNotificationRequest) ProtoReflect() protoreflect.Message {
+func (x *ShareBucketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4531,26 +4921,28 @@ func (x *ReadNotificationRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadNotificationRequest.ProtoReflect.Descriptor instead.
-func (*ReadNotificationRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareBucketRequest.ProtoReflect.Descriptor instead.
+func (*ShareBucketRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *ReadNotificationRequest) GetID() string {
+func (x *ShareBucketRequest) GetBucket() string {
 	if x != nil {
-		return x.ID
+		return x.Bucket
 	}
 	return ""
 }
 
-type ReadNotificationResponse struct {
+type ShareBucketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Threadinfo *ThreadInfo `protobuf:"bytes,1,opt,name=threadinfo,proto3" json:"threadinfo,omitempty"`
 }
 
-func (x *ReadNotificationResponse) Reset() {
-	*x = ReadNotificationResponse{}
+func (x *ShareBucketResponse) Reset() {
+	*x = ShareBucketResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4558,13 +4950,13 @@ func (x *ReadNotificationResponse) Reset() {
 	}
 }
 
-func (x *ReadNotificationResponse) String() string {
+func (x *ShareBucketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ReadNotificationResponse) ProtoMessage() {}
+func (*ShareBucketResponse) ProtoMessage() {}
 
-func (x *ReadNotificationResponse) ProtoReflect() protoreflect.Message {
+func (x *ShareBucketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4576,19 +4968,29 @@ func (x *ReadNotificationResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ReadNotificationResponse.ProtoReflect.Descriptor instead.
-func (*ReadNotificationResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareBucketResponse.ProtoReflect.Descriptor instead.
+func (*ShareBucketResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{76}
 }
 
-type GetPublicKeyRequest struct {
+func (x *ShareBucketResponse) GetThreadinfo() *ThreadInfo {
+	if x != nil {
+		return x.Threadinfo
+	}
+	return nil
+}
+
+type JoinBucketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Threadinfo *ThreadInfo `protobuf:"bytes,1,opt,name=threadinfo,proto3" json:"threadinfo,omitempty"`
+	Bucket     string      `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *GetPublicKeyRequest) Reset() {
-	*x = GetPublicKeyRequest{}
+func (x *JoinBucketRequest) Reset() {
+	*x = JoinBucketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4596,13 +4998,13 @@ func (x *GetPublicKeyRequest) Reset() {
 	}
 }
 
-func (x *GetPublicKeyRequest) String() string {
+func (x *JoinBucketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPublicKeyRequest) ProtoMessage() {}
+func (*JoinBucketRequest) ProtoMessage() {}
 
-func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinBucketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4614,22 +5016,35 @@ func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
-func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinBucketRequest.ProtoReflect.Descriptor instead.
+func (*JoinBucketRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{77}
 }
 
-type GetPublicKeyResponse struct {
+func (x *JoinBucketRequest) GetThreadinfo() *ThreadInfo {
+	if x != nil {
+		return x.Threadinfo
+	}
+	return nil
+}
+
+func (x *JoinBucketRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type JoinBucketResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Public key encoded in hex
-	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+	Result bool `protobuf:"varint,1,opt,name=result,proto3" json:"result,omitempty"`
 }
 
-func (x *GetPublicKeyResponse) Reset() {
-	*x = GetPublicKeyResponse{}
+func (x *JoinBucketResponse) Reset() {
+	*x = JoinBucketResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4637,13 +5052,13 @@ func (x *GetPublicKeyResponse) Reset() {
 	}
 }
 
-func (x *GetPublicKeyResponse) String() string {
+func (x *JoinBucketResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPublicKeyResponse) ProtoMessage() {}
+func (*JoinBucketResponse) ProtoMessage() {}
 
-func (x *GetPublicKeyResponse) ProtoReflect() protoreflect.Message {
+func (x *JoinBucketResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4655,28 +5070,31 @@ func (x *GetPublicKeyResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPublicKeyResponse.ProtoReflect.Descriptor instead.
-func (*GetPublicKeyResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinBucketResponse.ProtoReflect.Descriptor instead.
+func (*JoinBucketResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{78}
 }
 
-func (x *GetPublicKeyResponse) GetPublicKey() string {
+func (x *JoinBucketResponse) GetResult() bool {
 	if x != nil {
-		return x.PublicKey
+		return x.Result
 	}
-	return ""
+	return false
 }
 
-type RecoverKeysByLocalBackupRequest struct {
+type ShareFilesViaPublicKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PathToKeyBackup string `protobuf:"bytes,1,opt,name=pathToKeyBackup,proto3" json:"pathToKeyBackup,omitempty"`
+	PublicKeys []string    `protobuf:"bytes,1,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
+	Paths      []*FullPath `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Role       ShareRole   `protobuf:"varint,3,opt,name=role,proto3,enum=space.ShareRole" json:"role,omitempty"` // optional, the default share role is used when unspecified
+	ExpiresAt  int64       `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`            // optional unix timestamp (seconds) after which a pending invitation is rejected
 }
 
-func (x *RecoverKeysByLocalBackupRequest) Reset() {
-	*x = RecoverKeysByLocalBackupRequest{}
+func (x *ShareFilesViaPublicKeyRequest) Reset() {
+	*x = ShareFilesViaPublicKeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4684,13 +5102,13 @@ func (x *RecoverKeysByLocalBackupRequest) Reset() {
 	}
 }
 
-func (x *RecoverKeysByLocalBackupRequest) String() string {
+func (x *ShareFilesViaPublicKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverKeysByLocalBackupRequest) ProtoMessage() {}
+func (*ShareFilesViaPublicKeyRequest) ProtoMessage() {}
 
-func (x *RecoverKeysByLocalBackupRequest) ProtoReflect() protoreflect.Message {
+func (x *ShareFilesViaPublicKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4702,26 +5120,51 @@ func (x *RecoverKeysByLocalBackupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverKeysByLocalBackupRequest.ProtoReflect.Descriptor instead.
-func (*RecoverKeysByLocalBackupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareFilesViaPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*ShareFilesViaPublicKeyRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *RecoverKeysByLocalBackupRequest) GetPathToKeyBackup() string {
+func (x *ShareFilesViaPublicKeyRequest) GetPublicKeys() []string {
 	if x != nil {
-		return x.PathToKeyBackup
+		return x.PublicKeys
 	}
-	return ""
+	return nil
 }
 
-type RecoverKeysByLocalBackupResponse struct {
+func (x *ShareFilesViaPublicKeyRequest) GetPaths() []*FullPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *ShareFilesViaPublicKeyRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+func (x *ShareFilesViaPublicKeyRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type FullPath struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DbId   string `protobuf:"bytes,1,opt,name=dbId,proto3" json:"dbId,omitempty"` // optional field to specify shared with me file
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Path   string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
 }
 
-func (x *RecoverKeysByLocalBackupResponse) Reset() {
-	*x = RecoverKeysByLocalBackupResponse{}
+func (x *FullPath) Reset() {
+	*x = FullPath{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4729,13 +5172,13 @@ func (x *RecoverKeysByLocalBackupResponse) Reset() {
 	}
 }
 
-func (x *RecoverKeysByLocalBackupResponse) String() string {
+func (x *FullPath) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecoverKeysByLocalBackupResponse) ProtoMessage() {}
+func (*FullPath) ProtoMessage() {}
 
-func (x *RecoverKeysByLocalBackupResponse) ProtoReflect() protoreflect.Message {
+func (x *FullPath) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4747,22 +5190,40 @@ func (x *RecoverKeysByLocalBackupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecoverKeysByLocalBackupResponse.ProtoReflect.Descriptor instead.
-func (*RecoverKeysByLocalBackupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FullPath.ProtoReflect.Descriptor instead.
+func (*FullPath) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{80}
 }
 
-type CreateLocalKeysBackupRequest struct {
+func (x *FullPath) GetDbId() string {
+	if x != nil {
+		return x.DbId
+	}
+	return ""
+}
+
+func (x *FullPath) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *FullPath) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type ShareFilesViaPublicKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	// The path in which to save the backup
-	PathToKeyBackup string `protobuf:"bytes,1,opt,name=pathToKeyBackup,proto3" json:"pathToKeyBackup,omitempty"`
 }
 
-func (x *CreateLocalKeysBackupRequest) Reset() {
-	*x = CreateLocalKeysBackupRequest{}
+func (x *ShareFilesViaPublicKeyResponse) Reset() {
+	*x = ShareFilesViaPublicKeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4770,13 +5231,13 @@ func (x *CreateLocalKeysBackupRequest) Reset() {
 	}
 }
 
-func (x *CreateLocalKeysBackupRequest) String() string {
+func (x *ShareFilesViaPublicKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLocalKeysBackupRequest) ProtoMessage() {}
+func (*ShareFilesViaPublicKeyResponse) ProtoMessage() {}
 
-func (x *CreateLocalKeysBackupRequest) ProtoReflect() protoreflect.Message {
+func (x *ShareFilesViaPublicKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4788,26 +5249,22 @@ func (x *CreateLocalKeysBackupRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLocalKeysBackupRequest.ProtoReflect.Descriptor instead.
-func (*CreateLocalKeysBackupRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ShareFilesViaPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*ShareFilesViaPublicKeyResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *CreateLocalKeysBackupRequest) GetPathToKeyBackup() string {
-	if x != nil {
-		return x.PathToKeyBackup
-	}
-	return ""
-}
-
-type CreateLocalKeysBackupResponse struct {
+type UnshareFilesViaPublicKeyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PublicKeys []string    `protobuf:"bytes,1,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
+	Paths      []*FullPath `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
 }
 
-func (x *CreateLocalKeysBackupResponse) Reset() {
-	*x = CreateLocalKeysBackupResponse{}
+func (x *UnshareFilesViaPublicKeyRequest) Reset() {
+	*x = UnshareFilesViaPublicKeyRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4815,13 +5272,13 @@ func (x *CreateLocalKeysBackupResponse) Reset() {
 	}
 }
 
-func (x *CreateLocalKeysBackupResponse) String() string {
+func (x *UnshareFilesViaPublicKeyRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateLocalKeysBackupResponse) ProtoMessage() {}
+func (*UnshareFilesViaPublicKeyRequest) ProtoMessage() {}
 
-func (x *CreateLocalKeysBackupResponse) ProtoReflect() protoreflect.Message {
+func (x *UnshareFilesViaPublicKeyRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4833,19 +5290,33 @@ func (x *CreateLocalKeysBackupResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateLocalKeysBackupResponse.ProtoReflect.Descriptor instead.
-func (*CreateLocalKeysBackupResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnshareFilesViaPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*UnshareFilesViaPublicKeyRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{82}
 }
 
-type DeleteAccountRequest struct {
+func (x *UnshareFilesViaPublicKeyRequest) GetPublicKeys() []string {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *UnshareFilesViaPublicKeyRequest) GetPaths() []*FullPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type UnshareFilesViaPublicKeyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
+func (x *UnshareFilesViaPublicKeyResponse) Reset() {
+	*x = UnshareFilesViaPublicKeyResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4853,13 +5324,13 @@ func (x *DeleteAccountRequest) Reset() {
 	}
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *UnshareFilesViaPublicKeyResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*UnshareFilesViaPublicKeyResponse) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+func (x *UnshareFilesViaPublicKeyResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4871,19 +5342,23 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnshareFilesViaPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*UnshareFilesViaPublicKeyResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{83}
 }
 
-type DeleteAccountResponse struct {
+type ChangeShareRoleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	PublicKeys []string    `protobuf:"bytes,1,rep,name=publicKeys,proto3" json:"publicKeys,omitempty"`
+	Paths      []*FullPath `protobuf:"bytes,2,rep,name=paths,proto3" json:"paths,omitempty"`
+	Role       ShareRole   `protobuf:"varint,3,opt,name=role,proto3,enum=space.ShareRole" json:"role,omitempty"`
 }
 
-func (x *DeleteAccountResponse) Reset() {
-	*x = DeleteAccountResponse{}
+func (x *ChangeShareRoleRequest) Reset() {
+	*x = ChangeShareRoleRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4891,13 +5366,13 @@ func (x *DeleteAccountResponse) Reset() {
 	}
 }
 
-func (x *DeleteAccountResponse) String() string {
+func (x *ChangeShareRoleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountResponse) ProtoMessage() {}
+func (*ChangeShareRoleRequest) ProtoMessage() {}
 
-func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+func (x *ChangeShareRoleRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4909,19 +5384,40 @@ func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeShareRoleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeShareRoleRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{84}
 }
 
-type DeleteKeyPairRequest struct {
+func (x *ChangeShareRoleRequest) GetPublicKeys() []string {
+	if x != nil {
+		return x.PublicKeys
+	}
+	return nil
+}
+
+func (x *ChangeShareRoleRequest) GetPaths() []*FullPath {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *ChangeShareRoleRequest) GetRole() ShareRole {
+	if x != nil {
+		return x.Role
+	}
+	return ShareRole_SHARE_ROLE_UNSPECIFIED
+}
+
+type ChangeShareRoleResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *DeleteKeyPairRequest) Reset() {
-	*x = DeleteKeyPairRequest{}
+func (x *ChangeShareRoleResponse) Reset() {
+	*x = ChangeShareRoleResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4929,13 +5425,13 @@ func (x *DeleteKeyPairRequest) Reset() {
 	}
 }
 
-func (x *DeleteKeyPairRequest) String() string {
+func (x *ChangeShareRoleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteKeyPairRequest) ProtoMessage() {}
+func (*ChangeShareRoleResponse) ProtoMessage() {}
 
-func (x *DeleteKeyPairRequest) ProtoReflect() protoreflect.Message {
+func (x *ChangeShareRoleResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4947,19 +5443,36 @@ func (x *DeleteKeyPairRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteKeyPairRequest.ProtoReflect.Descriptor instead.
-func (*DeleteKeyPairRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ChangeShareRoleResponse.ProtoReflect.Descriptor instead.
+func (*ChangeShareRoleResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{85}
 }
 
-type DeleteKeyPairResponse struct {
+type GeneratePublicFileLinkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bucket    string   `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	ItemPaths []string `protobuf:"bytes,2,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
+	Password  string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// optional field to specify db id
+	// for shared with me files
+	DbId string `protobuf:"bytes,4,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	// optional unix timestamp (seconds) after which the link is revoked. This is best effort: the gateway
+	// serves the file until the daemon of the user unpins it, which it checks every minute while running,
+	// and gateways may serve cached copies after that
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// when true, password is ignored and a random key is embedded in the link fragment
+	EmbedKey bool `protobuf:"varint,6,opt,name=embedKey,proto3" json:"embedKey,omitempty"`
+	// optional max number of downloads before the link is revoked. Only downloads opened through a Space daemon
+	// with the full link are counted and reported to the daemon of the user, downloads straight from the public
+	// gateway are not. The public key of the user is added to the link so the download can be reported
+	MaxDownloads int64 `protobuf:"varint,7,opt,name=maxDownloads,proto3" json:"maxDownloads,omitempty"`
 }
 
-func (x *DeleteKeyPairResponse) Reset() {
-	*x = DeleteKeyPairResponse{}
+func (x *GeneratePublicFileLinkRequest) Reset() {
+	*x = GeneratePublicFileLinkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[86]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4967,13 +5480,13 @@ func (x *DeleteKeyPairResponse) Reset() {
 	}
 }
 
-func (x *DeleteKeyPairResponse) String() string {
+func (x *GeneratePublicFileLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteKeyPairResponse) ProtoMessage() {}
+func (*GeneratePublicFileLinkRequest) ProtoMessage() {}
 
-func (x *DeleteKeyPairResponse) ProtoReflect() protoreflect.Message {
+func (x *GeneratePublicFileLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[86]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -4985,19 +5498,72 @@ func (x *DeleteKeyPairResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteKeyPairResponse.ProtoReflect.Descriptor instead.
-func (*DeleteKeyPairResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeneratePublicFileLinkRequest.ProtoReflect.Descriptor instead.
+func (*GeneratePublicFileLinkRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{86}
 }
 
-type GetAPISessionTokensRequest struct {
+func (x *GeneratePublicFileLinkRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *GeneratePublicFileLinkRequest) GetItemPaths() []string {
+	if x != nil {
+		return x.ItemPaths
+	}
+	return nil
+}
+
+func (x *GeneratePublicFileLinkRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *GeneratePublicFileLinkRequest) GetDbId() string {
+	if x != nil {
+		return x.DbId
+	}
+	return ""
+}
+
+func (x *GeneratePublicFileLinkRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *GeneratePublicFileLinkRequest) GetEmbedKey() bool {
+	if x != nil {
+		return x.EmbedKey
+	}
+	return false
+}
+
+func (x *GeneratePublicFileLinkRequest) GetMaxDownloads() int64 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+type GeneratePublicFileLinkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Link    string `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	FileCid string `protobuf:"bytes,2,opt,name=fileCid,proto3" json:"fileCid,omitempty"`
+	LinkId  string `protobuf:"bytes,3,opt,name=linkId,proto3" json:"linkId,omitempty"`
 }
 
-func (x *GetAPISessionTokensRequest) Reset() {
-	*x = GetAPISessionTokensRequest{}
+func (x *GeneratePublicFileLinkResponse) Reset() {
+	*x = GeneratePublicFileLinkResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[87]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5005,13 +5571,13 @@ func (x *GetAPISessionTokensRequest) Reset() {
 	}
 }
 
-func (x *GetAPISessionTokensRequest) String() string {
+func (x *GeneratePublicFileLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAPISessionTokensRequest) ProtoMessage() {}
+func (*GeneratePublicFileLinkResponse) ProtoMessage() {}
 
-func (x *GetAPISessionTokensRequest) ProtoReflect() protoreflect.Message {
+func (x *GeneratePublicFileLinkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[87]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5023,22 +5589,56 @@ func (x *GetAPISessionTokensRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAPISessionTokensRequest.ProtoReflect.Descriptor instead.
-func (*GetAPISessionTokensRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use GeneratePublicFileLinkResponse.ProtoReflect.Descriptor instead.
+func (*GeneratePublicFileLinkResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{87}
 }
 
-type GetAPISessionTokensResponse struct {
+func (x *GeneratePublicFileLinkResponse) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *GeneratePublicFileLinkResponse) GetFileCid() string {
+	if x != nil {
+		return x.FileCid
+	}
+	return ""
+}
+
+func (x *GeneratePublicFileLinkResponse) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type PublicLink struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	HubToken      string `protobuf:"bytes,1,opt,name=hubToken,proto3" json:"hubToken,omitempty"`
-	ServicesToken string `protobuf:"bytes,2,opt,name=servicesToken,proto3" json:"servicesToken,omitempty"`
-}
-
-func (x *GetAPISessionTokensResponse) Reset() {
-	*x = GetAPISessionTokensResponse{}
+	Id        string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Bucket    string   `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	DbId      string   `protobuf:"bytes,3,opt,name=dbId,proto3" json:"dbId,omitempty"`
+	ItemPaths []string `protobuf:"bytes,4,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
+	FileName  string   `protobuf:"bytes,5,opt,name=fileName,proto3" json:"fileName,omitempty"`
+	FileCid   string   `protobuf:"bytes,6,opt,name=fileCid,proto3" json:"fileCid,omitempty"`
+	Link      string   `protobuf:"bytes,7,opt,name=link,proto3" json:"link,omitempty"`
+	CreatedAt int64    `protobuf:"varint,8,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt int64    `protobuf:"varint,9,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	Revoked   bool     `protobuf:"varint,10,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// false once the link expired or reached its max downloads, it is revoked in the background
+	Active       bool  `protobuf:"varint,11,opt,name=active,proto3" json:"active,omitempty"`
+	MaxDownloads int64 `protobuf:"varint,12,opt,name=maxDownloads,proto3" json:"maxDownloads,omitempty"`
+	// downloads counted by the daemon of the user, see maxDownloads of GeneratePublicFileLinkRequest
+	DownloadCount int64 `protobuf:"varint,13,opt,name=downloadCount,proto3" json:"downloadCount,omitempty"`
+}
+
+func (x *PublicLink) Reset() {
+	*x = PublicLink{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[88]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5046,13 +5646,13 @@ func (x *GetAPISessionTokensResponse) Reset() {
 	}
 }
 
-func (x *GetAPISessionTokensResponse) String() string {
+func (x *PublicLink) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAPISessionTokensResponse) ProtoMessage() {}
+func (*PublicLink) ProtoMessage() {}
 
-func (x *GetAPISessionTokensResponse) ProtoReflect() protoreflect.Message {
+func (x *PublicLink) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[88]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5064,33 +5664,113 @@ func (x *GetAPISessionTokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAPISessionTokensResponse.ProtoReflect.Descriptor instead.
-func (*GetAPISessionTokensResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PublicLink.ProtoReflect.Descriptor instead.
+func (*PublicLink) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{88}
 }
 
-func (x *GetAPISessionTokensResponse) GetHubToken() string {
+func (x *PublicLink) GetId() string {
 	if x != nil {
-		return x.HubToken
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetAPISessionTokensResponse) GetServicesToken() string {
+func (x *PublicLink) GetBucket() string {
 	if x != nil {
-		return x.ServicesToken
+		return x.Bucket
 	}
 	return ""
 }
 
-type GetRecentlySharedWithRequest struct {
+func (x *PublicLink) GetDbId() string {
+	if x != nil {
+		return x.DbId
+	}
+	return ""
+}
+
+func (x *PublicLink) GetItemPaths() []string {
+	if x != nil {
+		return x.ItemPaths
+	}
+	return nil
+}
+
+func (x *PublicLink) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *PublicLink) GetFileCid() string {
+	if x != nil {
+		return x.FileCid
+	}
+	return ""
+}
+
+func (x *PublicLink) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+func (x *PublicLink) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *PublicLink) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *PublicLink) GetRevoked() bool {
+	if x != nil {
+		return x.Revoked
+	}
+	return false
+}
+
+func (x *PublicLink) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *PublicLink) GetMaxDownloads() int64 {
+	if x != nil {
+		return x.MaxDownloads
+	}
+	return 0
+}
+
+func (x *PublicLink) GetDownloadCount() int64 {
+	if x != nil {
+		return x.DownloadCount
+	}
+	return 0
+}
+
+type ListPublicLinksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Seek  string `protobuf:"bytes,1,opt,name=seek,proto3" json:"seek,omitempty"`
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (x *GetRecentlySharedWithRequest) Reset() {
-	*x = GetRecentlySharedWithRequest{}
+func (x *ListPublicLinksRequest) Reset() {
+	*x = ListPublicLinksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[89]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5098,13 +5778,13 @@ func (x *GetRecentlySharedWithRequest) Reset() {
 	}
 }
 
-func (x *GetRecentlySharedWithRequest) String() string {
+func (x *ListPublicLinksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecentlySharedWithRequest) ProtoMessage() {}
+func (*ListPublicLinksRequest) ProtoMessage() {}
 
-func (x *GetRecentlySharedWithRequest) ProtoReflect() protoreflect.Message {
+func (x *ListPublicLinksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[89]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5116,21 +5796,36 @@ func (x *GetRecentlySharedWithRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecentlySharedWithRequest.ProtoReflect.Descriptor instead.
-func (*GetRecentlySharedWithRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPublicLinksRequest.ProtoReflect.Descriptor instead.
+func (*ListPublicLinksRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{89}
 }
 
-type GetRecentlySharedWithResponse struct {
+func (x *ListPublicLinksRequest) GetSeek() string {
+	if x != nil {
+		return x.Seek
+	}
+	return ""
+}
+
+func (x *ListPublicLinksRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListPublicLinksResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Members []*FileMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+	Links      []*PublicLink `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	NextOffset string        `protobuf:"bytes,2,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
 }
 
-func (x *GetRecentlySharedWithResponse) Reset() {
-	*x = GetRecentlySharedWithResponse{}
+func (x *ListPublicLinksResponse) Reset() {
+	*x = ListPublicLinksResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[90]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5138,13 +5833,13 @@ func (x *GetRecentlySharedWithResponse) Reset() {
 	}
 }
 
-func (x *GetRecentlySharedWithResponse) String() string {
+func (x *ListPublicLinksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRecentlySharedWithResponse) ProtoMessage() {}
+func (*ListPublicLinksResponse) ProtoMessage() {}
 
-func (x *GetRecentlySharedWithResponse) ProtoReflect() protoreflect.Message {
+func (x *ListPublicLinksResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[90]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5156,26 +5851,35 @@ func (x *GetRecentlySharedWithResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRecentlySharedWithResponse.ProtoReflect.Descriptor instead.
-func (*GetRecentlySharedWithResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPublicLinksResponse.ProtoReflect.Descriptor instead.
+func (*ListPublicLinksResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{90}
 }
 
-func (x *GetRecentlySharedWithResponse) GetMembers() []*FileMember {
+func (x *ListPublicLinksResponse) GetLinks() []*PublicLink {
 	if x != nil {
-		return x.Members
+		return x.Links
 	}
 	return nil
 }
 
-type InitializeMasterAppTokenRequest struct {
+func (x *ListPublicLinksResponse) GetNextOffset() string {
+	if x != nil {
+		return x.NextOffset
+	}
+	return ""
+}
+
+type RevokePublicLinkRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	LinkId string `protobuf:"bytes,1,opt,name=linkId,proto3" json:"linkId,omitempty"`
 }
 
-func (x *InitializeMasterAppTokenRequest) Reset() {
-	*x = InitializeMasterAppTokenRequest{}
+func (x *RevokePublicLinkRequest) Reset() {
+	*x = RevokePublicLinkRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[91]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5183,13 +5887,13 @@ func (x *InitializeMasterAppTokenRequest) Reset() {
 	}
 }
 
-func (x *InitializeMasterAppTokenRequest) String() string {
+func (x *RevokePublicLinkRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitializeMasterAppTokenRequest) ProtoMessage() {}
+func (*RevokePublicLinkRequest) ProtoMessage() {}
 
-func (x *InitializeMasterAppTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *RevokePublicLinkRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[91]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5201,21 +5905,26 @@ func (x *InitializeMasterAppTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitializeMasterAppTokenRequest.ProtoReflect.Descriptor instead.
-func (*InitializeMasterAppTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokePublicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RevokePublicLinkRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{91}
 }
 
-type InitializeMasterAppTokenResponse struct {
+func (x *RevokePublicLinkRequest) GetLinkId() string {
+	if x != nil {
+		return x.LinkId
+	}
+	return ""
+}
+
+type RevokePublicLinkResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AppToken string `protobuf:"bytes,1,opt,name=appToken,proto3" json:"appToken,omitempty"`
 }
 
-func (x *InitializeMasterAppTokenResponse) Reset() {
-	*x = InitializeMasterAppTokenResponse{}
+func (x *RevokePublicLinkResponse) Reset() {
+	*x = RevokePublicLinkResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[92]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5223,13 +5932,13 @@ func (x *InitializeMasterAppTokenResponse) Reset() {
 	}
 }
 
-func (x *InitializeMasterAppTokenResponse) String() string {
+func (x *RevokePublicLinkResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InitializeMasterAppTokenResponse) ProtoMessage() {}
+func (*RevokePublicLinkResponse) ProtoMessage() {}
 
-func (x *InitializeMasterAppTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *RevokePublicLinkResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[92]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5241,28 +5950,21 @@ func (x *InitializeMasterAppTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InitializeMasterAppTokenResponse.ProtoReflect.Descriptor instead.
-func (*InitializeMasterAppTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RevokePublicLinkResponse.ProtoReflect.Descriptor instead.
+func (*RevokePublicLinkResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{92}
 }
 
-func (x *InitializeMasterAppTokenResponse) GetAppToken() string {
-	if x != nil {
-		return x.AppToken
-	}
-	return ""
-}
-
-type AllowedMethod struct {
+type ToggleFuseRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	MethodName string `protobuf:"bytes,1,opt,name=methodName,proto3" json:"methodName,omitempty"`
+	MountDrive bool `protobuf:"varint,1,opt,name=mountDrive,proto3" json:"mountDrive,omitempty"`
 }
 
-func (x *AllowedMethod) Reset() {
-	*x = AllowedMethod{}
+func (x *ToggleFuseRequest) Reset() {
+	*x = ToggleFuseRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[93]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5270,13 +5972,13 @@ func (x *AllowedMethod) Reset() {
 	}
 }
 
-func (x *AllowedMethod) String() string {
+func (x *ToggleFuseRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AllowedMethod) ProtoMessage() {}
+func (*ToggleFuseRequest) ProtoMessage() {}
 
-func (x *AllowedMethod) ProtoReflect() protoreflect.Message {
+func (x *ToggleFuseRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[93]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5288,28 +5990,29 @@ func (x *AllowedMethod) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AllowedMethod.ProtoReflect.Descriptor instead.
-func (*AllowedMethod) Descriptor() ([]byte, []int) {
+// Deprecated: Use ToggleFuseRequest.ProtoReflect.Descriptor instead.
+func (*ToggleFuseRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{93}
 }
 
-func (x *AllowedMethod) GetMethodName() string {
+func (x *ToggleFuseRequest) GetMountDrive() bool {
 	if x != nil {
-		return x.MethodName
+		return x.MountDrive
 	}
-	return ""
+	return false
 }
 
-type GenerateAppTokenRequest struct {
+type FuseDriveResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AllowedMethods []*AllowedMethod `protobuf:"bytes,1,rep,name=allowedMethods,proto3" json:"allowedMethods,omitempty"`
+	State     FuseState `protobuf:"varint,1,opt,name=state,proto3,enum=space.FuseState" json:"state,omitempty"`
+	MountPath string    `protobuf:"bytes,2,opt,name=mountPath,proto3" json:"mountPath,omitempty"`
 }
 
-func (x *GenerateAppTokenRequest) Reset() {
-	*x = GenerateAppTokenRequest{}
+func (x *FuseDriveResponse) Reset() {
+	*x = FuseDriveResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[94]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5317,13 +6020,13 @@ func (x *GenerateAppTokenRequest) Reset() {
 	}
 }
 
-func (x *GenerateAppTokenRequest) String() string {
+func (x *FuseDriveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAppTokenRequest) ProtoMessage() {}
+func (*FuseDriveResponse) ProtoMessage() {}
 
-func (x *GenerateAppTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *FuseDriveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[94]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5335,28 +6038,33 @@ func (x *GenerateAppTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAppTokenRequest.ProtoReflect.Descriptor instead.
-func (*GenerateAppTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FuseDriveResponse.ProtoReflect.Descriptor instead.
+func (*FuseDriveResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{94}
 }
 
-func (x *GenerateAppTokenRequest) GetAllowedMethods() []*AllowedMethod {
+func (x *FuseDriveResponse) GetState() FuseState {
 	if x != nil {
-		return x.AllowedMethods
+		return x.State
 	}
-	return nil
+	return FuseState_UNSUPPORTED
 }
 
-type GenerateAppTokenResponse struct {
+func (x *FuseDriveResponse) GetMountPath() string {
+	if x != nil {
+		return x.MountPath
+	}
+	return ""
+}
+
+type ListBucketsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	AppToken string `protobuf:"bytes,1,opt,name=appToken,proto3" json:"appToken,omitempty"`
 }
 
-func (x *GenerateAppTokenResponse) Reset() {
-	*x = GenerateAppTokenResponse{}
+func (x *ListBucketsRequest) Reset() {
+	*x = ListBucketsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[95]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5364,13 +6072,13 @@ func (x *GenerateAppTokenResponse) Reset() {
 	}
 }
 
-func (x *GenerateAppTokenResponse) String() string {
+func (x *ListBucketsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAppTokenResponse) ProtoMessage() {}
+func (*ListBucketsRequest) ProtoMessage() {}
 
-func (x *GenerateAppTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *ListBucketsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[95]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5382,29 +6090,21 @@ func (x *GenerateAppTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAppTokenResponse.ProtoReflect.Descriptor instead.
-func (*GenerateAppTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBucketsRequest.ProtoReflect.Descriptor instead.
+func (*ListBucketsRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{95}
 }
 
-func (x *GenerateAppTokenResponse) GetAppToken() string {
-	if x != nil {
-		return x.AppToken
-	}
-	return ""
-}
-
-type RemoveDirOrFileRequest struct {
+type ListBucketsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Buckets []*Bucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
 }
 
-func (x *RemoveDirOrFileRequest) Reset() {
-	*x = RemoveDirOrFileRequest{}
+func (x *ListBucketsResponse) Reset() {
+	*x = ListBucketsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[96]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5412,13 +6112,13 @@ func (x *RemoveDirOrFileRequest) Reset() {
 	}
 }
 
-func (x *RemoveDirOrFileRequest) String() string {
+func (x *ListBucketsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDirOrFileRequest) ProtoMessage() {}
+func (*ListBucketsResponse) ProtoMessage() {}
 
-func (x *RemoveDirOrFileRequest) ProtoReflect() protoreflect.Message {
+func (x *ListBucketsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[96]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5430,33 +6130,28 @@ func (x *RemoveDirOrFileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDirOrFileRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDirOrFileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListBucketsResponse.ProtoReflect.Descriptor instead.
+func (*ListBucketsResponse) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{96}
 }
 
-func (x *RemoveDirOrFileRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
-}
-
-func (x *RemoveDirOrFileRequest) GetBucket() string {
+func (x *ListBucketsResponse) GetBuckets() []*Bucket {
 	if x != nil {
-		return x.Bucket
+		return x.Buckets
 	}
-	return ""
+	return nil
 }
 
-type RemoveDirOrFileResponse struct {
+type DeleteBucketRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Bucket string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
 }
 
-func (x *RemoveDirOrFileResponse) Reset() {
-	*x = RemoveDirOrFileResponse{}
+func (x *DeleteBucketRequest) Reset() {
+	*x = DeleteBucketRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_space_proto_msgTypes[97]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5464,13 +6159,13 @@ func (x *RemoveDirOrFileResponse) Reset() {
 	}
 }
 
-func (x *RemoveDirOrFileResponse) String() string {
+func (x *DeleteBucketRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDirOrFileResponse) ProtoMessage() {}
+func (*DeleteBucketRequest) ProtoMessage() {}
 
-func (x *RemoveDirOrFileResponse) ProtoReflect() protoreflect.Message {
+func (x *DeleteBucketRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_space_proto_msgTypes[97]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -5482,1153 +6177,5410 @@ func (x *RemoveDirOrFileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDirOrFileResponse.ProtoReflect.Descriptor instead.
-func (*RemoveDirOrFileResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use DeleteBucketRequest.ProtoReflect.Descriptor instead.
+func (*DeleteBucketRequest) Descriptor() ([]byte, []int) {
 	return file_space_proto_rawDescGZIP(), []int{97}
 }
 
-var File_space_proto protoreflect.FileDescriptor
+func (x *DeleteBucketRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
 
-var file_space_proto_rawDesc = []byte{
-	0x0a, 0x0b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
-	0x2a, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x13, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
-	0x75, 0x65, 0x72, 0x79, 0x22, 0x78, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x41,
-	0x0a, 0x21, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69,
-	0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x22, 0x75, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74,
-	0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78,
-	0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x45, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x73,
-	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x14, 0x47,
-	0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72,
-	0x6f, 0x67, 0x65, 0x55, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x6f, 0x67, 0x65, 0x55, 0x73, 0x65, 0x64, 0x12,
-	0x2e, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74,
-	0x68, 0x55, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x6f, 0x63,
-	0x61, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x65, 0x64, 0x12,
-	0x2a, 0x0a, 0x10, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55,
-	0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x65,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x61,
-	0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x19, 0x54,
-	0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x34, 0x0a, 0x1a, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x1d, 0x0a, 0x1b,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x52, 0x0a, 0x16, 0x4c,
-	0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x0a,
-	0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
-	0x44, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
-	0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0xcb, 0x03, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04,
-	0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x05, 0x69, 0x73, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69,
-	0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x69, 0x7a, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x12, 0x24, 0x0a, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
-	0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74,
-	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61,
-	0x73, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x41,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12,
-	0x69, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18,
-	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x69,
-	0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x12, 0x2e, 0x0a, 0x12, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x50,
-	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69,
-	0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
-	0x73, 0x12, 0x30, 0x0a, 0x13, 0x69, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13,
-	0x69, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72,
-	0x65, 0x73, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x18, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69,
-	0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x2f, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65,
-	0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x0c, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e,
-	0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4e, 0x0a,
-	0x17, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
-	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x64, 0x0a,
-	0x14, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x6d, 0x62,
-	0x65, 0x72, 0x73, 0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
-	0x73, 0x22, 0x29, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x7e, 0x0a, 0x0c,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x09, 0x68, 0x61, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x68, 0x61, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0xa3, 0x02, 0x0a,
-	0x06, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
-	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
-	0x68, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x1c, 0x0a, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a,
-	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10,
-	0x69, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e,
-	0x61, 0x6c, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0f, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c,
-	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x22, 0x3d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x22, 0x18, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x35, 0x0a, 0x17, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
-	0x69, 0x63, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37,
-	0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f,
-	0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x3e, 0x0a, 0x20, 0x52, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x56, 0x69, 0x61, 0x4d, 0x6e, 0x65, 0x6d,
-	0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x23, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x56, 0x69, 0x61, 0x4d, 0x6e, 0x65, 0x6d,
-	0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x01, 0x0a,
-	0x11, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x64, 0x62, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x14, 0x54, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a,
-	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x51, 0x0a, 0x0f, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x22, 0x2e, 0x0a, 0x10, 0x4f, 0x70, 0x65, 0x6e,
-	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x69, 0x0a, 0x15, 0x4f, 0x70, 0x65, 0x6e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x22, 0x34, 0x0a, 0x16, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x0f, 0x41, 0x64, 0x64,
-	0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1e,
-	0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16,
-	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x65, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65,
-	0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd0, 0x01,
-	0x0a, 0x10, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73,
-	0x22, 0x41, 0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c,
-	0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7d, 0x0a, 0x1d, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70,
-	0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x20, 0x0a, 0x1e, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68,
-	0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e, 0x0a, 0x1e,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
-	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
-	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
-	0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x21, 0x0a, 0x1f,
-	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73,
-	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x4f, 0x0a, 0x19, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x73, 0x73, 0x70,
-	0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69, 0x64,
-	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x22, 0x1c, 0x0a, 0x1a, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x73, 0x73,
-	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3c,
-	0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c, 0x0a, 0x09,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2c, 0x0a, 0x12,
-	0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x68,
-	0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x5e, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x68, 0x72,
-	0x65, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x06,
-	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75,
-	0x6c, 0x74, 0x22, 0x66, 0x0a, 0x1d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x50,
-	0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x4a, 0x0a, 0x08, 0x46, 0x75,
-	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x20, 0x0a, 0x1e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x68, 0x0a, 0x1f, 0x55, 0x6e, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x70,
-	0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74,
-	0x68, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
-	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20,
-	0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62,
-	0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x22, 0x4e,
-	0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x22, 0x33,
-	0x0a, 0x11, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x72, 0x69, 0x76,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x72,
-	0x69, 0x76, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x46, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x14,
-	0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x62,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x22, 0xbc, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69,
-	0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
-	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61,
-	0x74, 0x68, 0x73, 0x22, 0x50, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x6e, 0x0a,
-	0x11, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62,
-	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e,
-	0x76, 0x69, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d,
-	0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61,
-	0x74, 0x68, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xc5, 0x03,
-	0x0a, 0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
-	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18,
-	0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x3d, 0x0a, 0x0f,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e,
-	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x75,
-	0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65,
-	0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
-	0x12, 0x45, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63,
-	0x63, 0x65, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x48, 0x00, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b,
-	0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11,
-	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32,
-	0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c,
-	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x72, 0x65, 0x61, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65,
-	0x61, 0x64, 0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4f,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0x5a, 0x0a, 0x1c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76,
-	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63,
-	0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70,
-	0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x54, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x37, 0x0a, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x43, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x95, 0x01,
-	0x0a, 0x18, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x6e, 0x6f,
-	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66,
-	0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f,
-	0x66, 0x66, 0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65,
-	0x6e, 0x41, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53,
-	0x65, 0x65, 0x6e, 0x41, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x0e, 0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44,
-	0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13,
-	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
-	0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x4b, 0x0a, 0x1f, 0x52, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f,
-	0x70, 0x61, 0x74, 0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x74, 0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x22, 0x0a, 0x20, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x1c, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61,
-	0x74, 0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x74, 0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f,
-	0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a,
-	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17,
-	0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x50,
-	0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53,
-	0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x75, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x75, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1e, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x20, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61,
-	0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61,
-	0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2f, 0x0a, 0x0d, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
-	0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x68,
-	0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x57, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65,
-	0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x73, 0x22, 0x36, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22,
-	0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69,
-	0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0xea, 0x01, 0x0a, 0x09, 0x45,
-	0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x45, 0x4e, 0x54, 0x52,
-	0x59, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x54,
-	0x52, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x11, 0x0a, 0x0d,
-	0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
-	0x1c, 0x0a, 0x18, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f,
-	0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x03, 0x12, 0x16, 0x0a,
-	0x12, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x52, 0x45,
-	0x41, 0x44, 0x59, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x52,
-	0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45,
-	0x53, 0x53, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x52, 0x45,
-	0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x06, 0x12, 0x10, 0x0a,
-	0x0c, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x07, 0x12,
-	0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45,
-	0x44, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x55, 0x50,
-	0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x09, 0x2a, 0x41, 0x0a, 0x0d, 0x4b, 0x65, 0x79, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x41, 0x53, 0x53,
-	0x57, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45,
-	0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x57, 0x49, 0x54, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12,
-	0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x03, 0x2a, 0x4b, 0x0a, 0x09, 0x46, 0x75,
-	0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x55, 0x50,
-	0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f,
-	0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55,
-	0x4e, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f,
-	0x55, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x6d, 0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55,
-	0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x56, 0x49,
-	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x55, 0x53, 0x41, 0x47,
-	0x45, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x49,
-	0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x16,
-	0x0a, 0x12, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44, 0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41,
-	0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x2a, 0x3b, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x45,
-	0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43, 0x45, 0x50,
-	0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43, 0x54, 0x45,
-	0x44, 0x10, 0x02, 0x32, 0xd2, 0x29, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70, 0x69,
-	0x12, 0x6d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f,
-	0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x61, 0x6c, 0x6c, 0x12,
-	0x63, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15,
-	0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x67, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x1f, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d,
-	0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
-	0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65,
-	0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12,
-	0x9b, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61,
-	0x69, 0x72, 0x56, 0x69, 0x61, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x27, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79,
-	0x50, 0x61, 0x69, 0x72, 0x56, 0x69, 0x61, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52,
-	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x56, 0x69, 0x61,
-	0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65,
-	0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57, 0x69,
-	0x74, 0x68, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a,
-	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1b,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79,
-	0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x57, 0x69, 0x74,
-	0x68, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f,
-	0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x63,
-	0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a, 0x0c,
-	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f,
-	0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x3a, 0x01, 0x2a, 0x12,
-	0x5f, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
-	0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c,
-	0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x30, 0x01,
-	0x12, 0x68, 0x0a, 0x0c, 0x54, 0x78, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x54, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x12, 0x19, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x30, 0x01, 0x12, 0x56, 0x0a, 0x08, 0x4f, 0x70,
-	0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4f,
-	0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22,
-	0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x3a,
-	0x01, 0x2a, 0x12, 0x63, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f,
-	0x72, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65,
-	0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x2a, 0x09, 0x2f, 0x76,
-	0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x9d, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x6e, 0x65,
-	0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69,
-	0x6e, 0x6b, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e,
-	0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46,
-	0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x7d, 0x2f, 0x67, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65,
-	0x4c, 0x69, 0x6e, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
-	0x22, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18,
-	0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x12, 0x77, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x20,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31,
-	0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d,
-	0x65, 0x12, 0x6b, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46,
-	0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69,
-	0x6c, 0x65, 0x73, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12, 0x53,
-	0x0a, 0x08, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x74,
-	0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x3a, 0x01,
-	0x2a, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c,
-	0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f,
-	0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82, 0xd3,
-	0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
-	0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x60, 0x0a, 0x0f, 0x54, 0x6f, 0x67, 0x67,
-	0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75,
-	0x73, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67,
-	0x67, 0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x58, 0x0a, 0x12, 0x47, 0x65,
-	0x74, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f,
-	0x66, 0x75, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b,
-	0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70,
-	0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x70,
-	0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x3a, 0x01, 0x2a,
-	0x12, 0x8c, 0x01, 0x0a, 0x17, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73,
-	0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73,
-	0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f,
-	0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
-	0x61, 0x73, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12,
-	0x7a, 0x0a, 0x12, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x73, 0x73, 0x70,
-	0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x65,
-	0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x54, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x19, 0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
-	0x73, 0x65, 0x73, 0x2f, 0x74, 0x65, 0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x15,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65,
-	0x79, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f,
-	0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x3a, 0x01, 0x2a, 0x12, 0x90, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72,
-	0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75,
-	0x70, 0x12, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x23, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f,
-	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x6b, 0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x65,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82,
-	0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x3a, 0x01, 0x2a, 0x12, 0x67, 0x0a, 0x0a, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x42,
-	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22,
-	0x19, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75,
-	0x63, 0x6b, 0x65, 0x74, 0x7d, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01,
-	0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f,
-	0x76, 0x31, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x94, 0x01, 0x0a,
-	0x18, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61,
-	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69,
-	0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b,
-	0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46,
-	0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
-	0x3a, 0x01, 0x2a, 0x12, 0x91, 0x01, 0x0a, 0x15, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69,
-	0x6c, 0x65, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-	0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27,
-	0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x69, 0x6e, 0x76, 0x69, 0x74,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x44, 0x7d, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
-	0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x20, 0x12, 0x1e, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x30, 0x01, 0x12, 0x59, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0d, 0x12, 0x0b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12,
-	0x6e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x76,
-	0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x7b, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x64,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76,
-	0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f,
-	0x7b, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12, 0x68, 0x0a, 0x0d,
-	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16,
-	0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x12, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
-	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x20, 0x2e, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
-	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x75, 0x63,
-	0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x76, 0x31, 0x2f, 0x62,
-	0x61, 0x63, 0x6b, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x13, 0x42, 0x75, 0x63, 0x6b,
-	0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12,
-	0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65,
-	0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12,
-	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x5a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61,
-	0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x7a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69,
-	0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x7e, 0x0a,
-	0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72,
-	0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
-	0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73,
-	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x9a, 0x01,
-	0x0a, 0x1a, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x28, 0x2e, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53,
-	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c,
-	0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6c, 0x61, 0x73,
-	0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x5e, 0x0a, 0x0b, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x18, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41,
-	0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72,
-	0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x27, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19,
-	0x22, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f,
-	0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
-	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+type DeleteBucketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-var (
-	file_space_proto_rawDescOnce sync.Once
-	file_space_proto_rawDescData = file_space_proto_rawDesc
-)
+func (x *DeleteBucketResponse) Reset() {
+	*x = DeleteBucketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[98]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-func file_space_proto_rawDescGZIP() []byte {
-	file_space_proto_rawDescOnce.Do(func() {
-		file_space_proto_rawDescData = protoimpl.X.CompressGZIP(file_space_proto_rawDescData)
-	})
-	return file_space_proto_rawDescData
+func (x *DeleteBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var file_space_proto_enumTypes = make([]protoimpl.EnumInfo, 5)
-var file_space_proto_msgTypes = make([]protoimpl.MessageInfo, 98)
-var file_space_proto_goTypes = []interface{}{
-	(EventType)(0),                             // 0: space.EventType
-	(KeyBackupType)(0),                         // 1: space.KeyBackupType
-	(FuseState)(0),                             // 2: space.FuseState
-	(NotificationType)(0),                      // 3: space.NotificationType
-	(InvitationStatus)(0),                      // 4: space.InvitationStatus
-	(*SearchFilesRequest)(nil),                 // 5: space.SearchFilesRequest
-	(*SearchFilesResponse)(nil),                // 6: space.SearchFilesResponse
-	(*SearchFilesDirectoryEntry)(nil),          // 7: space.SearchFilesDirectoryEntry
-	(*SetNotificationsLastSeenAtRequest)(nil),  // 8: space.SetNotificationsLastSeenAtRequest
-	(*SetNotificationsLastSeenAtResponse)(nil), // 9: space.SetNotificationsLastSeenAtResponse
-	(*GetSharedWithMeFilesRequest)(nil),        // 10: space.GetSharedWithMeFilesRequest
-	(*GetSharedWithMeFilesResponse)(nil),       // 11: space.GetSharedWithMeFilesResponse
-	(*GetSharedByMeFilesRequest)(nil),          // 12: space.GetSharedByMeFilesRequest
-	(*GetSharedByMeFilesResponse)(nil),         // 13: space.GetSharedByMeFilesResponse
-	(*GetUsageInfoRequest)(nil),                // 14: space.GetUsageInfoRequest
-	(*GetUsageInfoResponse)(nil),               // 15: space.GetUsageInfoResponse
-	(*ToggleBucketBackupRequest)(nil),          // 16: space.ToggleBucketBackupRequest
-	(*ToggleBucketBackupResponse)(nil),         // 17: space.ToggleBucketBackupResponse
-	(*BucketBackupRestoreRequest)(nil),         // 18: space.BucketBackupRestoreRequest
-	(*BucketBackupRestoreResponse)(nil),        // 19: space.BucketBackupRestoreResponse
-	(*ListDirectoriesRequest)(nil),             // 20: space.ListDirectoriesRequest
-	(*FileMember)(nil),                         // 21: space.FileMember
-	(*ListDirectoryEntry)(nil),                 // 22: space.ListDirectoryEntry
-	(*SharedListDirectoryEntry)(nil),           // 23: space.SharedListDirectoryEntry
-	(*ListDirectoriesResponse)(nil),            // 24: space.ListDirectoriesResponse
-	(*ListDirectoryRequest)(nil),               // 25: space.ListDirectoryRequest
-	(*ListDirectoryResponse)(nil),              // 26: space.ListDirectoryResponse
-	(*CreateBucketRequest)(nil),                // 27: space.CreateBucketRequest
-	(*BucketMember)(nil),                       // 28: space.BucketMember
-	(*Bucket)(nil),                             // 29: space.Bucket
-	(*CreateBucketResponse)(nil),               // 30: space.CreateBucketResponse
-	(*GenerateKeyPairRequest)(nil),             // 31: space.GenerateKeyPairRequest
-	(*GenerateKeyPairResponse)(nil),            // 32: space.GenerateKeyPairResponse
-	(*GetStoredMnemonicRequest)(nil),           // 33: space.GetStoredMnemonicRequest
-	(*GetStoredMnemonicResponse)(nil),          // 34: space.GetStoredMnemonicResponse
-	(*RestoreKeyPairViaMnemonicRequest)(nil),   // 35: space.RestoreKeyPairViaMnemonicRequest
-	(*RestoreKeyPairViaMnemonicResponse)(nil),  // 36: space.RestoreKeyPairViaMnemonicResponse
-	(*FileEventResponse)(nil),                  // 37: space.FileEventResponse
-	(*TextileEventResponse)(nil),               // 38: space.TextileEventResponse
-	(*OpenFileRequest)(nil),                    // 39: space.OpenFileRequest
-	(*OpenFileResponse)(nil),                   // 40: space.OpenFileResponse
-	(*OpenPublicFileRequest)(nil),              // 41: space.OpenPublicFileRequest
-	(*OpenPublicFileResponse)(nil),             // 42: space.OpenPublicFileResponse
-	(*AddItemsRequest)(nil),                    // 43: space.AddItemsRequest
-	(*AddItemResult)(nil),                      // 44: space.AddItemResult
-	(*AddItemsResponse)(nil),                   // 45: space.AddItemsResponse
-	(*CreateFolderRequest)(nil),                // 46: space.CreateFolderRequest
-	(*CreateFolderResponse)(nil),               // 47: space.CreateFolderResponse
-	(*BackupKeysByPassphraseRequest)(nil),      // 48: space.BackupKeysByPassphraseRequest
-	(*BackupKeysByPassphraseResponse)(nil),     // 49: space.BackupKeysByPassphraseResponse
-	(*RecoverKeysByPassphraseRequest)(nil),     // 50: space.RecoverKeysByPassphraseRequest
-	(*RecoverKeysByPassphraseResponse)(nil),    // 51: space.RecoverKeysByPassphraseResponse
-	(*TestKeysPassphraseRequest)(nil),          // 52: space.TestKeysPassphraseRequest
-	(*TestKeysPassphraseResponse)(nil),         // 53: space.TestKeysPassphraseResponse
-	(*ThreadInfo)(nil),                         // 54: space.ThreadInfo
-	(*ShareBucketRequest)(nil),                 // 55: space.ShareBucketRequest
-	(*ShareBucketResponse)(nil),                // 56: space.ShareBucketResponse
-	(*JoinBucketRequest)(nil),                  // 57: space.JoinBucketRequest
-	(*JoinBucketResponse)(nil),                 // 58: space.JoinBucketResponse
-	(*ShareFilesViaPublicKeyRequest)(nil),      // 59: space.ShareFilesViaPublicKeyRequest
-	(*FullPath)(nil),                           // 60: space.FullPath
-	(*ShareFilesViaPublicKeyResponse)(nil),     // 61: space.ShareFilesViaPublicKeyResponse
-	(*UnshareFilesViaPublicKeyRequest)(nil),    // 62: space.UnshareFilesViaPublicKeyRequest
-	(*UnshareFilesViaPublicKeyResponse)(nil),   // 63: space.UnshareFilesViaPublicKeyResponse
-	(*GeneratePublicFileLinkRequest)(nil),      // 64: space.GeneratePublicFileLinkRequest
-	(*GeneratePublicFileLinkResponse)(nil),     // 65: space.GeneratePublicFileLinkResponse
-	(*ToggleFuseRequest)(nil),                  // 66: space.ToggleFuseRequest
-	(*FuseDriveResponse)(nil),                  // 67: space.FuseDriveResponse
-	(*ListBucketsRequest)(nil),                 // 68: space.ListBucketsRequest
-	(*ListBucketsResponse)(nil),                // 69: space.ListBucketsResponse
-	(*Invitation)(nil),                         // 70: space.Invitation
-	(*UsageAlert)(nil),                         // 71: space.UsageAlert
-	(*InvitationAccept)(nil),                   // 72: space.InvitationAccept
-	(*RevokedInvitation)(nil),                  // 73: space.RevokedInvitation
-	(*Notification)(nil),                       // 74: space.Notification
-	(*HandleFilesInvitationRequest)(nil),       // 75: space.HandleFilesInvitationRequest
-	(*HandleFilesInvitationResponse)(nil),      // 76: space.HandleFilesInvitationResponse
-	(*NotificationEventResponse)(nil),          // 77: space.NotificationEventResponse
-	(*GetNotificationsRequest)(nil),            // 78: space.GetNotificationsRequest
-	(*GetNotificationsResponse)(nil),           // 79: space.GetNotificationsResponse
-	(*ReadNotificationRequest)(nil),            // 80: space.ReadNotificationRequest
-	(*ReadNotificationResponse)(nil),           // 81: space.ReadNotificationResponse
-	(*GetPublicKeyRequest)(nil),                // 82: space.GetPublicKeyRequest
-	(*GetPublicKeyResponse)(nil),               // 83: space.GetPublicKeyResponse
-	(*RecoverKeysByLocalBackupRequest)(nil),    // 84: space.RecoverKeysByLocalBackupRequest
-	(*RecoverKeysByLocalBackupResponse)(nil),   // 85: space.RecoverKeysByLocalBackupResponse
-	(*CreateLocalKeysBackupRequest)(nil),       // 86: space.CreateLocalKeysBackupRequest
-	(*CreateLocalKeysBackupResponse)(nil),      // 87: space.CreateLocalKeysBackupResponse
-	(*DeleteAccountRequest)(nil),               // 88: space.DeleteAccountRequest
-	(*DeleteAccountResponse)(nil),              // 89: space.DeleteAccountResponse
-	(*DeleteKeyPairRequest)(nil),               // 90: space.DeleteKeyPairRequest
-	(*DeleteKeyPairResponse)(nil),              // 91: space.DeleteKeyPairResponse
-	(*GetAPISessionTokensRequest)(nil),         // 92: space.GetAPISessionTokensRequest
-	(*GetAPISessionTokensResponse)(nil),        // 93: space.GetAPISessionTokensResponse
-	(*GetRecentlySharedWithRequest)(nil),       // 94: space.GetRecentlySharedWithRequest
-	(*GetRecentlySharedWithResponse)(nil),      // 95: space.GetRecentlySharedWithResponse
-	(*InitializeMasterAppTokenRequest)(nil),    // 96: space.InitializeMasterAppTokenRequest
-	(*InitializeMasterAppTokenResponse)(nil),   // 97: space.InitializeMasterAppTokenResponse
-	(*AllowedMethod)(nil),                      // 98: space.AllowedMethod
-	(*GenerateAppTokenRequest)(nil),            // 99: space.GenerateAppTokenRequest
-	(*GenerateAppTokenResponse)(nil),           // 100: space.GenerateAppTokenResponse
-	(*RemoveDirOrFileRequest)(nil),             // 101: space.RemoveDirOrFileRequest
-	(*RemoveDirOrFileResponse)(nil),            // 102: space.RemoveDirOrFileResponse
-	(*empty.Empty)(nil),                        // 103: google.protobuf.Empty
+func (*DeleteBucketResponse) ProtoMessage() {}
+
+func (x *DeleteBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[98]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
-var file_space_proto_depIdxs = []int32{
-	7,   // 0: space.SearchFilesResponse.entries:type_name -> space.SearchFilesDirectoryEntry
-	22,  // 1: space.SearchFilesDirectoryEntry.entry:type_name -> space.ListDirectoryEntry
-	23,  // 2: space.GetSharedWithMeFilesResponse.items:type_name -> space.SharedListDirectoryEntry
-	23,  // 3: space.GetSharedByMeFilesResponse.items:type_name -> space.SharedListDirectoryEntry
-	21,  // 4: space.ListDirectoryEntry.members:type_name -> space.FileMember
-	22,  // 5: space.SharedListDirectoryEntry.entry:type_name -> space.ListDirectoryEntry
-	22,  // 6: space.ListDirectoriesResponse.entries:type_name -> space.ListDirectoryEntry
-	22,  // 7: space.ListDirectoryResponse.entries:type_name -> space.ListDirectoryEntry
-	28,  // 8: space.Bucket.members:type_name -> space.BucketMember
-	29,  // 9: space.CreateBucketResponse.bucket:type_name -> space.Bucket
-	0,   // 10: space.FileEventResponse.type:type_name -> space.EventType
-	22,  // 11: space.FileEventResponse.entry:type_name -> space.ListDirectoryEntry
-	44,  // 12: space.AddItemsResponse.result:type_name -> space.AddItemResult
-	1,   // 13: space.BackupKeysByPassphraseRequest.type:type_name -> space.KeyBackupType
-	1,   // 14: space.RecoverKeysByPassphraseRequest.type:type_name -> space.KeyBackupType
-	54,  // 15: space.ShareBucketResponse.threadinfo:type_name -> space.ThreadInfo
-	54,  // 16: space.JoinBucketRequest.threadinfo:type_name -> space.ThreadInfo
-	60,  // 17: space.ShareFilesViaPublicKeyRequest.paths:type_name -> space.FullPath
-	60,  // 18: space.UnshareFilesViaPublicKeyRequest.paths:type_name -> space.FullPath
-	2,   // 19: space.FuseDriveResponse.state:type_name -> space.FuseState
-	29,  // 20: space.ListBucketsResponse.buckets:type_name -> space.Bucket
-	4,   // 21: space.Invitation.status:type_name -> space.InvitationStatus
-	60,  // 22: space.Invitation.itemPaths:type_name -> space.FullPath
-	60,  // 23: space.RevokedInvitation.itemPaths:type_name -> space.FullPath
-	70,  // 24: space.Notification.invitationValue:type_name -> space.Invitation
-	71,  // 25: space.Notification.usageAlert:type_name -> space.UsageAlert
-	72,  // 26: space.Notification.invitationAccept:type_name -> space.InvitationAccept
-	73,  // 27: space.Notification.revokedInvitation:type_name -> space.RevokedInvitation
-	3,   // 28: space.Notification.type:type_name -> space.NotificationType
-	74,  // 29: space.NotificationEventResponse.notification:type_name -> space.Notification
-	74,  // 30: space.GetNotificationsResponse.notifications:type_name -> space.Notification
-	21,  // 31: space.GetRecentlySharedWithResponse.members:type_name -> space.FileMember
-	98,  // 32: space.GenerateAppTokenRequest.allowedMethods:type_name -> space.AllowedMethod
-	20,  // 33: space.SpaceApi.ListDirectories:input_type -> space.ListDirectoriesRequest
-	25,  // 34: space.SpaceApi.ListDirectory:input_type -> space.ListDirectoryRequest
-	31,  // 35: space.SpaceApi.GenerateKeyPair:input_type -> space.GenerateKeyPairRequest
-	33,  // 36: space.SpaceApi.GetStoredMnemonic:input_type -> space.GetStoredMnemonicRequest
-	35,  // 37: space.SpaceApi.RestoreKeyPairViaMnemonic:input_type -> space.RestoreKeyPairViaMnemonicRequest
-	90,  // 38: space.SpaceApi.DeleteKeyPair:input_type -> space.DeleteKeyPairRequest
-	31,  // 39: space.SpaceApi.GenerateKeyPairWithForce:input_type -> space.GenerateKeyPairRequest
-	82,  // 40: space.SpaceApi.GetPublicKey:input_type -> space.GetPublicKeyRequest
-	103, // 41: space.SpaceApi.Subscribe:input_type -> google.protobuf.Empty
-	103, // 42: space.SpaceApi.TxlSubscribe:input_type -> google.protobuf.Empty
-	39,  // 43: space.SpaceApi.OpenFile:input_type -> space.OpenFileRequest
-	101, // 44: space.SpaceApi.RemoveDirOrFile:input_type -> space.RemoveDirOrFileRequest
-	64,  // 45: space.SpaceApi.GeneratePublicFileLink:input_type -> space.GeneratePublicFileLinkRequest
-	10,  // 46: space.SpaceApi.GetSharedWithMeFiles:input_type -> space.GetSharedWithMeFilesRequest
-	12,  // 47: space.SpaceApi.GetSharedByMeFiles:input_type -> space.GetSharedByMeFilesRequest
-	41,  // 48: space.SpaceApi.OpenPublicFile:input_type -> space.OpenPublicFileRequest
-	43,  // 49: space.SpaceApi.AddItems:input_type -> space.AddItemsRequest
-	46,  // 50: space.SpaceApi.CreateFolder:input_type -> space.CreateFolderRequest
-	66,  // 51: space.SpaceApi.ToggleFuseDrive:input_type -> space.ToggleFuseRequest
-	103, // 52: space.SpaceApi.GetFuseDriveStatus:input_type -> google.protobuf.Empty
-	27,  // 53: space.SpaceApi.CreateBucket:input_type -> space.CreateBucketRequest
-	48,  // 54: space.SpaceApi.BackupKeysByPassphrase:input_type -> space.BackupKeysByPassphraseRequest
-	50,  // 55: space.SpaceApi.RecoverKeysByPassphrase:input_type -> space.RecoverKeysByPassphraseRequest
-	52,  // 56: space.SpaceApi.TestKeysPassphrase:input_type -> space.TestKeysPassphraseRequest
-	86,  // 57: space.SpaceApi.CreateLocalKeysBackup:input_type -> space.CreateLocalKeysBackupRequest
-	84,  // 58: space.SpaceApi.RecoverKeysByLocalBackup:input_type -> space.RecoverKeysByLocalBackupRequest
-	55,  // 59: space.SpaceApi.ShareBucket:input_type -> space.ShareBucketRequest
-	57,  // 60: space.SpaceApi.JoinBucket:input_type -> space.JoinBucketRequest
-	59,  // 61: space.SpaceApi.ShareFilesViaPublicKey:input_type -> space.ShareFilesViaPublicKeyRequest
-	62,  // 62: space.SpaceApi.UnshareFilesViaPublicKey:input_type -> space.UnshareFilesViaPublicKeyRequest
-	75,  // 63: space.SpaceApi.HandleFilesInvitation:input_type -> space.HandleFilesInvitationRequest
-	103, // 64: space.SpaceApi.NotificationSubscribe:input_type -> google.protobuf.Empty
-	68,  // 65: space.SpaceApi.ListBuckets:input_type -> space.ListBucketsRequest
-	78,  // 66: space.SpaceApi.GetNotifications:input_type -> space.GetNotificationsRequest
-	80,  // 67: space.SpaceApi.ReadNotification:input_type -> space.ReadNotificationRequest
-	88,  // 68: space.SpaceApi.DeleteAccount:input_type -> space.DeleteAccountRequest
-	16,  // 69: space.SpaceApi.ToggleBucketBackup:input_type -> space.ToggleBucketBackupRequest
-	18,  // 70: space.SpaceApi.BucketBackupRestore:input_type -> space.BucketBackupRestoreRequest
-	14,  // 71: space.SpaceApi.GetUsageInfo:input_type -> space.GetUsageInfoRequest
-	92,  // 72: space.SpaceApi.GetAPISessionTokens:input_type -> space.GetAPISessionTokensRequest
-	94,  // 73: space.SpaceApi.GetRecentlySharedWith:input_type -> space.GetRecentlySharedWithRequest
-	8,   // 74: space.SpaceApi.SetNotificationsLastSeenAt:input_type -> space.SetNotificationsLastSeenAtRequest
-	5,   // 75: space.SpaceApi.SearchFiles:input_type -> space.SearchFilesRequest
-	96,  // 76: space.SpaceApi.InitializeMasterAppToken:input_type -> space.InitializeMasterAppTokenRequest
-	99,  // 77: space.SpaceApi.GenerateAppToken:input_type -> space.GenerateAppTokenRequest
-	24,  // 78: space.SpaceApi.ListDirectories:output_type -> space.ListDirectoriesResponse
-	26,  // 79: space.SpaceApi.ListDirectory:output_type -> space.ListDirectoryResponse
-	32,  // 80: space.SpaceApi.GenerateKeyPair:output_type -> space.GenerateKeyPairResponse
-	34,  // 81: space.SpaceApi.GetStoredMnemonic:output_type -> space.GetStoredMnemonicResponse
-	36,  // 82: space.SpaceApi.RestoreKeyPairViaMnemonic:output_type -> space.RestoreKeyPairViaMnemonicResponse
-	91,  // 83: space.SpaceApi.DeleteKeyPair:output_type -> space.DeleteKeyPairResponse
-	32,  // 84: space.SpaceApi.GenerateKeyPairWithForce:output_type -> space.GenerateKeyPairResponse
-	83,  // 85: space.SpaceApi.GetPublicKey:output_type -> space.GetPublicKeyResponse
-	37,  // 86: space.SpaceApi.Subscribe:output_type -> space.FileEventResponse
-	38,  // 87: space.SpaceApi.TxlSubscribe:output_type -> space.TextileEventResponse
-	40,  // 88: space.SpaceApi.OpenFile:output_type -> space.OpenFileResponse
-	102, // 89: space.SpaceApi.RemoveDirOrFile:output_type -> space.RemoveDirOrFileResponse
-	65,  // 90: space.SpaceApi.GeneratePublicFileLink:output_type -> space.GeneratePublicFileLinkResponse
-	11,  // 91: space.SpaceApi.GetSharedWithMeFiles:output_type -> space.GetSharedWithMeFilesResponse
-	13,  // 92: space.SpaceApi.GetSharedByMeFiles:output_type -> space.GetSharedByMeFilesResponse
-	42,  // 93: space.SpaceApi.OpenPublicFile:output_type -> space.OpenPublicFileResponse
-	45,  // 94: space.SpaceApi.AddItems:output_type -> space.AddItemsResponse
-	47,  // 95: space.SpaceApi.CreateFolder:output_type -> space.CreateFolderResponse
-	67,  // 96: space.SpaceApi.ToggleFuseDrive:output_type -> space.FuseDriveResponse
-	67,  // 97: space.SpaceApi.GetFuseDriveStatus:output_type -> space.FuseDriveResponse
-	30,  // 98: space.SpaceApi.CreateBucket:output_type -> space.CreateBucketResponse
-	49,  // 99: space.SpaceApi.BackupKeysByPassphrase:output_type -> space.BackupKeysByPassphraseResponse
-	51,  // 100: space.SpaceApi.RecoverKeysByPassphrase:output_type -> space.RecoverKeysByPassphraseResponse
-	53,  // 101: space.SpaceApi.TestKeysPassphrase:output_type -> space.TestKeysPassphraseResponse
-	87,  // 102: space.SpaceApi.CreateLocalKeysBackup:output_type -> space.CreateLocalKeysBackupResponse
-	85,  // 103: space.SpaceApi.RecoverKeysByLocalBackup:output_type -> space.RecoverKeysByLocalBackupResponse
-	56,  // 104: space.SpaceApi.ShareBucket:output_type -> space.ShareBucketResponse
-	58,  // 105: space.SpaceApi.JoinBucket:output_type -> space.JoinBucketResponse
-	61,  // 106: space.SpaceApi.ShareFilesViaPublicKey:output_type -> space.ShareFilesViaPublicKeyResponse
-	63,  // 107: space.SpaceApi.UnshareFilesViaPublicKey:output_type -> space.UnshareFilesViaPublicKeyResponse
-	76,  // 108: space.SpaceApi.HandleFilesInvitation:output_type -> space.HandleFilesInvitationResponse
-	77,  // 109: space.SpaceApi.NotificationSubscribe:output_type -> space.NotificationEventResponse
-	69,  // 110: space.SpaceApi.ListBuckets:output_type -> space.ListBucketsResponse
-	79,  // 111: space.SpaceApi.GetNotifications:output_type -> space.GetNotificationsResponse
-	81,  // 112: space.SpaceApi.ReadNotification:output_type -> space.ReadNotificationResponse
-	89,  // 113: space.SpaceApi.DeleteAccount:output_type -> space.DeleteAccountResponse
-	17,  // 114: space.SpaceApi.ToggleBucketBackup:output_type -> space.ToggleBucketBackupResponse
-	19,  // 115: space.SpaceApi.BucketBackupRestore:output_type -> space.BucketBackupRestoreResponse
-	15,  // 116: space.SpaceApi.GetUsageInfo:output_type -> space.GetUsageInfoResponse
-	93,  // 117: space.SpaceApi.GetAPISessionTokens:output_type -> space.GetAPISessionTokensResponse
-	95,  // 118: space.SpaceApi.GetRecentlySharedWith:output_type -> space.GetRecentlySharedWithResponse
-	9,   // 119: space.SpaceApi.SetNotificationsLastSeenAt:output_type -> space.SetNotificationsLastSeenAtResponse
-	6,   // 120: space.SpaceApi.SearchFiles:output_type -> space.SearchFilesResponse
-	97,  // 121: space.SpaceApi.InitializeMasterAppToken:output_type -> space.InitializeMasterAppTokenResponse
-	100, // 122: space.SpaceApi.GenerateAppToken:output_type -> space.GenerateAppTokenResponse
-	78,  // [78:123] is the sub-list for method output_type
-	33,  // [33:78] is the sub-list for method input_type
-	33,  // [33:33] is the sub-list for extension type_name
-	33,  // [33:33] is the sub-list for extension extendee
-	0,   // [0:33] is the sub-list for field type_name
+
+// Deprecated: Use DeleteBucketResponse.ProtoReflect.Descriptor instead.
+func (*DeleteBucketResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{98}
 }
 
-func init() { file_space_proto_init() }
-func file_space_proto_init() {
-	if File_space_proto != nil {
-		return
+type RenameBucketRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket  string `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	NewSlug string `protobuf:"bytes,2,opt,name=newSlug,proto3" json:"newSlug,omitempty"`
+}
+
+func (x *RenameBucketRequest) Reset() {
+	*x = RenameBucketRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[99]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_space_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchFilesRequest); i {
+}
+
+func (x *RenameBucketRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameBucketRequest) ProtoMessage() {}
+
+func (x *RenameBucketRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[99]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameBucketRequest.ProtoReflect.Descriptor instead.
+func (*RenameBucketRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *RenameBucketRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+func (x *RenameBucketRequest) GetNewSlug() string {
+	if x != nil {
+		return x.NewSlug
+	}
+	return ""
+}
+
+type RenameBucketResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Bucket *Bucket `protobuf:"bytes,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *RenameBucketResponse) Reset() {
+	*x = RenameBucketResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[100]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameBucketResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameBucketResponse) ProtoMessage() {}
+
+func (x *RenameBucketResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[100]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameBucketResponse.ProtoReflect.Descriptor instead.
+func (*RenameBucketResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *RenameBucketResponse) GetBucket() *Bucket {
+	if x != nil {
+		return x.Bucket
+	}
+	return nil
+}
+
+type Invitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviterPublicKey string           `protobuf:"bytes,1,opt,name=inviterPublicKey,proto3" json:"inviterPublicKey,omitempty"`
+	InvitationID     string           `protobuf:"bytes,2,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+	Status           InvitationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=space.InvitationStatus" json:"status,omitempty"`
+	ItemPaths        []*FullPath      `protobuf:"bytes,5,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
+	ExpiresAt        int64            `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+}
+
+func (x *Invitation) Reset() {
+	*x = Invitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[101]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invitation) ProtoMessage() {}
+
+func (x *Invitation) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[101]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invitation.ProtoReflect.Descriptor instead.
+func (*Invitation) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *Invitation) GetInviterPublicKey() string {
+	if x != nil {
+		return x.InviterPublicKey
+	}
+	return ""
+}
+
+func (x *Invitation) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+func (x *Invitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_PENDING
+}
+
+func (x *Invitation) GetItemPaths() []*FullPath {
+	if x != nil {
+		return x.ItemPaths
+	}
+	return nil
+}
+
+func (x *Invitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type UsageAlert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Used    int64  `protobuf:"varint,1,opt,name=used,proto3" json:"used,omitempty"`
+	Limit   int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *UsageAlert) Reset() {
+	*x = UsageAlert{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[102]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageAlert) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageAlert) ProtoMessage() {}
+
+func (x *UsageAlert) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[102]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageAlert.ProtoReflect.Descriptor instead.
+func (*UsageAlert) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *UsageAlert) GetUsed() int64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *UsageAlert) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *UsageAlert) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type InvitationAccept struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationID string `protobuf:"bytes,2,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+}
+
+func (x *InvitationAccept) Reset() {
+	*x = InvitationAccept{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[103]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvitationAccept) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvitationAccept) ProtoMessage() {}
+
+func (x *InvitationAccept) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[103]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvitationAccept.ProtoReflect.Descriptor instead.
+func (*InvitationAccept) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *InvitationAccept) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+type RevokedInvitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InviterPublicKey string      `protobuf:"bytes,1,opt,name=inviterPublicKey,proto3" json:"inviterPublicKey,omitempty"`
+	ItemPaths        []*FullPath `protobuf:"bytes,5,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
+}
+
+func (x *RevokedInvitation) Reset() {
+	*x = RevokedInvitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[104]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokedInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokedInvitation) ProtoMessage() {}
+
+func (x *RevokedInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[104]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokedInvitation.ProtoReflect.Descriptor instead.
+func (*RevokedInvitation) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *RevokedInvitation) GetInviterPublicKey() string {
+	if x != nil {
+		return x.InviterPublicKey
+	}
+	return ""
+}
+
+func (x *RevokedInvitation) GetItemPaths() []*FullPath {
+	if x != nil {
+		return x.ItemPaths
+	}
+	return nil
+}
+
+type Notification struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID      string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"` // underlying message id from textile
+	Subject string `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	Body    string `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	// Types that are assignable to RelatedObject:
+	//	*Notification_InvitationValue
+	//	*Notification_UsageAlert
+	//	*Notification_InvitationAccept
+	//	*Notification_RevokedInvitation
+	//	*Notification_KeyShare
+	//	*Notification_KeyRotation
+	RelatedObject isNotification_RelatedObject `protobuf_oneof:"relatedObject"`
+	Type          NotificationType             `protobuf:"varint,8,opt,name=type,proto3,enum=space.NotificationType" json:"type,omitempty"`
+	CreatedAt     int64                        `protobuf:"varint,9,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ReadAt        int64                        `protobuf:"varint,10,opt,name=readAt,proto3" json:"readAt,omitempty"`
+}
+
+func (x *Notification) Reset() {
+	*x = Notification{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[105]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Notification) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Notification) ProtoMessage() {}
+
+func (x *Notification) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[105]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Notification.ProtoReflect.Descriptor instead.
+func (*Notification) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *Notification) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+func (x *Notification) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *Notification) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (m *Notification) GetRelatedObject() isNotification_RelatedObject {
+	if m != nil {
+		return m.RelatedObject
+	}
+	return nil
+}
+
+func (x *Notification) GetInvitationValue() *Invitation {
+	if x, ok := x.GetRelatedObject().(*Notification_InvitationValue); ok {
+		return x.InvitationValue
+	}
+	return nil
+}
+
+func (x *Notification) GetUsageAlert() *UsageAlert {
+	if x, ok := x.GetRelatedObject().(*Notification_UsageAlert); ok {
+		return x.UsageAlert
+	}
+	return nil
+}
+
+func (x *Notification) GetInvitationAccept() *InvitationAccept {
+	if x, ok := x.GetRelatedObject().(*Notification_InvitationAccept); ok {
+		return x.InvitationAccept
+	}
+	return nil
+}
+
+func (x *Notification) GetRevokedInvitation() *RevokedInvitation {
+	if x, ok := x.GetRelatedObject().(*Notification_RevokedInvitation); ok {
+		return x.RevokedInvitation
+	}
+	return nil
+}
+
+func (x *Notification) GetKeyShare() *ReceivedKeyShare {
+	if x, ok := x.GetRelatedObject().(*Notification_KeyShare); ok {
+		return x.KeyShare
+	}
+	return nil
+}
+
+func (x *Notification) GetKeyRotation() *KeyRotation {
+	if x, ok := x.GetRelatedObject().(*Notification_KeyRotation); ok {
+		return x.KeyRotation
+	}
+	return nil
+}
+
+func (x *Notification) GetType() NotificationType {
+	if x != nil {
+		return x.Type
+	}
+	return NotificationType_UNKNOWN
+}
+
+func (x *Notification) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Notification) GetReadAt() int64 {
+	if x != nil {
+		return x.ReadAt
+	}
+	return 0
+}
+
+type isNotification_RelatedObject interface {
+	isNotification_RelatedObject()
+}
+
+type Notification_InvitationValue struct {
+	InvitationValue *Invitation `protobuf:"bytes,4,opt,name=invitationValue,proto3,oneof"`
+}
+
+type Notification_UsageAlert struct {
+	UsageAlert *UsageAlert `protobuf:"bytes,5,opt,name=usageAlert,proto3,oneof"`
+}
+
+type Notification_InvitationAccept struct {
+	InvitationAccept *InvitationAccept `protobuf:"bytes,6,opt,name=invitationAccept,proto3,oneof"`
+}
+
+type Notification_RevokedInvitation struct {
+	RevokedInvitation *RevokedInvitation `protobuf:"bytes,7,opt,name=revokedInvitation,proto3,oneof"`
+}
+
+type Notification_KeyShare struct {
+	KeyShare *ReceivedKeyShare `protobuf:"bytes,11,opt,name=keyShare,proto3,oneof"`
+}
+
+type Notification_KeyRotation struct {
+	KeyRotation *KeyRotation `protobuf:"bytes,12,opt,name=keyRotation,proto3,oneof"`
+}
+
+func (*Notification_InvitationValue) isNotification_RelatedObject() {}
+
+func (*Notification_UsageAlert) isNotification_RelatedObject() {}
+
+func (*Notification_InvitationAccept) isNotification_RelatedObject() {}
+
+func (*Notification_RevokedInvitation) isNotification_RelatedObject() {}
+
+func (*Notification_KeyShare) isNotification_RelatedObject() {}
+
+func (*Notification_KeyRotation) isNotification_RelatedObject() {}
+
+type ReceivedKeyShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OwnerPublicKey string `protobuf:"bytes,1,opt,name=ownerPublicKey,proto3" json:"ownerPublicKey,omitempty"`
+	Payload        string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (x *ReceivedKeyShare) Reset() {
+	*x = ReceivedKeyShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[106]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReceivedKeyShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivedKeyShare) ProtoMessage() {}
+
+func (x *ReceivedKeyShare) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[106]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivedKeyShare.ProtoReflect.Descriptor instead.
+func (*ReceivedKeyShare) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ReceivedKeyShare) GetOwnerPublicKey() string {
+	if x != nil {
+		return x.OwnerPublicKey
+	}
+	return ""
+}
+
+func (x *ReceivedKeyShare) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+// a contact replaced their identity key
+type KeyRotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OldPublicKey string `protobuf:"bytes,1,opt,name=oldPublicKey,proto3" json:"oldPublicKey,omitempty"`
+	NewPublicKey string `protobuf:"bytes,2,opt,name=newPublicKey,proto3" json:"newPublicKey,omitempty"`
+	RotatedAt    int64  `protobuf:"varint,3,opt,name=rotatedAt,proto3" json:"rotatedAt,omitempty"`
+}
+
+func (x *KeyRotation) Reset() {
+	*x = KeyRotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[107]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyRotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyRotation) ProtoMessage() {}
+
+func (x *KeyRotation) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[107]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyRotation.ProtoReflect.Descriptor instead.
+func (*KeyRotation) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *KeyRotation) GetOldPublicKey() string {
+	if x != nil {
+		return x.OldPublicKey
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetNewPublicKey() string {
+	if x != nil {
+		return x.NewPublicKey
+	}
+	return ""
+}
+
+func (x *KeyRotation) GetRotatedAt() int64 {
+	if x != nil {
+		return x.RotatedAt
+	}
+	return 0
+}
+
+type HandleFilesInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationID string `protobuf:"bytes,1,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+	Accept       bool   `protobuf:"varint,2,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (x *HandleFilesInvitationRequest) Reset() {
+	*x = HandleFilesInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[108]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleFilesInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleFilesInvitationRequest) ProtoMessage() {}
+
+func (x *HandleFilesInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[108]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleFilesInvitationRequest.ProtoReflect.Descriptor instead.
+func (*HandleFilesInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *HandleFilesInvitationRequest) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+func (x *HandleFilesInvitationRequest) GetAccept() bool {
+	if x != nil {
+		return x.Accept
+	}
+	return false
+}
+
+type HandleFilesInvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *HandleFilesInvitationResponse) Reset() {
+	*x = HandleFilesInvitationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[109]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HandleFilesInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HandleFilesInvitationResponse) ProtoMessage() {}
+
+func (x *HandleFilesInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[109]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HandleFilesInvitationResponse.ProtoReflect.Descriptor instead.
+func (*HandleFilesInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{109}
+}
+
+type SentInvitation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationID     string           `protobuf:"bytes,1,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+	InviteePublicKey string           `protobuf:"bytes,2,opt,name=inviteePublicKey,proto3" json:"inviteePublicKey,omitempty"`
+	ItemPaths        []*FullPath      `protobuf:"bytes,3,rep,name=itemPaths,proto3" json:"itemPaths,omitempty"`
+	Status           InvitationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=space.InvitationStatus" json:"status,omitempty"`
+	CreatedAt        int64            `protobuf:"varint,5,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt        int64            `protobuf:"varint,6,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	// pending invitation past its expiry date, treated as rejected
+	Expired bool `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+}
+
+func (x *SentInvitation) Reset() {
+	*x = SentInvitation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[110]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SentInvitation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SentInvitation) ProtoMessage() {}
+
+func (x *SentInvitation) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[110]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SentInvitation.ProtoReflect.Descriptor instead.
+func (*SentInvitation) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *SentInvitation) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+func (x *SentInvitation) GetInviteePublicKey() string {
+	if x != nil {
+		return x.InviteePublicKey
+	}
+	return ""
+}
+
+func (x *SentInvitation) GetItemPaths() []*FullPath {
+	if x != nil {
+		return x.ItemPaths
+	}
+	return nil
+}
+
+func (x *SentInvitation) GetStatus() InvitationStatus {
+	if x != nil {
+		return x.Status
+	}
+	return InvitationStatus_PENDING
+}
+
+func (x *SentInvitation) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *SentInvitation) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *SentInvitation) GetExpired() bool {
+	if x != nil {
+		return x.Expired
+	}
+	return false
+}
+
+type ListSentInvitationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seek  string `protobuf:"bytes,1,opt,name=seek,proto3" json:"seek,omitempty"`
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *ListSentInvitationsRequest) Reset() {
+	*x = ListSentInvitationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[111]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSentInvitationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSentInvitationsRequest) ProtoMessage() {}
+
+func (x *ListSentInvitationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[111]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSentInvitationsRequest.ProtoReflect.Descriptor instead.
+func (*ListSentInvitationsRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *ListSentInvitationsRequest) GetSeek() string {
+	if x != nil {
+		return x.Seek
+	}
+	return ""
+}
+
+func (x *ListSentInvitationsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListSentInvitationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Invitations []*SentInvitation `protobuf:"bytes,1,rep,name=invitations,proto3" json:"invitations,omitempty"`
+	NextOffset  string            `protobuf:"bytes,2,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
+}
+
+func (x *ListSentInvitationsResponse) Reset() {
+	*x = ListSentInvitationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[112]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSentInvitationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSentInvitationsResponse) ProtoMessage() {}
+
+func (x *ListSentInvitationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[112]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSentInvitationsResponse.ProtoReflect.Descriptor instead.
+func (*ListSentInvitationsResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *ListSentInvitationsResponse) GetInvitations() []*SentInvitation {
+	if x != nil {
+		return x.Invitations
+	}
+	return nil
+}
+
+func (x *ListSentInvitationsResponse) GetNextOffset() string {
+	if x != nil {
+		return x.NextOffset
+	}
+	return ""
+}
+
+type CancelInvitationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	InvitationID string `protobuf:"bytes,1,opt,name=invitationID,proto3" json:"invitationID,omitempty"`
+}
+
+func (x *CancelInvitationRequest) Reset() {
+	*x = CancelInvitationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[113]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelInvitationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvitationRequest) ProtoMessage() {}
+
+func (x *CancelInvitationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[113]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvitationRequest.ProtoReflect.Descriptor instead.
+func (*CancelInvitationRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *CancelInvitationRequest) GetInvitationID() string {
+	if x != nil {
+		return x.InvitationID
+	}
+	return ""
+}
+
+type CancelInvitationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CancelInvitationResponse) Reset() {
+	*x = CancelInvitationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[114]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CancelInvitationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelInvitationResponse) ProtoMessage() {}
+
+func (x *CancelInvitationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[114]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelInvitationResponse.ProtoReflect.Descriptor instead.
+func (*CancelInvitationResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{114}
+}
+
+type NotificationEventResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notification *Notification `protobuf:"bytes,1,opt,name=notification,proto3" json:"notification,omitempty"`
+	// increasing number of the event, used to resume the subscription
+	Seq uint64 `protobuf:"varint,2,opt,name=seq,proto3" json:"seq,omitempty"`
+	// set on an event without notification when resuming from sinceSeq missed events that are no longer logged
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+}
+
+func (x *NotificationEventResponse) Reset() {
+	*x = NotificationEventResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[115]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotificationEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationEventResponse) ProtoMessage() {}
+
+func (x *NotificationEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[115]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationEventResponse.ProtoReflect.Descriptor instead.
+func (*NotificationEventResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *NotificationEventResponse) GetNotification() *Notification {
+	if x != nil {
+		return x.Notification
+	}
+	return nil
+}
+
+func (x *NotificationEventResponse) GetSeq() uint64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *NotificationEventResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+type GetNotificationsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Seek  string `protobuf:"bytes,1,opt,name=seek,proto3" json:"seek,omitempty"`
+	Limit int64  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (x *GetNotificationsRequest) Reset() {
+	*x = GetNotificationsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[116]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsRequest) ProtoMessage() {}
+
+func (x *GetNotificationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[116]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationsRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *GetNotificationsRequest) GetSeek() string {
+	if x != nil {
+		return x.Seek
+	}
+	return ""
+}
+
+func (x *GetNotificationsRequest) GetLimit() int64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetNotificationsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Notifications []*Notification `protobuf:"bytes,1,rep,name=notifications,proto3" json:"notifications,omitempty"`
+	NextOffset    string          `protobuf:"bytes,2,opt,name=nextOffset,proto3" json:"nextOffset,omitempty"`
+	LastSeenAt    int64           `protobuf:"varint,3,opt,name=lastSeenAt,proto3" json:"lastSeenAt,omitempty"`
+}
+
+func (x *GetNotificationsResponse) Reset() {
+	*x = GetNotificationsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[117]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNotificationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationsResponse) ProtoMessage() {}
+
+func (x *GetNotificationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[117]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationsResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetNotificationsResponse) GetNotifications() []*Notification {
+	if x != nil {
+		return x.Notifications
+	}
+	return nil
+}
+
+func (x *GetNotificationsResponse) GetNextOffset() string {
+	if x != nil {
+		return x.NextOffset
+	}
+	return ""
+}
+
+func (x *GetNotificationsResponse) GetLastSeenAt() int64 {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return 0
+}
+
+type ReadNotificationRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ID string `protobuf:"bytes,1,opt,name=ID,proto3" json:"ID,omitempty"`
+}
+
+func (x *ReadNotificationRequest) Reset() {
+	*x = ReadNotificationRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[118]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadNotificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadNotificationRequest) ProtoMessage() {}
+
+func (x *ReadNotificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[118]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadNotificationRequest.ProtoReflect.Descriptor instead.
+func (*ReadNotificationRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *ReadNotificationRequest) GetID() string {
+	if x != nil {
+		return x.ID
+	}
+	return ""
+}
+
+type ReadNotificationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ReadNotificationResponse) Reset() {
+	*x = ReadNotificationResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[119]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReadNotificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReadNotificationResponse) ProtoMessage() {}
+
+func (x *ReadNotificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[119]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReadNotificationResponse.ProtoReflect.Descriptor instead.
+func (*ReadNotificationResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{119}
+}
+
+type GetPublicKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetPublicKeyRequest) Reset() {
+	*x = GetPublicKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[120]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyRequest) ProtoMessage() {}
+
+func (x *GetPublicKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[120]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyRequest.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{120}
+}
+
+type GetPublicKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Public key encoded in hex
+	PublicKey string `protobuf:"bytes,1,opt,name=publicKey,proto3" json:"publicKey,omitempty"`
+}
+
+func (x *GetPublicKeyResponse) Reset() {
+	*x = GetPublicKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[121]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPublicKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPublicKeyResponse) ProtoMessage() {}
+
+func (x *GetPublicKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[121]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPublicKeyResponse.ProtoReflect.Descriptor instead.
+func (*GetPublicKeyResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetPublicKeyResponse) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+type RecoverKeysByLocalBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PathToKeyBackup string `protobuf:"bytes,1,opt,name=pathToKeyBackup,proto3" json:"pathToKeyBackup,omitempty"`
+	// passphrase used when creating the backup. Ignored for legacy backups
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+}
+
+func (x *RecoverKeysByLocalBackupRequest) Reset() {
+	*x = RecoverKeysByLocalBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[122]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverKeysByLocalBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverKeysByLocalBackupRequest) ProtoMessage() {}
+
+func (x *RecoverKeysByLocalBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[122]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverKeysByLocalBackupRequest.ProtoReflect.Descriptor instead.
+func (*RecoverKeysByLocalBackupRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *RecoverKeysByLocalBackupRequest) GetPathToKeyBackup() string {
+	if x != nil {
+		return x.PathToKeyBackup
+	}
+	return ""
+}
+
+func (x *RecoverKeysByLocalBackupRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+type RecoverKeysByLocalBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecoverKeysByLocalBackupResponse) Reset() {
+	*x = RecoverKeysByLocalBackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[123]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverKeysByLocalBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverKeysByLocalBackupResponse) ProtoMessage() {}
+
+func (x *RecoverKeysByLocalBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[123]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverKeysByLocalBackupResponse.ProtoReflect.Descriptor instead.
+func (*RecoverKeysByLocalBackupResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{123}
+}
+
+type CreateLocalKeysBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The path in which to save the backup
+	PathToKeyBackup string `protobuf:"bytes,1,opt,name=pathToKeyBackup,proto3" json:"pathToKeyBackup,omitempty"`
+	// The passphrase used to encrypt the backup
+	Passphrase string `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	// Optional secrets to include besides the private key
+	IncludeMnemonic   bool `protobuf:"varint,3,opt,name=includeMnemonic,proto3" json:"includeMnemonic,omitempty"`
+	IncludeAppTokens  bool `protobuf:"varint,4,opt,name=includeAppTokens,proto3" json:"includeAppTokens,omitempty"`
+	IncludeThreadKeys bool `protobuf:"varint,5,opt,name=includeThreadKeys,proto3" json:"includeThreadKeys,omitempty"`
+}
+
+func (x *CreateLocalKeysBackupRequest) Reset() {
+	*x = CreateLocalKeysBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[124]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLocalKeysBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLocalKeysBackupRequest) ProtoMessage() {}
+
+func (x *CreateLocalKeysBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[124]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLocalKeysBackupRequest.ProtoReflect.Descriptor instead.
+func (*CreateLocalKeysBackupRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *CreateLocalKeysBackupRequest) GetPathToKeyBackup() string {
+	if x != nil {
+		return x.PathToKeyBackup
+	}
+	return ""
+}
+
+func (x *CreateLocalKeysBackupRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *CreateLocalKeysBackupRequest) GetIncludeMnemonic() bool {
+	if x != nil {
+		return x.IncludeMnemonic
+	}
+	return false
+}
+
+func (x *CreateLocalKeysBackupRequest) GetIncludeAppTokens() bool {
+	if x != nil {
+		return x.IncludeAppTokens
+	}
+	return false
+}
+
+func (x *CreateLocalKeysBackupRequest) GetIncludeThreadKeys() bool {
+	if x != nil {
+		return x.IncludeThreadKeys
+	}
+	return false
+}
+
+type CreateLocalKeysBackupResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateLocalKeysBackupResponse) Reset() {
+	*x = CreateLocalKeysBackupResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[125]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateLocalKeysBackupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateLocalKeysBackupResponse) ProtoMessage() {}
+
+func (x *CreateLocalKeysBackupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[125]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateLocalKeysBackupResponse.ProtoReflect.Descriptor instead.
+func (*CreateLocalKeysBackupResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{125}
+}
+
+type SplitKeyIntoSharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// number of shares to create
+	TotalShares int64 `protobuf:"varint,1,opt,name=totalShares,proto3" json:"totalShares,omitempty"`
+	// number of shares needed to recover the key
+	Threshold int64 `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	// optional directory in which to export each share as a file
+	ExportDirectory string `protobuf:"bytes,3,opt,name=exportDirectory,proto3" json:"exportDirectory,omitempty"`
+	// optional contacts to send one share each through the mailbox
+	RecipientPublicKeys []string `protobuf:"bytes,4,rep,name=recipientPublicKeys,proto3" json:"recipientPublicKeys,omitempty"`
+}
+
+func (x *SplitKeyIntoSharesRequest) Reset() {
+	*x = SplitKeyIntoSharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[126]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitKeyIntoSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitKeyIntoSharesRequest) ProtoMessage() {}
+
+func (x *SplitKeyIntoSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[126]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitKeyIntoSharesRequest.ProtoReflect.Descriptor instead.
+func (*SplitKeyIntoSharesRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *SplitKeyIntoSharesRequest) GetTotalShares() int64 {
+	if x != nil {
+		return x.TotalShares
+	}
+	return 0
+}
+
+func (x *SplitKeyIntoSharesRequest) GetThreshold() int64 {
+	if x != nil {
+		return x.Threshold
+	}
+	return 0
+}
+
+func (x *SplitKeyIntoSharesRequest) GetExportDirectory() string {
+	if x != nil {
+		return x.ExportDirectory
+	}
+	return ""
+}
+
+func (x *SplitKeyIntoSharesRequest) GetRecipientPublicKeys() []string {
+	if x != nil {
+		return x.RecipientPublicKeys
+	}
+	return nil
+}
+
+type KeyShare struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index              int64  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Payload            string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	FilePath           string `protobuf:"bytes,3,opt,name=filePath,proto3" json:"filePath,omitempty"`
+	RecipientPublicKey string `protobuf:"bytes,4,opt,name=recipientPublicKey,proto3" json:"recipientPublicKey,omitempty"`
+}
+
+func (x *KeyShare) Reset() {
+	*x = KeyShare{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[127]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *KeyShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KeyShare) ProtoMessage() {}
+
+func (x *KeyShare) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[127]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KeyShare.ProtoReflect.Descriptor instead.
+func (*KeyShare) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *KeyShare) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *KeyShare) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *KeyShare) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *KeyShare) GetRecipientPublicKey() string {
+	if x != nil {
+		return x.RecipientPublicKey
+	}
+	return ""
+}
+
+type SplitKeyIntoSharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Shares []*KeyShare `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (x *SplitKeyIntoSharesResponse) Reset() {
+	*x = SplitKeyIntoSharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[128]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SplitKeyIntoSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SplitKeyIntoSharesResponse) ProtoMessage() {}
+
+func (x *SplitKeyIntoSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[128]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SplitKeyIntoSharesResponse.ProtoReflect.Descriptor instead.
+func (*SplitKeyIntoSharesResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *SplitKeyIntoSharesResponse) GetShares() []*KeyShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RecoverFromSharesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// encoded shares
+	Shares []string `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	// paths to exported share files
+	FilePaths []string `protobuf:"bytes,2,rep,name=filePaths,proto3" json:"filePaths,omitempty"`
+}
+
+func (x *RecoverFromSharesRequest) Reset() {
+	*x = RecoverFromSharesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[129]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverFromSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverFromSharesRequest) ProtoMessage() {}
+
+func (x *RecoverFromSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[129]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverFromSharesRequest.ProtoReflect.Descriptor instead.
+func (*RecoverFromSharesRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{129}
+}
+
+func (x *RecoverFromSharesRequest) GetShares() []string {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *RecoverFromSharesRequest) GetFilePaths() []string {
+	if x != nil {
+		return x.FilePaths
+	}
+	return nil
+}
+
+type RecoverFromSharesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RecoverFromSharesResponse) Reset() {
+	*x = RecoverFromSharesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[130]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RecoverFromSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoverFromSharesResponse) ProtoMessage() {}
+
+func (x *RecoverFromSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[130]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoverFromSharesResponse.ProtoReflect.Descriptor instead.
+func (*RecoverFromSharesResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{130}
+}
+
+type RotateIdentityKeyRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// optional vault backup stored again with the new key once rotated, as in BackupKeysByPassphrase.
+	// A wrong passphrase fails the rotation before the key changes
+	Uuid       string        `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Passphrase string        `protobuf:"bytes,2,opt,name=passphrase,proto3" json:"passphrase,omitempty"`
+	Type       KeyBackupType `protobuf:"varint,3,opt,name=type,proto3,enum=space.KeyBackupType" json:"type,omitempty"`
+}
+
+func (x *RotateIdentityKeyRequest) Reset() {
+	*x = RotateIdentityKeyRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[131]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateIdentityKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateIdentityKeyRequest) ProtoMessage() {}
+
+func (x *RotateIdentityKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[131]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateIdentityKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateIdentityKeyRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *RotateIdentityKeyRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RotateIdentityKeyRequest) GetPassphrase() string {
+	if x != nil {
+		return x.Passphrase
+	}
+	return ""
+}
+
+func (x *RotateIdentityKeyRequest) GetType() KeyBackupType {
+	if x != nil {
+		return x.Type
+	}
+	return KeyBackupType_PASSWORD
+}
+
+type RotateIdentityKeyResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// mnemonic of the new key, it should be backed up again
+	Mnemonic string `protobuf:"bytes,1,opt,name=mnemonic,proto3" json:"mnemonic,omitempty"`
+	// true unless the vault backup was stored again with the new key. Local backup files and key shares
+	// made before the rotation always hold the revoked key and have to be made again
+	BackupRequired bool `protobuf:"varint,2,opt,name=backupRequired,proto3" json:"backupRequired,omitempty"`
+}
+
+func (x *RotateIdentityKeyResponse) Reset() {
+	*x = RotateIdentityKeyResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[132]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RotateIdentityKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateIdentityKeyResponse) ProtoMessage() {}
+
+func (x *RotateIdentityKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[132]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateIdentityKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateIdentityKeyResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *RotateIdentityKeyResponse) GetMnemonic() string {
+	if x != nil {
+		return x.Mnemonic
+	}
+	return ""
+}
+
+func (x *RotateIdentityKeyResponse) GetBackupRequired() bool {
+	if x != nil {
+		return x.BackupRequired
+	}
+	return false
+}
+
+type DeleteAccountRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[133]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[133]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{133}
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[134]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[134]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{134}
+}
+
+type DeleteKeyPairRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteKeyPairRequest) Reset() {
+	*x = DeleteKeyPairRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[135]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteKeyPairRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeyPairRequest) ProtoMessage() {}
+
+func (x *DeleteKeyPairRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[135]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeyPairRequest.ProtoReflect.Descriptor instead.
+func (*DeleteKeyPairRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{135}
+}
+
+type DeleteKeyPairResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteKeyPairResponse) Reset() {
+	*x = DeleteKeyPairResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[136]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteKeyPairResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteKeyPairResponse) ProtoMessage() {}
+
+func (x *DeleteKeyPairResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[136]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteKeyPairResponse.ProtoReflect.Descriptor instead.
+func (*DeleteKeyPairResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{136}
+}
+
+type GetAPISessionTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetAPISessionTokensRequest) Reset() {
+	*x = GetAPISessionTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[137]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAPISessionTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPISessionTokensRequest) ProtoMessage() {}
+
+func (x *GetAPISessionTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[137]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPISessionTokensRequest.ProtoReflect.Descriptor instead.
+func (*GetAPISessionTokensRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{137}
+}
+
+type GetAPISessionTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	HubToken      string `protobuf:"bytes,1,opt,name=hubToken,proto3" json:"hubToken,omitempty"`
+	ServicesToken string `protobuf:"bytes,2,opt,name=servicesToken,proto3" json:"servicesToken,omitempty"`
+}
+
+func (x *GetAPISessionTokensResponse) Reset() {
+	*x = GetAPISessionTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[138]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetAPISessionTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAPISessionTokensResponse) ProtoMessage() {}
+
+func (x *GetAPISessionTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[138]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAPISessionTokensResponse.ProtoReflect.Descriptor instead.
+func (*GetAPISessionTokensResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{138}
+}
+
+func (x *GetAPISessionTokensResponse) GetHubToken() string {
+	if x != nil {
+		return x.HubToken
+	}
+	return ""
+}
+
+func (x *GetAPISessionTokensResponse) GetServicesToken() string {
+	if x != nil {
+		return x.ServicesToken
+	}
+	return ""
+}
+
+type GetRecentlySharedWithRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetRecentlySharedWithRequest) Reset() {
+	*x = GetRecentlySharedWithRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[139]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentlySharedWithRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlySharedWithRequest) ProtoMessage() {}
+
+func (x *GetRecentlySharedWithRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[139]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlySharedWithRequest.ProtoReflect.Descriptor instead.
+func (*GetRecentlySharedWithRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{139}
+}
+
+type GetRecentlySharedWithResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Members []*FileMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (x *GetRecentlySharedWithResponse) Reset() {
+	*x = GetRecentlySharedWithResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[140]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetRecentlySharedWithResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRecentlySharedWithResponse) ProtoMessage() {}
+
+func (x *GetRecentlySharedWithResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[140]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRecentlySharedWithResponse.ProtoReflect.Descriptor instead.
+func (*GetRecentlySharedWithResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{140}
+}
+
+func (x *GetRecentlySharedWithResponse) GetMembers() []*FileMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type InitializeMasterAppTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *InitializeMasterAppTokenRequest) Reset() {
+	*x = InitializeMasterAppTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[141]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitializeMasterAppTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitializeMasterAppTokenRequest) ProtoMessage() {}
+
+func (x *InitializeMasterAppTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[141]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitializeMasterAppTokenRequest.ProtoReflect.Descriptor instead.
+func (*InitializeMasterAppTokenRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{141}
+}
+
+type InitializeMasterAppTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppToken string `protobuf:"bytes,1,opt,name=appToken,proto3" json:"appToken,omitempty"`
+}
+
+func (x *InitializeMasterAppTokenResponse) Reset() {
+	*x = InitializeMasterAppTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[142]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InitializeMasterAppTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InitializeMasterAppTokenResponse) ProtoMessage() {}
+
+func (x *InitializeMasterAppTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[142]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InitializeMasterAppTokenResponse.ProtoReflect.Descriptor instead.
+func (*InitializeMasterAppTokenResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{142}
+}
+
+func (x *InitializeMasterAppTokenResponse) GetAppToken() string {
+	if x != nil {
+		return x.AppToken
+	}
+	return ""
+}
+
+type AllowedMethod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MethodName string `protobuf:"bytes,1,opt,name=methodName,proto3" json:"methodName,omitempty"`
+}
+
+func (x *AllowedMethod) Reset() {
+	*x = AllowedMethod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[143]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AllowedMethod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllowedMethod) ProtoMessage() {}
+
+func (x *AllowedMethod) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[143]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllowedMethod.ProtoReflect.Descriptor instead.
+func (*AllowedMethod) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *AllowedMethod) GetMethodName() string {
+	if x != nil {
+		return x.MethodName
+	}
+	return ""
+}
+
+type GenerateAppTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AllowedMethods []*AllowedMethod `protobuf:"bytes,1,rep,name=allowedMethods,proto3" json:"allowedMethods,omitempty"`
+}
+
+func (x *GenerateAppTokenRequest) Reset() {
+	*x = GenerateAppTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[144]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateAppTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAppTokenRequest) ProtoMessage() {}
+
+func (x *GenerateAppTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[144]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAppTokenRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAppTokenRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *GenerateAppTokenRequest) GetAllowedMethods() []*AllowedMethod {
+	if x != nil {
+		return x.AllowedMethods
+	}
+	return nil
+}
+
+type GenerateAppTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AppToken string `protobuf:"bytes,1,opt,name=appToken,proto3" json:"appToken,omitempty"`
+}
+
+func (x *GenerateAppTokenResponse) Reset() {
+	*x = GenerateAppTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[145]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenerateAppTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenerateAppTokenResponse) ProtoMessage() {}
+
+func (x *GenerateAppTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[145]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GenerateAppTokenResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAppTokenResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{145}
+}
+
+func (x *GenerateAppTokenResponse) GetAppToken() string {
+	if x != nil {
+		return x.AppToken
+	}
+	return ""
+}
+
+type RemoveDirOrFileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path   string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Bucket string `protobuf:"bytes,2,opt,name=bucket,proto3" json:"bucket,omitempty"`
+}
+
+func (x *RemoveDirOrFileRequest) Reset() {
+	*x = RemoveDirOrFileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[146]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDirOrFileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDirOrFileRequest) ProtoMessage() {}
+
+func (x *RemoveDirOrFileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[146]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDirOrFileRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDirOrFileRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{146}
+}
+
+func (x *RemoveDirOrFileRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *RemoveDirOrFileRequest) GetBucket() string {
+	if x != nil {
+		return x.Bucket
+	}
+	return ""
+}
+
+type RemoveDirOrFileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveDirOrFileResponse) Reset() {
+	*x = RemoveDirOrFileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[147]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDirOrFileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDirOrFileResponse) ProtoMessage() {}
+
+func (x *RemoveDirOrFileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[147]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDirOrFileResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDirOrFileResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{147}
+}
+
+type ListProfilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProfilesRequest) Reset() {
+	*x = ListProfilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[148]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProfilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilesRequest) ProtoMessage() {}
+
+func (x *ListProfilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[148]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilesRequest.ProtoReflect.Descriptor instead.
+func (*ListProfilesRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{148}
+}
+
+type ListProfilesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Profiles      []string `protobuf:"bytes,1,rep,name=profiles,proto3" json:"profiles,omitempty"`
+	ActiveProfile string   `protobuf:"bytes,2,opt,name=activeProfile,proto3" json:"activeProfile,omitempty"`
+}
+
+func (x *ListProfilesResponse) Reset() {
+	*x = ListProfilesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[149]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProfilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProfilesResponse) ProtoMessage() {}
+
+func (x *ListProfilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[149]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProfilesResponse.ProtoReflect.Descriptor instead.
+func (*ListProfilesResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *ListProfilesResponse) GetProfiles() []string {
+	if x != nil {
+		return x.Profiles
+	}
+	return nil
+}
+
+func (x *ListProfilesResponse) GetActiveProfile() string {
+	if x != nil {
+		return x.ActiveProfile
+	}
+	return ""
+}
+
+type CreateProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *CreateProfileRequest) Reset() {
+	*x = CreateProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[150]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileRequest) ProtoMessage() {}
+
+func (x *CreateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[150]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileRequest.ProtoReflect.Descriptor instead.
+func (*CreateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *CreateProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CreateProfileResponse) Reset() {
+	*x = CreateProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[151]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProfileResponse) ProtoMessage() {}
+
+func (x *CreateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[151]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProfileResponse.ProtoReflect.Descriptor instead.
+func (*CreateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{151}
+}
+
+type SwitchProfileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SwitchProfileRequest) Reset() {
+	*x = SwitchProfileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[152]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchProfileRequest) ProtoMessage() {}
+
+func (x *SwitchProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[152]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchProfileRequest.ProtoReflect.Descriptor instead.
+func (*SwitchProfileRequest) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{152}
+}
+
+func (x *SwitchProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SwitchProfileResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SwitchProfileResponse) Reset() {
+	*x = SwitchProfileResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_space_proto_msgTypes[153]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwitchProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwitchProfileResponse) ProtoMessage() {}
+
+func (x *SwitchProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_space_proto_msgTypes[153]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwitchProfileResponse.ProtoReflect.Descriptor instead.
+func (*SwitchProfileResponse) Descriptor() ([]byte, []int) {
+	return file_space_proto_rawDescGZIP(), []int{153}
+}
+
+var File_space_proto protoreflect.FileDescriptor
+
+var file_space_proto_rawDesc = []byte{
+	0x0a, 0x0b, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22,
+	0x2a, 0x0a, 0x12, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x22, 0x67, 0x0a, 0x13, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x14,
+	0x0a, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71,
+	0x75, 0x65, 0x72, 0x79, 0x22, 0x78, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x12, 0x2f, 0x0a, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x41,
+	0x0a, 0x21, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x24, 0x0a, 0x22, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x87, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c,
+	0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x22, 0x75, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x35, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74,
+	0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65,
+	0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x22, 0x45, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22,
+	0x73, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x35, 0x0a,
+	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xee, 0x01, 0x0a, 0x14,
+	0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61,
+	0x72, 0x6f, 0x67, 0x65, 0x55, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x61, 0x72, 0x6f, 0x67, 0x65, 0x55, 0x73, 0x65, 0x64,
+	0x12, 0x2e, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64,
+	0x74, 0x68, 0x55, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x65, 0x64,
+	0x12, 0x2a, 0x0a, 0x10, 0x73, 0x70, 0x61, 0x63, 0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x55, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x55, 0x73, 0x65, 0x64, 0x12, 0x2e, 0x0a, 0x12,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x42, 0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42,
+	0x61, 0x6e, 0x64, 0x77, 0x69, 0x64, 0x74, 0x68, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x4b, 0x0a, 0x19,
+	0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x06, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x22, 0x1c, 0x0a, 0x1a, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x9f, 0x02, 0x0a, 0x0e, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x37, 0x0a, 0x0c, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x11,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x12, 0x2a, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x65,
+	0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x10, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3c, 0x0a,
+	0x10, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x10, 0x64, 0x65, 0x66, 0x61, 0x75,
+	0x6c, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0x32, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x4e, 0x0a,
+	0x19, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e,
+	0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65,
+	0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x68, 0x0a,
+	0x1b, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x51, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x1a, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x64, 0x72, 0x79, 0x52, 0x75, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4f, 0x6e, 0x6c, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4f,
+	0x6e, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x0b, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x7a, 0x65, 0x49, 0x6e,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x69, 0x7a,
+	0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x67, 0x0a, 0x1b, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x22, 0x52, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x6a, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c,
+	0x65, 0x22, 0xcb, 0x03, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x73, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44,
+	0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x69, 0x7a, 0x65, 0x49, 0x6e,
+	0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x69, 0x7a,
+	0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x24, 0x0a, 0x0d,
+	0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x69, 0x6c, 0x65, 0x45, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x70, 0x66, 0x73, 0x48, 0x61, 0x73, 0x68, 0x12, 0x2e,
+	0x0a, 0x12, 0x69, 0x73, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x6c, 0x79, 0x41, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20,
+	0x0a, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a,
+	0x12, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x69, 0x73, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x30, 0x0a,
+	0x13, 0x69, 0x73, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x69, 0x73, 0x52, 0x65,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x49, 0x6e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22,
+	0xb7, 0x01, 0x0a, 0x18, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x2f, 0x0a, 0x05,
+	0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x73, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x69, 0x73, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1a, 0x0a,
+	0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x22, 0x4e, 0x0a, 0x17, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69,
+	0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x64, 0x0a, 0x14, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x20, 0x0a,
+	0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0b, 0x6f, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22,
+	0x4c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x29, 0x0a,
+	0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x22, 0x7e, 0x0a, 0x0c, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x07, 0x69, 0x73, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x61,
+	0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x68,
+	0x61, 0x73, 0x4a, 0x6f, 0x69, 0x6e, 0x65, 0x64, 0x22, 0xa3, 0x02, 0x0a, 0x06, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1c, 0x0a,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x07, 0x6d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x73, 0x50, 0x65,
+	0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x69, 0x73, 0x50, 0x65, 0x72, 0x73, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69,
+	0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x0a, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x3d,
+	0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x54, 0x0a,
+	0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70,
+	0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75,
+	0x61, 0x67, 0x65, 0x22, 0x35, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x22, 0x1a, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x7a, 0x0a, 0x20, 0x52,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x56, 0x69, 0x61,
+	0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x1e, 0x0a, 0x0a, 0x70,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c,
+	0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22, 0x23, 0x0a, 0x21, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x56, 0x69, 0x61, 0x4d, 0x6e, 0x65, 0x6d,
+	0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x51, 0x0a, 0x17,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x6e, 0x67, 0x75, 0x61, 0x67, 0x65, 0x22,
+	0x6c, 0x0a, 0x18, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6e, 0x65, 0x6d, 0x6f,
+	0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x12, 0x22, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x57, 0x6f, 0x72, 0x64,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x57, 0x6f, 0x72, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x81, 0x02,
+	0x0a, 0x10, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x26, 0x0a, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x74, 0x68, 0x50, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x12, 0x4f, 0x0a, 0x14, 0x73, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x77, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x14, 0x73, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x71, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x71, 0x22, 0xa0, 0x01, 0x0a, 0x13, 0x54, 0x78, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x73, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x77, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x14,
+	0x73, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53, 0x69,
+	0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72,
+	0x53, 0x69, 0x7a, 0x65, 0x22, 0xda, 0x01, 0x0a, 0x1c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x74, 0x79, 0x70, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05, 0x74,
+	0x79, 0x70, 0x65, 0x73, 0x12, 0x4f, 0x0a, 0x14, 0x73, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x6c, 0x6f, 0x77, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x14, 0x73, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x72, 0x50,
+	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x62, 0x75, 0x66, 0x66, 0x65,
+	0x72, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x71, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x53, 0x65,
+	0x71, 0x22, 0xc6, 0x01, 0x0a, 0x11, 0x46, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x2f, 0x0a,
+	0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x79, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65,
+	0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x2e, 0x0a, 0x14, 0x54, 0x65,
+	0x78, 0x74, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x51, 0x0a, 0x0f, 0x4f, 0x70,
+	0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x22, 0x2e, 0x0a,
+	0x10, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7d, 0x0a,
+	0x15, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0x34, 0x0a, 0x16,
+	0x4f, 0x70, 0x65, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0x6b, 0x0a, 0x0f, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22,
+	0x65, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xd0, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x49, 0x74,
+	0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72,
+	0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x41, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x0c, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x62, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x62, 0x61, 0x73, 0x65, 0x43, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x62, 0x61, 0x73, 0x65, 0x43, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x43, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x43, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x74, 0x65, 0x63,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x64, 0x65, 0x74,
+	0x65, 0x63, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x4e, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x31, 0x0a, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x73, 0x22, 0x79, 0x0a, 0x1a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x3d, 0x0a, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x1d,
+	0x0a, 0x1b, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x6c, 0x0a,
+	0x14, 0x41, 0x64, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x17, 0x0a, 0x15, 0x41,
+	0x64, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x37, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x79,
+	0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x1a, 0x0a,
+	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x62, 0x0a, 0x0a, 0x53, 0x79, 0x6e,
+	0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c,
+	0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1e, 0x0a,
+	0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x50, 0x61, 0x74, 0x68, 0x22, 0x18, 0x0a,
+	0x16, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x46, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x53,
+	0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x79, 0x6e, 0x63,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x07, 0x66, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x22,
+	0x7d, 0x0a, 0x1d, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x20,
+	0x0a, 0x1e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xa9, 0x01, 0x0a, 0x1d, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x6f, 0x6c, 0x64, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6f,
+	0x6c, 0x64, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x0d,
+	0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e, 0x65, 0x77, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x20, 0x0a, 0x1e,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x7e,
+	0x0a, 0x1e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x75, 0x75, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x21,
+	0x0a, 0x1f, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50,
+	0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x4f, 0x0a, 0x19, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75,
+	0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x22, 0x1c, 0x0a, 0x1a, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x3c, 0x0a, 0x0a, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1c,
+	0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x2c,
+	0x0a, 0x12, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x48, 0x0a, 0x13,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x66,
+	0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65,
+	0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0x5e, 0x0a, 0x11, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x31, 0x0a, 0x0a, 0x74,
+	0x68, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x0a, 0x74, 0x68, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x2c, 0x0a, 0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x72, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x22, 0xaa, 0x01, 0x0a, 0x1d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75,
+	0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x24, 0x0a,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72,
+	0x6f, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x4a, 0x0a, 0x08, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a,
+	0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x22, 0x20, 0x0a,
+	0x1e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x68, 0x0a, 0x1f, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56,
+	0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x22, 0x22, 0x0a, 0x20, 0x55, 0x6e, 0x73,
+	0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x85, 0x01,
+	0x0a, 0x16, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12,
+	0x24, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0xe3, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x74,
+	0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69,
+	0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61,
+	0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6f, 0x77,
+	0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x22, 0x66, 0x0a, 0x1e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07,
+	0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66,
+	0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x22, 0xe8,
+	0x02, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x62, 0x49, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x62, 0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x74, 0x65,
+	0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x69, 0x74,
+	0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x65, 0x43, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e,
+	0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x12,
+	0x22, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x44, 0x6f, 0x77, 0x6e, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x6f, 0x77, 0x6e, 0x6c, 0x6f, 0x61, 0x64, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x6f, 0x77, 0x6e,
+	0x6c, 0x6f, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x42, 0x0a, 0x16, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x62, 0x0a,
+	0x17, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x6b,
+	0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x22, 0x31, 0x0a, 0x17, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x69,
+	0x6e, 0x6b, 0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x33, 0x0a, 0x11, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x10, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x46, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x50, 0x61, 0x74, 0x68,
+	0x22, 0x14, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
+	0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x22, 0x2d, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x16, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a,
+	0x13, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x0a, 0x07,
+	0x6e, 0x65, 0x77, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e,
+	0x65, 0x77, 0x53, 0x6c, 0x75, 0x67, 0x22, 0x3d, 0x0a, 0x14, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x06, 0x62,
+	0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0xda, 0x01, 0x0a, 0x0a, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74,
+	0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x22, 0x50, 0x0a, 0x0a, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x36, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x22, 0x6e, 0x0a, 0x11,
+	0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d, 0x0a,
+	0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61, 0x74,
+	0x68, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xb4, 0x04, 0x0a,
+	0x0c, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a,
+	0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x3d, 0x0a, 0x0f, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0f, 0x69, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x33, 0x0a, 0x0a, 0x75, 0x73,
+	0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72,
+	0x74, 0x48, 0x00, 0x52, 0x0a, 0x75, 0x73, 0x61, 0x67, 0x65, 0x41, 0x6c, 0x65, 0x72, 0x74, 0x12,
+	0x45, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63,
+	0x65, 0x70, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x63, 0x65,
+	0x70, 0x74, 0x48, 0x00, 0x52, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x41, 0x63, 0x63, 0x65, 0x70, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65,
+	0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x11, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x64, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x35, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x48, 0x00, 0x52, 0x08, 0x6b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x36, 0x0a, 0x0b, 0x6b, 0x65, 0x79, 0x52, 0x6f,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x00, 0x52, 0x0b, 0x6b, 0x65, 0x79, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2b, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x61, 0x64, 0x41, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65, 0x61, 0x64,
+	0x41, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x4f, 0x62, 0x6a,
+	0x65, 0x63, 0x74, 0x22, 0x54, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x4b,
+	0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0e, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x22, 0x73, 0x0a, 0x0b, 0x4b, 0x65, 0x79,
+	0x52, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x6c, 0x64, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x6f, 0x6c, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x0c,
+	0x6e, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6e, 0x65, 0x77, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22, 0x5a,
+	0x0a, 0x1c, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x22, 0x1f, 0x0a, 0x1d, 0x48, 0x61,
+	0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x96, 0x02, 0x0a, 0x0e,
+	0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22,
+	0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x44, 0x12, 0x2a, 0x0a, 0x10, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x65, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x69, 0x6e,
+	0x76, 0x69, 0x74, 0x65, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x2d,
+	0x0a, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75, 0x6c, 0x6c, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x09, 0x69, 0x74, 0x65, 0x6d, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x2f, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x64, 0x22, 0x46, 0x0a, 0x1a, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x76, 0x0a, 0x1b,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b, 0x69,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73,
+	0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66,
+	0x66, 0x73, 0x65, 0x74, 0x22, 0x3d, 0x0a, 0x17, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e,
+	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x44, 0x22, 0x1a, 0x0a, 0x18, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x84, 0x01, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a,
+	0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x73, 0x65, 0x71, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x03, 0x73, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e,
+	0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75,
+	0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x22, 0x43, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x65, 0x65, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x95, 0x01, 0x0a, 0x18,
+	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x0d, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x13, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x65, 0x78, 0x74, 0x4f, 0x66, 0x66,
+	0x73, 0x65, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65,
+	0x6e, 0x41, 0x74, 0x22, 0x29, 0x0a, 0x17, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x49, 0x44, 0x22, 0x1a,
+	0x0a, 0x18, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x47, 0x65,
+	0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x34, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x6b, 0x0a, 0x1f, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61,
+	0x74, 0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x74, 0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68,
+	0x72, 0x61, 0x73, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b,
+	0x65, 0x79, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xec, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x61, 0x74,
+	0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x74, 0x68, 0x54, 0x6f, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
+	0x61, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x6e,
+	0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x2a, 0x0a,
+	0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x54, 0x68, 0x72,
+	0x65, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x1f, 0x0a, 0x1d, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb7, 0x01, 0x0a, 0x19, 0x53, 0x70, 0x6c,
+	0x69, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x68, 0x72, 0x65,
+	0x73, 0x68, 0x6f, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x68, 0x72,
+	0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x65, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x30, 0x0a, 0x13, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x08, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2e, 0x0a, 0x12, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65,
+	0x6e, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x22, 0x45, 0x0a, 0x1a, 0x53,
+	0x70, 0x6c, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x06, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x06, 0x73, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x22, 0x50, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x72, 0x6f,
+	0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50, 0x61,
+	0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x6c, 0x65, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46,
+	0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x78, 0x0a, 0x18, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x75, 0x69,
+	0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73,
+	0x65, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x5f, 0x0a, 0x19, 0x52,
+	0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6e, 0x65, 0x6d,
+	0x6f, 0x6e, 0x69, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x6e, 0x65, 0x6d,
+	0x6f, 0x6e, 0x69, 0x63, 0x12, 0x26, 0x0a, 0x0e, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x0a,
+	0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b,
+	0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x0a, 0x1a, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x5f, 0x0a, 0x1b,
+	0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x68,
+	0x75, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68,
+	0x75, 0x62, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x1e, 0x0a,
+	0x1c, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a,
+	0x1d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x22, 0x21, 0x0a, 0x1f, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41,
+	0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x3e,
+	0x0a, 0x20, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74,
+	0x65, 0x72, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2f,
+	0x0a, 0x0d, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x22,
+	0x57, 0x0a, 0x17, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x0e, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
+	0x64, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x36, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65,
+	0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x22, 0x44, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x58, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x17,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x0a, 0x14, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x22, 0x17, 0x0a, 0x15, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2a, 0x47, 0x0a, 0x0c,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0e, 0x0a, 0x0a,
+	0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x4f, 0x46, 0x46, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09,
+	0x42, 0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x4f, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x42,
+	0x41, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x4d, 0x45, 0x54, 0x41, 0x44, 0x41, 0x54, 0x41, 0x5f, 0x4f,
+	0x4e, 0x4c, 0x59, 0x10, 0x02, 0x2a, 0x68, 0x0a, 0x09, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f,
+	0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x16, 0x53, 0x48, 0x41, 0x52, 0x45, 0x5f, 0x52, 0x4f, 0x4c, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a,
+	0x0a, 0x06, 0x56, 0x49, 0x45, 0x57, 0x45, 0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f,
+	0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x44, 0x49,
+	0x54, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x4d, 0x49, 0x4e, 0x10, 0x04,
+	0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x53, 0x53, 0x10, 0x05, 0x2a,
+	0xfe, 0x01, 0x0a, 0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0f, 0x0a,
+	0x0b, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x41, 0x44, 0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x44, 0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x42, 0x41,
+	0x43, 0x4b, 0x55, 0x50, 0x5f, 0x49, 0x4e, 0x5f, 0x50, 0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53,
+	0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x42, 0x41, 0x43, 0x4b,
+	0x55, 0x50, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x45, 0x4e,
+	0x54, 0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x50,
+	0x52, 0x4f, 0x47, 0x52, 0x45, 0x53, 0x53, 0x10, 0x05, 0x12, 0x17, 0x0a, 0x13, 0x45, 0x4e, 0x54,
+	0x52, 0x59, 0x5f, 0x52, 0x45, 0x53, 0x54, 0x4f, 0x52, 0x45, 0x5f, 0x52, 0x45, 0x41, 0x44, 0x59,
+	0x10, 0x06, 0x12, 0x10, 0x0a, 0x0c, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x41, 0x44, 0x44,
+	0x45, 0x44, 0x10, 0x07, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x4c, 0x44, 0x45, 0x52, 0x5f, 0x44,
+	0x45, 0x4c, 0x45, 0x54, 0x45, 0x44, 0x10, 0x08, 0x12, 0x12, 0x0a, 0x0e, 0x46, 0x4f, 0x4c, 0x44,
+	0x45, 0x52, 0x5f, 0x55, 0x50, 0x44, 0x41, 0x54, 0x45, 0x44, 0x10, 0x09, 0x12, 0x12, 0x0a, 0x0e,
+	0x45, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x4c, 0x49, 0x43, 0x54, 0x10, 0x0a,
+	0x2a, 0x37, 0x0a, 0x14, 0x53, 0x6c, 0x6f, 0x77, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x72, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x44, 0x52, 0x4f, 0x50,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x53, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x49, 0x53,
+	0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43, 0x54, 0x10, 0x01, 0x2a, 0x72, 0x0a, 0x16, 0x46, 0x69, 0x6c,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x24, 0x46, 0x49, 0x4c, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46,
+	0x4c, 0x49, 0x43, 0x54, 0x5f, 0x52, 0x45, 0x53, 0x4f, 0x4c, 0x55, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a,
+	0x0a, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x4c, 0x10, 0x01, 0x12, 0x0f, 0x0a,
+	0x0b, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x52, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x10, 0x02, 0x12, 0x0d,
+	0x0a, 0x09, 0x4b, 0x45, 0x45, 0x50, 0x5f, 0x42, 0x4f, 0x54, 0x48, 0x10, 0x03, 0x2a, 0x41, 0x0a,
+	0x0d, 0x4b, 0x65, 0x79, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0c,
+	0x0a, 0x08, 0x50, 0x41, 0x53, 0x53, 0x57, 0x4f, 0x52, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06,
+	0x47, 0x4f, 0x4f, 0x47, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x54, 0x57, 0x49, 0x54,
+	0x54, 0x45, 0x52, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05, 0x45, 0x4d, 0x41, 0x49, 0x4c, 0x10, 0x03,
+	0x2a, 0x4b, 0x0a, 0x09, 0x46, 0x75, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0f, 0x0a,
+	0x0b, 0x55, 0x4e, 0x53, 0x55, 0x50, 0x50, 0x4f, 0x52, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x11,
+	0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4c, 0x4c, 0x45, 0x44, 0x10,
+	0x01, 0x12, 0x0d, 0x0a, 0x09, 0x55, 0x4e, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x4d, 0x4f, 0x55, 0x4e, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xa8, 0x01,
+	0x0a, 0x10, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12,
+	0x0e, 0x0a, 0x0a, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x01, 0x12,
+	0x0e, 0x0a, 0x0a, 0x55, 0x53, 0x41, 0x47, 0x45, 0x41, 0x4c, 0x45, 0x52, 0x54, 0x10, 0x02, 0x12,
+	0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45,
+	0x50, 0x4c, 0x59, 0x10, 0x03, 0x12, 0x16, 0x0a, 0x12, 0x52, 0x45, 0x56, 0x4f, 0x4b, 0x45, 0x44,
+	0x5f, 0x49, 0x4e, 0x56, 0x49, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x04, 0x12, 0x0d, 0x0a,
+	0x09, 0x4b, 0x45, 0x59, 0x5f, 0x53, 0x48, 0x41, 0x52, 0x45, 0x10, 0x05, 0x12, 0x10, 0x0a, 0x0c,
+	0x4b, 0x45, 0x59, 0x5f, 0x52, 0x4f, 0x54, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x06, 0x12, 0x18,
+	0x0a, 0x14, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x43, 0x5f, 0x4c, 0x49, 0x4e, 0x4b, 0x5f, 0x44, 0x4f,
+	0x57, 0x4e, 0x4c, 0x4f, 0x41, 0x44, 0x10, 0x07, 0x2a, 0x4a, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0b, 0x0a, 0x07,
+	0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x43, 0x43,
+	0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x52, 0x45, 0x4a, 0x45, 0x43,
+	0x54, 0x45, 0x44, 0x10, 0x02, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c,
+	0x45, 0x44, 0x10, 0x03, 0x32, 0x9f, 0x3e, 0x0a, 0x08, 0x53, 0x70, 0x61, 0x63, 0x65, 0x41, 0x70,
+	0x69, 0x12, 0x6d, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31,
+	0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x2f, 0x61, 0x6c, 0x6c,
+	0x12, 0x63, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x0f, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22,
+	0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x67, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x75, 0x0a, 0x11, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x1f,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x64,
+	0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x64, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x76, 0x31, 0x2f, 0x6b,
+	0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x6d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
+	0x12, 0x9b, 0x01, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x50,
+	0x61, 0x69, 0x72, 0x56, 0x69, 0x61, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x12, 0x27,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65,
+	0x79, 0x50, 0x61, 0x69, 0x72, 0x56, 0x69, 0x61, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x56, 0x69,
+	0x61, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x25, 0x22, 0x20, 0x2f, 0x76, 0x31, 0x2f, 0x6b,
+	0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x57,
+	0x69, 0x74, 0x68, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x7d,
+	0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e,
+	0x69, 0x63, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x65, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x22, 0x22, 0x1d, 0x2f, 0x76, 0x31,
+	0x2f, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x4d, 0x6e, 0x65, 0x6d, 0x6f, 0x6e, 0x69, 0x63, 0x3a, 0x01, 0x2a, 0x12, 0x6a, 0x0a,
+	0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x12, 0x1b,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79,
+	0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x80, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x57, 0x69, 0x74,
+	0x68, 0x46, 0x6f, 0x72, 0x63, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65,
+	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x69, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f,
+	0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2f, 0x66, 0x6f, 0x72, 0x63,
+	0x65, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x61, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1a, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x3a, 0x01, 0x2a, 0x12,
+	0x60, 0x0a, 0x09, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x17, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x69,
+	0x6c, 0x65, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x30,
+	0x01, 0x12, 0x6c, 0x0a, 0x0c, 0x54, 0x78, 0x6c, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x78, 0x6c, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1b, 0x12, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x74, 0x65, 0x78, 0x74, 0x69, 0x6c, 0x65, 0x30, 0x01, 0x12,
+	0x56, 0x0a, 0x08, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e,
+	0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x22, 0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f,
+	0x6f, 0x70, 0x65, 0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x63, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x72, 0x4f, 0x72, 0x46, 0x69, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x0b, 0x2a, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x9d, 0x01, 0x0a,
+	0x16, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46,
+	0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69,
+	0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x36, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x30, 0x22, 0x2b, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x46, 0x69, 0x6c, 0x65, 0x4c, 0x69, 0x6e, 0x6b, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0f,
+	0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12,
+	0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x12, 0x7f, 0x0a, 0x10, 0x52, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1e, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4c, 0x69, 0x6e, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2a, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x24, 0x22, 0x1f, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x4c, 0x69, 0x6e, 0x6b, 0x73, 0x2f, 0x7b, 0x6c, 0x69, 0x6e, 0x6b, 0x49, 0x64, 0x7d, 0x2f, 0x72,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x7f, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53,
+	0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x18, 0x12, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4d, 0x65, 0x12, 0x77, 0x0a, 0x12, 0x47, 0x65, 0x74,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x64, 0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x42, 0x79, 0x4d, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76,
+	0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x42, 0x79,
+	0x4d, 0x65, 0x12, 0x6b, 0x0a, 0x0e, 0x4f, 0x70, 0x65, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63,
+	0x46, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65,
+	0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x63, 0x46, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x12,
+	0x53, 0x0a, 0x08, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x16, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x49,
+	0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x22, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x3a,
+	0x01, 0x2a, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x6f,
+	0x6c, 0x64, 0x65, 0x72, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46,
+	0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1a, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x73, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x1f,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x43,
+	0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x69, 0x6c, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x12, 0x84,
+	0x01, 0x0a, 0x13, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x6c, 0x69,
+	0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
+	0x66, 0x6c, 0x69, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x6c, 0x69, 0x63, 0x74, 0x73, 0x2f, 0x72, 0x65, 0x73, 0x6f, 0x6c,
+	0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x66, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x53, 0x79, 0x6e, 0x63,
+	0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x41,
+	0x64, 0x64, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x41, 0x64, 0x64, 0x53,
+	0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x22, 0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73,
+	0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a,
+	0x10, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65,
+	0x72, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f,
+	0x73, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x2f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x69, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e,
+	0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x12,
+	0x0f, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x46, 0x6f, 0x6c, 0x64, 0x65, 0x72, 0x73,
+	0x12, 0x60, 0x0a, 0x0f, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72,
+	0x69, 0x76, 0x65, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x6f, 0x67, 0x67,
+	0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69, 0x76, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x22,
+	0x0e, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x46, 0x75, 0x73, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0x58, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79,
+	0x1a, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x46, 0x75, 0x73, 0x65, 0x44, 0x72, 0x69,
+	0x76, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x10, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0a, 0x12, 0x08, 0x2f, 0x76, 0x31, 0x2f, 0x66, 0x75, 0x73, 0x65, 0x12, 0x5f, 0x0a, 0x0c,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x22, 0x0b, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01,
+	0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73,
+	0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x4b, 0x65, 0x79,
+	0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x62,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x16, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72,
+	0x61, 0x73, 0x65, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x50, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x21, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1b, 0x22, 0x16, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61,
+	0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x5d, 0x0a, 0x0c, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0e, 0x12, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x73, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x17,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x11, 0x22, 0x0c, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x71, 0x0a, 0x0d, 0x53, 0x77, 0x69, 0x74, 0x63,
+	0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x53, 0x77, 0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x77,
+	0x69, 0x74, 0x63, 0x68, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31,
+	0x2f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x6e, 0x61, 0x6d, 0x65, 0x7d,
+	0x2f, 0x73, 0x77, 0x69, 0x74, 0x63, 0x68, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01, 0x0a, 0x17, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73,
+	0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79,
+	0x73, 0x42, 0x79, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1c, 0x22, 0x17, 0x2f,
+	0x76, 0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x72,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x7a, 0x0a, 0x12, 0x54, 0x65, 0x73,
+	0x74, 0x4b, 0x65, 0x79, 0x73, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x12,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x73,
+	0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x65, 0x73, 0x74, 0x4b, 0x65,
+	0x79, 0x73, 0x50, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14, 0x2f, 0x76,
+	0x31, 0x2f, 0x70, 0x61, 0x73, 0x73, 0x70, 0x68, 0x72, 0x61, 0x73, 0x65, 0x73, 0x2f, 0x74, 0x65,
+	0x73, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x86, 0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12,
+	0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4c, 0x6f,
+	0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x22, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x1c, 0x22, 0x17, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x73, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x90,
+	0x01, 0x0a, 0x18, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x26, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42,
+	0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x4b, 0x65, 0x79, 0x73, 0x42, 0x79, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x42, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x23, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1d, 0x22, 0x18, 0x2f, 0x76, 0x31, 0x2f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x3a, 0x01,
+	0x2a, 0x12, 0x79, 0x0a, 0x12, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74,
+	0x6f, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x53, 0x70, 0x6c, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x6f, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x53, 0x70, 0x6c, 0x69, 0x74, 0x4b, 0x65, 0x79, 0x49, 0x6e, 0x74, 0x6f, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x73, 0x2f, 0x73, 0x70, 0x6c, 0x69, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x78, 0x0a, 0x11,
+	0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x73, 0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x63, 0x6f, 0x76,
+	0x65, 0x72, 0x46, 0x72, 0x6f, 0x6d, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x22, 0x15, 0x2f, 0x76,
+	0x31, 0x2f, 0x6b, 0x65, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x73, 0x2f, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x76, 0x0a, 0x11, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x49, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x6b, 0x65, 0x79, 0x70,
+	0x61, 0x69, 0x72, 0x73, 0x2f, 0x72, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x6b,
+	0x0a, 0x0b, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x19, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x7d, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x67, 0x0a, 0x0a, 0x4a,
+	0x6f, 0x69, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x18, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x24,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1e, 0x22, 0x19, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x7d, 0x2f, 0x6a, 0x6f, 0x69,
+	0x6e, 0x3a, 0x01, 0x2a, 0x12, 0x8c, 0x01, 0x0a, 0x16, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69,
+	0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12,
+	0x24, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x68,
+	0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69,
+	0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x1f, 0x22, 0x1a, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x3a, 0x01, 0x2a, 0x12, 0x94, 0x01, 0x0a, 0x18, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46,
+	0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79,
+	0x12, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65,
+	0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65,
+	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x2e, 0x55, 0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x75,
+	0x6e, 0x73, 0x68, 0x61, 0x72, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x56, 0x69, 0x61, 0x50, 0x75,
+	0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x0f, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x1d, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x68, 0x61, 0x72,
+	0x65, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73,
+	0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x68, 0x61, 0x72, 0x65,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1e, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x18, 0x22, 0x13, 0x2f, 0x76, 0x31, 0x2f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x53, 0x68, 0x61, 0x72, 0x65, 0x52, 0x6f, 0x6c, 0x65, 0x3a, 0x01, 0x2a, 0x12, 0x91, 0x01, 0x0a,
+	0x15, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x48,
+	0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x49,
+	0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x27, 0x22, 0x22, 0x2f, 0x76, 0x31, 0x2f, 0x66,
+	0x69, 0x6c, 0x65, 0x73, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2f, 0x7b,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x3a, 0x01, 0x2a,
+	0x12, 0x7a, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x69, 0x6e, 0x76, 0x69,
+	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x73, 0x65, 0x6e, 0x74, 0x12, 0x85, 0x01, 0x0a,
+	0x10, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x43, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x30, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2a, 0x22, 0x25, 0x2f, 0x76, 0x31, 0x2f,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x69, 0x6e, 0x76,
+	0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x7d, 0x2f, 0x63, 0x61, 0x6e, 0x63, 0x65,
+	0x6c, 0x3a, 0x01, 0x2a, 0x12, 0x88, 0x01, 0x0a, 0x15, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x23,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x12, 0x1e, 0x2f,
+	0x76, 0x31, 0x2f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12,
+	0x59, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x19,
+	0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x13, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0d, 0x12, 0x0b, 0x2f,
+	0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x12, 0x65, 0x0a, 0x0c, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x2a, 0x14, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x7d, 0x12, 0x6f, 0x0a, 0x0c, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65,
+	0x74, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x20, 0x22, 0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f,
+	0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x7d, 0x2f, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x3a,
+	0x01, 0x2a, 0x12, 0x6e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x12,
+	0x11, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x7b, 0x0a, 0x10, 0x52, 0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x52,
+	0x65, 0x61, 0x64, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x20, 0x22,
+	0x1b, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2f, 0x7b, 0x49, 0x44, 0x7d, 0x2f, 0x72, 0x65, 0x61, 0x64, 0x3a, 0x01, 0x2a, 0x12,
+	0x68, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x16, 0x22, 0x11, 0x2f, 0x76, 0x31, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x70, 0x0a, 0x12, 0x54, 0x6f, 0x67,
+	0x67, 0x6c, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12,
+	0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65, 0x42, 0x75,
+	0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x54, 0x6f, 0x67, 0x67, 0x6c, 0x65,
+	0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x22, 0x0a, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x3a, 0x01, 0x2a, 0x12, 0x7d, 0x0a, 0x11, 0x47,
+	0x65, 0x74, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x12, 0x1f, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63, 0x6b,
+	0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x20, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f, 0x12, 0x1d, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x89, 0x01, 0x0a, 0x14, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x53, 0x65, 0x74, 0x74,
+	0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x28, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x22, 0x1a, 0x1d, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x2f, 0x7b, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x3a, 0x01, 0x2a, 0x12, 0x7b, 0x0a, 0x13, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x21, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x42,
+	0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x22, 0x12, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x2f, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x3a, 0x01, 0x2a, 0x12, 0x5a, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x11, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0b, 0x12, 0x09, 0x2f, 0x76, 0x31, 0x2f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x7a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x50, 0x49, 0x53, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x69, 0x53, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x7e, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64,
+	0x57, 0x69, 0x74, 0x68, 0x12, 0x23, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x57, 0x69,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x73, 0x70, 0x61, 0x63,
+	0x65, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x65, 0x6e, 0x74, 0x6c, 0x79, 0x53, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x12, 0x12, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x68, 0x61,
+	0x72, 0x65, 0x64, 0x57, 0x69, 0x74, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x9a, 0x01, 0x0a, 0x1a,
+	0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x12, 0x28, 0x2e, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x4c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x74,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x4c, 0x61, 0x73,
+	0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x22, 0x1c, 0x2f, 0x76, 0x31, 0x2f, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6c, 0x61, 0x73, 0x74, 0x53,
+	0x65, 0x65, 0x6e, 0x41, 0x74, 0x3a, 0x01, 0x2a, 0x12, 0x5e, 0x0a, 0x0b, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x12, 0x10, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x2f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x8c, 0x01, 0x0a, 0x18, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x26, 0x2e, 0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70,
+	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e,
+	0x73, 0x70, 0x61, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x4d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1f, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x19, 0x22, 0x14,
+	0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x2f, 0x6d, 0x61,
+	0x73, 0x74, 0x65, 0x72, 0x3a, 0x01, 0x2a, 0x12, 0x6d, 0x0a, 0x10, 0x47, 0x65, 0x6e, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x41, 0x70, 0x70, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x18, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x12, 0x22, 0x0d, 0x2f, 0x76, 0x31, 0x2f, 0x61, 0x70, 0x70, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x3a, 0x01, 0x2a, 0x42, 0x06, 0x5a, 0x04, 0x2e, 0x3b, 0x70, 0x62, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_space_proto_rawDescOnce sync.Once
+	file_space_proto_rawDescData = file_space_proto_rawDesc
+)
+
+func file_space_proto_rawDescGZIP() []byte {
+	file_space_proto_rawDescOnce.Do(func() {
+		file_space_proto_rawDescData = protoimpl.X.CompressGZIP(file_space_proto_rawDescData)
+	})
+	return file_space_proto_rawDescData
+}
+
+var file_space_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_space_proto_msgTypes = make([]protoimpl.MessageInfo, 154)
+var file_space_proto_goTypes = []interface{}{
+	(BackupPolicy)(0),                          // 0: space.BackupPolicy
+	(ShareRole)(0),                             // 1: space.ShareRole
+	(EventType)(0),                             // 2: space.EventType
+	(SlowSubscriberPolicy)(0),                  // 3: space.SlowSubscriberPolicy
+	(FileConflictResolution)(0),                // 4: space.FileConflictResolution
+	(KeyBackupType)(0),                         // 5: space.KeyBackupType
+	(FuseState)(0),                             // 6: space.FuseState
+	(NotificationType)(0),                      // 7: space.NotificationType
+	(InvitationStatus)(0),                      // 8: space.InvitationStatus
+	(*SearchFilesRequest)(nil),                 // 9: space.SearchFilesRequest
+	(*SearchFilesResponse)(nil),                // 10: space.SearchFilesResponse
+	(*SearchFilesDirectoryEntry)(nil),          // 11: space.SearchFilesDirectoryEntry
+	(*SetNotificationsLastSeenAtRequest)(nil),  // 12: space.SetNotificationsLastSeenAtRequest
+	(*SetNotificationsLastSeenAtResponse)(nil), // 13: space.SetNotificationsLastSeenAtResponse
+	(*GetSharedWithMeFilesRequest)(nil),        // 14: space.GetSharedWithMeFilesRequest
+	(*GetSharedWithMeFilesResponse)(nil),       // 15: space.GetSharedWithMeFilesResponse
+	(*GetSharedByMeFilesRequest)(nil),          // 16: space.GetSharedByMeFilesRequest
+	(*GetSharedByMeFilesResponse)(nil),         // 17: space.GetSharedByMeFilesResponse
+	(*GetUsageInfoRequest)(nil),                // 18: space.GetUsageInfoRequest
+	(*GetUsageInfoResponse)(nil),               // 19: space.GetUsageInfoResponse
+	(*ToggleBucketBackupRequest)(nil),          // 20: space.ToggleBucketBackupRequest
+	(*ToggleBucketBackupResponse)(nil),         // 21: space.ToggleBucketBackupResponse
+	(*BucketSettings)(nil),                     // 22: space.BucketSettings
+	(*GetBucketSettingsRequest)(nil),           // 23: space.GetBucketSettingsRequest
+	(*GetBucketSettingsResponse)(nil),          // 24: space.GetBucketSettingsResponse
+	(*UpdateBucketSettingsRequest)(nil),        // 25: space.UpdateBucketSettingsRequest
+	(*UpdateBucketSettingsResponse)(nil),       // 26: space.UpdateBucketSettingsResponse
+	(*BucketBackupRestoreRequest)(nil),         // 27: space.BucketBackupRestoreRequest
+	(*RestoreFile)(nil),                        // 28: space.RestoreFile
+	(*BucketBackupRestoreResponse)(nil),        // 29: space.BucketBackupRestoreResponse
+	(*ListDirectoriesRequest)(nil),             // 30: space.ListDirectoriesRequest
+	(*FileMember)(nil),                         // 31: space.FileMember
+	(*ListDirectoryEntry)(nil),                 // 32: space.ListDirectoryEntry
+	(*SharedListDirectoryEntry)(nil),           // 33: space.SharedListDirectoryEntry
+	(*ListDirectoriesResponse)(nil),            // 34: space.ListDirectoriesResponse
+	(*ListDirectoryRequest)(nil),               // 35: space.ListDirectoryRequest
+	(*ListDirectoryResponse)(nil),              // 36: space.ListDirectoryResponse
+	(*CreateBucketRequest)(nil),                // 37: space.CreateBucketRequest
+	(*BucketMember)(nil),                       // 38: space.BucketMember
+	(*Bucket)(nil),                             // 39: space.Bucket
+	(*CreateBucketResponse)(nil),               // 40: space.CreateBucketResponse
+	(*GenerateKeyPairRequest)(nil),             // 41: space.GenerateKeyPairRequest
+	(*GenerateKeyPairResponse)(nil),            // 42: space.GenerateKeyPairResponse
+	(*GetStoredMnemonicRequest)(nil),           // 43: space.GetStoredMnemonicRequest
+	(*GetStoredMnemonicResponse)(nil),          // 44: space.GetStoredMnemonicResponse
+	(*RestoreKeyPairViaMnemonicRequest)(nil),   // 45: space.RestoreKeyPairViaMnemonicRequest
+	(*RestoreKeyPairViaMnemonicResponse)(nil),  // 46: space.RestoreKeyPairViaMnemonicResponse
+	(*ValidateMnemonicRequest)(nil),            // 47: space.ValidateMnemonicRequest
+	(*ValidateMnemonicResponse)(nil),           // 48: space.ValidateMnemonicResponse
+	(*SubscribeRequest)(nil),                   // 49: space.SubscribeRequest
+	(*TxlSubscribeRequest)(nil),                // 50: space.TxlSubscribeRequest
+	(*NotificationSubscribeRequest)(nil),       // 51: space.NotificationSubscribeRequest
+	(*FileEventResponse)(nil),                  // 52: space.FileEventResponse
+	(*TextileEventResponse)(nil),               // 53: space.TextileEventResponse
+	(*OpenFileRequest)(nil),                    // 54: space.OpenFileRequest
+	(*OpenFileResponse)(nil),                   // 55: space.OpenFileResponse
+	(*OpenPublicFileRequest)(nil),              // 56: space.OpenPublicFileRequest
+	(*OpenPublicFileResponse)(nil),             // 57: space.OpenPublicFileResponse
+	(*AddItemsRequest)(nil),                    // 58: space.AddItemsRequest
+	(*AddItemResult)(nil),                      // 59: space.AddItemResult
+	(*AddItemsResponse)(nil),                   // 60: space.AddItemsResponse
+	(*CreateFolderRequest)(nil),                // 61: space.CreateFolderRequest
+	(*CreateFolderResponse)(nil),               // 62: space.CreateFolderResponse
+	(*FileConflict)(nil),                       // 63: space.FileConflict
+	(*ListFileConflictsRequest)(nil),           // 64: space.ListFileConflictsRequest
+	(*ListFileConflictsResponse)(nil),          // 65: space.ListFileConflictsResponse
+	(*ResolveFileConflictRequest)(nil),         // 66: space.ResolveFileConflictRequest
+	(*ResolveFileConflictResponse)(nil),        // 67: space.ResolveFileConflictResponse
+	(*AddSyncFolderRequest)(nil),               // 68: space.AddSyncFolderRequest
+	(*AddSyncFolderResponse)(nil),              // 69: space.AddSyncFolderResponse
+	(*RemoveSyncFolderRequest)(nil),            // 70: space.RemoveSyncFolderRequest
+	(*RemoveSyncFolderResponse)(nil),           // 71: space.RemoveSyncFolderResponse
+	(*SyncFolder)(nil),                         // 72: space.SyncFolder
+	(*ListSyncFoldersRequest)(nil),             // 73: space.ListSyncFoldersRequest
+	(*ListSyncFoldersResponse)(nil),            // 74: space.ListSyncFoldersResponse
+	(*BackupKeysByPassphraseRequest)(nil),      // 75: space.BackupKeysByPassphraseRequest
+	(*BackupKeysByPassphraseResponse)(nil),     // 76: space.BackupKeysByPassphraseResponse
+	(*ChangeBackupPassphraseRequest)(nil),      // 77: space.ChangeBackupPassphraseRequest
+	(*ChangeBackupPassphraseResponse)(nil),     // 78: space.ChangeBackupPassphraseResponse
+	(*RecoverKeysByPassphraseRequest)(nil),     // 79: space.RecoverKeysByPassphraseRequest
+	(*RecoverKeysByPassphraseResponse)(nil),    // 80: space.RecoverKeysByPassphraseResponse
+	(*TestKeysPassphraseRequest)(nil),          // 81: space.TestKeysPassphraseRequest
+	(*TestKeysPassphraseResponse)(nil),         // 82: space.TestKeysPassphraseResponse
+	(*ThreadInfo)(nil),                         // 83: space.ThreadInfo
+	(*ShareBucketRequest)(nil),                 // 84: space.ShareBucketRequest
+	(*ShareBucketResponse)(nil),                // 85: space.ShareBucketResponse
+	(*JoinBucketRequest)(nil),                  // 86: space.JoinBucketRequest
+	(*JoinBucketResponse)(nil),                 // 87: space.JoinBucketResponse
+	(*ShareFilesViaPublicKeyRequest)(nil),      // 88: space.ShareFilesViaPublicKeyRequest
+	(*FullPath)(nil),                           // 89: space.FullPath
+	(*ShareFilesViaPublicKeyResponse)(nil),     // 90: space.ShareFilesViaPublicKeyResponse
+	(*UnshareFilesViaPublicKeyRequest)(nil),    // 91: space.UnshareFilesViaPublicKeyRequest
+	(*UnshareFilesViaPublicKeyResponse)(nil),   // 92: space.UnshareFilesViaPublicKeyResponse
+	(*ChangeShareRoleRequest)(nil),             // 93: space.ChangeShareRoleRequest
+	(*ChangeShareRoleResponse)(nil),            // 94: space.ChangeShareRoleResponse
+	(*GeneratePublicFileLinkRequest)(nil),      // 95: space.GeneratePublicFileLinkRequest
+	(*GeneratePublicFileLinkResponse)(nil),     // 96: space.GeneratePublicFileLinkResponse
+	(*PublicLink)(nil),                         // 97: space.PublicLink
+	(*ListPublicLinksRequest)(nil),             // 98: space.ListPublicLinksRequest
+	(*ListPublicLinksResponse)(nil),            // 99: space.ListPublicLinksResponse
+	(*RevokePublicLinkRequest)(nil),            // 100: space.RevokePublicLinkRequest
+	(*RevokePublicLinkResponse)(nil),           // 101: space.RevokePublicLinkResponse
+	(*ToggleFuseRequest)(nil),                  // 102: space.ToggleFuseRequest
+	(*FuseDriveResponse)(nil),                  // 103: space.FuseDriveResponse
+	(*ListBucketsRequest)(nil),                 // 104: space.ListBucketsRequest
+	(*ListBucketsResponse)(nil),                // 105: space.ListBucketsResponse
+	(*DeleteBucketRequest)(nil),                // 106: space.DeleteBucketRequest
+	(*DeleteBucketResponse)(nil),               // 107: space.DeleteBucketResponse
+	(*RenameBucketRequest)(nil),                // 108: space.RenameBucketRequest
+	(*RenameBucketResponse)(nil),               // 109: space.RenameBucketResponse
+	(*Invitation)(nil),                         // 110: space.Invitation
+	(*UsageAlert)(nil),                         // 111: space.UsageAlert
+	(*InvitationAccept)(nil),                   // 112: space.InvitationAccept
+	(*RevokedInvitation)(nil),                  // 113: space.RevokedInvitation
+	(*Notification)(nil),                       // 114: space.Notification
+	(*ReceivedKeyShare)(nil),                   // 115: space.ReceivedKeyShare
+	(*KeyRotation)(nil),                        // 116: space.KeyRotation
+	(*HandleFilesInvitationRequest)(nil),       // 117: space.HandleFilesInvitationRequest
+	(*HandleFilesInvitationResponse)(nil),      // 118: space.HandleFilesInvitationResponse
+	(*SentInvitation)(nil),                     // 119: space.SentInvitation
+	(*ListSentInvitationsRequest)(nil),         // 120: space.ListSentInvitationsRequest
+	(*ListSentInvitationsResponse)(nil),        // 121: space.ListSentInvitationsResponse
+	(*CancelInvitationRequest)(nil),            // 122: space.CancelInvitationRequest
+	(*CancelInvitationResponse)(nil),           // 123: space.CancelInvitationResponse
+	(*NotificationEventResponse)(nil),          // 124: space.NotificationEventResponse
+	(*GetNotificationsRequest)(nil),            // 125: space.GetNotificationsRequest
+	(*GetNotificationsResponse)(nil),           // 126: space.GetNotificationsResponse
+	(*ReadNotificationRequest)(nil),            // 127: space.ReadNotificationRequest
+	(*ReadNotificationResponse)(nil),           // 128: space.ReadNotificationResponse
+	(*GetPublicKeyRequest)(nil),                // 129: space.GetPublicKeyRequest
+	(*GetPublicKeyResponse)(nil),               // 130: space.GetPublicKeyResponse
+	(*RecoverKeysByLocalBackupRequest)(nil),    // 131: space.RecoverKeysByLocalBackupRequest
+	(*RecoverKeysByLocalBackupResponse)(nil),   // 132: space.RecoverKeysByLocalBackupResponse
+	(*CreateLocalKeysBackupRequest)(nil),       // 133: space.CreateLocalKeysBackupRequest
+	(*CreateLocalKeysBackupResponse)(nil),      // 134: space.CreateLocalKeysBackupResponse
+	(*SplitKeyIntoSharesRequest)(nil),          // 135: space.SplitKeyIntoSharesRequest
+	(*KeyShare)(nil),                           // 136: space.KeyShare
+	(*SplitKeyIntoSharesResponse)(nil),         // 137: space.SplitKeyIntoSharesResponse
+	(*RecoverFromSharesRequest)(nil),           // 138: space.RecoverFromSharesRequest
+	(*RecoverFromSharesResponse)(nil),          // 139: space.RecoverFromSharesResponse
+	(*RotateIdentityKeyRequest)(nil),           // 140: space.RotateIdentityKeyRequest
+	(*RotateIdentityKeyResponse)(nil),          // 141: space.RotateIdentityKeyResponse
+	(*DeleteAccountRequest)(nil),               // 142: space.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),              // 143: space.DeleteAccountResponse
+	(*DeleteKeyPairRequest)(nil),               // 144: space.DeleteKeyPairRequest
+	(*DeleteKeyPairResponse)(nil),              // 145: space.DeleteKeyPairResponse
+	(*GetAPISessionTokensRequest)(nil),         // 146: space.GetAPISessionTokensRequest
+	(*GetAPISessionTokensResponse)(nil),        // 147: space.GetAPISessionTokensResponse
+	(*GetRecentlySharedWithRequest)(nil),       // 148: space.GetRecentlySharedWithRequest
+	(*GetRecentlySharedWithResponse)(nil),      // 149: space.GetRecentlySharedWithResponse
+	(*InitializeMasterAppTokenRequest)(nil),    // 150: space.InitializeMasterAppTokenRequest
+	(*InitializeMasterAppTokenResponse)(nil),   // 151: space.InitializeMasterAppTokenResponse
+	(*AllowedMethod)(nil),                      // 152: space.AllowedMethod
+	(*GenerateAppTokenRequest)(nil),            // 153: space.GenerateAppTokenRequest
+	(*GenerateAppTokenResponse)(nil),           // 154: space.GenerateAppTokenResponse
+	(*RemoveDirOrFileRequest)(nil),             // 155: space.RemoveDirOrFileRequest
+	(*RemoveDirOrFileResponse)(nil),            // 156: space.RemoveDirOrFileResponse
+	(*ListProfilesRequest)(nil),                // 157: space.ListProfilesRequest
+	(*ListProfilesResponse)(nil),               // 158: space.ListProfilesResponse
+	(*CreateProfileRequest)(nil),               // 159: space.CreateProfileRequest
+	(*CreateProfileResponse)(nil),              // 160: space.CreateProfileResponse
+	(*SwitchProfileRequest)(nil),               // 161: space.SwitchProfileRequest
+	(*SwitchProfileResponse)(nil),              // 162: space.SwitchProfileResponse
+	(*empty.Empty)(nil),                        // 163: google.protobuf.Empty
+}
+var file_space_proto_depIdxs = []int32{
+	11,  // 0: space.SearchFilesResponse.entries:type_name -> space.SearchFilesDirectoryEntry
+	32,  // 1: space.SearchFilesDirectoryEntry.entry:type_name -> space.ListDirectoryEntry
+	33,  // 2: space.GetSharedWithMeFilesResponse.items:type_name -> space.SharedListDirectoryEntry
+	33,  // 3: space.GetSharedByMeFilesResponse.items:type_name -> space.SharedListDirectoryEntry
+	0,   // 4: space.BucketSettings.backupPolicy:type_name -> space.BackupPolicy
+	1,   // 5: space.BucketSettings.defaultShareRole:type_name -> space.ShareRole
+	22,  // 6: space.GetBucketSettingsResponse.settings:type_name -> space.BucketSettings
+	22,  // 7: space.UpdateBucketSettingsRequest.settings:type_name -> space.BucketSettings
+	22,  // 8: space.UpdateBucketSettingsResponse.settings:type_name -> space.BucketSettings
+	28,  // 9: space.BucketBackupRestoreResponse.files:type_name -> space.RestoreFile
+	1,   // 10: space.FileMember.role:type_name -> space.ShareRole
+	31,  // 11: space.ListDirectoryEntry.members:type_name -> space.FileMember
+	32,  // 12: space.SharedListDirectoryEntry.entry:type_name -> space.ListDirectoryEntry
+	32,  // 13: space.ListDirectoriesResponse.entries:type_name -> space.ListDirectoryEntry
+	32,  // 14: space.ListDirectoryResponse.entries:type_name -> space.ListDirectoryEntry
+	38,  // 15: space.Bucket.members:type_name -> space.BucketMember
+	39,  // 16: space.CreateBucketResponse.bucket:type_name -> space.Bucket
+	2,   // 17: space.SubscribeRequest.types:type_name -> space.EventType
+	3,   // 18: space.SubscribeRequest.slowSubscriberPolicy:type_name -> space.SlowSubscriberPolicy
+	3,   // 19: space.TxlSubscribeRequest.slowSubscriberPolicy:type_name -> space.SlowSubscriberPolicy
+	7,   // 20: space.NotificationSubscribeRequest.types:type_name -> space.NotificationType
+	3,   // 21: space.NotificationSubscribeRequest.slowSubscriberPolicy:type_name -> space.SlowSubscriberPolicy
+	2,   // 22: space.FileEventResponse.type:type_name -> space.EventType
+	32,  // 23: space.FileEventResponse.entry:type_name -> space.ListDirectoryEntry
+	59,  // 24: space.AddItemsResponse.result:type_name -> space.AddItemResult
+	63,  // 25: space.ListFileConflictsResponse.conflicts:type_name -> space.FileConflict
+	4,   // 26: space.ResolveFileConflictRequest.resolution:type_name -> space.FileConflictResolution
+	72,  // 27: space.ListSyncFoldersResponse.folders:type_name -> space.SyncFolder
+	5,   // 28: space.BackupKeysByPassphraseRequest.type:type_name -> space.KeyBackupType
+	5,   // 29: space.ChangeBackupPassphraseRequest.type:type_name -> space.KeyBackupType
+	5,   // 30: space.RecoverKeysByPassphraseRequest.type:type_name -> space.KeyBackupType
+	83,  // 31: space.ShareBucketResponse.threadinfo:type_name -> space.ThreadInfo
+	83,  // 32: space.JoinBucketRequest.threadinfo:type_name -> space.ThreadInfo
+	89,  // 33: space.ShareFilesViaPublicKeyRequest.paths:type_name -> space.FullPath
+	1,   // 34: space.ShareFilesViaPublicKeyRequest.role:type_name -> space.ShareRole
+	89,  // 35: space.UnshareFilesViaPublicKeyRequest.paths:type_name -> space.FullPath
+	89,  // 36: space.ChangeShareRoleRequest.paths:type_name -> space.FullPath
+	1,   // 37: space.ChangeShareRoleRequest.role:type_name -> space.ShareRole
+	97,  // 38: space.ListPublicLinksResponse.links:type_name -> space.PublicLink
+	6,   // 39: space.FuseDriveResponse.state:type_name -> space.FuseState
+	39,  // 40: space.ListBucketsResponse.buckets:type_name -> space.Bucket
+	39,  // 41: space.RenameBucketResponse.bucket:type_name -> space.Bucket
+	8,   // 42: space.Invitation.status:type_name -> space.InvitationStatus
+	89,  // 43: space.Invitation.itemPaths:type_name -> space.FullPath
+	89,  // 44: space.RevokedInvitation.itemPaths:type_name -> space.FullPath
+	110, // 45: space.Notification.invitationValue:type_name -> space.Invitation
+	111, // 46: space.Notification.usageAlert:type_name -> space.UsageAlert
+	112, // 47: space.Notification.invitationAccept:type_name -> space.InvitationAccept
+	113, // 48: space.Notification.revokedInvitation:type_name -> space.RevokedInvitation
+	115, // 49: space.Notification.keyShare:type_name -> space.ReceivedKeyShare
+	116, // 50: space.Notification.keyRotation:type_name -> space.KeyRotation
+	7,   // 51: space.Notification.type:type_name -> space.NotificationType
+	89,  // 52: space.SentInvitation.itemPaths:type_name -> space.FullPath
+	8,   // 53: space.SentInvitation.status:type_name -> space.InvitationStatus
+	119, // 54: space.ListSentInvitationsResponse.invitations:type_name -> space.SentInvitation
+	114, // 55: space.NotificationEventResponse.notification:type_name -> space.Notification
+	114, // 56: space.GetNotificationsResponse.notifications:type_name -> space.Notification
+	136, // 57: space.SplitKeyIntoSharesResponse.shares:type_name -> space.KeyShare
+	5,   // 58: space.RotateIdentityKeyRequest.type:type_name -> space.KeyBackupType
+	31,  // 59: space.GetRecentlySharedWithResponse.members:type_name -> space.FileMember
+	152, // 60: space.GenerateAppTokenRequest.allowedMethods:type_name -> space.AllowedMethod
+	30,  // 61: space.SpaceApi.ListDirectories:input_type -> space.ListDirectoriesRequest
+	35,  // 62: space.SpaceApi.ListDirectory:input_type -> space.ListDirectoryRequest
+	41,  // 63: space.SpaceApi.GenerateKeyPair:input_type -> space.GenerateKeyPairRequest
+	43,  // 64: space.SpaceApi.GetStoredMnemonic:input_type -> space.GetStoredMnemonicRequest
+	45,  // 65: space.SpaceApi.RestoreKeyPairViaMnemonic:input_type -> space.RestoreKeyPairViaMnemonicRequest
+	47,  // 66: space.SpaceApi.ValidateMnemonic:input_type -> space.ValidateMnemonicRequest
+	144, // 67: space.SpaceApi.DeleteKeyPair:input_type -> space.DeleteKeyPairRequest
+	41,  // 68: space.SpaceApi.GenerateKeyPairWithForce:input_type -> space.GenerateKeyPairRequest
+	129, // 69: space.SpaceApi.GetPublicKey:input_type -> space.GetPublicKeyRequest
+	49,  // 70: space.SpaceApi.Subscribe:input_type -> space.SubscribeRequest
+	50,  // 71: space.SpaceApi.TxlSubscribe:input_type -> space.TxlSubscribeRequest
+	54,  // 72: space.SpaceApi.OpenFile:input_type -> space.OpenFileRequest
+	155, // 73: space.SpaceApi.RemoveDirOrFile:input_type -> space.RemoveDirOrFileRequest
+	95,  // 74: space.SpaceApi.GeneratePublicFileLink:input_type -> space.GeneratePublicFileLinkRequest
+	98,  // 75: space.SpaceApi.ListPublicLinks:input_type -> space.ListPublicLinksRequest
+	100, // 76: space.SpaceApi.RevokePublicLink:input_type -> space.RevokePublicLinkRequest
+	14,  // 77: space.SpaceApi.GetSharedWithMeFiles:input_type -> space.GetSharedWithMeFilesRequest
+	16,  // 78: space.SpaceApi.GetSharedByMeFiles:input_type -> space.GetSharedByMeFilesRequest
+	56,  // 79: space.SpaceApi.OpenPublicFile:input_type -> space.OpenPublicFileRequest
+	58,  // 80: space.SpaceApi.AddItems:input_type -> space.AddItemsRequest
+	61,  // 81: space.SpaceApi.CreateFolder:input_type -> space.CreateFolderRequest
+	64,  // 82: space.SpaceApi.ListFileConflicts:input_type -> space.ListFileConflictsRequest
+	66,  // 83: space.SpaceApi.ResolveFileConflict:input_type -> space.ResolveFileConflictRequest
+	68,  // 84: space.SpaceApi.AddSyncFolder:input_type -> space.AddSyncFolderRequest
+	70,  // 85: space.SpaceApi.RemoveSyncFolder:input_type -> space.RemoveSyncFolderRequest
+	73,  // 86: space.SpaceApi.ListSyncFolders:input_type -> space.ListSyncFoldersRequest
+	102, // 87: space.SpaceApi.ToggleFuseDrive:input_type -> space.ToggleFuseRequest
+	163, // 88: space.SpaceApi.GetFuseDriveStatus:input_type -> google.protobuf.Empty
+	37,  // 89: space.SpaceApi.CreateBucket:input_type -> space.CreateBucketRequest
+	75,  // 90: space.SpaceApi.BackupKeysByPassphrase:input_type -> space.BackupKeysByPassphraseRequest
+	77,  // 91: space.SpaceApi.ChangeBackupPassphrase:input_type -> space.ChangeBackupPassphraseRequest
+	157, // 92: space.SpaceApi.ListProfiles:input_type -> space.ListProfilesRequest
+	159, // 93: space.SpaceApi.CreateProfile:input_type -> space.CreateProfileRequest
+	161, // 94: space.SpaceApi.SwitchProfile:input_type -> space.SwitchProfileRequest
+	79,  // 95: space.SpaceApi.RecoverKeysByPassphrase:input_type -> space.RecoverKeysByPassphraseRequest
+	81,  // 96: space.SpaceApi.TestKeysPassphrase:input_type -> space.TestKeysPassphraseRequest
+	133, // 97: space.SpaceApi.CreateLocalKeysBackup:input_type -> space.CreateLocalKeysBackupRequest
+	131, // 98: space.SpaceApi.RecoverKeysByLocalBackup:input_type -> space.RecoverKeysByLocalBackupRequest
+	135, // 99: space.SpaceApi.SplitKeyIntoShares:input_type -> space.SplitKeyIntoSharesRequest
+	138, // 100: space.SpaceApi.RecoverFromShares:input_type -> space.RecoverFromSharesRequest
+	140, // 101: space.SpaceApi.RotateIdentityKey:input_type -> space.RotateIdentityKeyRequest
+	84,  // 102: space.SpaceApi.ShareBucket:input_type -> space.ShareBucketRequest
+	86,  // 103: space.SpaceApi.JoinBucket:input_type -> space.JoinBucketRequest
+	88,  // 104: space.SpaceApi.ShareFilesViaPublicKey:input_type -> space.ShareFilesViaPublicKeyRequest
+	91,  // 105: space.SpaceApi.UnshareFilesViaPublicKey:input_type -> space.UnshareFilesViaPublicKeyRequest
+	93,  // 106: space.SpaceApi.ChangeShareRole:input_type -> space.ChangeShareRoleRequest
+	117, // 107: space.SpaceApi.HandleFilesInvitation:input_type -> space.HandleFilesInvitationRequest
+	120, // 108: space.SpaceApi.ListSentInvitations:input_type -> space.ListSentInvitationsRequest
+	122, // 109: space.SpaceApi.CancelInvitation:input_type -> space.CancelInvitationRequest
+	51,  // 110: space.SpaceApi.NotificationSubscribe:input_type -> space.NotificationSubscribeRequest
+	104, // 111: space.SpaceApi.ListBuckets:input_type -> space.ListBucketsRequest
+	106, // 112: space.SpaceApi.DeleteBucket:input_type -> space.DeleteBucketRequest
+	108, // 113: space.SpaceApi.RenameBucket:input_type -> space.RenameBucketRequest
+	125, // 114: space.SpaceApi.GetNotifications:input_type -> space.GetNotificationsRequest
+	127, // 115: space.SpaceApi.ReadNotification:input_type -> space.ReadNotificationRequest
+	142, // 116: space.SpaceApi.DeleteAccount:input_type -> space.DeleteAccountRequest
+	20,  // 117: space.SpaceApi.ToggleBucketBackup:input_type -> space.ToggleBucketBackupRequest
+	23,  // 118: space.SpaceApi.GetBucketSettings:input_type -> space.GetBucketSettingsRequest
+	25,  // 119: space.SpaceApi.UpdateBucketSettings:input_type -> space.UpdateBucketSettingsRequest
+	27,  // 120: space.SpaceApi.BucketBackupRestore:input_type -> space.BucketBackupRestoreRequest
+	18,  // 121: space.SpaceApi.GetUsageInfo:input_type -> space.GetUsageInfoRequest
+	146, // 122: space.SpaceApi.GetAPISessionTokens:input_type -> space.GetAPISessionTokensRequest
+	148, // 123: space.SpaceApi.GetRecentlySharedWith:input_type -> space.GetRecentlySharedWithRequest
+	12,  // 124: space.SpaceApi.SetNotificationsLastSeenAt:input_type -> space.SetNotificationsLastSeenAtRequest
+	9,   // 125: space.SpaceApi.SearchFiles:input_type -> space.SearchFilesRequest
+	150, // 126: space.SpaceApi.InitializeMasterAppToken:input_type -> space.InitializeMasterAppTokenRequest
+	153, // 127: space.SpaceApi.GenerateAppToken:input_type -> space.GenerateAppTokenRequest
+	34,  // 128: space.SpaceApi.ListDirectories:output_type -> space.ListDirectoriesResponse
+	36,  // 129: space.SpaceApi.ListDirectory:output_type -> space.ListDirectoryResponse
+	42,  // 130: space.SpaceApi.GenerateKeyPair:output_type -> space.GenerateKeyPairResponse
+	44,  // 131: space.SpaceApi.GetStoredMnemonic:output_type -> space.GetStoredMnemonicResponse
+	46,  // 132: space.SpaceApi.RestoreKeyPairViaMnemonic:output_type -> space.RestoreKeyPairViaMnemonicResponse
+	48,  // 133: space.SpaceApi.ValidateMnemonic:output_type -> space.ValidateMnemonicResponse
+	145, // 134: space.SpaceApi.DeleteKeyPair:output_type -> space.DeleteKeyPairResponse
+	42,  // 135: space.SpaceApi.GenerateKeyPairWithForce:output_type -> space.GenerateKeyPairResponse
+	130, // 136: space.SpaceApi.GetPublicKey:output_type -> space.GetPublicKeyResponse
+	52,  // 137: space.SpaceApi.Subscribe:output_type -> space.FileEventResponse
+	53,  // 138: space.SpaceApi.TxlSubscribe:output_type -> space.TextileEventResponse
+	55,  // 139: space.SpaceApi.OpenFile:output_type -> space.OpenFileResponse
+	156, // 140: space.SpaceApi.RemoveDirOrFile:output_type -> space.RemoveDirOrFileResponse
+	96,  // 141: space.SpaceApi.GeneratePublicFileLink:output_type -> space.GeneratePublicFileLinkResponse
+	99,  // 142: space.SpaceApi.ListPublicLinks:output_type -> space.ListPublicLinksResponse
+	101, // 143: space.SpaceApi.RevokePublicLink:output_type -> space.RevokePublicLinkResponse
+	15,  // 144: space.SpaceApi.GetSharedWithMeFiles:output_type -> space.GetSharedWithMeFilesResponse
+	17,  // 145: space.SpaceApi.GetSharedByMeFiles:output_type -> space.GetSharedByMeFilesResponse
+	57,  // 146: space.SpaceApi.OpenPublicFile:output_type -> space.OpenPublicFileResponse
+	60,  // 147: space.SpaceApi.AddItems:output_type -> space.AddItemsResponse
+	62,  // 148: space.SpaceApi.CreateFolder:output_type -> space.CreateFolderResponse
+	65,  // 149: space.SpaceApi.ListFileConflicts:output_type -> space.ListFileConflictsResponse
+	67,  // 150: space.SpaceApi.ResolveFileConflict:output_type -> space.ResolveFileConflictResponse
+	69,  // 151: space.SpaceApi.AddSyncFolder:output_type -> space.AddSyncFolderResponse
+	71,  // 152: space.SpaceApi.RemoveSyncFolder:output_type -> space.RemoveSyncFolderResponse
+	74,  // 153: space.SpaceApi.ListSyncFolders:output_type -> space.ListSyncFoldersResponse
+	103, // 154: space.SpaceApi.ToggleFuseDrive:output_type -> space.FuseDriveResponse
+	103, // 155: space.SpaceApi.GetFuseDriveStatus:output_type -> space.FuseDriveResponse
+	40,  // 156: space.SpaceApi.CreateBucket:output_type -> space.CreateBucketResponse
+	76,  // 157: space.SpaceApi.BackupKeysByPassphrase:output_type -> space.BackupKeysByPassphraseResponse
+	78,  // 158: space.SpaceApi.ChangeBackupPassphrase:output_type -> space.ChangeBackupPassphraseResponse
+	158, // 159: space.SpaceApi.ListProfiles:output_type -> space.ListProfilesResponse
+	160, // 160: space.SpaceApi.CreateProfile:output_type -> space.CreateProfileResponse
+	162, // 161: space.SpaceApi.SwitchProfile:output_type -> space.SwitchProfileResponse
+	80,  // 162: space.SpaceApi.RecoverKeysByPassphrase:output_type -> space.RecoverKeysByPassphraseResponse
+	82,  // 163: space.SpaceApi.TestKeysPassphrase:output_type -> space.TestKeysPassphraseResponse
+	134, // 164: space.SpaceApi.CreateLocalKeysBackup:output_type -> space.CreateLocalKeysBackupResponse
+	132, // 165: space.SpaceApi.RecoverKeysByLocalBackup:output_type -> space.RecoverKeysByLocalBackupResponse
+	137, // 166: space.SpaceApi.SplitKeyIntoShares:output_type -> space.SplitKeyIntoSharesResponse
+	139, // 167: space.SpaceApi.RecoverFromShares:output_type -> space.RecoverFromSharesResponse
+	141, // 168: space.SpaceApi.RotateIdentityKey:output_type -> space.RotateIdentityKeyResponse
+	85,  // 169: space.SpaceApi.ShareBucket:output_type -> space.ShareBucketResponse
+	87,  // 170: space.SpaceApi.JoinBucket:output_type -> space.JoinBucketResponse
+	90,  // 171: space.SpaceApi.ShareFilesViaPublicKey:output_type -> space.ShareFilesViaPublicKeyResponse
+	92,  // 172: space.SpaceApi.UnshareFilesViaPublicKey:output_type -> space.UnshareFilesViaPublicKeyResponse
+	94,  // 173: space.SpaceApi.ChangeShareRole:output_type -> space.ChangeShareRoleResponse
+	118, // 174: space.SpaceApi.HandleFilesInvitation:output_type -> space.HandleFilesInvitationResponse
+	121, // 175: space.SpaceApi.ListSentInvitations:output_type -> space.ListSentInvitationsResponse
+	123, // 176: space.SpaceApi.CancelInvitation:output_type -> space.CancelInvitationResponse
+	124, // 177: space.SpaceApi.NotificationSubscribe:output_type -> space.NotificationEventResponse
+	105, // 178: space.SpaceApi.ListBuckets:output_type -> space.ListBucketsResponse
+	107, // 179: space.SpaceApi.DeleteBucket:output_type -> space.DeleteBucketResponse
+	109, // 180: space.SpaceApi.RenameBucket:output_type -> space.RenameBucketResponse
+	126, // 181: space.SpaceApi.GetNotifications:output_type -> space.GetNotificationsResponse
+	128, // 182: space.SpaceApi.ReadNotification:output_type -> space.ReadNotificationResponse
+	143, // 183: space.SpaceApi.DeleteAccount:output_type -> space.DeleteAccountResponse
+	21,  // 184: space.SpaceApi.ToggleBucketBackup:output_type -> space.ToggleBucketBackupResponse
+	24,  // 185: space.SpaceApi.GetBucketSettings:output_type -> space.GetBucketSettingsResponse
+	26,  // 186: space.SpaceApi.UpdateBucketSettings:output_type -> space.UpdateBucketSettingsResponse
+	29,  // 187: space.SpaceApi.BucketBackupRestore:output_type -> space.BucketBackupRestoreResponse
+	19,  // 188: space.SpaceApi.GetUsageInfo:output_type -> space.GetUsageInfoResponse
+	147, // 189: space.SpaceApi.GetAPISessionTokens:output_type -> space.GetAPISessionTokensResponse
+	149, // 190: space.SpaceApi.GetRecentlySharedWith:output_type -> space.GetRecentlySharedWithResponse
+	13,  // 191: space.SpaceApi.SetNotificationsLastSeenAt:output_type -> space.SetNotificationsLastSeenAtResponse
+	10,  // 192: space.SpaceApi.SearchFiles:output_type -> space.SearchFilesResponse
+	151, // 193: space.SpaceApi.InitializeMasterAppToken:output_type -> space.InitializeMasterAppTokenResponse
+	154, // 194: space.SpaceApi.GenerateAppToken:output_type -> space.GenerateAppTokenResponse
+	128, // [128:195] is the sub-list for method output_type
+	61,  // [61:128] is the sub-list for method input_type
+	61,  // [61:61] is the sub-list for extension type_name
+	61,  // [61:61] is the sub-list for extension extendee
+	0,   // [0:61] is the sub-list for field type_name
+}
+
+func init() { file_space_proto_init() }
+func file_space_proto_init() {
+	if File_space_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_space_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchFilesDirectoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotificationsLastSeenAtRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetNotificationsLastSeenAtResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSharedWithMeFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSharedWithMeFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSharedByMeFilesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSharedByMeFilesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleBucketBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleBucketBackupResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BucketSettings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBucketSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBucketSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBucketSettingsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateBucketSettingsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BucketBackupRestoreRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreFile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BucketBackupRestoreResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDirectoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDirectoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SharedListDirectoryEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDirectoriesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListDirectoryResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBucketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BucketMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Bucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBucketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateKeyPairRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateKeyPairResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoredMnemonicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStoredMnemonicResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreKeyPairViaMnemonicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreKeyPairViaMnemonicResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateMnemonicRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ValidateMnemonicResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxlSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationSubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextileEventResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenFileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenFileResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPublicFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6639,8 +11591,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchFilesResponse); i {
+		file_space_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenPublicFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6651,8 +11603,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchFilesDirectoryEntry); i {
+		file_space_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddItemsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6663,8 +11615,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNotificationsLastSeenAtRequest); i {
+		file_space_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddItemResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6675,8 +11627,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SetNotificationsLastSeenAtResponse); i {
+		file_space_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddItemsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6687,8 +11639,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSharedWithMeFilesRequest); i {
+		file_space_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFolderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6699,8 +11651,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSharedWithMeFilesResponse); i {
+		file_space_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFolderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6711,8 +11663,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSharedByMeFilesRequest); i {
+		file_space_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FileConflict); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6723,8 +11675,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetSharedByMeFilesResponse); i {
+		file_space_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFileConflictsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6735,8 +11687,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsageInfoRequest); i {
+		file_space_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFileConflictsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6747,8 +11699,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUsageInfoResponse); i {
+		file_space_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveFileConflictRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6759,8 +11711,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleBucketBackupRequest); i {
+		file_space_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResolveFileConflictResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6771,8 +11723,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleBucketBackupResponse); i {
+		file_space_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSyncFolderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6783,8 +11735,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BucketBackupRestoreRequest); i {
+		file_space_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddSyncFolderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6795,8 +11747,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BucketBackupRestoreResponse); i {
+		file_space_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSyncFolderRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6807,8 +11759,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDirectoriesRequest); i {
+		file_space_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveSyncFolderResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6819,8 +11771,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileMember); i {
+		file_space_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SyncFolder); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6831,8 +11783,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDirectoryEntry); i {
+		file_space_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSyncFoldersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6843,8 +11795,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SharedListDirectoryEntry); i {
+		file_space_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSyncFoldersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6855,8 +11807,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDirectoriesResponse); i {
+		file_space_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupKeysByPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6867,8 +11819,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDirectoryRequest); i {
+		file_space_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupKeysByPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6879,8 +11831,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListDirectoryResponse); i {
+		file_space_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeBackupPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6891,8 +11843,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBucketRequest); i {
+		file_space_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeBackupPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6903,8 +11855,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BucketMember); i {
+		file_space_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverKeysByPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6915,8 +11867,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Bucket); i {
+		file_space_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverKeysByPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6927,8 +11879,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBucketResponse); i {
+		file_space_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestKeysPassphraseRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6939,8 +11891,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateKeyPairRequest); i {
+		file_space_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TestKeysPassphraseResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6951,8 +11903,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateKeyPairResponse); i {
+		file_space_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ThreadInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6963,8 +11915,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStoredMnemonicRequest); i {
+		file_space_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareBucketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6975,8 +11927,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStoredMnemonicResponse); i {
+		file_space_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareBucketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6987,8 +11939,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreKeyPairViaMnemonicRequest); i {
+		file_space_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinBucketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -6999,8 +11951,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestoreKeyPairViaMnemonicResponse); i {
+		file_space_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinBucketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7011,8 +11963,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FileEventResponse); i {
+		file_space_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareFilesViaPublicKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7023,8 +11975,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TextileEventResponse); i {
+		file_space_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FullPath); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7035,8 +11987,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenFileRequest); i {
+		file_space_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ShareFilesViaPublicKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7047,8 +11999,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenFileResponse); i {
+		file_space_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareFilesViaPublicKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7059,8 +12011,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPublicFileRequest); i {
+		file_space_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnshareFilesViaPublicKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7071,8 +12023,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenPublicFileResponse); i {
+		file_space_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeShareRoleRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7083,8 +12035,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddItemsRequest); i {
+		file_space_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChangeShareRoleResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7095,8 +12047,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddItemResult); i {
+		file_space_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePublicFileLinkRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7107,8 +12059,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddItemsResponse); i {
+		file_space_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GeneratePublicFileLinkResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7119,8 +12071,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFolderRequest); i {
+		file_space_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublicLink); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7131,8 +12083,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateFolderResponse); i {
+		file_space_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPublicLinksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7143,8 +12095,116 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackupKeysByPassphraseRequest); i {
+		file_space_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPublicLinksResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokePublicLinkRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokePublicLinkResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToggleFuseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FuseDriveResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBucketsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListBucketsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBucketRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[98].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBucketResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_space_proto_msgTypes[99].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameBucketRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7155,8 +12215,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BackupKeysByPassphraseResponse); i {
+		file_space_proto_msgTypes[100].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameBucketResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7167,8 +12227,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverKeysByPassphraseRequest); i {
+		file_space_proto_msgTypes[101].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Invitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7179,8 +12239,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverKeysByPassphraseResponse); i {
+		file_space_proto_msgTypes[102].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageAlert); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7191,8 +12251,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestKeysPassphraseRequest); i {
+		file_space_proto_msgTypes[103].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InvitationAccept); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7203,8 +12263,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TestKeysPassphraseResponse); i {
+		file_space_proto_msgTypes[104].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevokedInvitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7215,8 +12275,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ThreadInfo); i {
+		file_space_proto_msgTypes[105].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Notification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7227,8 +12287,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareBucketRequest); i {
+		file_space_proto_msgTypes[106].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReceivedKeyShare); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7239,8 +12299,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareBucketResponse); i {
+		file_space_proto_msgTypes[107].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyRotation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7251,8 +12311,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinBucketRequest); i {
+		file_space_proto_msgTypes[108].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleFilesInvitationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7263,8 +12323,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinBucketResponse); i {
+		file_space_proto_msgTypes[109].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HandleFilesInvitationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7275,8 +12335,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareFilesViaPublicKeyRequest); i {
+		file_space_proto_msgTypes[110].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SentInvitation); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7287,8 +12347,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FullPath); i {
+		file_space_proto_msgTypes[111].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSentInvitationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7299,8 +12359,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ShareFilesViaPublicKeyResponse); i {
+		file_space_proto_msgTypes[112].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSentInvitationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7311,8 +12371,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnshareFilesViaPublicKeyRequest); i {
+		file_space_proto_msgTypes[113].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelInvitationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7323,8 +12383,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UnshareFilesViaPublicKeyResponse); i {
+		file_space_proto_msgTypes[114].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CancelInvitationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7335,8 +12395,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeneratePublicFileLinkRequest); i {
+		file_space_proto_msgTypes[115].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotificationEventResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7347,8 +12407,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GeneratePublicFileLinkResponse); i {
+		file_space_proto_msgTypes[116].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7359,8 +12419,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ToggleFuseRequest); i {
+		file_space_proto_msgTypes[117].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNotificationsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7371,8 +12431,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FuseDriveResponse); i {
+		file_space_proto_msgTypes[118].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotificationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7383,8 +12443,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBucketsRequest); i {
+		file_space_proto_msgTypes[119].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReadNotificationResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7395,8 +12455,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListBucketsResponse); i {
+		file_space_proto_msgTypes[120].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7407,8 +12467,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Invitation); i {
+		file_space_proto_msgTypes[121].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPublicKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7419,8 +12479,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UsageAlert); i {
+		file_space_proto_msgTypes[122].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverKeysByLocalBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7431,8 +12491,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InvitationAccept); i {
+		file_space_proto_msgTypes[123].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverKeysByLocalBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7443,8 +12503,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevokedInvitation); i {
+		file_space_proto_msgTypes[124].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLocalKeysBackupRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7455,8 +12515,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Notification); i {
+		file_space_proto_msgTypes[125].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateLocalKeysBackupResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7467,8 +12527,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleFilesInvitationRequest); i {
+		file_space_proto_msgTypes[126].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitKeyIntoSharesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7479,8 +12539,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HandleFilesInvitationResponse); i {
+		file_space_proto_msgTypes[127].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeyShare); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7491,8 +12551,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotificationEventResponse); i {
+		file_space_proto_msgTypes[128].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SplitKeyIntoSharesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7503,8 +12563,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNotificationsRequest); i {
+		file_space_proto_msgTypes[129].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverFromSharesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7515,8 +12575,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNotificationsResponse); i {
+		file_space_proto_msgTypes[130].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RecoverFromSharesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7527,8 +12587,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadNotificationRequest); i {
+		file_space_proto_msgTypes[131].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateIdentityKeyRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7539,8 +12599,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ReadNotificationResponse); i {
+		file_space_proto_msgTypes[132].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RotateIdentityKeyResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7551,8 +12611,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPublicKeyRequest); i {
+		file_space_proto_msgTypes[133].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7563,8 +12623,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPublicKeyResponse); i {
+		file_space_proto_msgTypes[134].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAccountResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7575,8 +12635,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverKeysByLocalBackupRequest); i {
+		file_space_proto_msgTypes[135].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteKeyPairRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7587,8 +12647,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RecoverKeysByLocalBackupResponse); i {
+		file_space_proto_msgTypes[136].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteKeyPairResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7599,8 +12659,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLocalKeysBackupRequest); i {
+		file_space_proto_msgTypes[137].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAPISessionTokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7611,8 +12671,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateLocalKeysBackupResponse); i {
+		file_space_proto_msgTypes[138].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetAPISessionTokensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7623,8 +12683,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAccountRequest); i {
+		file_space_proto_msgTypes[139].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentlySharedWithRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7635,8 +12695,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteAccountResponse); i {
+		file_space_proto_msgTypes[140].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetRecentlySharedWithResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7647,8 +12707,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteKeyPairRequest); i {
+		file_space_proto_msgTypes[141].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitializeMasterAppTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7659,8 +12719,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteKeyPairResponse); i {
+		file_space_proto_msgTypes[142].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*InitializeMasterAppTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7671,8 +12731,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAPISessionTokensRequest); i {
+		file_space_proto_msgTypes[143].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AllowedMethod); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7683,8 +12743,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetAPISessionTokensResponse); i {
+		file_space_proto_msgTypes[144].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateAppTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7695,8 +12755,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRecentlySharedWithRequest); i {
+		file_space_proto_msgTypes[145].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GenerateAppTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7707,8 +12767,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetRecentlySharedWithResponse); i {
+		file_space_proto_msgTypes[146].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDirOrFileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7719,8 +12779,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[91].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitializeMasterAppTokenRequest); i {
+		file_space_proto_msgTypes[147].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDirOrFileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7731,8 +12791,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[92].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitializeMasterAppTokenResponse); i {
+		file_space_proto_msgTypes[148].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7743,8 +12803,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[93].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AllowedMethod); i {
+		file_space_proto_msgTypes[149].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProfilesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7755,8 +12815,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[94].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateAppTokenRequest); i {
+		file_space_proto_msgTypes[150].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProfileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7767,8 +12827,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[95].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateAppTokenResponse); i {
+		file_space_proto_msgTypes[151].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateProfileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7779,8 +12839,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[96].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveDirOrFileRequest); i {
+		file_space_proto_msgTypes[152].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchProfileRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7791,8 +12851,8 @@ func file_space_proto_init() {
 				return nil
 			}
 		}
-		file_space_proto_msgTypes[97].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveDirOrFileResponse); i {
+		file_space_proto_msgTypes[153].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SwitchProfileResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -7804,19 +12864,21 @@ func file_space_proto_init() {
 			}
 		}
 	}
-	file_space_proto_msgTypes[69].OneofWrappers = []interface{}{
+	file_space_proto_msgTypes[105].OneofWrappers = []interface{}{
 		(*Notification_InvitationValue)(nil),
 		(*Notification_UsageAlert)(nil),
 		(*Notification_InvitationAccept)(nil),
 		(*Notification_RevokedInvitation)(nil),
+		(*Notification_KeyShare)(nil),
+		(*Notification_KeyRotation)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_space_proto_rawDesc,
-			NumEnums:      5,
-			NumMessages:   98,
+			NumEnums:      9,
+			NumMessages:   154,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -7855,21 +12917,29 @@ type SpaceApiClient interface {
 	// Restores a keypair given a mnemonic.
 	// This will override any existing key pair
 	RestoreKeyPairViaMnemonic(ctx context.Context, in *RestoreKeyPairViaMnemonicRequest, opts ...grpc.CallOption) (*RestoreKeyPairViaMnemonicResponse, error)
+	// Checks the words and checksum of a mnemonic without restoring from it
+	ValidateMnemonic(ctx context.Context, in *ValidateMnemonicRequest, opts ...grpc.CallOption) (*ValidateMnemonicResponse, error)
 	DeleteKeyPair(ctx context.Context, in *DeleteKeyPairRequest, opts ...grpc.CallOption) (*DeleteKeyPairResponse, error)
 	// Force Generation of KeyPair. This will override existing keys stored in daemon.
 	GenerateKeyPairWithForce(ctx context.Context, in *GenerateKeyPairRequest, opts ...grpc.CallOption) (*GenerateKeyPairResponse, error)
 	GetPublicKey(ctx context.Context, in *GetPublicKeyRequest, opts ...grpc.CallOption) (*GetPublicKeyResponse, error)
 	// Subscribe to file events. This streams responses to the caller
-	Subscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SpaceApi_SubscribeClient, error)
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (SpaceApi_SubscribeClient, error)
 	// Subscribe to textile events. This streams responses to the caller
-	TxlSubscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SpaceApi_TxlSubscribeClient, error)
+	TxlSubscribe(ctx context.Context, in *TxlSubscribeRequest, opts ...grpc.CallOption) (SpaceApi_TxlSubscribeClient, error)
 	// Open a file in the daemon.
 	// Daemon keeps track of all open files and closes them if no activity is noticed after a while
 	OpenFile(ctx context.Context, in *OpenFileRequest, opts ...grpc.CallOption) (*OpenFileResponse, error)
 	// Removes a file or dir from a bucket
 	RemoveDirOrFile(ctx context.Context, in *RemoveDirOrFileRequest, opts ...grpc.CallOption) (*RemoveDirOrFileResponse, error)
-	// Generates a copy of the file that's accessible through IPFS gateways
+	// Generates a copy of the file that's accessible through IPFS gateways.
+	// The link stops working once its copy is unpinned, on revoke, after it expires or reaches its max downloads.
 	GeneratePublicFileLink(ctx context.Context, in *GeneratePublicFileLinkRequest, opts ...grpc.CallOption) (*GeneratePublicFileLinkResponse, error)
+	// Lists the public links generated by the user.
+	// Links that expired or reached their max downloads are revoked in the background by the daemon of the user, while it is running.
+	ListPublicLinks(ctx context.Context, in *ListPublicLinksRequest, opts ...grpc.CallOption) (*ListPublicLinksResponse, error)
+	// Revokes a public link by unpinning its encrypted copy from the public share bucket
+	RevokePublicLink(ctx context.Context, in *RevokePublicLinkRequest, opts ...grpc.CallOption) (*RevokePublicLinkResponse, error)
 	// Gets the files that are shared with this recipient
 	GetSharedWithMeFiles(ctx context.Context, in *GetSharedWithMeFilesRequest, opts ...grpc.CallOption) (*GetSharedWithMeFilesResponse, error)
 	// Gets the files that are shared by the sender
@@ -7881,6 +12951,14 @@ type SpaceApiClient interface {
 	AddItems(ctx context.Context, in *AddItemsRequest, opts ...grpc.CallOption) (SpaceApi_AddItemsClient, error)
 	// Creates a folder/directory at the specified path
 	CreateFolder(ctx context.Context, in *CreateFolderRequest, opts ...grpc.CallOption) (*CreateFolderResponse, error)
+	// Lists the opened files that were changed locally after their bucket version changed
+	ListFileConflicts(ctx context.Context, in *ListFileConflictsRequest, opts ...grpc.CallOption) (*ListFileConflictsResponse, error)
+	ResolveFileConflict(ctx context.Context, in *ResolveFileConflictRequest, opts ...grpc.CallOption) (*ResolveFileConflictResponse, error)
+	// Keeps a local folder in sync with a path of a bucket in both directions
+	AddSyncFolder(ctx context.Context, in *AddSyncFolderRequest, opts ...grpc.CallOption) (*AddSyncFolderResponse, error)
+	// Stops syncing a local folder, its files are kept both locally and in the bucket
+	RemoveSyncFolder(ctx context.Context, in *RemoveSyncFolderRequest, opts ...grpc.CallOption) (*RemoveSyncFolderResponse, error)
+	ListSyncFolders(ctx context.Context, in *ListSyncFoldersRequest, opts ...grpc.CallOption) (*ListSyncFoldersResponse, error)
 	// Toggle FUSE drive to be mounted or unmounted
 	ToggleFuseDrive(ctx context.Context, in *ToggleFuseRequest, opts ...grpc.CallOption) (*FuseDriveResponse, error)
 	// Get status of FUSE drive. If mounted or unmounted
@@ -7889,12 +12967,26 @@ type SpaceApiClient interface {
 	CreateBucket(ctx context.Context, in *CreateBucketRequest, opts ...grpc.CallOption) (*CreateBucketResponse, error)
 	// Backup Key by Passphrase
 	BackupKeysByPassphrase(ctx context.Context, in *BackupKeysByPassphraseRequest, opts ...grpc.CallOption) (*BackupKeysByPassphraseResponse, error)
+	// Re-encrypts the keys backup with a new passphrase
+	ChangeBackupPassphrase(ctx context.Context, in *ChangeBackupPassphraseRequest, opts ...grpc.CallOption) (*ChangeBackupPassphraseResponse, error)
+	// Lists the profiles of the daemon and which one is active
+	ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error)
+	// Creates a new empty profile. Switch to it to generate or restore its key pair
+	CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error)
+	// Restarts the daemon services with the keys, buckets, mailbox and search index of the given profile
+	SwitchProfile(ctx context.Context, in *SwitchProfileRequest, opts ...grpc.CallOption) (*SwitchProfileResponse, error)
 	// Recover Keys by Passphrase
 	RecoverKeysByPassphrase(ctx context.Context, in *RecoverKeysByPassphraseRequest, opts ...grpc.CallOption) (*RecoverKeysByPassphraseResponse, error)
 	// Tests a passphrase to see if it matches the one previously used
 	TestKeysPassphrase(ctx context.Context, in *TestKeysPassphraseRequest, opts ...grpc.CallOption) (*TestKeysPassphraseResponse, error)
 	CreateLocalKeysBackup(ctx context.Context, in *CreateLocalKeysBackupRequest, opts ...grpc.CallOption) (*CreateLocalKeysBackupResponse, error)
 	RecoverKeysByLocalBackup(ctx context.Context, in *RecoverKeysByLocalBackupRequest, opts ...grpc.CallOption) (*RecoverKeysByLocalBackupResponse, error)
+	// Splits the identity key into shares for social recovery
+	SplitKeyIntoShares(ctx context.Context, in *SplitKeyIntoSharesRequest, opts ...grpc.CallOption) (*SplitKeyIntoSharesResponse, error)
+	// Rebuilds the identity key from enough key shares and restores the state
+	RecoverFromShares(ctx context.Context, in *RecoverFromSharesRequest, opts ...grpc.CallOption) (*RecoverFromSharesResponse, error)
+	// Replaces the identity key with a new one, moves buckets, shares and contacts over to it and revokes the old key
+	RotateIdentityKey(ctx context.Context, in *RotateIdentityKeyRequest, opts ...grpc.CallOption) (*RotateIdentityKeyResponse, error)
 	// Share bucket
 	ShareBucket(ctx context.Context, in *ShareBucketRequest, opts ...grpc.CallOption) (*ShareBucketResponse, error)
 	// Join bucket
@@ -7903,13 +12995,26 @@ type SpaceApiClient interface {
 	ShareFilesViaPublicKey(ctx context.Context, in *ShareFilesViaPublicKeyRequest, opts ...grpc.CallOption) (*ShareFilesViaPublicKeyResponse, error)
 	// Remove public keys for shared files in buckets
 	UnshareFilesViaPublicKey(ctx context.Context, in *UnshareFilesViaPublicKeyRequest, opts ...grpc.CallOption) (*UnshareFilesViaPublicKeyResponse, error)
+	// Changes the role of members that files were already shared with
+	ChangeShareRole(ctx context.Context, in *ChangeShareRoleRequest, opts ...grpc.CallOption) (*ChangeShareRoleResponse, error)
 	HandleFilesInvitation(ctx context.Context, in *HandleFilesInvitationRequest, opts ...grpc.CallOption) (*HandleFilesInvitationResponse, error)
-	NotificationSubscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SpaceApi_NotificationSubscribeClient, error)
+	// Lists the invitations sent by this user along with their status
+	ListSentInvitations(ctx context.Context, in *ListSentInvitationsRequest, opts ...grpc.CallOption) (*ListSentInvitationsResponse, error)
+	// Cancels a pending invitation, revoking the invitee access to the shared files
+	CancelInvitation(ctx context.Context, in *CancelInvitationRequest, opts ...grpc.CallOption) (*CancelInvitationResponse, error)
+	NotificationSubscribe(ctx context.Context, in *NotificationSubscribeRequest, opts ...grpc.CallOption) (SpaceApi_NotificationSubscribeClient, error)
 	ListBuckets(ctx context.Context, in *ListBucketsRequest, opts ...grpc.CallOption) (*ListBucketsResponse, error)
+	// Delete a bucket with its backup, the default buckets can not be deleted
+	DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error)
+	// Rename a bucket, it keeps its thread and keys. The new slug cannot contain '#' or '/'
+	RenameBucket(ctx context.Context, in *RenameBucketRequest, opts ...grpc.CallOption) (*RenameBucketResponse, error)
 	GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error)
 	ReadNotification(ctx context.Context, in *ReadNotificationRequest, opts ...grpc.CallOption) (*ReadNotificationResponse, error)
 	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
 	ToggleBucketBackup(ctx context.Context, in *ToggleBucketBackupRequest, opts ...grpc.CallOption) (*ToggleBucketBackupResponse, error)
+	GetBucketSettings(ctx context.Context, in *GetBucketSettingsRequest, opts ...grpc.CallOption) (*GetBucketSettingsResponse, error)
+	// Replaces the settings of a bucket, ToggleBucketBackup only changes its backup policy
+	UpdateBucketSettings(ctx context.Context, in *UpdateBucketSettingsRequest, opts ...grpc.CallOption) (*UpdateBucketSettingsResponse, error)
 	BucketBackupRestore(ctx context.Context, in *BucketBackupRestoreRequest, opts ...grpc.CallOption) (*BucketBackupRestoreResponse, error)
 	GetUsageInfo(ctx context.Context, in *GetUsageInfoRequest, opts ...grpc.CallOption) (*GetUsageInfoResponse, error)
 	GetAPISessionTokens(ctx context.Context, in *GetAPISessionTokensRequest, opts ...grpc.CallOption) (*GetAPISessionTokensResponse, error)
@@ -7981,6 +13086,15 @@ func (c *spaceApiClient) RestoreKeyPairViaMnemonic(ctx context.Context, in *Rest
 	return out, nil
 }
 
+func (c *spaceApiClient) ValidateMnemonic(ctx context.Context, in *ValidateMnemonicRequest, opts ...grpc.CallOption) (*ValidateMnemonicResponse, error) {
+	out := new(ValidateMnemonicResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ValidateMnemonic", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spaceApiClient) DeleteKeyPair(ctx context.Context, in *DeleteKeyPairRequest, opts ...grpc.CallOption) (*DeleteKeyPairResponse, error) {
 	out := new(DeleteKeyPairResponse)
 	err := c.cc.Invoke(ctx, "/space.SpaceApi/DeleteKeyPair", in, out, opts...)
@@ -8008,7 +13122,7 @@ func (c *spaceApiClient) GetPublicKey(ctx context.Context, in *GetPublicKeyReque
 	return out, nil
 }
 
-func (c *spaceApiClient) Subscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SpaceApi_SubscribeClient, error) {
+func (c *spaceApiClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (SpaceApi_SubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_SpaceApi_serviceDesc.Streams[0], "/space.SpaceApi/Subscribe", opts...)
 	if err != nil {
 		return nil, err
@@ -8040,7 +13154,7 @@ func (x *spaceApiSubscribeClient) Recv() (*FileEventResponse, error) {
 	return m, nil
 }
 
-func (c *spaceApiClient) TxlSubscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SpaceApi_TxlSubscribeClient, error) {
+func (c *spaceApiClient) TxlSubscribe(ctx context.Context, in *TxlSubscribeRequest, opts ...grpc.CallOption) (SpaceApi_TxlSubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_SpaceApi_serviceDesc.Streams[1], "/space.SpaceApi/TxlSubscribe", opts...)
 	if err != nil {
 		return nil, err
@@ -8099,6 +13213,24 @@ func (c *spaceApiClient) GeneratePublicFileLink(ctx context.Context, in *Generat
 	return out, nil
 }
 
+func (c *spaceApiClient) ListPublicLinks(ctx context.Context, in *ListPublicLinksRequest, opts ...grpc.CallOption) (*ListPublicLinksResponse, error) {
+	out := new(ListPublicLinksResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ListPublicLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) RevokePublicLink(ctx context.Context, in *RevokePublicLinkRequest, opts ...grpc.CallOption) (*RevokePublicLinkResponse, error) {
+	out := new(RevokePublicLinkResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/RevokePublicLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spaceApiClient) GetSharedWithMeFiles(ctx context.Context, in *GetSharedWithMeFilesRequest, opts ...grpc.CallOption) (*GetSharedWithMeFilesResponse, error) {
 	out := new(GetSharedWithMeFilesResponse)
 	err := c.cc.Invoke(ctx, "/space.SpaceApi/GetSharedWithMeFiles", in, out, opts...)
@@ -8167,6 +13299,51 @@ func (c *spaceApiClient) CreateFolder(ctx context.Context, in *CreateFolderReque
 	return out, nil
 }
 
+func (c *spaceApiClient) ListFileConflicts(ctx context.Context, in *ListFileConflictsRequest, opts ...grpc.CallOption) (*ListFileConflictsResponse, error) {
+	out := new(ListFileConflictsResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ListFileConflicts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) ResolveFileConflict(ctx context.Context, in *ResolveFileConflictRequest, opts ...grpc.CallOption) (*ResolveFileConflictResponse, error) {
+	out := new(ResolveFileConflictResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ResolveFileConflict", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) AddSyncFolder(ctx context.Context, in *AddSyncFolderRequest, opts ...grpc.CallOption) (*AddSyncFolderResponse, error) {
+	out := new(AddSyncFolderResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/AddSyncFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) RemoveSyncFolder(ctx context.Context, in *RemoveSyncFolderRequest, opts ...grpc.CallOption) (*RemoveSyncFolderResponse, error) {
+	out := new(RemoveSyncFolderResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/RemoveSyncFolder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) ListSyncFolders(ctx context.Context, in *ListSyncFoldersRequest, opts ...grpc.CallOption) (*ListSyncFoldersResponse, error) {
+	out := new(ListSyncFoldersResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ListSyncFolders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spaceApiClient) ToggleFuseDrive(ctx context.Context, in *ToggleFuseRequest, opts ...grpc.CallOption) (*FuseDriveResponse, error) {
 	out := new(FuseDriveResponse)
 	err := c.cc.Invoke(ctx, "/space.SpaceApi/ToggleFuseDrive", in, out, opts...)
@@ -8203,6 +13380,42 @@ func (c *spaceApiClient) BackupKeysByPassphrase(ctx context.Context, in *BackupK
 	return out, nil
 }
 
+func (c *spaceApiClient) ChangeBackupPassphrase(ctx context.Context, in *ChangeBackupPassphraseRequest, opts ...grpc.CallOption) (*ChangeBackupPassphraseResponse, error) {
+	out := new(ChangeBackupPassphraseResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ChangeBackupPassphrase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) ListProfiles(ctx context.Context, in *ListProfilesRequest, opts ...grpc.CallOption) (*ListProfilesResponse, error) {
+	out := new(ListProfilesResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ListProfiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) CreateProfile(ctx context.Context, in *CreateProfileRequest, opts ...grpc.CallOption) (*CreateProfileResponse, error) {
+	out := new(CreateProfileResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/CreateProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) SwitchProfile(ctx context.Context, in *SwitchProfileRequest, opts ...grpc.CallOption) (*SwitchProfileResponse, error) {
+	out := new(SwitchProfileResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/SwitchProfile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spaceApiClient) RecoverKeysByPassphrase(ctx context.Context, in *RecoverKeysByPassphraseRequest, opts ...grpc.CallOption) (*RecoverKeysByPassphraseResponse, error) {
 	out := new(RecoverKeysByPassphraseResponse)
 	err := c.cc.Invoke(ctx, "/space.SpaceApi/RecoverKeysByPassphrase", in, out, opts...)
@@ -8239,6 +13452,33 @@ func (c *spaceApiClient) RecoverKeysByLocalBackup(ctx context.Context, in *Recov
 	return out, nil
 }
 
+func (c *spaceApiClient) SplitKeyIntoShares(ctx context.Context, in *SplitKeyIntoSharesRequest, opts ...grpc.CallOption) (*SplitKeyIntoSharesResponse, error) {
+	out := new(SplitKeyIntoSharesResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/SplitKeyIntoShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) RecoverFromShares(ctx context.Context, in *RecoverFromSharesRequest, opts ...grpc.CallOption) (*RecoverFromSharesResponse, error) {
+	out := new(RecoverFromSharesResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/RecoverFromShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) RotateIdentityKey(ctx context.Context, in *RotateIdentityKeyRequest, opts ...grpc.CallOption) (*RotateIdentityKeyResponse, error) {
+	out := new(RotateIdentityKeyResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/RotateIdentityKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spaceApiClient) ShareBucket(ctx context.Context, in *ShareBucketRequest, opts ...grpc.CallOption) (*ShareBucketResponse, error) {
 	out := new(ShareBucketResponse)
 	err := c.cc.Invoke(ctx, "/space.SpaceApi/ShareBucket", in, out, opts...)
@@ -8257,34 +13497,61 @@ func (c *spaceApiClient) JoinBucket(ctx context.Context, in *JoinBucketRequest,
 	return out, nil
 }
 
-func (c *spaceApiClient) ShareFilesViaPublicKey(ctx context.Context, in *ShareFilesViaPublicKeyRequest, opts ...grpc.CallOption) (*ShareFilesViaPublicKeyResponse, error) {
-	out := new(ShareFilesViaPublicKeyResponse)
-	err := c.cc.Invoke(ctx, "/space.SpaceApi/ShareFilesViaPublicKey", in, out, opts...)
+func (c *spaceApiClient) ShareFilesViaPublicKey(ctx context.Context, in *ShareFilesViaPublicKeyRequest, opts ...grpc.CallOption) (*ShareFilesViaPublicKeyResponse, error) {
+	out := new(ShareFilesViaPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ShareFilesViaPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) UnshareFilesViaPublicKey(ctx context.Context, in *UnshareFilesViaPublicKeyRequest, opts ...grpc.CallOption) (*UnshareFilesViaPublicKeyResponse, error) {
+	out := new(UnshareFilesViaPublicKeyResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/UnshareFilesViaPublicKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) ChangeShareRole(ctx context.Context, in *ChangeShareRoleRequest, opts ...grpc.CallOption) (*ChangeShareRoleResponse, error) {
+	out := new(ChangeShareRoleResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ChangeShareRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) HandleFilesInvitation(ctx context.Context, in *HandleFilesInvitationRequest, opts ...grpc.CallOption) (*HandleFilesInvitationResponse, error) {
+	out := new(HandleFilesInvitationResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/HandleFilesInvitation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *spaceApiClient) UnshareFilesViaPublicKey(ctx context.Context, in *UnshareFilesViaPublicKeyRequest, opts ...grpc.CallOption) (*UnshareFilesViaPublicKeyResponse, error) {
-	out := new(UnshareFilesViaPublicKeyResponse)
-	err := c.cc.Invoke(ctx, "/space.SpaceApi/UnshareFilesViaPublicKey", in, out, opts...)
+func (c *spaceApiClient) ListSentInvitations(ctx context.Context, in *ListSentInvitationsRequest, opts ...grpc.CallOption) (*ListSentInvitationsResponse, error) {
+	out := new(ListSentInvitationsResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/ListSentInvitations", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *spaceApiClient) HandleFilesInvitation(ctx context.Context, in *HandleFilesInvitationRequest, opts ...grpc.CallOption) (*HandleFilesInvitationResponse, error) {
-	out := new(HandleFilesInvitationResponse)
-	err := c.cc.Invoke(ctx, "/space.SpaceApi/HandleFilesInvitation", in, out, opts...)
+func (c *spaceApiClient) CancelInvitation(ctx context.Context, in *CancelInvitationRequest, opts ...grpc.CallOption) (*CancelInvitationResponse, error) {
+	out := new(CancelInvitationResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/CancelInvitation", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *spaceApiClient) NotificationSubscribe(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (SpaceApi_NotificationSubscribeClient, error) {
+func (c *spaceApiClient) NotificationSubscribe(ctx context.Context, in *NotificationSubscribeRequest, opts ...grpc.CallOption) (SpaceApi_NotificationSubscribeClient, error) {
 	stream, err := c.cc.NewStream(ctx, &_SpaceApi_serviceDesc.Streams[3], "/space.SpaceApi/NotificationSubscribe", opts...)
 	if err != nil {
 		return nil, err
@@ -8325,6 +13592,24 @@ func (c *spaceApiClient) ListBuckets(ctx context.Context, in *ListBucketsRequest
 	return out, nil
 }
 
+func (c *spaceApiClient) DeleteBucket(ctx context.Context, in *DeleteBucketRequest, opts ...grpc.CallOption) (*DeleteBucketResponse, error) {
+	out := new(DeleteBucketResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/DeleteBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) RenameBucket(ctx context.Context, in *RenameBucketRequest, opts ...grpc.CallOption) (*RenameBucketResponse, error) {
+	out := new(RenameBucketResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/RenameBucket", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spaceApiClient) GetNotifications(ctx context.Context, in *GetNotificationsRequest, opts ...grpc.CallOption) (*GetNotificationsResponse, error) {
 	out := new(GetNotificationsResponse)
 	err := c.cc.Invoke(ctx, "/space.SpaceApi/GetNotifications", in, out, opts...)
@@ -8361,6 +13646,24 @@ func (c *spaceApiClient) ToggleBucketBackup(ctx context.Context, in *ToggleBucke
 	return out, nil
 }
 
+func (c *spaceApiClient) GetBucketSettings(ctx context.Context, in *GetBucketSettingsRequest, opts ...grpc.CallOption) (*GetBucketSettingsResponse, error) {
+	out := new(GetBucketSettingsResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/GetBucketSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *spaceApiClient) UpdateBucketSettings(ctx context.Context, in *UpdateBucketSettingsRequest, opts ...grpc.CallOption) (*UpdateBucketSettingsResponse, error) {
+	out := new(UpdateBucketSettingsResponse)
+	err := c.cc.Invoke(ctx, "/space.SpaceApi/UpdateBucketSettings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *spaceApiClient) BucketBackupRestore(ctx context.Context, in *BucketBackupRestoreRequest, opts ...grpc.CallOption) (*BucketBackupRestoreResponse, error) {
 	out := new(BucketBackupRestoreResponse)
 	err := c.cc.Invoke(ctx, "/space.SpaceApi/BucketBackupRestore", in, out, opts...)
@@ -8447,21 +13750,29 @@ type SpaceApiServer interface {
 	// Restores a keypair given a mnemonic.
 	// This will override any existing key pair
 	RestoreKeyPairViaMnemonic(context.Context, *RestoreKeyPairViaMnemonicRequest) (*RestoreKeyPairViaMnemonicResponse, error)
+	// Checks the words and checksum of a mnemonic without restoring from it
+	ValidateMnemonic(context.Context, *ValidateMnemonicRequest) (*ValidateMnemonicResponse, error)
 	DeleteKeyPair(context.Context, *DeleteKeyPairRequest) (*DeleteKeyPairResponse, error)
 	// Force Generation of KeyPair. This will override existing keys stored in daemon.
 	GenerateKeyPairWithForce(context.Context, *GenerateKeyPairRequest) (*GenerateKeyPairResponse, error)
 	GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error)
 	// Subscribe to file events. This streams responses to the caller
-	Subscribe(*empty.Empty, SpaceApi_SubscribeServer) error
+	Subscribe(*SubscribeRequest, SpaceApi_SubscribeServer) error
 	// Subscribe to textile events. This streams responses to the caller
-	TxlSubscribe(*empty.Empty, SpaceApi_TxlSubscribeServer) error
+	TxlSubscribe(*TxlSubscribeRequest, SpaceApi_TxlSubscribeServer) error
 	// Open a file in the daemon.
 	// Daemon keeps track of all open files and closes them if no activity is noticed after a while
 	OpenFile(context.Context, *OpenFileRequest) (*OpenFileResponse, error)
 	// Removes a file or dir from a bucket
 	RemoveDirOrFile(context.Context, *RemoveDirOrFileRequest) (*RemoveDirOrFileResponse, error)
-	// Generates a copy of the file that's accessible through IPFS gateways
+	// Generates a copy of the file that's accessible through IPFS gateways.
+	// The link stops working once its copy is unpinned, on revoke, after it expires or reaches its max downloads.
 	GeneratePublicFileLink(context.Context, *GeneratePublicFileLinkRequest) (*GeneratePublicFileLinkResponse, error)
+	// Lists the public links generated by the user.
+	// Links that expired or reached their max downloads are revoked in the background by the daemon of the user, while it is running.
+	ListPublicLinks(context.Context, *ListPublicLinksRequest) (*ListPublicLinksResponse, error)
+	// Revokes a public link by unpinning its encrypted copy from the public share bucket
+	RevokePublicLink(context.Context, *RevokePublicLinkRequest) (*RevokePublicLinkResponse, error)
 	// Gets the files that are shared with this recipient
 	GetSharedWithMeFiles(context.Context, *GetSharedWithMeFilesRequest) (*GetSharedWithMeFilesResponse, error)
 	// Gets the files that are shared by the sender
@@ -8473,6 +13784,14 @@ type SpaceApiServer interface {
 	AddItems(*AddItemsRequest, SpaceApi_AddItemsServer) error
 	// Creates a folder/directory at the specified path
 	CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error)
+	// Lists the opened files that were changed locally after their bucket version changed
+	ListFileConflicts(context.Context, *ListFileConflictsRequest) (*ListFileConflictsResponse, error)
+	ResolveFileConflict(context.Context, *ResolveFileConflictRequest) (*ResolveFileConflictResponse, error)
+	// Keeps a local folder in sync with a path of a bucket in both directions
+	AddSyncFolder(context.Context, *AddSyncFolderRequest) (*AddSyncFolderResponse, error)
+	// Stops syncing a local folder, its files are kept both locally and in the bucket
+	RemoveSyncFolder(context.Context, *RemoveSyncFolderRequest) (*RemoveSyncFolderResponse, error)
+	ListSyncFolders(context.Context, *ListSyncFoldersRequest) (*ListSyncFoldersResponse, error)
 	// Toggle FUSE drive to be mounted or unmounted
 	ToggleFuseDrive(context.Context, *ToggleFuseRequest) (*FuseDriveResponse, error)
 	// Get status of FUSE drive. If mounted or unmounted
@@ -8481,12 +13800,26 @@ type SpaceApiServer interface {
 	CreateBucket(context.Context, *CreateBucketRequest) (*CreateBucketResponse, error)
 	// Backup Key by Passphrase
 	BackupKeysByPassphrase(context.Context, *BackupKeysByPassphraseRequest) (*BackupKeysByPassphraseResponse, error)
+	// Re-encrypts the keys backup with a new passphrase
+	ChangeBackupPassphrase(context.Context, *ChangeBackupPassphraseRequest) (*ChangeBackupPassphraseResponse, error)
+	// Lists the profiles of the daemon and which one is active
+	ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error)
+	// Creates a new empty profile. Switch to it to generate or restore its key pair
+	CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error)
+	// Restarts the daemon services with the keys, buckets, mailbox and search index of the given profile
+	SwitchProfile(context.Context, *SwitchProfileRequest) (*SwitchProfileResponse, error)
 	// Recover Keys by Passphrase
 	RecoverKeysByPassphrase(context.Context, *RecoverKeysByPassphraseRequest) (*RecoverKeysByPassphraseResponse, error)
 	// Tests a passphrase to see if it matches the one previously used
 	TestKeysPassphrase(context.Context, *TestKeysPassphraseRequest) (*TestKeysPassphraseResponse, error)
 	CreateLocalKeysBackup(context.Context, *CreateLocalKeysBackupRequest) (*CreateLocalKeysBackupResponse, error)
 	RecoverKeysByLocalBackup(context.Context, *RecoverKeysByLocalBackupRequest) (*RecoverKeysByLocalBackupResponse, error)
+	// Splits the identity key into shares for social recovery
+	SplitKeyIntoShares(context.Context, *SplitKeyIntoSharesRequest) (*SplitKeyIntoSharesResponse, error)
+	// Rebuilds the identity key from enough key shares and restores the state
+	RecoverFromShares(context.Context, *RecoverFromSharesRequest) (*RecoverFromSharesResponse, error)
+	// Replaces the identity key with a new one, moves buckets, shares and contacts over to it and revokes the old key
+	RotateIdentityKey(context.Context, *RotateIdentityKeyRequest) (*RotateIdentityKeyResponse, error)
 	// Share bucket
 	ShareBucket(context.Context, *ShareBucketRequest) (*ShareBucketResponse, error)
 	// Join bucket
@@ -8495,13 +13828,26 @@ type SpaceApiServer interface {
 	ShareFilesViaPublicKey(context.Context, *ShareFilesViaPublicKeyRequest) (*ShareFilesViaPublicKeyResponse, error)
 	// Remove public keys for shared files in buckets
 	UnshareFilesViaPublicKey(context.Context, *UnshareFilesViaPublicKeyRequest) (*UnshareFilesViaPublicKeyResponse, error)
+	// Changes the role of members that files were already shared with
+	ChangeShareRole(context.Context, *ChangeShareRoleRequest) (*ChangeShareRoleResponse, error)
 	HandleFilesInvitation(context.Context, *HandleFilesInvitationRequest) (*HandleFilesInvitationResponse, error)
-	NotificationSubscribe(*empty.Empty, SpaceApi_NotificationSubscribeServer) error
+	// Lists the invitations sent by this user along with their status
+	ListSentInvitations(context.Context, *ListSentInvitationsRequest) (*ListSentInvitationsResponse, error)
+	// Cancels a pending invitation, revoking the invitee access to the shared files
+	CancelInvitation(context.Context, *CancelInvitationRequest) (*CancelInvitationResponse, error)
+	NotificationSubscribe(*NotificationSubscribeRequest, SpaceApi_NotificationSubscribeServer) error
 	ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error)
+	// Delete a bucket with its backup, the default buckets can not be deleted
+	DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error)
+	// Rename a bucket, it keeps its thread and keys. The new slug cannot contain '#' or '/'
+	RenameBucket(context.Context, *RenameBucketRequest) (*RenameBucketResponse, error)
 	GetNotifications(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error)
 	ReadNotification(context.Context, *ReadNotificationRequest) (*ReadNotificationResponse, error)
 	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
 	ToggleBucketBackup(context.Context, *ToggleBucketBackupRequest) (*ToggleBucketBackupResponse, error)
+	GetBucketSettings(context.Context, *GetBucketSettingsRequest) (*GetBucketSettingsResponse, error)
+	// Replaces the settings of a bucket, ToggleBucketBackup only changes its backup policy
+	UpdateBucketSettings(context.Context, *UpdateBucketSettingsRequest) (*UpdateBucketSettingsResponse, error)
 	BucketBackupRestore(context.Context, *BucketBackupRestoreRequest) (*BucketBackupRestoreResponse, error)
 	GetUsageInfo(context.Context, *GetUsageInfoRequest) (*GetUsageInfoResponse, error)
 	GetAPISessionTokens(context.Context, *GetAPISessionTokensRequest) (*GetAPISessionTokensResponse, error)
@@ -8539,6 +13885,9 @@ func (*UnimplementedSpaceApiServer) GetStoredMnemonic(context.Context, *GetStore
 func (*UnimplementedSpaceApiServer) RestoreKeyPairViaMnemonic(context.Context, *RestoreKeyPairViaMnemonicRequest) (*RestoreKeyPairViaMnemonicResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestoreKeyPairViaMnemonic not implemented")
 }
+func (*UnimplementedSpaceApiServer) ValidateMnemonic(context.Context, *ValidateMnemonicRequest) (*ValidateMnemonicResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateMnemonic not implemented")
+}
 func (*UnimplementedSpaceApiServer) DeleteKeyPair(context.Context, *DeleteKeyPairRequest) (*DeleteKeyPairResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteKeyPair not implemented")
 }
@@ -8548,10 +13897,10 @@ func (*UnimplementedSpaceApiServer) GenerateKeyPairWithForce(context.Context, *G
 func (*UnimplementedSpaceApiServer) GetPublicKey(context.Context, *GetPublicKeyRequest) (*GetPublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPublicKey not implemented")
 }
-func (*UnimplementedSpaceApiServer) Subscribe(*empty.Empty, SpaceApi_SubscribeServer) error {
+func (*UnimplementedSpaceApiServer) Subscribe(*SubscribeRequest, SpaceApi_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
-func (*UnimplementedSpaceApiServer) TxlSubscribe(*empty.Empty, SpaceApi_TxlSubscribeServer) error {
+func (*UnimplementedSpaceApiServer) TxlSubscribe(*TxlSubscribeRequest, SpaceApi_TxlSubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method TxlSubscribe not implemented")
 }
 func (*UnimplementedSpaceApiServer) OpenFile(context.Context, *OpenFileRequest) (*OpenFileResponse, error) {
@@ -8563,6 +13912,12 @@ func (*UnimplementedSpaceApiServer) RemoveDirOrFile(context.Context, *RemoveDirO
 func (*UnimplementedSpaceApiServer) GeneratePublicFileLink(context.Context, *GeneratePublicFileLinkRequest) (*GeneratePublicFileLinkResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GeneratePublicFileLink not implemented")
 }
+func (*UnimplementedSpaceApiServer) ListPublicLinks(context.Context, *ListPublicLinksRequest) (*ListPublicLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPublicLinks not implemented")
+}
+func (*UnimplementedSpaceApiServer) RevokePublicLink(context.Context, *RevokePublicLinkRequest) (*RevokePublicLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePublicLink not implemented")
+}
 func (*UnimplementedSpaceApiServer) GetSharedWithMeFiles(context.Context, *GetSharedWithMeFilesRequest) (*GetSharedWithMeFilesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetSharedWithMeFiles not implemented")
 }
@@ -8578,6 +13933,21 @@ func (*UnimplementedSpaceApiServer) AddItems(*AddItemsRequest, SpaceApi_AddItems
 func (*UnimplementedSpaceApiServer) CreateFolder(context.Context, *CreateFolderRequest) (*CreateFolderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateFolder not implemented")
 }
+func (*UnimplementedSpaceApiServer) ListFileConflicts(context.Context, *ListFileConflictsRequest) (*ListFileConflictsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFileConflicts not implemented")
+}
+func (*UnimplementedSpaceApiServer) ResolveFileConflict(context.Context, *ResolveFileConflictRequest) (*ResolveFileConflictResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveFileConflict not implemented")
+}
+func (*UnimplementedSpaceApiServer) AddSyncFolder(context.Context, *AddSyncFolderRequest) (*AddSyncFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSyncFolder not implemented")
+}
+func (*UnimplementedSpaceApiServer) RemoveSyncFolder(context.Context, *RemoveSyncFolderRequest) (*RemoveSyncFolderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveSyncFolder not implemented")
+}
+func (*UnimplementedSpaceApiServer) ListSyncFolders(context.Context, *ListSyncFoldersRequest) (*ListSyncFoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSyncFolders not implemented")
+}
 func (*UnimplementedSpaceApiServer) ToggleFuseDrive(context.Context, *ToggleFuseRequest) (*FuseDriveResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleFuseDrive not implemented")
 }
@@ -8590,6 +13960,18 @@ func (*UnimplementedSpaceApiServer) CreateBucket(context.Context, *CreateBucketR
 func (*UnimplementedSpaceApiServer) BackupKeysByPassphrase(context.Context, *BackupKeysByPassphraseRequest) (*BackupKeysByPassphraseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BackupKeysByPassphrase not implemented")
 }
+func (*UnimplementedSpaceApiServer) ChangeBackupPassphrase(context.Context, *ChangeBackupPassphraseRequest) (*ChangeBackupPassphraseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeBackupPassphrase not implemented")
+}
+func (*UnimplementedSpaceApiServer) ListProfiles(context.Context, *ListProfilesRequest) (*ListProfilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProfiles not implemented")
+}
+func (*UnimplementedSpaceApiServer) CreateProfile(context.Context, *CreateProfileRequest) (*CreateProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProfile not implemented")
+}
+func (*UnimplementedSpaceApiServer) SwitchProfile(context.Context, *SwitchProfileRequest) (*SwitchProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SwitchProfile not implemented")
+}
 func (*UnimplementedSpaceApiServer) RecoverKeysByPassphrase(context.Context, *RecoverKeysByPassphraseRequest) (*RecoverKeysByPassphraseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverKeysByPassphrase not implemented")
 }
@@ -8602,6 +13984,15 @@ func (*UnimplementedSpaceApiServer) CreateLocalKeysBackup(context.Context, *Crea
 func (*UnimplementedSpaceApiServer) RecoverKeysByLocalBackup(context.Context, *RecoverKeysByLocalBackupRequest) (*RecoverKeysByLocalBackupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RecoverKeysByLocalBackup not implemented")
 }
+func (*UnimplementedSpaceApiServer) SplitKeyIntoShares(context.Context, *SplitKeyIntoSharesRequest) (*SplitKeyIntoSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitKeyIntoShares not implemented")
+}
+func (*UnimplementedSpaceApiServer) RecoverFromShares(context.Context, *RecoverFromSharesRequest) (*RecoverFromSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecoverFromShares not implemented")
+}
+func (*UnimplementedSpaceApiServer) RotateIdentityKey(context.Context, *RotateIdentityKeyRequest) (*RotateIdentityKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateIdentityKey not implemented")
+}
 func (*UnimplementedSpaceApiServer) ShareBucket(context.Context, *ShareBucketRequest) (*ShareBucketResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShareBucket not implemented")
 }
@@ -8614,15 +14005,30 @@ func (*UnimplementedSpaceApiServer) ShareFilesViaPublicKey(context.Context, *Sha
 func (*UnimplementedSpaceApiServer) UnshareFilesViaPublicKey(context.Context, *UnshareFilesViaPublicKeyRequest) (*UnshareFilesViaPublicKeyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnshareFilesViaPublicKey not implemented")
 }
+func (*UnimplementedSpaceApiServer) ChangeShareRole(context.Context, *ChangeShareRoleRequest) (*ChangeShareRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeShareRole not implemented")
+}
 func (*UnimplementedSpaceApiServer) HandleFilesInvitation(context.Context, *HandleFilesInvitationRequest) (*HandleFilesInvitationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleFilesInvitation not implemented")
 }
-func (*UnimplementedSpaceApiServer) NotificationSubscribe(*empty.Empty, SpaceApi_NotificationSubscribeServer) error {
+func (*UnimplementedSpaceApiServer) ListSentInvitations(context.Context, *ListSentInvitationsRequest) (*ListSentInvitationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSentInvitations not implemented")
+}
+func (*UnimplementedSpaceApiServer) CancelInvitation(context.Context, *CancelInvitationRequest) (*CancelInvitationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelInvitation not implemented")
+}
+func (*UnimplementedSpaceApiServer) NotificationSubscribe(*NotificationSubscribeRequest, SpaceApi_NotificationSubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method NotificationSubscribe not implemented")
 }
 func (*UnimplementedSpaceApiServer) ListBuckets(context.Context, *ListBucketsRequest) (*ListBucketsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListBuckets not implemented")
 }
+func (*UnimplementedSpaceApiServer) DeleteBucket(context.Context, *DeleteBucketRequest) (*DeleteBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteBucket not implemented")
+}
+func (*UnimplementedSpaceApiServer) RenameBucket(context.Context, *RenameBucketRequest) (*RenameBucketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameBucket not implemented")
+}
 func (*UnimplementedSpaceApiServer) GetNotifications(context.Context, *GetNotificationsRequest) (*GetNotificationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNotifications not implemented")
 }
@@ -8635,6 +14041,12 @@ func (*UnimplementedSpaceApiServer) DeleteAccount(context.Context, *DeleteAccoun
 func (*UnimplementedSpaceApiServer) ToggleBucketBackup(context.Context, *ToggleBucketBackupRequest) (*ToggleBucketBackupResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ToggleBucketBackup not implemented")
 }
+func (*UnimplementedSpaceApiServer) GetBucketSettings(context.Context, *GetBucketSettingsRequest) (*GetBucketSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBucketSettings not implemented")
+}
+func (*UnimplementedSpaceApiServer) UpdateBucketSettings(context.Context, *UpdateBucketSettingsRequest) (*UpdateBucketSettingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateBucketSettings not implemented")
+}
 func (*UnimplementedSpaceApiServer) BucketBackupRestore(context.Context, *BucketBackupRestoreRequest) (*BucketBackupRestoreResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BucketBackupRestore not implemented")
 }
@@ -8754,6 +14166,24 @@ func _SpaceApi_RestoreKeyPairViaMnemonic_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpaceApi_ValidateMnemonic_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateMnemonicRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).ValidateMnemonic(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/ValidateMnemonic",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).ValidateMnemonic(ctx, req.(*ValidateMnemonicRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpaceApi_DeleteKeyPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteKeyPairRequest)
 	if err := dec(in); err != nil {
@@ -8809,7 +14239,7 @@ func _SpaceApi_GetPublicKey_Handler(srv interface{}, ctx context.Context, dec fu
 }
 
 func _SpaceApi_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(SubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -8830,7 +14260,7 @@ func (x *spaceApiSubscribeServer) Send(m *FileEventResponse) error {
 }
 
 func _SpaceApi_TxlSubscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(TxlSubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -8899,172 +14329,370 @@ func _SpaceApi_GeneratePublicFileLink_Handler(srv interface{}, ctx context.Conte
 		FullMethod: "/space.SpaceApi/GeneratePublicFileLink",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).GeneratePublicFileLink(ctx, req.(*GeneratePublicFileLinkRequest))
+		return srv.(SpaceApiServer).GeneratePublicFileLink(ctx, req.(*GeneratePublicFileLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_ListPublicLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPublicLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).ListPublicLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/ListPublicLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).ListPublicLinks(ctx, req.(*ListPublicLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_RevokePublicLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePublicLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).RevokePublicLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/RevokePublicLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).RevokePublicLink(ctx, req.(*RevokePublicLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_GetSharedWithMeFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedWithMeFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).GetSharedWithMeFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/GetSharedWithMeFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).GetSharedWithMeFiles(ctx, req.(*GetSharedWithMeFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_GetSharedByMeFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedByMeFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).GetSharedByMeFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/GetSharedByMeFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).GetSharedByMeFiles(ctx, req.(*GetSharedByMeFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_OpenPublicFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OpenPublicFileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).OpenPublicFile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/OpenPublicFile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).OpenPublicFile(ctx, req.(*OpenPublicFileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_AddItems_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddItemsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(SpaceApiServer).AddItems(m, &spaceApiAddItemsServer{stream})
+}
+
+type SpaceApi_AddItemsServer interface {
+	Send(*AddItemsResponse) error
+	grpc.ServerStream
+}
+
+type spaceApiAddItemsServer struct {
+	grpc.ServerStream
+}
+
+func (x *spaceApiAddItemsServer) Send(m *AddItemsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _SpaceApi_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).CreateFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/CreateFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_ListFileConflicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFileConflictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).ListFileConflicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/ListFileConflicts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).ListFileConflicts(ctx, req.(*ListFileConflictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_ResolveFileConflict_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResolveFileConflictRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).ResolveFileConflict(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/ResolveFileConflict",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).ResolveFileConflict(ctx, req.(*ResolveFileConflictRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_AddSyncFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSyncFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).AddSyncFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/AddSyncFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).AddSyncFolder(ctx, req.(*AddSyncFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_RemoveSyncFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveSyncFolderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).RemoveSyncFolder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/RemoveSyncFolder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).RemoveSyncFolder(ctx, req.(*RemoveSyncFolderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_ListSyncFolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSyncFoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).ListSyncFolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/ListSyncFolders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).ListSyncFolders(ctx, req.(*ListSyncFoldersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_GetSharedWithMeFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSharedWithMeFilesRequest)
+func _SpaceApi_ToggleFuseDrive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ToggleFuseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).GetSharedWithMeFiles(ctx, in)
+		return srv.(SpaceApiServer).ToggleFuseDrive(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/GetSharedWithMeFiles",
+		FullMethod: "/space.SpaceApi/ToggleFuseDrive",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).GetSharedWithMeFiles(ctx, req.(*GetSharedWithMeFilesRequest))
+		return srv.(SpaceApiServer).ToggleFuseDrive(ctx, req.(*ToggleFuseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_GetSharedByMeFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSharedByMeFilesRequest)
+func _SpaceApi_GetFuseDriveStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).GetSharedByMeFiles(ctx, in)
+		return srv.(SpaceApiServer).GetFuseDriveStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/GetSharedByMeFiles",
+		FullMethod: "/space.SpaceApi/GetFuseDriveStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).GetSharedByMeFiles(ctx, req.(*GetSharedByMeFilesRequest))
+		return srv.(SpaceApiServer).GetFuseDriveStatus(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_OpenPublicFile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(OpenPublicFileRequest)
+func _SpaceApi_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateBucketRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).OpenPublicFile(ctx, in)
+		return srv.(SpaceApiServer).CreateBucket(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/OpenPublicFile",
+		FullMethod: "/space.SpaceApi/CreateBucket",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).OpenPublicFile(ctx, req.(*OpenPublicFileRequest))
+		return srv.(SpaceApiServer).CreateBucket(ctx, req.(*CreateBucketRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_AddItems_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(AddItemsRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(SpaceApiServer).AddItems(m, &spaceApiAddItemsServer{stream})
-}
-
-type SpaceApi_AddItemsServer interface {
-	Send(*AddItemsResponse) error
-	grpc.ServerStream
-}
-
-type spaceApiAddItemsServer struct {
-	grpc.ServerStream
-}
-
-func (x *spaceApiAddItemsServer) Send(m *AddItemsResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _SpaceApi_CreateFolder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateFolderRequest)
+func _SpaceApi_BackupKeysByPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupKeysByPassphraseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).CreateFolder(ctx, in)
+		return srv.(SpaceApiServer).BackupKeysByPassphrase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/CreateFolder",
+		FullMethod: "/space.SpaceApi/BackupKeysByPassphrase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).CreateFolder(ctx, req.(*CreateFolderRequest))
+		return srv.(SpaceApiServer).BackupKeysByPassphrase(ctx, req.(*BackupKeysByPassphraseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_ToggleFuseDrive_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ToggleFuseRequest)
+func _SpaceApi_ChangeBackupPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeBackupPassphraseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).ToggleFuseDrive(ctx, in)
+		return srv.(SpaceApiServer).ChangeBackupPassphrase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/ToggleFuseDrive",
+		FullMethod: "/space.SpaceApi/ChangeBackupPassphrase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).ToggleFuseDrive(ctx, req.(*ToggleFuseRequest))
+		return srv.(SpaceApiServer).ChangeBackupPassphrase(ctx, req.(*ChangeBackupPassphraseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_GetFuseDriveStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(empty.Empty)
+func _SpaceApi_ListProfiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProfilesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).GetFuseDriveStatus(ctx, in)
+		return srv.(SpaceApiServer).ListProfiles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/GetFuseDriveStatus",
+		FullMethod: "/space.SpaceApi/ListProfiles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).GetFuseDriveStatus(ctx, req.(*empty.Empty))
+		return srv.(SpaceApiServer).ListProfiles(ctx, req.(*ListProfilesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_CreateBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateBucketRequest)
+func _SpaceApi_CreateProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).CreateBucket(ctx, in)
+		return srv.(SpaceApiServer).CreateProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/CreateBucket",
+		FullMethod: "/space.SpaceApi/CreateProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).CreateBucket(ctx, req.(*CreateBucketRequest))
+		return srv.(SpaceApiServer).CreateProfile(ctx, req.(*CreateProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _SpaceApi_BackupKeysByPassphrase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BackupKeysByPassphraseRequest)
+func _SpaceApi_SwitchProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SwitchProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(SpaceApiServer).BackupKeysByPassphrase(ctx, in)
+		return srv.(SpaceApiServer).SwitchProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/space.SpaceApi/BackupKeysByPassphrase",
+		FullMethod: "/space.SpaceApi/SwitchProfile",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(SpaceApiServer).BackupKeysByPassphrase(ctx, req.(*BackupKeysByPassphraseRequest))
+		return srv.(SpaceApiServer).SwitchProfile(ctx, req.(*SwitchProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -9141,6 +14769,60 @@ func _SpaceApi_RecoverKeysByLocalBackup_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpaceApi_SplitKeyIntoShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitKeyIntoSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).SplitKeyIntoShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/SplitKeyIntoShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).SplitKeyIntoShares(ctx, req.(*SplitKeyIntoSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_RecoverFromShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecoverFromSharesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).RecoverFromShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/RecoverFromShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).RecoverFromShares(ctx, req.(*RecoverFromSharesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_RotateIdentityKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateIdentityKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).RotateIdentityKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/RotateIdentityKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).RotateIdentityKey(ctx, req.(*RotateIdentityKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpaceApi_ShareBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ShareBucketRequest)
 	if err := dec(in); err != nil {
@@ -9213,6 +14895,24 @@ func _SpaceApi_UnshareFilesViaPublicKey_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpaceApi_ChangeShareRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeShareRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).ChangeShareRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/ChangeShareRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).ChangeShareRole(ctx, req.(*ChangeShareRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpaceApi_HandleFilesInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(HandleFilesInvitationRequest)
 	if err := dec(in); err != nil {
@@ -9231,8 +14931,44 @@ func _SpaceApi_HandleFilesInvitation_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpaceApi_ListSentInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSentInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).ListSentInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/ListSentInvitations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).ListSentInvitations(ctx, req.(*ListSentInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_CancelInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).CancelInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/CancelInvitation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).CancelInvitation(ctx, req.(*CancelInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpaceApi_NotificationSubscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(empty.Empty)
+	m := new(NotificationSubscribeRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
@@ -9270,6 +15006,42 @@ func _SpaceApi_ListBuckets_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpaceApi_DeleteBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).DeleteBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/DeleteBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).DeleteBucket(ctx, req.(*DeleteBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_RenameBucket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameBucketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).RenameBucket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/RenameBucket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).RenameBucket(ctx, req.(*RenameBucketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpaceApi_GetNotifications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetNotificationsRequest)
 	if err := dec(in); err != nil {
@@ -9342,6 +15114,42 @@ func _SpaceApi_ToggleBucketBackup_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SpaceApi_GetBucketSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBucketSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).GetBucketSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/GetBucketSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).GetBucketSettings(ctx, req.(*GetBucketSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SpaceApi_UpdateBucketSettings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateBucketSettingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SpaceApiServer).UpdateBucketSettings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/space.SpaceApi/UpdateBucketSettings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SpaceApiServer).UpdateBucketSettings(ctx, req.(*UpdateBucketSettingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _SpaceApi_BucketBackupRestore_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(BucketBackupRestoreRequest)
 	if err := dec(in); err != nil {
@@ -9510,6 +15318,10 @@ var _SpaceApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RestoreKeyPairViaMnemonic",
 			Handler:    _SpaceApi_RestoreKeyPairViaMnemonic_Handler,
 		},
+		{
+			MethodName: "ValidateMnemonic",
+			Handler:    _SpaceApi_ValidateMnemonic_Handler,
+		},
 		{
 			MethodName: "DeleteKeyPair",
 			Handler:    _SpaceApi_DeleteKeyPair_Handler,
@@ -9534,6 +15346,14 @@ var _SpaceApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GeneratePublicFileLink",
 			Handler:    _SpaceApi_GeneratePublicFileLink_Handler,
 		},
+		{
+			MethodName: "ListPublicLinks",
+			Handler:    _SpaceApi_ListPublicLinks_Handler,
+		},
+		{
+			MethodName: "RevokePublicLink",
+			Handler:    _SpaceApi_RevokePublicLink_Handler,
+		},
 		{
 			MethodName: "GetSharedWithMeFiles",
 			Handler:    _SpaceApi_GetSharedWithMeFiles_Handler,
@@ -9550,6 +15370,26 @@ var _SpaceApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateFolder",
 			Handler:    _SpaceApi_CreateFolder_Handler,
 		},
+		{
+			MethodName: "ListFileConflicts",
+			Handler:    _SpaceApi_ListFileConflicts_Handler,
+		},
+		{
+			MethodName: "ResolveFileConflict",
+			Handler:    _SpaceApi_ResolveFileConflict_Handler,
+		},
+		{
+			MethodName: "AddSyncFolder",
+			Handler:    _SpaceApi_AddSyncFolder_Handler,
+		},
+		{
+			MethodName: "RemoveSyncFolder",
+			Handler:    _SpaceApi_RemoveSyncFolder_Handler,
+		},
+		{
+			MethodName: "ListSyncFolders",
+			Handler:    _SpaceApi_ListSyncFolders_Handler,
+		},
 		{
 			MethodName: "ToggleFuseDrive",
 			Handler:    _SpaceApi_ToggleFuseDrive_Handler,
@@ -9566,6 +15406,22 @@ var _SpaceApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BackupKeysByPassphrase",
 			Handler:    _SpaceApi_BackupKeysByPassphrase_Handler,
 		},
+		{
+			MethodName: "ChangeBackupPassphrase",
+			Handler:    _SpaceApi_ChangeBackupPassphrase_Handler,
+		},
+		{
+			MethodName: "ListProfiles",
+			Handler:    _SpaceApi_ListProfiles_Handler,
+		},
+		{
+			MethodName: "CreateProfile",
+			Handler:    _SpaceApi_CreateProfile_Handler,
+		},
+		{
+			MethodName: "SwitchProfile",
+			Handler:    _SpaceApi_SwitchProfile_Handler,
+		},
 		{
 			MethodName: "RecoverKeysByPassphrase",
 			Handler:    _SpaceApi_RecoverKeysByPassphrase_Handler,
@@ -9582,6 +15438,18 @@ var _SpaceApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RecoverKeysByLocalBackup",
 			Handler:    _SpaceApi_RecoverKeysByLocalBackup_Handler,
 		},
+		{
+			MethodName: "SplitKeyIntoShares",
+			Handler:    _SpaceApi_SplitKeyIntoShares_Handler,
+		},
+		{
+			MethodName: "RecoverFromShares",
+			Handler:    _SpaceApi_RecoverFromShares_Handler,
+		},
+		{
+			MethodName: "RotateIdentityKey",
+			Handler:    _SpaceApi_RotateIdentityKey_Handler,
+		},
 		{
 			MethodName: "ShareBucket",
 			Handler:    _SpaceApi_ShareBucket_Handler,
@@ -9598,14 +15466,34 @@ var _SpaceApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnshareFilesViaPublicKey",
 			Handler:    _SpaceApi_UnshareFilesViaPublicKey_Handler,
 		},
+		{
+			MethodName: "ChangeShareRole",
+			Handler:    _SpaceApi_ChangeShareRole_Handler,
+		},
 		{
 			MethodName: "HandleFilesInvitation",
 			Handler:    _SpaceApi_HandleFilesInvitation_Handler,
 		},
+		{
+			MethodName: "ListSentInvitations",
+			Handler:    _SpaceApi_ListSentInvitations_Handler,
+		},
+		{
+			MethodName: "CancelInvitation",
+			Handler:    _SpaceApi_CancelInvitation_Handler,
+		},
 		{
 			MethodName: "ListBuckets",
 			Handler:    _SpaceApi_ListBuckets_Handler,
 		},
+		{
+			MethodName: "DeleteBucket",
+			Handler:    _SpaceApi_DeleteBucket_Handler,
+		},
+		{
+			MethodName: "RenameBucket",
+			Handler:    _SpaceApi_RenameBucket_Handler,
+		},
 		{
 			MethodName: "GetNotifications",
 			Handler:    _SpaceApi_GetNotifications_Handler,
@@ -9622,6 +15510,14 @@ var _SpaceApi_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ToggleBucketBackup",
 			Handler:    _SpaceApi_ToggleBucketBackup_Handler,
 		},
+		{
+			MethodName: "GetBucketSettings",
+			Handler:    _SpaceApi_GetBucketSettings_Handler,
+		},
+		{
+			MethodName: "UpdateBucketSettings",
+			Handler:    _SpaceApi_UpdateBucketSettings_Handler,
+		},
 		{
 			MethodName: "BucketBackupRestore",
 			Handler:    _SpaceApi_BucketBackupRestore_Handler,
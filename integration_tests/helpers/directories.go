@@ -9,8 +9,6 @@ import (
 
 	"github.com/onsi/ginkgo"
 
-	"github.com/golang/protobuf/ptypes/empty"
-
 	"github.com/FleekHQ/space-daemon/integration_tests/fixtures"
 
 	"github.com/FleekHQ/space-daemon/grpc/pb"
@@ -69,7 +67,7 @@ func StartWatchingForRemoteBackup(
 	ExpectWithOffset(1, filePathsToWatch).NotTo(BeEmpty())
 
 	streamCtx, cancelStreamCtx := context.WithCancel(context.Background())
-	streamResponse, err := client.Subscribe(streamCtx, &empty.Empty{})
+	streamResponse, err := client.Subscribe(streamCtx, &pb.SubscribeRequest{})
 	ExpectWithOffset(1, err).NotTo(HaveOccurred(), "Subscribe failed to connect")
 	foundFiles := make(map[string]bool)
 	totalFound := 0
@@ -77,6 +77,11 @@ func (_m *Client) AcceptSharedFilesInvitation(ctx context.Context, invitation do
 	return r0, r1
 }
 
+// AttachListenerHandler provides a mock function with given fields: handler
+func (_m *Client) AttachListenerHandler(handler textile.EventHandler) {
+	_m.Called(handler)
+}
+
 // AttachMailboxNotifier provides a mock function with given fields: notif
 func (_m *Client) AttachMailboxNotifier(notif textile.GrpcMailboxNotifier) {
 	_m.Called(notif)
@@ -87,13 +92,34 @@ func (_m *Client) AttachSynchronizerNotifier(notif sync.EventNotifier) {
 	_m.Called(notif)
 }
 
-// BucketBackupRestore provides a mock function with given fields: ctx, bucketSlug
-func (_m *Client) BucketBackupRestore(ctx context.Context, bucketSlug string) error {
-	ret := _m.Called(ctx, bucketSlug)
+// BucketBackupRestore provides a mock function with given fields: ctx, bucketSlug, opts
+func (_m *Client) BucketBackupRestore(ctx context.Context, bucketSlug string, opts domain.RestoreOptions) (domain.RestorePlan, error) {
+	ret := _m.Called(ctx, bucketSlug, opts)
+
+	var r0 domain.RestorePlan
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.RestoreOptions) domain.RestorePlan); ok {
+		r0 = rf(ctx, bucketSlug, opts)
+	} else {
+		r0 = ret.Get(0).(domain.RestorePlan)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.RestoreOptions) error); ok {
+		r1 = rf(ctx, bucketSlug, opts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountPublicLinkDownload provides a mock function with given fields: ctx, fileCid
+func (_m *Client) CountPublicLinkDownload(ctx context.Context, fileCid string) error {
+	ret := _m.Called(ctx, fileCid)
 
 	var r0 error
 	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, bucketSlug)
+		r0 = rf(ctx, fileCid)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -138,6 +164,20 @@ func (_m *Client) DeleteAccount(ctx context.Context) error {
 	return r0
 }
 
+// DeleteBucket provides a mock function with given fields: ctx, bucketSlug
+func (_m *Client) DeleteBucket(ctx context.Context, bucketSlug string) error {
+	ret := _m.Called(ctx, bucketSlug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, bucketSlug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DisableSync provides a mock function with given fields:
 func (_m *Client) DisableSync() {
 	_m.Called()
@@ -189,6 +229,27 @@ func (_m *Client) GetBucket(ctx context.Context, slug string, remoteFile *textil
 	return r0, r1
 }
 
+// GetBucketSettings provides a mock function with given fields: ctx, bucketSlug
+func (_m *Client) GetBucketSettings(ctx context.Context, bucketSlug string) (domain.BucketSettings, error) {
+	ret := _m.Called(ctx, bucketSlug)
+
+	var r0 domain.BucketSettings
+	if rf, ok := ret.Get(0).(func(context.Context, string) domain.BucketSettings); ok {
+		r0 = rf(ctx, bucketSlug)
+	} else {
+		r0 = ret.Get(0).(domain.BucketSettings)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, bucketSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetDefaultBucket provides a mock function with given fields: ctx
 func (_m *Client) GetDefaultBucket(ctx context.Context) (textile.Bucket, error) {
 	ret := _m.Called(ctx)
@@ -226,6 +287,27 @@ func (_m *Client) GetFailedHealthchecks() int {
 	return r0
 }
 
+// GetLazyRestoredFile provides a mock function with given fields: bucketSlug, path
+func (_m *Client) GetLazyRestoredFile(bucketSlug string, path string) (domain.LazyRestoredFile, bool) {
+	ret := _m.Called(bucketSlug, path)
+
+	var r0 domain.LazyRestoredFile
+	if rf, ok := ret.Get(0).(func(string, string) domain.LazyRestoredFile); ok {
+		r0 = rf(bucketSlug, path)
+	} else {
+		r0 = ret.Get(0).(domain.LazyRestoredFile)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func(string, string) bool); ok {
+		r1 = rf(bucketSlug, path)
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GetMailAsNotifications provides a mock function with given fields: ctx, seek, limit
 func (_m *Client) GetMailAsNotifications(ctx context.Context, seek string, limit int) ([]*domain.Notification, error) {
 	ret := _m.Called(ctx, seek, limit)
@@ -249,6 +331,29 @@ func (_m *Client) GetMailAsNotifications(ctx context.Context, seek string, limit
 	return r0, r1
 }
 
+// GetManagedThreadKeys provides a mock function with given fields: ctx
+func (_m *Client) GetManagedThreadKeys(ctx context.Context) (map[string]string, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[string]string
+	if rf, ok := ret.Get(0).(func(context.Context) map[string]string); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]string)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetModel provides a mock function with given fields:
 func (_m *Client) GetModel() model.Model {
 	ret := _m.Called()
@@ -341,6 +446,29 @@ func (_m *Client) GetPublicShareBucket(ctx context.Context) (textile.Bucket, err
 	return r0, r1
 }
 
+// GetReceivedDirectory provides a mock function with given fields: ctx, dbID, bucket, path
+func (_m *Client) GetReceivedDirectory(ctx context.Context, dbID string, bucket string, path string) ([]*domain.SharedDirEntry, error) {
+	ret := _m.Called(ctx, dbID, bucket, path)
+
+	var r0 []*domain.SharedDirEntry
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) []*domain.SharedDirEntry); ok {
+		r0 = rf(ctx, dbID, bucket, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.SharedDirEntry)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, dbID, bucket, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetReceivedFiles provides a mock function with given fields: ctx, accepted, seek, limit
 func (_m *Client) GetReceivedFiles(ctx context.Context, accepted bool, seek string, limit int) ([]*domain.SharedDirEntry, string, error) {
 	ret := _m.Called(ctx, accepted, seek, limit)
@@ -510,6 +638,66 @@ func (_m *Client) ListBuckets(ctx context.Context) ([]textile.Bucket, error) {
 	return r0, r1
 }
 
+// ListPublicLinks provides a mock function with given fields: ctx, seek, limit
+func (_m *Client) ListPublicLinks(ctx context.Context, seek string, limit int) ([]*domain.PublicLink, string, error) {
+	ret := _m.Called(ctx, seek, limit)
+
+	var r0 []*domain.PublicLink
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*domain.PublicLink); ok {
+		r0 = rf(ctx, seek, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.PublicLink)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) string); ok {
+		r1 = rf(ctx, seek, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(ctx, seek, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// ListSentInvitations provides a mock function with given fields: ctx, seek, limit
+func (_m *Client) ListSentInvitations(ctx context.Context, seek string, limit int) ([]*domain.SentInvitation, string, error) {
+	ret := _m.Called(ctx, seek, limit)
+
+	var r0 []*domain.SentInvitation
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*domain.SentInvitation); ok {
+		r0 = rf(ctx, seek, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*domain.SentInvitation)
+		}
+	}
+
+	var r1 string
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) string); ok {
+		r1 = rf(ctx, seek, limit)
+	} else {
+		r1 = ret.Get(1).(string)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(context.Context, string, int) error); ok {
+		r2 = rf(ctx, seek, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Listen provides a mock function with given fields: ctx, dbID, threadName
 func (_m *Client) Listen(ctx context.Context, dbID string, threadName string) (<-chan client.ListenEvent, error) {
 	ret := _m.Called(ctx, dbID, threadName)
@@ -533,12 +721,26 @@ func (_m *Client) Listen(ctx context.Context, dbID string, threadName string) (<
 	return r0, r1
 }
 
+// ManageBucketsAccess provides a mock function with given fields: ctx, bucketSchemas, pubkey, role
+func (_m *Client) ManageBucketsAccess(ctx context.Context, bucketSchemas []*model.BucketSchema, pubkey crypto.PubKey, role domain.ShareRole) error {
+	ret := _m.Called(ctx, bucketSchemas, pubkey, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []*model.BucketSchema, crypto.PubKey, domain.ShareRole) error); ok {
+		r0 = rf(ctx, bucketSchemas, pubkey, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ManageShareFilesViaPublicKey provides a mock function with given fields: ctx, paths, pubkeys, keys, role
-func (_m *Client) ManageShareFilesViaPublicKey(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey, keys [][]byte, role domain.SharedFilesRoleAction) error {
+func (_m *Client) ManageShareFilesViaPublicKey(ctx context.Context, paths []domain.FullPath, pubkeys []crypto.PubKey, keys [][]byte, role domain.ShareRole) error {
 	ret := _m.Called(ctx, paths, pubkeys, keys, role)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, []domain.FullPath, []crypto.PubKey, [][]byte, domain.SharedFilesRoleAction) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, []domain.FullPath, []crypto.PubKey, [][]byte, domain.ShareRole) error); ok {
 		r0 = rf(ctx, paths, pubkeys, keys, role)
 	} else {
 		r0 = ret.Error(0)
@@ -582,6 +784,43 @@ func (_m *Client) RemoveKeys(ctx context.Context) error {
 	return r0
 }
 
+// RenameBucket provides a mock function with given fields: ctx, bucketSlug, newBucketSlug
+func (_m *Client) RenameBucket(ctx context.Context, bucketSlug string, newBucketSlug string) (textile.Bucket, error) {
+	ret := _m.Called(ctx, bucketSlug, newBucketSlug)
+
+	var r0 textile.Bucket
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) textile.Bucket); ok {
+		r0 = rf(ctx, bucketSlug, newBucketSlug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(textile.Bucket)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bucketSlug, newBucketSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetIdentity provides a mock function with given fields: ctx
+func (_m *Client) ResetIdentity(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RestoreDB provides a mock function with given fields: ctx
 func (_m *Client) RestoreDB(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -596,6 +835,34 @@ func (_m *Client) RestoreDB(ctx context.Context) error {
 	return r0
 }
 
+// RestoreLazyFile provides a mock function with given fields: ctx, bucketSlug, path
+func (_m *Client) RestoreLazyFile(ctx context.Context, bucketSlug string, path string) error {
+	ret := _m.Called(ctx, bucketSlug, path)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bucketSlug, path)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RevokePublicLink provides a mock function with given fields: ctx, linkID
+func (_m *Client) RevokePublicLink(ctx context.Context, linkID string) error {
+	ret := _m.Called(ctx, linkID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, linkID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SendMessage provides a mock function with given fields: ctx, recipient, body
 func (_m *Client) SendMessage(ctx context.Context, recipient crypto.PubKey, body []byte) (*usersdclient.Message, error) {
 	ret := _m.Called(ctx, recipient, body)
@@ -670,6 +937,20 @@ func (_m *Client) Start(ctx context.Context, cfg config.Config) error {
 	return r0
 }
 
+// StoreBucketThreadKeys provides a mock function with given fields: ctx
+func (_m *Client) StoreBucketThreadKeys(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ToggleBucketBackup provides a mock function with given fields: ctx, bucketSlug, bucketBackup
 func (_m *Client) ToggleBucketBackup(ctx context.Context, bucketSlug string, bucketBackup bool) (bool, error) {
 	ret := _m.Called(ctx, bucketSlug, bucketBackup)
@@ -691,6 +972,27 @@ func (_m *Client) ToggleBucketBackup(ctx context.Context, bucketSlug string, buc
 	return r0, r1
 }
 
+// UpdateBucketSettings provides a mock function with given fields: ctx, bucketSlug, settings
+func (_m *Client) UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (domain.BucketSettings, error) {
+	ret := _m.Called(ctx, bucketSlug, settings)
+
+	var r0 domain.BucketSettings
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.BucketSettings) domain.BucketSettings); ok {
+		r0 = rf(ctx, bucketSlug, settings)
+	} else {
+		r0 = ret.Get(0).(domain.BucketSettings)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.BucketSettings) error); ok {
+		r1 = rf(ctx, bucketSlug, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // WaitForHealthy provides a mock function with given fields:
 func (_m *Client) WaitForHealthy() chan error {
 	ret := _m.Called()
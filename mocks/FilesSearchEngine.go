@@ -14,6 +14,20 @@ type FilesSearchEngine struct {
 	mock.Mock
 }
 
+// DeleteBucketData provides a mock function with given fields: ctx, bucketSlug
+func (_m *FilesSearchEngine) DeleteBucketData(ctx context.Context, bucketSlug string) error {
+	ret := _m.Called(ctx, bucketSlug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, bucketSlug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteFileData provides a mock function with given fields: ctx, data
 func (_m *FilesSearchEngine) DeleteFileData(ctx context.Context, data *search.DeleteIndexRecord) error {
 	ret := _m.Called(ctx, data)
@@ -74,6 +88,20 @@ func (_m *FilesSearchEngine) QueryFileData(ctx context.Context, query string, li
 	return r0, r1
 }
 
+// RenameBucketData provides a mock function with given fields: ctx, bucketSlug, newBucketSlug
+func (_m *FilesSearchEngine) RenameBucketData(ctx context.Context, bucketSlug string, newBucketSlug string) error {
+	ret := _m.Called(ctx, bucketSlug, newBucketSlug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, bucketSlug, newBucketSlug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *FilesSearchEngine) Start() error {
 	ret := _m.Called()
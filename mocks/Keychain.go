@@ -32,6 +32,43 @@ func (_m *Keychain) DeleteKeypair() error {
 	return r0
 }
 
+// DeriveKey provides a mock function with given fields: salt, size
+func (_m *Keychain) DeriveKey(salt []byte, size int) ([]byte, error) {
+	ret := _m.Called(salt, size)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func([]byte, int) []byte); ok {
+		r0 = rf(salt, size)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func([]byte, int) error); ok {
+		r1 = rf(salt, size)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DropPreviousStoreEncryptionKeys provides a mock function with given fields:
+func (_m *Keychain) DropPreviousStoreEncryptionKeys() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GenerateKeyFromMnemonic provides a mock function with given fields: _a0
 func (_m *Keychain) GenerateKeyFromMnemonic(_a0 ...keychain.GenerateKeyFromMnemonicOpts) (string, error) {
 	_va := make([]interface{}, len(_a0))
@@ -146,6 +183,29 @@ func (_m *Keychain) GetAppToken(key string) (*permissions.AppToken, error) {
 	return r0, r1
 }
 
+// GetLogKey provides a mock function with given fields:
+func (_m *Keychain) GetLogKey() (crypto.PrivKey, error) {
+	ret := _m.Called()
+
+	var r0 crypto.PrivKey
+	if rf, ok := ret.Get(0).(func() crypto.PrivKey); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(crypto.PrivKey)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetManagedThreadKey provides a mock function with given fields: threadKeyName
 func (_m *Keychain) GetManagedThreadKey(threadKeyName string) (thread.Key, error) {
 	ret := _m.Called(threadKeyName)
@@ -167,6 +227,45 @@ func (_m *Keychain) GetManagedThreadKey(threadKeyName string) (thread.Key, error
 	return r0, r1
 }
 
+// GetSigner provides a mock function with given fields:
+func (_m *Keychain) GetSigner() keychain.Signer {
+	ret := _m.Called()
+
+	var r0 keychain.Signer
+	if rf, ok := ret.Get(0).(func() keychain.Signer); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(keychain.Signer)
+		}
+	}
+
+	return r0
+}
+
+// GetStoreEncryptionKeys provides a mock function with given fields:
+func (_m *Keychain) GetStoreEncryptionKeys() ([][]byte, error) {
+	ret := _m.Called()
+
+	var r0 [][]byte
+	if rf, ok := ret.Get(0).(func() [][]byte); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([][]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStoredKeyPairInLibP2PFormat provides a mock function with given fields:
 func (_m *Keychain) GetStoredKeyPairInLibP2PFormat() (crypto.PrivKey, crypto.PubKey, error) {
 	ret := _m.Called()
@@ -220,6 +319,27 @@ func (_m *Keychain) GetStoredMnemonic() (string, error) {
 	return r0, r1
 }
 
+// GetStoredMnemonicLanguage provides a mock function with given fields:
+func (_m *Keychain) GetStoredMnemonicLanguage() (string, error) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetStoredPublicKey provides a mock function with given fields:
 func (_m *Keychain) GetStoredPublicKey() (crypto.PubKey, error) {
 	ret := _m.Called()
@@ -257,6 +377,20 @@ func (_m *Keychain) ImportExistingKeyPair(priv crypto.PrivKey, mnemonic string)
 	return r0
 }
 
+// RotateStoreEncryptionKey provides a mock function with given fields:
+func (_m *Keychain) RotateStoreEncryptionKey() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Sign provides a mock function with given fields: _a0
 func (_m *Keychain) Sign(_a0 []byte) ([]byte, error) {
 	ret := _m.Called(_a0)
@@ -293,3 +427,17 @@ func (_m *Keychain) StoreAppToken(tok *permissions.AppToken) error {
 
 	return r0
 }
+
+// UsesExternalSigner provides a mock function with given fields:
+func (_m *Keychain) UsesExternalSigner() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
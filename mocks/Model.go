@@ -5,7 +5,9 @@ package mocks
 import (
 	context "context"
 
+	json "encoding/json"
 	domain "github.com/FleekHQ/space-daemon/core/space/domain"
+
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/FleekHQ/space-daemon/core/textile/model"
@@ -16,29 +18,6 @@ type Model struct {
 	mock.Mock
 }
 
-// BucketBackupToggle provides a mock function with given fields: ctx, bucketSlug, backup
-func (_m *Model) BucketBackupToggle(ctx context.Context, bucketSlug string, backup bool) (*model.BucketSchema, error) {
-	ret := _m.Called(ctx, bucketSlug, backup)
-
-	var r0 *model.BucketSchema
-	if rf, ok := ret.Get(0).(func(context.Context, string, bool) *model.BucketSchema); ok {
-		r0 = rf(ctx, bucketSlug, backup)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*model.BucketSchema)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
-		r1 = rf(ctx, bucketSlug, backup)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
 // CreateBucket provides a mock function with given fields: ctx, bucketSlug, dbID
 func (_m *Model) CreateBucket(ctx context.Context, bucketSlug string, dbID string) (*model.BucketSchema, error) {
 	ret := _m.Called(ctx, bucketSlug, dbID)
@@ -108,6 +87,29 @@ func (_m *Model) CreateMirrorFile(ctx context.Context, mirrorFile *domain.Mirror
 	return r0, r1
 }
 
+// CreatePublicLink provides a mock function with given fields: ctx, link
+func (_m *Model) CreatePublicLink(ctx context.Context, link *model.PublicLinkSchema) (*model.PublicLinkSchema, error) {
+	ret := _m.Called(ctx, link)
+
+	var r0 *model.PublicLinkSchema
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PublicLinkSchema) *model.PublicLinkSchema); ok {
+		r0 = rf(ctx, link)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PublicLinkSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PublicLinkSchema) error); ok {
+		r1 = rf(ctx, link)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateReceivedFileViaInvitation provides a mock function with given fields: ctx, file, invitationId, accepted, key, sharedBy
 func (_m *Model) CreateReceivedFileViaInvitation(ctx context.Context, file domain.FullPath, invitationId string, accepted bool, key []byte, sharedBy string) (*model.ReceivedFileSchema, error) {
 	ret := _m.Called(ctx, file, invitationId, accepted, key, sharedBy)
@@ -177,6 +179,29 @@ func (_m *Model) CreateSentFileViaInvitation(ctx context.Context, file domain.Fu
 	return r0, r1
 }
 
+// CreateSentInvitation provides a mock function with given fields: ctx, invitation
+func (_m *Model) CreateSentInvitation(ctx context.Context, invitation *model.SentInvitationSchema) (*model.SentInvitationSchema, error) {
+	ret := _m.Called(ctx, invitation)
+
+	var r0 *model.SentInvitationSchema
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SentInvitationSchema) *model.SentInvitationSchema); ok {
+		r0 = rf(ctx, invitation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SentInvitationSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SentInvitationSchema) error); ok {
+		r1 = rf(ctx, invitation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSharedPublicKey provides a mock function with given fields: ctx, pubKey
 func (_m *Model) CreateSharedPublicKey(ctx context.Context, pubKey string) (*model.SharedPublicKeySchema, error) {
 	ret := _m.Called(ctx, pubKey)
@@ -200,6 +225,20 @@ func (_m *Model) CreateSharedPublicKey(ctx context.Context, pubKey string) (*mod
 	return r0, r1
 }
 
+// DeleteBucket provides a mock function with given fields: ctx, bucketSlug
+func (_m *Model) DeleteBucket(ctx context.Context, bucketSlug string) error {
+	ret := _m.Called(ctx, bucketSlug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, bucketSlug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteReceivedFiles provides a mock function with given fields: ctx, paths, keys
 func (_m *Model) DeleteReceivedFiles(ctx context.Context, paths []domain.FullPath, keys [][]byte) error {
 	ret := _m.Called(ctx, paths, keys)
@@ -228,6 +267,29 @@ func (_m *Model) DeleteSearchIndexRecord(ctx context.Context, name string, path
 	return r0
 }
 
+// ExportCollections provides a mock function with given fields: ctx
+func (_m *Model) ExportCollections(ctx context.Context) (map[string][]*json.RawMessage, error) {
+	ret := _m.Called(ctx)
+
+	var r0 map[string][]*json.RawMessage
+	if rf, ok := ret.Get(0).(func(context.Context) map[string][]*json.RawMessage); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string][]*json.RawMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindBucket provides a mock function with given fields: ctx, bucketSlug
 func (_m *Model) FindBucket(ctx context.Context, bucketSlug string) (*model.BucketSchema, error) {
 	ret := _m.Called(ctx, bucketSlug)
@@ -297,6 +359,52 @@ func (_m *Model) FindMirrorFileByPaths(ctx context.Context, paths []string) (map
 	return r0, r1
 }
 
+// FindPublicLink provides a mock function with given fields: ctx, linkID
+func (_m *Model) FindPublicLink(ctx context.Context, linkID string) (*model.PublicLinkSchema, error) {
+	ret := _m.Called(ctx, linkID)
+
+	var r0 *model.PublicLinkSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PublicLinkSchema); ok {
+		r0 = rf(ctx, linkID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PublicLinkSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, linkID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindPublicLinkByCid provides a mock function with given fields: ctx, fileCid
+func (_m *Model) FindPublicLinkByCid(ctx context.Context, fileCid string) (*model.PublicLinkSchema, error) {
+	ret := _m.Called(ctx, fileCid)
+
+	var r0 *model.PublicLinkSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.PublicLinkSchema); ok {
+		r0 = rf(ctx, fileCid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PublicLinkSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, fileCid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindPublicLinkReceivedFile provides a mock function with given fields: ctx, ipfsHash
 func (_m *Model) FindPublicLinkReceivedFile(ctx context.Context, ipfsHash string) (*model.ReceivedFileSchema, error) {
 	ret := _m.Called(ctx, ipfsHash)
@@ -343,6 +451,29 @@ func (_m *Model) FindReceivedFile(ctx context.Context, remoteDbID string, bucket
 	return r0, r1
 }
 
+// FindReceivedFileForPath provides a mock function with given fields: ctx, remoteDbID, bucket, path
+func (_m *Model) FindReceivedFileForPath(ctx context.Context, remoteDbID string, bucket string, path string) (*model.ReceivedFileSchema, error) {
+	ret := _m.Called(ctx, remoteDbID, bucket, path)
+
+	var r0 *model.ReceivedFileSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *model.ReceivedFileSchema); ok {
+		r0 = rf(ctx, remoteDbID, bucket, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ReceivedFileSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, remoteDbID, bucket, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // FindReceivedFilesByIds provides a mock function with given fields: ctx, ids
 func (_m *Model) FindReceivedFilesByIds(ctx context.Context, ids []string) ([]*model.ReceivedFileSchema, error) {
 	ret := _m.Called(ctx, ids)
@@ -389,6 +520,66 @@ func (_m *Model) FindSentFile(ctx context.Context, remoteDbID string, bucket str
 	return r0, r1
 }
 
+// FindSentFileForPath provides a mock function with given fields: ctx, remoteDbID, bucket, path
+func (_m *Model) FindSentFileForPath(ctx context.Context, remoteDbID string, bucket string, path string) (*model.SentFileSchema, error) {
+	ret := _m.Called(ctx, remoteDbID, bucket, path)
+
+	var r0 *model.SentFileSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) *model.SentFileSchema); ok {
+		r0 = rf(ctx, remoteDbID, bucket, path)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SentFileSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, remoteDbID, bucket, path)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindSentInvitation provides a mock function with given fields: ctx, invitationID
+func (_m *Model) FindSentInvitation(ctx context.Context, invitationID string) (*model.SentInvitationSchema, error) {
+	ret := _m.Called(ctx, invitationID)
+
+	var r0 *model.SentInvitationSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string) *model.SentInvitationSchema); ok {
+		r0 = rf(ctx, invitationID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SentInvitationSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, invitationID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportCollections provides a mock function with given fields: ctx, collections
+func (_m *Model) ImportCollections(ctx context.Context, collections map[string][]*json.RawMessage) error {
+	ret := _m.Called(ctx, collections)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, map[string][]*json.RawMessage) error); ok {
+		r0 = rf(ctx, collections)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // InitSearchIndexCollection provides a mock function with given fields: ctx
 func (_m *Model) InitSearchIndexCollection(ctx context.Context) error {
 	ret := _m.Called(ctx)
@@ -426,6 +617,29 @@ func (_m *Model) ListBuckets(ctx context.Context) ([]*model.BucketSchema, error)
 	return r0, r1
 }
 
+// ListPublicLinks provides a mock function with given fields: ctx, seek, limit
+func (_m *Model) ListPublicLinks(ctx context.Context, seek string, limit int) ([]*model.PublicLinkSchema, error) {
+	ret := _m.Called(ctx, seek, limit)
+
+	var r0 []*model.PublicLinkSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*model.PublicLinkSchema); ok {
+		r0 = rf(ctx, seek, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PublicLinkSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, seek, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListReceivedFiles provides a mock function with given fields: ctx, accepted, seek, limit
 func (_m *Model) ListReceivedFiles(ctx context.Context, accepted bool, seek string, limit int) ([]*model.ReceivedFileSchema, error) {
 	ret := _m.Called(ctx, accepted, seek, limit)
@@ -495,6 +709,29 @@ func (_m *Model) ListSentFiles(ctx context.Context, seek string, limit int) ([]*
 	return r0, r1
 }
 
+// ListSentInvitations provides a mock function with given fields: ctx, seek, limit
+func (_m *Model) ListSentInvitations(ctx context.Context, seek string, limit int) ([]*model.SentInvitationSchema, error) {
+	ret := _m.Called(ctx, seek, limit)
+
+	var r0 []*model.SentInvitationSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []*model.SentInvitationSchema); ok {
+		r0 = rf(ctx, seek, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SentInvitationSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, seek, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListSharedPublicKeys provides a mock function with given fields: ctx
 func (_m *Model) ListSharedPublicKeys(ctx context.Context) ([]*model.SharedPublicKeySchema, error) {
 	ret := _m.Called(ctx)
@@ -518,6 +755,20 @@ func (_m *Model) ListSharedPublicKeys(ctx context.Context) ([]*model.SharedPubli
 	return r0, r1
 }
 
+// Migrate provides a mock function with given fields: ctx
+func (_m *Model) Migrate(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // QuerySearchIndex provides a mock function with given fields: ctx, query
 func (_m *Model) QuerySearchIndex(ctx context.Context, query string) ([]*model.SearchIndexRecord, error) {
 	ret := _m.Called(ctx, query)
@@ -541,6 +792,98 @@ func (_m *Model) QuerySearchIndex(ctx context.Context, query string) ([]*model.S
 	return r0, r1
 }
 
+// RenameBucket provides a mock function with given fields: ctx, bucketSlug, newBucketSlug
+func (_m *Model) RenameBucket(ctx context.Context, bucketSlug string, newBucketSlug string) (*model.BucketSchema, error) {
+	ret := _m.Called(ctx, bucketSlug, newBucketSlug)
+
+	var r0 *model.BucketSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.BucketSchema); ok {
+		r0 = rf(ctx, bucketSlug, newBucketSlug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BucketSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bucketSlug, newBucketSlug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReplaceSharedPublicKey provides a mock function with given fields: ctx, oldPubKey, newPubKey
+func (_m *Model) ReplaceSharedPublicKey(ctx context.Context, oldPubKey string, newPubKey string) (*model.SharedPublicKeySchema, error) {
+	ret := _m.Called(ctx, oldPubKey, newPubKey)
+
+	var r0 *model.SharedPublicKeySchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.SharedPublicKeySchema); ok {
+		r0 = rf(ctx, oldPubKey, newPubKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SharedPublicKeySchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, oldPubKey, newPubKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBucketSettings provides a mock function with given fields: ctx, bucketSlug, settings
+func (_m *Model) UpdateBucketSettings(ctx context.Context, bucketSlug string, settings domain.BucketSettings) (*model.BucketSchema, error) {
+	ret := _m.Called(ctx, bucketSlug, settings)
+
+	var r0 *model.BucketSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.BucketSettings) *model.BucketSchema); ok {
+		r0 = rf(ctx, bucketSlug, settings)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BucketSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, domain.BucketSettings) error); ok {
+		r1 = rf(ctx, bucketSlug, settings)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBucketThreadKey provides a mock function with given fields: ctx, bucketSlug, threadKey
+func (_m *Model) UpdateBucketThreadKey(ctx context.Context, bucketSlug string, threadKey string) (*model.BucketSchema, error) {
+	ret := _m.Called(ctx, bucketSlug, threadKey)
+
+	var r0 *model.BucketSchema
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) *model.BucketSchema); ok {
+		r0 = rf(ctx, bucketSlug, threadKey)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BucketSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, bucketSlug, threadKey)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateMirrorFile provides a mock function with given fields: ctx, mirrorFile
 func (_m *Model) UpdateMirrorFile(ctx context.Context, mirrorFile *model.MirrorFileSchema) (*model.MirrorFileSchema, error) {
 	ret := _m.Called(ctx, mirrorFile)
@@ -564,6 +907,29 @@ func (_m *Model) UpdateMirrorFile(ctx context.Context, mirrorFile *model.MirrorF
 	return r0, r1
 }
 
+// UpdatePublicLink provides a mock function with given fields: ctx, link
+func (_m *Model) UpdatePublicLink(ctx context.Context, link *model.PublicLinkSchema) (*model.PublicLinkSchema, error) {
+	ret := _m.Called(ctx, link)
+
+	var r0 *model.PublicLinkSchema
+	if rf, ok := ret.Get(0).(func(context.Context, *model.PublicLinkSchema) *model.PublicLinkSchema); ok {
+		r0 = rf(ctx, link)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PublicLinkSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.PublicLinkSchema) error); ok {
+		r1 = rf(ctx, link)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateSearchIndexRecord provides a mock function with given fields: ctx, name, path, itemType, bucketSlug, dbId
 func (_m *Model) UpdateSearchIndexRecord(ctx context.Context, name string, path string, itemType model.SearchItemType, bucketSlug string, dbId string) (*model.SearchIndexRecord, error) {
 	ret := _m.Called(ctx, name, path, itemType, bucketSlug, dbId)
@@ -587,6 +953,43 @@ func (_m *Model) UpdateSearchIndexRecord(ctx context.Context, name string, path
 	return r0, r1
 }
 
+// UpdateSentFileRole provides a mock function with given fields: ctx, file, inviteePublicKey, role
+func (_m *Model) UpdateSentFileRole(ctx context.Context, file domain.FullPath, inviteePublicKey string, role domain.ShareRole) error {
+	ret := _m.Called(ctx, file, inviteePublicKey, role)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, domain.FullPath, string, domain.ShareRole) error); ok {
+		r0 = rf(ctx, file, inviteePublicKey, role)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateSentInvitation provides a mock function with given fields: ctx, invitation
+func (_m *Model) UpdateSentInvitation(ctx context.Context, invitation *model.SentInvitationSchema) (*model.SentInvitationSchema, error) {
+	ret := _m.Called(ctx, invitation)
+
+	var r0 *model.SentInvitationSchema
+	if rf, ok := ret.Get(0).(func(context.Context, *model.SentInvitationSchema) *model.SentInvitationSchema); ok {
+		r0 = rf(ctx, invitation)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SentInvitationSchema)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *model.SentInvitationSchema) error); ok {
+		r1 = rf(ctx, invitation)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpsertBucket provides a mock function with given fields: ctx, bucketSlug, dbID
 func (_m *Model) UpsertBucket(ctx context.Context, bucketSlug string, dbID string) (*model.BucketSchema, error) {
 	ret := _m.Called(ctx, bucketSlug, dbID)
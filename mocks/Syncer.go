@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	context "context"
+
 	domain "github.com/FleekHQ/space-daemon/core/space/domain"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -26,6 +28,20 @@ func (_m *Syncer) AddFileWatch(addFileInfo domain.AddWatchFile) error {
 	return r0
 }
 
+// AddSyncFolder provides a mock function with given fields: folder
+func (_m *Syncer) AddSyncFolder(folder domain.SyncFolder) error {
+	ret := _m.Called(folder)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(domain.SyncFolder) error); ok {
+		r0 = rf(folder)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetOpenFilePath provides a mock function with given fields: bucketSlug, bucketPath, dbID, cid
 func (_m *Syncer) GetOpenFilePath(bucketSlug string, bucketPath string, dbID string, cid string) (string, bool) {
 	ret := _m.Called(bucketSlug, bucketPath, dbID, cid)
@@ -46,3 +62,105 @@ func (_m *Syncer) GetOpenFilePath(bucketSlug string, bucketPath string, dbID str
 
 	return r0, r1
 }
+
+// ListFileConflicts provides a mock function with given fields:
+func (_m *Syncer) ListFileConflicts() ([]domain.FileConflict, error) {
+	ret := _m.Called()
+
+	var r0 []domain.FileConflict
+	if rf, ok := ret.Get(0).(func() []domain.FileConflict); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.FileConflict)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSyncFolders provides a mock function with given fields:
+func (_m *Syncer) ListSyncFolders() ([]domain.SyncFolder, error) {
+	ret := _m.Called()
+
+	var r0 []domain.SyncFolder
+	if rf, ok := ret.Get(0).(func() []domain.SyncFolder); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]domain.SyncFolder)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveBucket provides a mock function with given fields: bucketSlug
+func (_m *Syncer) RemoveBucket(bucketSlug string) error {
+	ret := _m.Called(bucketSlug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(bucketSlug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RemoveSyncFolder provides a mock function with given fields: localPath
+func (_m *Syncer) RemoveSyncFolder(localPath string) error {
+	ret := _m.Called(localPath)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(localPath)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RenameBucket provides a mock function with given fields: bucketSlug, newBucketSlug
+func (_m *Syncer) RenameBucket(bucketSlug string, newBucketSlug string) error {
+	ret := _m.Called(bucketSlug, newBucketSlug)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(bucketSlug, newBucketSlug)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResolveFileConflict provides a mock function with given fields: ctx, localPath, resolution
+func (_m *Syncer) ResolveFileConflict(ctx context.Context, localPath string, resolution domain.FileConflictResolution) error {
+	ret := _m.Called(ctx, localPath, resolution)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, domain.FileConflictResolution) error); ok {
+		r0 = rf(ctx, localPath, resolution)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -37,6 +37,36 @@ func (_m *Vault) Retrieve(uuid string, passphrase string, backupType domain.KeyB
 	return r0, r1
 }
 
+// RetrieveWithVersion provides a mock function with given fields: uuid, passphrase, backupType
+func (_m *Vault) RetrieveWithVersion(uuid string, passphrase string, backupType domain.KeyBackupType) ([]vault.VaultItem, vault.VkVersion, error) {
+	ret := _m.Called(uuid, passphrase, backupType)
+
+	var r0 []vault.VaultItem
+	if rf, ok := ret.Get(0).(func(string, string, domain.KeyBackupType) []vault.VaultItem); ok {
+		r0 = rf(uuid, passphrase, backupType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]vault.VaultItem)
+		}
+	}
+
+	var r1 vault.VkVersion
+	if rf, ok := ret.Get(1).(func(string, string, domain.KeyBackupType) vault.VkVersion); ok {
+		r1 = rf(uuid, passphrase, backupType)
+	} else {
+		r1 = ret.Get(1).(vault.VkVersion)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, string, domain.KeyBackupType) error); ok {
+		r2 = rf(uuid, passphrase, backupType)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Store provides a mock function with given fields: uuid, passphrase, backupType, apiToken, items
 func (_m *Vault) Store(uuid string, passphrase string, backupType domain.KeyBackupType, apiToken string, items []vault.VaultItem) (*vault.StoredVault, error) {
 	ret := _m.Called(uuid, passphrase, backupType, apiToken, items)